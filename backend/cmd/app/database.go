@@ -0,0 +1,143 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"io/fs"
+	"net/url"
+	"time"
+
+	_ "github.com/go-sql-driver/mysql"
+
+	"github.com/rimvydascivilis/book-tracker/backend/domain"
+	"github.com/rimvydascivilis/book-tracker/backend/internal/migration"
+	mariadbRepo "github.com/rimvydascivilis/book-tracker/backend/internal/repository/mariadb"
+	memoryRepo "github.com/rimvydascivilis/book-tracker/backend/internal/repository/memory"
+	sqliteRepo "github.com/rimvydascivilis/book-tracker/backend/internal/repository/sqlite"
+	"github.com/rimvydascivilis/book-tracker/backend/migrations"
+)
+
+type repositories struct {
+	tx       domain.TxManager
+	user     domain.UserRepository
+	book     domain.BookRepository
+	author   domain.AuthorRepository
+	goal     domain.GoalRepository
+	reading  domain.ReadingRepository
+	progress domain.ProgressRepository
+	list     domain.ListRepository
+	listItem domain.ListItemRepository
+	note     domain.NoteRepository
+	imports  domain.ImportRepository
+	sessions domain.ReadingSessionRepository
+	auth     domain.AuthSessionRepository
+	tokens   domain.AccessTokenRepository
+	accounts domain.LocalAccountRepository
+}
+
+// openDatabase connects to the configured backend and returns the migrations
+// written for its dialect.
+func openDatabase(cfg domain.Config) (*sql.DB, fs.FS, error) {
+	switch cfg.DBDriver {
+	case domain.DBDriverMariaDB:
+		val := url.Values{}
+		val.Add("parseTime", "1")
+		// Instants are kept in UTC and days are counted in the timezone of
+		// each user, not of the server or the database.
+		val.Add("loc", "UTC")
+		val.Add("time_zone", "'+00:00'")
+		dsn := fmt.Sprintf("%s?%s", cfg.DBUrl, val.Encode())
+		db, err := sql.Open(`mysql`, dsn)
+		if err != nil {
+			return nil, nil, err
+		}
+		migrationsFS, err := fs.Sub(migrations.MariaDB, "mariadb")
+		return db, migrationsFS, err
+	case domain.DBDriverSQLite:
+		db, err := sqliteRepo.Open(cfg.DBUrl)
+		if err != nil {
+			return nil, nil, err
+		}
+		migrationsFS, err := fs.Sub(migrations.SQLite, "sqlite")
+		return db, migrationsFS, err
+	default:
+		return nil, nil, fmt.Errorf("unsupported database driver %q", cfg.DBDriver)
+	}
+}
+
+// schemaVersion returns the newest migration known to the binary. Both
+// dialects share the same version numbers.
+func schemaVersion() (int64, error) {
+	migrationsFS, err := fs.Sub(migrations.SQLite, "sqlite")
+	if err != nil {
+		return 0, err
+	}
+	return migration.LatestVersion(migrationsFS)
+}
+
+func newRepositories(driver string, db *sql.DB) repositories {
+	if driver == domain.DBDriverSQLite {
+		return repositories{
+			tx:       sqliteRepo.NewTxManager(db),
+			user:     sqliteRepo.NewUserRepository(db),
+			book:     sqliteRepo.NewBookRepository(db),
+			author:   sqliteRepo.NewAuthorRepository(db),
+			goal:     sqliteRepo.NewGoalRepository(db),
+			reading:  sqliteRepo.NewReadingRepository(db),
+			progress: sqliteRepo.NewProgressRepository(db),
+			list:     sqliteRepo.NewListRepository(db),
+			listItem: sqliteRepo.NewListItemRepository(db),
+			note:     sqliteRepo.NewNoteRepository(db),
+			imports:  sqliteRepo.NewImportRepository(db),
+			sessions: sqliteRepo.NewReadingSessionRepository(db),
+			auth:     sqliteRepo.NewAuthSessionRepository(db),
+			tokens:   sqliteRepo.NewAccessTokenRepository(db),
+			accounts: sqliteRepo.NewLocalAccountRepository(db),
+		}
+	}
+
+	return repositories{
+		tx:       mariadbRepo.NewTxManager(db),
+		user:     mariadbRepo.NewUserRepository(db),
+		book:     mariadbRepo.NewBookRepository(db),
+		author:   mariadbRepo.NewAuthorRepository(db),
+		goal:     mariadbRepo.NewGoalRepository(db),
+		reading:  mariadbRepo.NewReadingRepository(db),
+		progress: mariadbRepo.NewProgressRepository(db),
+		list:     mariadbRepo.NewListRepository(db),
+		listItem: mariadbRepo.NewListItemRepository(db),
+		note:     mariadbRepo.NewNoteRepository(db),
+		imports:  mariadbRepo.NewImportRepository(db),
+		sessions: mariadbRepo.NewReadingSessionRepository(db),
+		auth:     mariadbRepo.NewAuthSessionRepository(db),
+		tokens:   mariadbRepo.NewAccessTokenRepository(db),
+		accounts: mariadbRepo.NewLocalAccountRepository(db),
+	}
+}
+
+// newDemoRepositories returns repositories backed by a seeded in-memory store.
+func newDemoRepositories(ctx context.Context) (repositories, error) {
+	store := memoryRepo.NewStore()
+	if _, err := memoryRepo.Seed(ctx, store, time.Now()); err != nil {
+		return repositories{}, err
+	}
+
+	return repositories{
+		tx:       memoryRepo.NewTxManager(store),
+		user:     memoryRepo.NewUserRepository(store),
+		book:     memoryRepo.NewBookRepository(store),
+		author:   memoryRepo.NewAuthorRepository(store),
+		goal:     memoryRepo.NewGoalRepository(store),
+		reading:  memoryRepo.NewReadingRepository(store),
+		progress: memoryRepo.NewProgressRepository(store),
+		list:     memoryRepo.NewListRepository(store),
+		listItem: memoryRepo.NewListItemRepository(store),
+		note:     memoryRepo.NewNoteRepository(store),
+		imports:  memoryRepo.NewImportRepository(store),
+		sessions: memoryRepo.NewReadingSessionRepository(store),
+		auth:     memoryRepo.NewAuthSessionRepository(store),
+		tokens:   memoryRepo.NewAccessTokenRepository(store),
+		accounts: memoryRepo.NewLocalAccountRepository(store),
+	}, nil
+}
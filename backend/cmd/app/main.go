@@ -1,44 +1,48 @@
 package main
 
 import (
+	"context"
 	"database/sql"
+	"flag"
 	"fmt"
 	"log"
-	"net/url"
+	"net/http"
+	"os"
 	"time"
 
-	_ "github.com/go-sql-driver/mysql"
 	echojwt "github.com/labstack/echo-jwt/v4"
 	"github.com/labstack/echo/v4"
 	"github.com/labstack/echo/v4/middleware"
 
 	"github.com/rimvydascivilis/book-tracker/backend/config"
-	mariadbRepo "github.com/rimvydascivilis/book-tracker/backend/internal/repository/mariadb"
+	"github.com/rimvydascivilis/book-tracker/backend/domain"
+	"github.com/rimvydascivilis/book-tracker/backend/internal/migration"
+	memoryRepo "github.com/rimvydascivilis/book-tracker/backend/internal/repository/memory"
 	"github.com/rimvydascivilis/book-tracker/backend/internal/rest"
+	"github.com/rimvydascivilis/book-tracker/backend/services/archive"
 	"github.com/rimvydascivilis/book-tracker/backend/services/auth"
 	"github.com/rimvydascivilis/book-tracker/backend/services/book"
 	"github.com/rimvydascivilis/book-tracker/backend/services/goal"
+	"github.com/rimvydascivilis/book-tracker/backend/services/importer"
 	"github.com/rimvydascivilis/book-tracker/backend/services/list"
+	"github.com/rimvydascivilis/book-tracker/backend/services/mail"
+	"github.com/rimvydascivilis/book-tracker/backend/services/metadata"
 	"github.com/rimvydascivilis/book-tracker/backend/services/note"
 	"github.com/rimvydascivilis/book-tracker/backend/services/progress"
 	"github.com/rimvydascivilis/book-tracker/backend/services/reading"
+	"github.com/rimvydascivilis/book-tracker/backend/services/readingsession"
 	"github.com/rimvydascivilis/book-tracker/backend/services/stat"
+	"github.com/rimvydascivilis/book-tracker/backend/services/streak"
 	"github.com/rimvydascivilis/book-tracker/backend/services/user"
 	"github.com/rimvydascivilis/book-tracker/backend/services/validation"
 	"github.com/rimvydascivilis/book-tracker/backend/utils"
 )
 
-func main() {
-	cfg := config.LoadConfig()
-
-	utils.SetupLogger(cfg.LogLevel)
-
+// setupDatabase connects to the configured database and handles the migrate
+// subcommand. The server is not started while migrations are pending.
+func setupDatabase(cfg domain.Config, args []string) *sql.DB {
 	// Database connection
-	val := url.Values{}
-	val.Add("parseTime", "1")
-	val.Add("loc", "Europe/Vilnius")
-	dsn := fmt.Sprintf("%s?%s", cfg.DBUrl, val.Encode())
-	dbConn, err := sql.Open(`mysql`, dsn)
+	dbConn, migrationsFS, err := openDatabase(cfg)
 	if err != nil {
 		utils.Fatal("failed to open connection to database", err)
 	}
@@ -47,20 +51,88 @@ func main() {
 		utils.Fatal("failed to ping database", err)
 	}
 
-	defer func() {
-		err := dbConn.Close()
+	// Migrations
+	migrator, err := migration.NewMigrator(dbConn, migrationsFS)
+	if err != nil {
+		utils.Fatal("failed to load migrations", err)
+	}
+
+	if len(args) > 0 && args[0] == "migrate" {
+		err := runMigrate(context.Background(), migrator, args[1:], os.Stdout)
+		_ = dbConn.Close()
 		if err != nil {
-			utils.Fatal("got error when closing the DB connection", err)
+			utils.Fatal("migration failed", err)
 		}
-	}()
+		os.Exit(0)
+	}
+
+	pending, err := migrator.Pending(context.Background())
+	if err != nil {
+		utils.Fatal("failed to check schema version", err)
+	}
+	if len(pending) > 0 {
+		utils.Fatal(fmt.Sprintf("database has %d pending migrations, run `migrate up` first", len(pending)), nil)
+	}
+
+	return dbConn
+}
+
+func main() {
+	cfg, err := config.LoadConfig()
+	if err != nil {
+		utils.Fatal("invalid configuration", err)
+	}
+
+	utils.SetupLogger(cfg.LogLevel)
+
+	demo := flag.Bool("demo", false, "serve seeded in-memory data instead of using a database")
+	flag.Parse()
+
+	var repos repositories
+	var oauth2Svc domain.OAuth2Service
+	if *demo {
+		var err error
+		repos, err = newDemoRepositories(context.Background())
+		if err != nil {
+			utils.Fatal("failed to seed demo data", err)
+		}
+		oauth2Svc = auth.NewDemoOAuth2Service(memoryRepo.DemoEmail)
+		utils.Info("running in demo mode, data is kept in memory", nil)
+	} else {
+		dbConn := setupDatabase(cfg, flag.Args())
+		defer func() {
+			err := dbConn.Close()
+			if err != nil {
+				utils.Fatal("got error when closing the DB connection", err)
+			}
+		}()
+		repos = newRepositories(cfg.DBDriver, dbConn)
+
+		if len(cfg.OIDCProviders) > 0 {
+			oauth2Svc = auth.NewOIDCService(cfg.OIDCProviders, &http.Client{Timeout: 5 * time.Second})
+		} else {
+			googleOauth2Svc, err := auth.NewGoogleOAuth2Service()
+			if err != nil {
+				utils.Fatal("failed to create Google OAuth2 service", err)
+			}
+			oauth2Svc = googleOauth2Svc
+		}
+	}
 
 	e := echo.New()
+	// X-Forwarded-For is only trusted from proxies on private networks, so
+	// clients cannot pick the address they are rate limited by.
+	e.IPExtractor = echo.ExtractIPFromXFFHeader()
 
 	// Middlewares
 	e.Use(middleware.CORS())
 	e.Use(middleware.Recover())
 	e.Use(middleware.TimeoutWithConfig(middleware.TimeoutConfig{
-		Skipper: middleware.DefaultSkipper,
+		// Exports are streamed and archive uploads can be large; the timeout
+		// handler would buffer them and cut them off.
+		Skipper: func(c echo.Context) bool {
+			return c.Path() == "/api/export" || c.Path() == "/api/import/archive"
+		},
 		OnTimeoutRouteErrorHandler: func(err error, c echo.Context) {
 			msg := fmt.Sprintf("request timed out on route %s", c.Path())
 			utils.Error(msg, err)
@@ -81,68 +153,134 @@ func main() {
 		},
 	}))
 
-	// Repositories
-	userRepo := mariadbRepo.NewUserRepository(dbConn)
-	bookRepo := mariadbRepo.NewBookRepository(dbConn)
-	goalRepo := mariadbRepo.NewGoalRepository(dbConn)
-	readingRepo := mariadbRepo.NewReadingRepository(dbConn)
-	progressRepo := mariadbRepo.NewProgressRepository(dbConn)
-	listRepo := mariadbRepo.NewListRepository(dbConn)
-	listItemRepo := mariadbRepo.NewListItemRepository(dbConn)
-	noteRepo := mariadbRepo.NewNoteRepository(dbConn)
-
 	// Services
+	metadataProvider := metadata.NewCachedProvider(
+		metadata.NewOpenLibraryProvider(cfg.MetadataURL, &http.Client{Timeout: 4 * time.Second}),
+		cfg.MetadataCacheTTL,
+	)
 	validationSvc := validation.NewValidationService()
-	googleOauth2Svc, err := auth.NewGoogleOAuth2Service()
+	jwtSvc := auth.NewJWTService(cfg.JWTSecret, cfg.AccessTokenTTL, repos.user)
+	userSvc := user.NewUserService(repos.user, validationSvc)
+	accessTokenSvc := auth.NewAccessTokenService(repos.tokens, validationSvc)
+	authSvc := auth.NewAuthService(userSvc, oauth2Svc, jwtSvc, repos.auth, repos.tx, cfg.RefreshTokenTTL)
+	var mailer domain.Mailer = mail.NewFileMailer(cfg.MailDir, cfg.MailFrom)
+	if cfg.SMTPHost != "" {
+		mailer = mail.NewSMTPMailer(cfg.SMTPHost, cfg.SMTPPort, cfg.SMTPUsername, cfg.SMTPPassword, cfg.MailFrom)
+	}
+	localAuthSvc := auth.NewLocalAuthService(authSvc, repos.user, repos.accounts, mailer, repos.tx, validationSvc,
+		cfg.AppURL)
+	bookSvc := book.NewBookService(repos.book, repos.author, repos.tx, metadataProvider, validationSvc)
+	streakSvc := streak.NewStreakService(repos.progress, repos.user)
+	goalSvc := goal.NewGoalService(repos.goal, repos.progress, repos.user, streakSvc, repos.tx, validationSvc)
+	readingSvc := reading.NewReadingService(repos.reading, repos.progress, repos.book, repos.user, repos.tx,
+		validationSvc, cfg.PaceWindows)
+	progressSvc := progress.NewProgressService(repos.progress, repos.reading, repos.user, repos.tx, validationSvc)
+	sessionSvc := readingsession.NewReadingSessionService(repos.sessions, repos.reading, repos.progress, repos.user,
+		progressSvc, repos.tx, cfg.SessionTimeout)
+	listSvc := list.NewListService(repos.list, repos.listItem, repos.book, repos.tx, validationSvc)
+	noteSvc := note.NewNoteService(repos.book, repos.note, validationSvc)
+	statSvc := stat.NewStatService(repos.progress, repos.reading, repos.goal)
+	importSvc := importer.NewImportService(repos.imports, bookSvc, repos.reading, repos.progress,
+		repos.list, repos.listItem, repos.tx, validationSvc)
+	if err := importSvc.ResumeImports(context.Background()); err != nil {
+		utils.Error("failed to resume unfinished imports", err)
+	}
+	go func() {
+		for range time.Tick(time.Minute) {
+			if _, err := sessionSvc.CloseExpiredReadingSessions(context.Background()); err != nil {
+				utils.Error("failed to close expired reading sessions", err)
+			}
+		}
+	}()
+	version, err := schemaVersion()
 	if err != nil {
-		utils.Fatal("failed to create Google OAuth2 service", err)
+		utils.Fatal("failed to load migrations", err)
 	}
-	jwtSvc := auth.NewJWTService(cfg.JWTSecret, userRepo)
-	userSvc := user.NewUserService(userRepo, validationSvc)
-	authSvc := auth.NewAuthService(userSvc, googleOauth2Svc, jwtSvc)
-	bookSvc := book.NewBookService(bookRepo, validationSvc)
-	goalSvc := goal.NewGoalService(goalRepo, progressRepo, readingRepo, validationSvc)
-	readingSvc := reading.NewReadingService(readingRepo, progressRepo, bookRepo, validationSvc)
-	progressSvc := progress.NewProgressService(progressRepo, readingRepo, validationSvc)
-	listSvc := list.NewListService(listRepo, listItemRepo, bookRepo, validationSvc)
-	noteSvc := note.NewNoteService(bookRepo, noteRepo, validationSvc)
-	statSvc := stat.NewStatService(progressRepo, goalRepo)
+	archiveSvc := archive.NewArchiveService(repos.book, repos.author, repos.reading, repos.progress, repos.goal,
+		repos.list, repos.listItem, repos.note, repos.tx, validationSvc, version)
 
 	// Handlers
 	authH := rest.NewAuthHandler(authSvc)
+	localAuthH := rest.NewLocalAuthHandler(localAuthSvc)
+	accessTokenH := rest.NewAccessTokenHandler(accessTokenSvc)
+	userH := rest.NewUserHandler(userSvc)
 	bookH := rest.NewBookHandler(bookSvc)
 	goalH := rest.NewGoalHandler(goalSvc)
 	readingH := rest.NewReadingHandler(readingSvc)
 	progressH := rest.NewProgressHandler(progressSvc)
+	sessionH := rest.NewReadingSessionHandler(sessionSvc)
 	listH := rest.NewListHandler(listSvc)
 	noteH := rest.NewNoteHandler(noteSvc)
 	statH := rest.NewStatHandler(statSvc)
+	streakH := rest.NewStreakHandler(streakSvc)
+	importH := rest.NewImportHandler(importSvc)
+	archiveH := rest.NewArchiveHandler(archiveSvc)
 
 	// Route groups
 	api := e.Group("/api")
+	// Authenticated routes take a session JWT or a personal access token.
 	authenticatedApi := api.Group("", echojwt.WithConfig(echojwt.Config{
-		SigningKey: []byte(cfg.JWTSecret),
-		ContextKey: "user",
-	}))
+		ParseTokenFunc: rest.ParseToken([]byte(cfg.JWTSecret), accessTokenSvc),
+		ContextKey:     "user",
+	}), rest.CheckScope)
 
 	// Unauthenticated routes
 	api.POST("/auth/login", authH.Login)
+	api.POST("/auth/refresh", authH.Refresh) // {"refresh_token": "..."}
+	if cfg.LocalAccounts {
+		// Passwords are slow to hash on purpose, so clients are limited.
+		passwordApi := api.Group("/auth", rest.RateLimit(cfg.AuthRateLimit))
+		passwordApi.POST("/register", localAuthH.Register)              // {"email": "me@example.com", "password": "..."}
+		passwordApi.POST("/login/password", localAuthH.Login)           // {"email": "me@example.com", "password": "..."}
+		passwordApi.POST("/verify-email", localAuthH.VerifyEmail)       // {"token": "..."}
+		passwordApi.POST("/forgot-password", localAuthH.ForgotPassword) // {"email": "me@example.com"}
+		passwordApi.POST("/reset-password", localAuthH.ResetPassword)   // {"token": "...", "password": "..."}
+	}
 
 	// Authenticated routes
+	authenticatedApi.POST("/auth/logout", authH.Logout)
+	authenticatedApi.GET("/auth/sessions", authH.GetSessions)
+	authenticatedApi.DELETE("/auth/sessions/:id", authH.DeleteSession)
+	authenticatedApi.GET("/auth/tokens", accessTokenH.GetAccessTokens)
+	authenticatedApi.POST("/auth/tokens", accessTokenH.CreateAccessToken) // {"name": "Kobo sync", "scope": "progress-write", "expires_at": "2025-01-01T00:00:00Z"}
+	authenticatedApi.DELETE("/auth/tokens/:id", accessTokenH.DeleteAccessToken)
+	authenticatedApi.GET("/user", userH.GetUser)
+	authenticatedApi.PUT("/user/settings", userH.UpdateUserSettings) // {"timezone": "Europe/Vilnius", "rest_days": ["sunday"]}
+
 	authenticatedApi.GET("/books", bookH.GetBooks)
-	authenticatedApi.GET("/books/search", bookH.SearchBooks) // ?title=My%20book
-	authenticatedApi.POST("/books", bookH.CreateBook)
+	authenticatedApi.GET("/books/search", bookH.SearchBooks) // ?q=My%20book
+	authenticatedApi.GET("/books/lookup", bookH.LookupBook)  // ?isbn=9780132350884 or ?title=Clean%20Code&author=Martin
+	authenticatedApi.POST("/books", bookH.CreateBook)        // {"title": "My book"} or {"isbn": "9780132350884"}
 	authenticatedApi.PUT("/books/:id", bookH.UpdateBook)
 	authenticatedApi.DELETE("/books/:id", bookH.DeleteBook)
+	authenticatedApi.GET("/books/:id/readings", readingH.GetBookReadings)
+
+	authenticatedApi.GET("/goals", goalH.GetGoals)
+	authenticatedApi.GET("/goals/progress", goalH.GetGoalProgress)
+	authenticatedApi.GET("/goals/history", goalH.GetGoalHistory)
+	authenticatedApi.POST("/goals", goalH.CreateGoal)    // {"type": "books", "frequency": "yearly", "value": 24}
+	authenticatedApi.PUT("/goals/:id", goalH.UpdateGoal) // {"value": 30}
+	authenticatedApi.DELETE("/goals/:id", goalH.DeleteGoal)
 
-	authenticatedApi.GET("/goal", goalH.GetGoal)
-	authenticatedApi.GET("/goal/progress", goalH.GetGoalProgress)
-	authenticatedApi.PUT("/goal", goalH.SetGoal)
+	// Deprecated: the single goal API, kept for clients older than /goals.
+	authenticatedApi.GET("/goal", goalH.GetLegacyGoal)
+	authenticatedApi.GET("/goal/progress", goalH.GetLegacyGoalProgress)
+	authenticatedApi.PUT("/goal", goalH.SetLegacyGoal)
 
-	authenticatedApi.GET("/readings", readingH.GetReadings)
+	authenticatedApi.GET("/readings", readingH.GetReadings) // ?status=paused
 	authenticatedApi.POST("/readings", readingH.CreateReading)
+	authenticatedApi.PUT("/readings/:id", readingH.UpdateReading) // {"total_pages": 320, "link": "https://...", "target_date": "2024-06-01T00:00:00Z"}
+	authenticatedApi.DELETE("/readings/:id", readingH.DeleteReading)
+	authenticatedApi.PATCH("/readings/:id/status", readingH.UpdateReadingStatus) // {"status": "abandoned"}
+	authenticatedApi.GET("/readings/:id/progress", progressH.GetReadingProgress)
+	authenticatedApi.GET("/readings/:id/pace", readingH.GetReadingPace) // ?windows=7,30
+	authenticatedApi.GET("/readings/:id/sessions", sessionH.GetReadingSessions)
+	authenticatedApi.POST("/readings/:id/sessions/start", sessionH.StartReadingSession)
+	authenticatedApi.POST("/readings/:id/sessions/stop", sessionH.StopReadingSession) // {"page": 213}
 
-	authenticatedApi.POST("/progress/:readingId", progressH.CreateProgress)
+	authenticatedApi.POST("/progress/:readingId", progressH.CreateProgress) // {"pages": 20} or {"page": 213}; {"page": 90, "correction": true} goes back
+	authenticatedApi.PUT("/progress/:id", progressH.UpdateProgress)         // {"pages": 20, "date": "2024-03-01T00:00:00Z"}
+	authenticatedApi.DELETE("/progress/:id", progressH.DeleteProgress)
 
 	authenticatedApi.GET("/lists", listH.ListLists)
 	authenticatedApi.GET("/list", listH.GetList)                                      // ?list_id=1
@@ -154,7 +292,14 @@ func main() {
 	authenticatedApi.POST("/notes/:book_id", noteH.CreateNote)   // /notes/1 {"page_number": 1, "content": "My note"}
 	authenticatedApi.DELETE("/notes/:note_id", noteH.DeleteNote) // /notes/1
 
+	authenticatedApi.GET("/stats/streaks", streakH.GetStreaks)
 	authenticatedApi.GET("/stats/:frequency", statH.GetProgress) // /stats/monthly?year=2021&month=1
 
+	authenticatedApi.POST("/import/goodreads", importH.ImportGoodreads) // multipart, CSV in the "file" field
+	authenticatedApi.GET("/import/jobs/:id", importH.GetImportJob)
+	authenticatedApi.POST("/import/archive", archiveH.Restore) // multipart, zip from /export in the "file" field
+
+	authenticatedApi.GET("/export", archiveH.Export)
+
 	log.Fatal(e.Start(cfg.ServerAddr))
 }
@@ -0,0 +1,62 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"text/tabwriter"
+
+	"github.com/rimvydascivilis/book-tracker/backend/internal/migration"
+)
+
+const migrateUsage = "usage: engine migrate up|down|status"
+
+func runMigrate(ctx context.Context, migrator *migration.Migrator, args []string, out io.Writer) error {
+	if len(args) != 1 {
+		return errors.New(migrateUsage)
+	}
+
+	switch args[0] {
+	case "up":
+		applied, err := migrator.Up(ctx)
+		for _, m := range applied {
+			fmt.Fprintf(out, "applied %04d_%s\n", m.Version, m.Name)
+		}
+		if err != nil {
+			return err
+		}
+		if len(applied) == 0 {
+			fmt.Fprintln(out, "no pending migrations")
+		}
+	case "down":
+		m, ok, err := migrator.Down(ctx)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			fmt.Fprintln(out, "no applied migrations")
+			return nil
+		}
+		fmt.Fprintf(out, "rolled back %04d_%s\n", m.Version, m.Name)
+	case "status":
+		statuses, err := migrator.Status(ctx)
+		if err != nil {
+			return err
+		}
+		w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(w, "VERSION\tNAME\tAPPLIED AT")
+		for _, s := range statuses {
+			appliedAt := "pending"
+			if s.AppliedAt != nil {
+				appliedAt = s.AppliedAt.Format("2006-01-02 15:04:05")
+			}
+			fmt.Fprintf(w, "%04d\t%s\t%s\n", s.Version, s.Name, appliedAt)
+		}
+		return w.Flush()
+	default:
+		return errors.New(migrateUsage)
+	}
+
+	return nil
+}
@@ -1,24 +1,57 @@
 package config
 
 import (
+	"fmt"
 	"os"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/rimvydascivilis/book-tracker/backend/domain"
 
 	"github.com/joho/godotenv"
 )
 
-func LoadConfig() domain.Config {
+// LoadConfig reads the configuration from the environment and .env. It fails
+// on settings that cannot be used as given.
+func LoadConfig() (domain.Config, error) {
 	_ = godotenv.Load()
 
+	oidcProviders, err := GetOIDCProviders("OIDC_PROVIDERS")
+	if err != nil {
+		return domain.Config{}, err
+	}
+
 	config := domain.Config{
 		ServerAddr: GetEnvWithDefault("SERVER_ADDRESS", ":8080"),
+		DBDriver:   GetEnvWithDefault("DATABASE_DRIVER", domain.DBDriverMariaDB),
 		DBUrl:      GetEnvWithDefault("DATABASE_URL", "user:userpassword@tcp(localhost:3306)/book"),
 		LogLevel:   GetEnvWithDefault("LOG_LEVEL", "INFO"),
 		JWTSecret:  GetEnvWithDefault("JWT_SECRET", "Sup3rS3cr3t"),
+
+		MetadataURL:      GetEnvWithDefault("METADATA_URL", "https://openlibrary.org"),
+		MetadataCacheTTL: GetDurationWithDefault("METADATA_CACHE_TTL", 24*time.Hour),
+
+		SessionTimeout: GetDurationWithDefault("SESSION_TIMEOUT", 4*time.Hour),
+		PaceWindows:    GetIntListWithDefault("PACE_WINDOWS", []int64{7, 30}),
+
+		AccessTokenTTL:  GetDurationWithDefault("ACCESS_TOKEN_TTL", 15*time.Minute),
+		RefreshTokenTTL: GetDurationWithDefault("REFRESH_TOKEN_TTL", 30*24*time.Hour),
+
+		OIDCProviders: oidcProviders,
+
+		LocalAccounts: GetBoolWithDefault("LOCAL_ACCOUNTS", false),
+		AppURL:        GetEnvWithDefault("APP_URL", "http://localhost:3000"),
+		AuthRateLimit: GetIntWithDefault("AUTH_RATE_LIMIT", 10),
+		SMTPHost:      os.Getenv("SMTP_HOST"),
+		SMTPPort:      GetIntWithDefault("SMTP_PORT", 587),
+		SMTPUsername:  os.Getenv("SMTP_USERNAME"),
+		SMTPPassword:  os.Getenv("SMTP_PASSWORD"),
+		MailFrom:      GetEnvWithDefault("MAIL_FROM", "Book Tracker <noreply@localhost>"),
+		MailDir:       GetEnvWithDefault("MAIL_DIR", "mail"),
 	}
 
-	return config
+	return config, nil
 }
 
 func GetEnvWithDefault(v string, f string) string {
@@ -28,3 +61,77 @@ func GetEnvWithDefault(v string, f string) string {
 	}
 	return env
 }
+
+// GetDurationWithDefault parses v with time.ParseDuration, falling back to f
+// when it is unset or invalid.
+func GetDurationWithDefault(v string, f time.Duration) time.Duration {
+	d, err := time.ParseDuration(os.Getenv(v))
+	if err != nil {
+		return f
+	}
+	return d
+}
+
+// GetBoolWithDefault parses v with strconv.ParseBool, falling back to f when
+// it is unset or invalid.
+func GetBoolWithDefault(v string, f bool) bool {
+	b, err := strconv.ParseBool(os.Getenv(v))
+	if err != nil {
+		return f
+	}
+	return b
+}
+
+// GetIntWithDefault parses v as a positive integer, falling back to f when it
+// is unset or invalid.
+func GetIntWithDefault(v string, f int) int {
+	n, err := strconv.Atoi(os.Getenv(v))
+	if err != nil || n < 1 {
+		return f
+	}
+	return n
+}
+
+// GetIntListWithDefault parses v as comma separated positive integers,
+// falling back to f when it is unset or invalid.
+func GetIntListWithDefault(v string, f []int64) []int64 {
+	env := os.Getenv(v)
+	if env == "" {
+		return f
+	}
+
+	var list []int64
+	for _, part := range strings.Split(env, ",") {
+		n, err := strconv.ParseInt(strings.TrimSpace(part), 10, 64)
+		if err != nil || n < 1 {
+			return f
+		}
+		list = append(list, n)
+	}
+	return list
+}
+
+// GetOIDCProviders reads the comma separated provider names in v and, for
+// each name, its OIDC_<NAME>_ISSUER and OIDC_<NAME>_CLIENT_ID. A provider
+// missing either is an error, rather than logins falling back to Google.
+func GetOIDCProviders(v string) ([]domain.OIDCProvider, error) {
+	var providers []domain.OIDCProvider
+	for _, name := range strings.Split(os.Getenv(v), ",") {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			continue
+		}
+
+		prefix := "OIDC_" + strings.ToUpper(strings.ReplaceAll(name, "-", "_")) + "_"
+		provider := domain.OIDCProvider{
+			Name:     name,
+			Issuer:   os.Getenv(prefix + "ISSUER"),
+			ClientID: os.Getenv(prefix + "CLIENT_ID"),
+		}
+		if provider.Issuer == "" || provider.ClientID == "" {
+			return nil, fmt.Errorf("OIDC provider %q needs both %sISSUER and %sCLIENT_ID", name, prefix, prefix)
+		}
+		providers = append(providers, provider)
+	}
+	return providers, nil
+}
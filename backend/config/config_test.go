@@ -4,39 +4,134 @@ package config
 import (
 	"os"
 	"testing"
+	"time"
 
+	"github.com/rimvydascivilis/book-tracker/backend/domain"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestLoadConfig_WithEnvVars(t *testing.T) {
 	os.Setenv("SERVER_ADDRESS", "localhost:9090")
 	os.Setenv("DATABASE_URL", "user:testpassword@tcp(localhost:3306)/book_test")
+	os.Setenv("DATABASE_DRIVER", "sqlite")
 	os.Setenv("LOG_LEVEL", "DEBUG")
 	os.Setenv("JWT_SECRET", "SuperSecretTestJWT")
+	os.Setenv("METADATA_URL", "http://localhost:9999")
+	os.Setenv("METADATA_CACHE_TTL", "5m")
+	os.Setenv("SESSION_TIMEOUT", "90m")
+	os.Setenv("PACE_WINDOWS", "7, 14,30")
+	os.Setenv("ACCESS_TOKEN_TTL", "5m")
+	os.Setenv("REFRESH_TOKEN_TTL", "168h")
+	os.Setenv("LOCAL_ACCOUNTS", "true")
+	os.Setenv("APP_URL", "https://books.example.com")
+	os.Setenv("AUTH_RATE_LIMIT", "30")
+	os.Setenv("SMTP_HOST", "smtp.example.com")
+	os.Setenv("SMTP_PORT", "2525")
+	os.Setenv("MAIL_FROM", "books@example.com")
 
-	config := LoadConfig()
+	config, err := LoadConfig()
+	require.NoError(t, err)
 
 	assert.Equal(t, "localhost:9090", config.ServerAddr)
 	assert.Equal(t, "user:testpassword@tcp(localhost:3306)/book_test", config.DBUrl)
+	assert.Equal(t, "sqlite", config.DBDriver)
 	assert.Equal(t, "DEBUG", config.LogLevel)
 	assert.Equal(t, "SuperSecretTestJWT", config.JWTSecret)
+	assert.Equal(t, "http://localhost:9999", config.MetadataURL)
+	assert.Equal(t, 5*time.Minute, config.MetadataCacheTTL)
+	assert.Equal(t, 90*time.Minute, config.SessionTimeout)
+	assert.Equal(t, []int64{7, 14, 30}, config.PaceWindows)
+	assert.Equal(t, 5*time.Minute, config.AccessTokenTTL)
+	assert.Equal(t, 7*24*time.Hour, config.RefreshTokenTTL)
+	assert.True(t, config.LocalAccounts)
+	assert.Equal(t, "https://books.example.com", config.AppURL)
+	assert.Equal(t, 30, config.AuthRateLimit)
+	assert.Equal(t, "smtp.example.com", config.SMTPHost)
+	assert.Equal(t, 2525, config.SMTPPort)
+	assert.Equal(t, "books@example.com", config.MailFrom)
 
 	os.Unsetenv("SERVER_ADDRESS")
 	os.Unsetenv("DATABASE_URL")
+	os.Unsetenv("DATABASE_DRIVER")
 	os.Unsetenv("LOG_LEVEL")
 	os.Unsetenv("JWT_SECRET")
+	os.Unsetenv("METADATA_URL")
+	os.Unsetenv("METADATA_CACHE_TTL")
+	os.Unsetenv("SESSION_TIMEOUT")
+	os.Unsetenv("PACE_WINDOWS")
+	os.Unsetenv("ACCESS_TOKEN_TTL")
+	os.Unsetenv("REFRESH_TOKEN_TTL")
+	os.Unsetenv("LOCAL_ACCOUNTS")
+	os.Unsetenv("APP_URL")
+	os.Unsetenv("AUTH_RATE_LIMIT")
+	os.Unsetenv("SMTP_HOST")
+	os.Unsetenv("SMTP_PORT")
+	os.Unsetenv("MAIL_FROM")
 }
 
 func TestLoadConfig_WithoutEnvVars(t *testing.T) {
 	os.Unsetenv("SERVER_ADDRESS")
 	os.Unsetenv("DATABASE_URL")
+	os.Unsetenv("DATABASE_DRIVER")
 	os.Unsetenv("LOG_LEVEL")
 	os.Unsetenv("JWT_SECRET")
 
-	config := LoadConfig()
+	config, err := LoadConfig()
+	require.NoError(t, err)
 
 	assert.Equal(t, ":8080", config.ServerAddr)
 	assert.Equal(t, "user:userpassword@tcp(localhost:3306)/book", config.DBUrl)
+	assert.Equal(t, "mariadb", config.DBDriver)
 	assert.Equal(t, "INFO", config.LogLevel)
 	assert.Equal(t, "Sup3rS3cr3t", config.JWTSecret)
+	assert.Equal(t, "https://openlibrary.org", config.MetadataURL)
+	assert.Equal(t, 24*time.Hour, config.MetadataCacheTTL)
+	assert.Equal(t, 4*time.Hour, config.SessionTimeout)
+	assert.Equal(t, []int64{7, 30}, config.PaceWindows)
+	assert.Equal(t, 15*time.Minute, config.AccessTokenTTL)
+	assert.Equal(t, 30*24*time.Hour, config.RefreshTokenTTL)
+	assert.Empty(t, config.OIDCProviders)
+	assert.False(t, config.LocalAccounts)
+	assert.Equal(t, "http://localhost:3000", config.AppURL)
+	assert.Equal(t, 10, config.AuthRateLimit)
+	assert.Empty(t, config.SMTPHost)
+	assert.Equal(t, 587, config.SMTPPort)
+	assert.Equal(t, "mail", config.MailDir)
+}
+
+func TestGetOIDCProviders(t *testing.T) {
+	os.Setenv("OIDC_PROVIDERS", "keycloak, my-gitlab,authentik")
+	os.Setenv("OIDC_KEYCLOAK_ISSUER", "https://sso.example.com/realms/books")
+	os.Setenv("OIDC_KEYCLOAK_CLIENT_ID", "book-tracker")
+	os.Setenv("OIDC_MY_GITLAB_ISSUER", "https://gitlab.example.com")
+	os.Setenv("OIDC_MY_GITLAB_CLIENT_ID", "abc123")
+	os.Setenv("OIDC_AUTHENTIK_ISSUER", "https://auth.example.com/application/o/books/")
+	defer func() {
+		for _, v := range []string{"OIDC_PROVIDERS", "OIDC_KEYCLOAK_ISSUER", "OIDC_KEYCLOAK_CLIENT_ID",
+			"OIDC_MY_GITLAB_ISSUER", "OIDC_MY_GITLAB_CLIENT_ID", "OIDC_AUTHENTIK_ISSUER"} {
+			os.Unsetenv(v)
+		}
+	}()
+
+	_, err := GetOIDCProviders("OIDC_PROVIDERS")
+	assert.ErrorContains(t, err, "OIDC_AUTHENTIK_CLIENT_ID", "authentik has no client id")
+
+	os.Setenv("OIDC_AUTHENTIK_CLIENT_ID", "books")
+	defer os.Unsetenv("OIDC_AUTHENTIK_CLIENT_ID")
+	providers, err := GetOIDCProviders("OIDC_PROVIDERS")
+
+	require.NoError(t, err)
+	assert.Equal(t, []domain.OIDCProvider{
+		{Name: "keycloak", Issuer: "https://sso.example.com/realms/books", ClientID: "book-tracker"},
+		{Name: "my-gitlab", Issuer: "https://gitlab.example.com", ClientID: "abc123"},
+		{Name: "authentik", Issuer: "https://auth.example.com/application/o/books/", ClientID: "books"},
+	}, providers)
+}
+
+func TestGetIntListWithDefault_Invalid(t *testing.T) {
+	os.Setenv("PACE_WINDOWS", "7,week")
+	defer os.Unsetenv("PACE_WINDOWS")
+
+	assert.Equal(t, []int64{30}, GetIntListWithDefault("PACE_WINDOWS", []int64{30}))
 }
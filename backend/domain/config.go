@@ -1,8 +1,53 @@
 package domain
 
+import "time"
+
 type Config struct {
-	ServerAddr string
-	DBUrl      string
-	LogLevel   string
-	JWTSecret  string
+	ServerAddr       string
+	DBDriver         string
+	DBUrl            string
+	LogLevel         string
+	JWTSecret        string
+	MetadataURL      string
+	MetadataCacheTTL time.Duration
+	// SessionTimeout is how long a reading session may run before it is
+	// closed automatically.
+	SessionTimeout time.Duration
+	// PaceWindows are the spans in days that reading pace is averaged over.
+	PaceWindows []int64
+	// AccessTokenTTL is the lifetime of access tokens and RefreshTokenTTL how
+	// long a session can go unused before it has to sign in again.
+	AccessTokenTTL  time.Duration
+	RefreshTokenTTL time.Duration
+	// OIDCProviders are the OpenID Connect issuers users can sign in with.
+	// Without any, logins are checked with Google's tokeninfo endpoint.
+	OIDCProviders []OIDCProvider
+	// LocalAccounts enables registering and signing in with an email and
+	// password. AppURL is where the frontend is served, for links in emails.
+	LocalAccounts bool
+	AppURL        string
+	// AuthRateLimit is the requests a client may make to the password
+	// endpoints per minute.
+	AuthRateLimit int
+	// Mail goes out through SMTPHost, or is written to files in MailDir when
+	// no host is set.
+	SMTPHost     string
+	SMTPPort     int
+	SMTPUsername string
+	SMTPPassword string
+	MailFrom     string
+	MailDir      string
+}
+
+// OIDCProvider is an OpenID Connect issuer whose ID tokens are accepted when
+// they were issued to ClientID.
+type OIDCProvider struct {
+	Name     string
+	Issuer   string
+	ClientID string
 }
+
+const (
+	DBDriverMariaDB = "mariadb"
+	DBDriverSQLite  = "sqlite"
+)
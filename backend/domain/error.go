@@ -5,9 +5,11 @@ import (
 )
 
 var (
-	ErrValidation     = errors.New("validation error")
-	ErrAuthentication = errors.New("authentication error")
-	ErrRecordNotFound = errors.New("record not found")
-	ErrForbidden      = errors.New("forbidden")
-	ErrAlreadyExists  = errors.New("record already exists")
+	ErrValidation      = errors.New("validation error")
+	ErrAuthentication  = errors.New("authentication error")
+	ErrRecordNotFound  = errors.New("record not found")
+	ErrForbidden       = errors.New("forbidden")
+	ErrAlreadyExists   = errors.New("record already exists")
+	ErrUpstream        = errors.New("upstream service error")
+	ErrTooManyAttempts = errors.New("too many attempts")
 )
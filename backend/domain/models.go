@@ -1,71 +1,361 @@
 package domain
 
 import (
+	"strings"
 	"time"
 )
 
 type User struct {
-	ID        int64     `json:"id"`
-	Email     string    `json:"email" validate:"required,email,max=255"`
+	ID    int64  `json:"id"`
+	Email string `json:"email" validate:"required,email,max=255"`
+	// Timezone is the IANA name of the zone the user's days are counted in.
+	Timezone string `json:"timezone" validate:"omitempty,timezone"`
+	// RestDays are the weekdays that do not break a reading streak.
+	RestDays  []string  `json:"rest_days" validate:"dive,oneof=monday tuesday wednesday thursday friday saturday sunday"`
 	CreatedAt time.Time `json:"created_at,omitempty"`
 }
 
+// Location returns the zone of the user, UTC when none is set.
+func (u *User) Location() *time.Location {
+	if u.Timezone == "" {
+		return time.UTC
+	}
+	loc, err := time.LoadLocation(u.Timezone)
+	if err != nil {
+		return time.UTC
+	}
+	return loc
+}
+
+// IsRestDay reports whether the weekday is one of the user's rest days.
+func (u *User) IsRestDay(weekday time.Weekday) bool {
+	for _, day := range u.RestDays {
+		if strings.EqualFold(day, weekday.String()) {
+			return true
+		}
+	}
+	return false
+}
+
+type Author struct {
+	ID   int64  `json:"id"`
+	Name string `json:"name" validate:"required,max=255"`
+}
+
 type Book struct {
-	ID        int64     `json:"id"`
-	UserID    int64     `json:"user_id" validate:"required"`
-	Title     string    `json:"title" validate:"required,min=1,max=50"`
-	Rating    float64   `json:"rating,omitempty" validate:"gte=0,lte=5"`
-	CreatedAt time.Time `json:"created_at"`
+	ID              int64     `json:"id"`
+	UserID          int64     `json:"user_id" validate:"required"`
+	Title           string    `json:"title" validate:"required,min=1,max=255"`
+	Authors         []Author  `json:"authors" validate:"dive"`
+	ISBN10          string    `json:"isbn10,omitempty" validate:"omitempty,isbn10"`
+	ISBN13          string    `json:"isbn13,omitempty" validate:"omitempty,isbn13"`
+	Publisher       string    `json:"publisher,omitempty" validate:"max=255"`
+	PublicationYear int64     `json:"publication_year,omitempty" validate:"gte=0,lte=9999"`
+	Language        string    `json:"language,omitempty" validate:"omitempty,max=35,bcp47_language_tag"`
+	Description     string    `json:"description,omitempty" validate:"max=10000"`
+	PageCount       int64     `json:"page_count,omitempty" validate:"gte=0"`
+	Rating          float64   `json:"rating,omitempty" validate:"gte=0,lte=5"`
+	CreatedAt       time.Time `json:"created_at"`
 }
 
 var (
 	GoalTypeBooks        = "books"
 	GoalTypePages        = "pages"
+	GoalTypeMinutes      = "minutes"
 	GoalFrequencyDaily   = "daily"
+	GoalFrequencyWeekly  = "weekly"
 	GoalFrequencyMonthly = "monthly"
+	GoalFrequencyYearly  = "yearly"
 )
 
+// Goal is one of the goals of a user, at most one per type and frequency.
 type Goal struct {
+	ID        int64  `json:"id"`
 	UserID    int64  `json:"user_id" validate:"required"`
-	Type      string `json:"type" validate:"required,oneof=books pages"`
-	Frequency string `json:"frequency" validate:"required,oneof=daily monthly"`
+	Type      string `json:"type" validate:"required,oneof=books pages minutes"`
+	Frequency string `json:"frequency" validate:"required,oneof=daily weekly monthly yearly"`
 	Value     int64  `json:"value" validate:"required,min=1"`
 }
 
+// Period returns the first and last day of the period of the goal that
+// contains day. Weeks start on Monday.
+func (g Goal) Period(day time.Time) (from, to time.Time) {
+	day = time.Date(day.Year(), day.Month(), day.Day(), 0, 0, 0, 0, time.UTC)
+	switch g.Frequency {
+	case GoalFrequencyWeekly:
+		from = day.AddDate(0, 0, -(int(day.Weekday())+6)%7)
+		return from, from.AddDate(0, 0, 6)
+	case GoalFrequencyMonthly:
+		from = day.AddDate(0, 0, 1-day.Day())
+		return from, from.AddDate(0, 1, -1)
+	case GoalFrequencyYearly:
+		from = time.Date(day.Year(), time.January, 1, 0, 0, 0, 0, time.UTC)
+		return from, from.AddDate(1, 0, -1)
+	default:
+		return day, day
+	}
+}
+
+// GoalVersion is a goal as it was set from one day until another, both
+// included. Changing a goal ends its open version, the one without
+// EffectiveTo, and starts a new one, so past periods keep the target they
+// had. GoalID is zero once the goal is deleted.
+type GoalVersion struct {
+	ID            int64      `json:"id"`
+	GoalID        int64      `json:"goal_id,omitempty"`
+	UserID        int64      `json:"user_id" validate:"required"`
+	Type          string     `json:"type" validate:"required,oneof=books pages minutes"`
+	Frequency     string     `json:"frequency" validate:"required,oneof=daily weekly monthly yearly"`
+	Value         int64      `json:"value" validate:"required,min=1"`
+	EffectiveFrom time.Time  `json:"effective_from"`
+	EffectiveTo   *time.Time `json:"effective_to"`
+}
+
+// Goal returns the goal the version was a version of.
+func (v GoalVersion) Goal() Goal {
+	return Goal{ID: v.GoalID, UserID: v.UserID, Type: v.Type, Frequency: v.Frequency, Value: v.Value}
+}
+
 var (
 	ReadingStatusNotStarted = "not started"
 	ReadingStatusReading    = "reading"
+	ReadingStatusPaused     = "paused"
+	ReadingStatusAbandoned  = "abandoned"
 	ReadingStatusCompleted  = "completed"
 )
 
+var (
+	ReadingFormatPrint     = "print"
+	ReadingFormatEbook     = "ebook"
+	ReadingFormatAudiobook = "audiobook"
+)
+
+var (
+	UnitPages   = "pages"
+	UnitPercent = "percent"
+	UnitMinutes = "minutes"
+)
+
+// readingTransitions lists the statuses each status can change to. A
+// completed reading is final; reading the book again starts a new reading.
+var readingTransitions = map[string][]string{
+	ReadingStatusNotStarted: {ReadingStatusReading, ReadingStatusAbandoned},
+	ReadingStatusReading:    {ReadingStatusPaused, ReadingStatusAbandoned, ReadingStatusCompleted},
+	ReadingStatusPaused:     {ReadingStatusReading, ReadingStatusAbandoned, ReadingStatusCompleted},
+	ReadingStatusAbandoned:  {ReadingStatusReading},
+}
+
 type Reading struct {
-	ID         int64     `json:"id"`
-	UserID     int64     `json:"user_id" validate:"required"`
-	BookID     int64     `json:"book_id" validate:"required"`
-	TotalPages int64     `json:"total_pages" validate:"required,min=1"`
-	Link       string    `json:"link,omitempty" validate:"omitempty,url"`
-	CreatedAt  time.Time `json:"created_at" validate:"required"`
-	UpdatedAt  time.Time `json:"updated_at" validate:"required"`
+	ID     int64  `json:"id"`
+	UserID int64  `json:"user_id" validate:"required"`
+	BookID int64  `json:"book_id" validate:"required"`
+	Format string `json:"format" validate:"required,oneof=print ebook audiobook"`
+	// TotalPages is the length of the reading in its unit: the pages of a
+	// print book, 100 percent of an ebook or the minutes of an audiobook.
+	TotalPages int64  `json:"total_pages" validate:"required,min=1"`
+	Link       string `json:"link,omitempty" validate:"omitempty,url"`
+	Status     string `json:"status" validate:"required,oneof='not started' reading paused abandoned completed"`
+	// StartedAt and FinishedAt are dates without a time of day. FinishedAt is
+	// set when the reading is completed or abandoned.
+	StartedAt  *time.Time `json:"started_at,omitempty"`
+	FinishedAt *time.Time `json:"finished_at,omitempty"`
+	// TargetDate is the day the reader wants to finish by, if any.
+	TargetDate *time.Time `json:"target_date,omitempty"`
+	CreatedAt  time.Time  `json:"created_at" validate:"required"`
+	UpdatedAt  time.Time  `json:"updated_at" validate:"required"`
 }
 
-func (r *Reading) GetStatus(progress int64) string {
-	if progress == 0 {
-		return ReadingStatusNotStarted
-	} else if progress < r.TotalPages {
-		return ReadingStatusReading
+// CanTransitionTo reports whether the reading may change to status.
+func (r *Reading) CanTransitionTo(status string) bool {
+	for _, next := range readingTransitions[r.Status] {
+		if next == status {
+			return true
+		}
 	}
+	return false
+}
 
-	return ReadingStatusCompleted
+// Unit is what the total and the progress of the reading are measured in.
+func (r *Reading) Unit() string {
+	switch r.Format {
+	case ReadingFormatEbook:
+		return UnitPercent
+	case ReadingFormatAudiobook:
+		return UnitMinutes
+	default:
+		return UnitPages
+	}
+}
+
+// PagesOf converts progress on the reading to pages, going by the page count
+// of the book for ebooks. Audiobook minutes are not pages.
+func (r *Reading) PagesOf(progress, bookPageCount int64) int64 {
+	switch r.Format {
+	case ReadingFormatEbook:
+		return progress * bookPageCount / 100
+	case ReadingFormatAudiobook:
+		return 0
+	default:
+		return progress
+	}
+}
+
+// MinutesOf is the listening time of progress on an audiobook.
+func (r *Reading) MinutesOf(progress int64) int64 {
+	if r.Format != ReadingFormatAudiobook {
+		return 0
+	}
+	return progress
+}
+
+// IsOpen reports whether the reading has not ended yet.
+func (r *Reading) IsOpen() bool {
+	return r.Status != ReadingStatusCompleted && r.Status != ReadingStatusAbandoned
+}
+
+// ReadingSummary is a reading with the title of its book and its total
+// progress, as shown in lists of readings.
+type ReadingSummary struct {
+	Reading
+	BookTitle string
+	Progress  int64
 }
 
 type Progress struct {
-	ID          int64     `json:"id"`
-	UserID      int64     `json:"user_id" validate:"required"`
-	ReadingID   int64     `json:"reading_id" validate:"required"`
-	Pages       int64     `json:"pages" validate:"required,min=1"`
+	ID        int64 `json:"id"`
+	UserID    int64 `json:"user_id" validate:"required"`
+	ReadingID int64 `json:"reading_id" validate:"required"`
+	// Pages is the change since the previous entry and Position the place
+	// the reader was at after it, both in the unit of the reading.
+	// Corrections move back, so their Pages are negative.
+	Pages       int64     `json:"pages" validate:"required"`
+	Position    int64     `json:"position" validate:"gte=0"`
 	ReadingDate time.Time `json:"reading_date" validate:"required"`
 }
 
+// ReadingSession is a timed stretch of reading. A user has at most one open
+// session; stopping it records the progress made as a Progress entry.
+type ReadingSession struct {
+	ID            int64      `json:"id"`
+	UserID        int64      `json:"user_id" validate:"required"`
+	ReadingID     int64      `json:"reading_id" validate:"required"`
+	StartPosition int64      `json:"start_position" validate:"gte=0"`
+	EndPosition   *int64     `json:"end_position,omitempty"`
+	StartedAt     time.Time  `json:"started_at" validate:"required"`
+	EndedAt       *time.Time `json:"ended_at,omitempty"`
+	// Duration is in seconds. Sessions closed for running past the timeout
+	// record none, as it is unknown when the reading stopped.
+	Duration   int64  `json:"duration"`
+	ProgressID *int64 `json:"progress_id,omitempty"`
+	AutoClosed bool   `json:"auto_closed"`
+}
+
+// IsOpen reports whether the session has not been stopped yet.
+func (s *ReadingSession) IsOpen() bool {
+	return s.EndedAt == nil
+}
+
+// AuthSession is a signed-in device. Its refresh tokens form one family:
+// each is used once and replaced by the next, and reusing one revokes the
+// session.
+type AuthSession struct {
+	ID         int64     `json:"id"`
+	UserID     int64     `json:"user_id"`
+	UserAgent  string    `json:"user_agent"`
+	CreatedAt  time.Time `json:"created_at"`
+	LastUsedAt time.Time `json:"last_used_at"`
+	// ExpiresAt is when the newest refresh token of the session expires.
+	ExpiresAt time.Time  `json:"expires_at"`
+	RevokedAt *time.Time `json:"revoked_at,omitempty"`
+}
+
+// IsActive reports whether the session can still be refreshed at t.
+func (s *AuthSession) IsActive(t time.Time) bool {
+	return s.RevokedAt == nil && t.Before(s.ExpiresAt)
+}
+
+// RefreshToken is a single-use token of an AuthSession. Only the SHA-256 of
+// the token is kept.
+type RefreshToken struct {
+	ID        int64
+	SessionID int64
+	TokenHash string
+	ExpiresAt time.Time
+	UsedAt    *time.Time
+}
+
+// AccessTokenPrefix starts every personal access token, telling them apart
+// from JWTs.
+const AccessTokenPrefix = "btpat_"
+
+const (
+	AccessTokenScopeReadOnly      = "read-only"
+	AccessTokenScopeProgressWrite = "progress-write"
+	AccessTokenScopeFull          = "full"
+)
+
+// AccessToken is a personal access token a user creates for scripts. Its
+// scope limits it to reading, to reading and logging progress, or allows
+// everything but managing sessions and tokens. Only the SHA-256 of the token
+// is kept.
+type AccessToken struct {
+	ID        int64     `json:"id"`
+	UserID    int64     `json:"user_id" validate:"required"`
+	Name      string    `json:"name" validate:"required,max=100"`
+	Scope     string    `json:"scope" validate:"required,oneof=read-only progress-write full"`
+	TokenHash string    `json:"-"`
+	CreatedAt time.Time `json:"created_at"`
+	// ExpiresAt is nil for tokens that do not expire.
+	ExpiresAt  *time.Time `json:"expires_at,omitempty"`
+	LastUsedAt *time.Time `json:"last_used_at,omitempty"`
+}
+
+// IsExpired reports whether the token can no longer be used at t.
+func (a *AccessToken) IsExpired(t time.Time) bool {
+	return a.ExpiresAt != nil && !t.Before(*a.ExpiresAt)
+}
+
+// LocalAccount lets a user sign in with their email and a password.
+type LocalAccount struct {
+	UserID int64
+	// PasswordHash is an argon2id hash in PHC string format.
+	PasswordHash    string
+	EmailVerifiedAt *time.Time
+	// FailedLogins counts wrong passwords since the last sign-in. Too many
+	// lock the account until LockedUntil.
+	FailedLogins int64
+	LockedUntil  *time.Time
+	CreatedAt    time.Time
+}
+
+// IsLocked reports whether sign-ins are refused at t.
+func (a *LocalAccount) IsLocked(t time.Time) bool {
+	return a.LockedUntil != nil && t.Before(*a.LockedUntil)
+}
+
+const (
+	AccountTokenVerifyEmail   = "verify-email"
+	AccountTokenResetPassword = "reset-password"
+)
+
+// AccountToken is a single-use token mailed to a user to verify their email
+// or reset their password. Only the SHA-256 of the token is kept.
+type AccountToken struct {
+	ID        int64
+	UserID    int64
+	Purpose   string
+	TokenHash string
+	ExpiresAt time.Time
+	UsedAt    *time.Time
+}
+
+// Email is a plain text message to a single recipient.
+type Email struct {
+	To      string
+	Subject string
+	Body    string
+}
+
 type List struct {
 	ID        int64     `json:"id"`
 	UserID    int64     `json:"user_id" validate:"required"`
@@ -80,6 +370,12 @@ type ListItem struct {
 	CreatedAt time.Time `json:"created_at"`
 }
 
+// ListItemBook is a list item with the title of its book.
+type ListItemBook struct {
+	ListItem
+	BookTitle string
+}
+
 type Note struct {
 	ID         int64     `json:"id"`
 	UserID     int64     `json:"user_id" validate:"required"`
@@ -88,3 +384,62 @@ type Note struct {
 	Content    string    `json:"content" validate:"required"`
 	CreatedAt  time.Time `json:"created_at"`
 }
+
+var (
+	ImportSourceGoodreads = "goodreads"
+
+	ImportStatusPending   = "pending"
+	ImportStatusRunning   = "running"
+	ImportStatusCompleted = "completed"
+	ImportStatusFailed    = "failed"
+
+	ImportRowStatusImported = "imported"
+	ImportRowStatusSkipped  = "skipped"
+	ImportRowStatusFailed   = "failed"
+)
+
+// ImportJob is an uploaded file imported in the background. Every processed
+// row is recorded as an ImportRow, so an interrupted job continues after the
+// last recorded row. The row counts are derived from Rows.
+type ImportJob struct {
+	ID           int64       `json:"id"`
+	UserID       int64       `json:"user_id"`
+	Source       string      `json:"source"`
+	Checksum     string      `json:"-"`
+	Data         []byte      `json:"-"`
+	Status       string      `json:"status"`
+	TotalRows    int64       `json:"total_rows"`
+	ImportedRows int64       `json:"imported_rows"`
+	SkippedRows  int64       `json:"skipped_rows"`
+	FailedRows   int64       `json:"failed_rows"`
+	Error        string      `json:"error,omitempty"`
+	Rows         []ImportRow `json:"rows,omitempty"`
+	CreatedAt    time.Time   `json:"created_at"`
+	UpdatedAt    time.Time   `json:"updated_at"`
+}
+
+// ImportRow is the outcome of a single row. ExternalID is the row's id in the
+// source system and BookID the book it was imported as, if any.
+type ImportRow struct {
+	ID         int64     `json:"id"`
+	JobID      int64     `json:"job_id"`
+	RowNumber  int64     `json:"row_number"`
+	ExternalID string    `json:"external_id"`
+	Status     string    `json:"status"`
+	BookID     int64     `json:"book_id,omitempty"`
+	Error      string    `json:"error,omitempty"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+// ArchiveFormat identifies zip files written by ArchiveService.Export.
+var ArchiveFormat = "book-tracker-export"
+
+// ArchiveManifest is stored as manifest.json in an exported archive. Every
+// entity is stored twice, as <entity>.json and <entity>.csv, and Entities
+// holds the number of records of each.
+type ArchiveManifest struct {
+	Format        string           `json:"format"`
+	SchemaVersion int64            `json:"schema_version"`
+	ExportedAt    time.Time        `json:"exported_at"`
+	Entities      map[string]int64 `json:"entities"`
+}
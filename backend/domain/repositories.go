@@ -2,47 +2,196 @@ package domain
 
 import (
 	"context"
+	"time"
 
 	"github.com/rimvydascivilis/book-tracker/backend/dto"
 )
 
+// TxManager runs several repository calls atomically. Repositories take part
+// in the transaction when they are called with the ctx passed to fn; it is
+// committed when fn returns nil and rolled back otherwise. Calling
+// WithinTransaction with a ctx that already carries a transaction joins it.
+type TxManager interface {
+	WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error
+	// WithinReadOnlyTransaction runs fn in a transaction that only reads, so
+	// that everything fn loads comes from one snapshot of the data.
+	WithinReadOnlyTransaction(ctx context.Context, fn func(ctx context.Context) error) error
+}
+
 type UserRepository interface {
 	GetByID(ctx context.Context, id int64) (User, error)
 	GetByEmail(ctx context.Context, email string) (User, error)
 	CreateUser(ctx context.Context, u User) (User, error)
+	// UpdateUser saves the timezone and rest days of u.
+	UpdateUser(ctx context.Context, u User) (User, error)
 }
 
 type BookRepository interface {
 	CountBooksByUser(ctx context.Context, userID int64) (int64, error)
 	GetBooksByUser(ctx context.Context, userID, offset, limit int64) ([]Book, error)
 	GetBookByUserID(ctx context.Context, userID, bookID int64) (Book, error)
-	SearchBooksByTitle(ctx context.Context, userID int64, title string, limit int64) ([]Book, error)
+	// GetBookByUserIDForUpdate is GetBookByUserID that also locks the row
+	// until the surrounding transaction ends.
+	GetBookByUserIDForUpdate(ctx context.Context, userID, bookID int64) (Book, error)
+	// SearchBooks matches query against the title, author names and ISBNs.
+	SearchBooks(ctx context.Context, userID int64, query string, limit int64) ([]Book, error)
 	UpdateBook(ctx context.Context, book Book) (Book, error)
 	CreateBook(ctx context.Context, book Book) (Book, error)
 	DeleteBook(ctx context.Context, userID, bookID int64) error
 }
 
+// AuthorRepository stores authors and the ordered author list of each book.
+// Books returned by BookRepository do not have their Authors set.
+type AuthorRepository interface {
+	// GetOrCreateAuthors returns the author of each name, creating the ones
+	// that do not exist. Names are compared ignoring case, and existing
+	// authors keep the name they were created with.
+	GetOrCreateAuthors(ctx context.Context, names []string) ([]Author, error)
+	GetAuthorsByBookIDs(ctx context.Context, bookIDs []int64) (map[int64][]Author, error)
+	SetBookAuthors(ctx context.Context, bookID int64, authorIDs []int64) error
+}
+
 type GoalRepository interface {
-	GetGoalByUserID(ctx context.Context, userID int64) (Goal, error)
+	// GetGoalsByUserID returns the goals of the user in the order they were
+	// created.
+	GetGoalsByUserID(ctx context.Context, userID int64) ([]Goal, error)
+	GetGoalByID(ctx context.Context, id int64) (Goal, error)
+	// CreateGoal and UpdateGoal return ErrAlreadyExists when the user has
+	// another goal of the same type and frequency.
 	CreateGoal(ctx context.Context, goal Goal) (Goal, error)
 	UpdateGoal(ctx context.Context, goal Goal) (Goal, error)
+	DeleteGoal(ctx context.Context, id int64) error
+	// GetGoalVersionsByUserID returns every version of the goals of the
+	// user, including deleted goals, oldest first.
+	GetGoalVersionsByUserID(ctx context.Context, userID int64) ([]GoalVersion, error)
+	CreateGoalVersion(ctx context.Context, version GoalVersion) (GoalVersion, error)
+	// EndGoalVersion ends the open version of the goal on day to. A version
+	// that started after to is removed instead.
+	EndGoalVersion(ctx context.Context, goalID int64, to time.Time) error
 }
 
 type ReadingRepository interface {
-	GetReadingsByUserID(ctx context.Context, userID, offset, limit int64) ([]Reading, error)
+	// GetReadingsByUserID and CountReadingsByUserID only include readings
+	// with status, or every reading when status is empty.
+	GetReadingsByUserID(ctx context.Context, userID int64, status string, offset, limit int64) ([]Reading, error)
+	// GetReadingSummariesByUserID is GetReadingsByUserID that also loads the
+	// book title and total progress of every reading in the same query.
+	GetReadingSummariesByUserID(ctx context.Context, userID int64, status string, offset, limit int64) ([]ReadingSummary, error)
 	GetReadingByID(ctx context.Context, id int64) (Reading, error)
-	CountReadingsByUserID(ctx context.Context, userID int64) (int64, error)
-	CountReadingsByUserIDAndBookID(ctx context.Context, userID, bookID int64) (int64, error)
+	// GetReadingByIDForUpdate is GetReadingByID that also locks the row
+	// until the surrounding transaction ends.
+	GetReadingByIDForUpdate(ctx context.Context, id int64) (Reading, error)
+	CountReadingsByUserID(ctx context.Context, userID int64, status string) (int64, error)
+	// GetReadingsByUserIDAndBookID returns every reading of the book, newest
+	// first.
+	GetReadingsByUserIDAndBookID(ctx context.Context, userID, bookID int64) ([]Reading, error)
+	// GetMonthlyFinishedReadings and GetDailyFinishedReadings count the
+	// completed readings; abandoned readings are not counted.
+	GetMonthlyFinishedReadings(ctx context.Context, userID, year int64) ([]dto.Progress, error)
+	GetDailyFinishedReadings(ctx context.Context, userID, year, month int64) ([]dto.Progress, error)
 	CreateReading(ctx context.Context, reading Reading) (Reading, error)
+	// UpdateReading saves the total pages, link, start and finish dates and
+	// updated_at of reading.
+	UpdateReading(ctx context.Context, reading Reading) (Reading, error)
+	// DeleteReading deletes the reading together with its progress.
+	DeleteReading(ctx context.Context, id int64) error
 }
 
 type ProgressRepository interface {
 	GetTotalProgressByReadingID(ctx context.Context, readingID int64) (int64, error)
-	GetProgressByReadingAndDate(ctx context.Context, readingID int64, date string) (int64, error)
 	GetMonthlyProgress(ctx context.Context, userID, year int64) ([]dto.Progress, error)
 	GetDailyProgress(ctx context.Context, userID, year, month int64) ([]dto.Progress, error)
-	GetUserReadingIDsByPeriod(ctx context.Context, userID int64, period string) ([]int64, error)
+	// GetPeriodProgress adds up what the user read from one day to another,
+	// both included, in a single query: pages and minutes like
+	// GetMonthlyProgress, leaving out abandoned readings, and the readings
+	// completed.
+	GetPeriodProgress(ctx context.Context, userID int64, from, to time.Time) (dto.PeriodProgress, error)
+	// GetProgressByDay adds up the same as GetPeriodProgress for each day
+	// from one day to another in a single query, leaving out the days the
+	// user did not read.
+	GetProgressByDay(ctx context.Context, userID int64, from, to time.Time) ([]dto.DayProgress, error)
+	// GetProgressByUserID pages through every progress entry of the user in
+	// insertion order.
+	GetProgressByUserID(ctx context.Context, userID, offset, limit int64) ([]Progress, error)
+	GetProgressByID(ctx context.Context, id int64) (Progress, error)
+	// GetProgressByReadingID returns the progress entries of the reading in
+	// the order they were read.
+	GetProgressByReadingID(ctx context.Context, readingID int64) ([]Progress, error)
+	// GetProgressByReadingIDs loads the progress entries of several readings
+	// at once, keyed by reading, each in the order they were read.
+	GetProgressByReadingIDs(ctx context.Context, readingIDs []int64) (map[int64][]Progress, error)
 	CreateProgress(ctx context.Context, progressReq Progress) (Progress, error)
+	// UpdateProgress saves the pages and reading date of the entry.
+	UpdateProgress(ctx context.Context, progress Progress) (Progress, error)
+	DeleteProgress(ctx context.Context, id int64) error
+	// GetReadingDays returns the distinct days the user moved forward in any
+	// reading, oldest first.
+	GetReadingDays(ctx context.Context, userID int64) ([]time.Time, error)
+}
+
+type ReadingSessionRepository interface {
+	// GetReadingSessionsByReadingID returns the sessions of the reading, most
+	// recent first.
+	GetReadingSessionsByReadingID(ctx context.Context, readingID int64) ([]ReadingSession, error)
+	// GetOpenReadingSessionForUpdate returns the open session of the user and
+	// locks it until the transaction ends, or ErrRecordNotFound.
+	GetOpenReadingSessionForUpdate(ctx context.Context, userID int64) (ReadingSession, error)
+	// GetOpenReadingSessionsStartedBefore returns the open sessions of every
+	// user that were started before t.
+	GetOpenReadingSessionsStartedBefore(ctx context.Context, t time.Time) ([]ReadingSession, error)
+	// CreateReadingSession fails with ErrAlreadyExists when the user already
+	// has an open session.
+	CreateReadingSession(ctx context.Context, session ReadingSession) (ReadingSession, error)
+	// UpdateReadingSession saves the end, duration, progress and auto-closed
+	// flag of session.
+	UpdateReadingSession(ctx context.Context, session ReadingSession) (ReadingSession, error)
+}
+
+type AuthSessionRepository interface {
+	CreateSession(ctx context.Context, session AuthSession) (AuthSession, error)
+	GetSessionByID(ctx context.Context, id int64) (AuthSession, error)
+	// GetActiveSessionsByUserID returns the sessions of the user that are
+	// neither revoked nor expired at t, most recently used first.
+	GetActiveSessionsByUserID(ctx context.Context, userID int64, t time.Time) ([]AuthSession, error)
+	// UpdateSession saves the last use, expiry and revocation of session.
+	UpdateSession(ctx context.Context, session AuthSession) (AuthSession, error)
+	CreateRefreshToken(ctx context.Context, token RefreshToken) (RefreshToken, error)
+	// GetRefreshTokenByHashForUpdate returns the token with hash and locks it
+	// until the transaction ends, or ErrRecordNotFound.
+	GetRefreshTokenByHashForUpdate(ctx context.Context, hash string) (RefreshToken, error)
+	MarkRefreshTokenUsed(ctx context.Context, id int64, t time.Time) error
+}
+
+type AccessTokenRepository interface {
+	// GetAccessTokensByUserID returns the tokens of the user in the order
+	// they were created.
+	GetAccessTokensByUserID(ctx context.Context, userID int64) ([]AccessToken, error)
+	GetAccessTokenByID(ctx context.Context, id int64) (AccessToken, error)
+	GetAccessTokenByHash(ctx context.Context, hash string) (AccessToken, error)
+	CreateAccessToken(ctx context.Context, token AccessToken) (AccessToken, error)
+	UpdateAccessTokenLastUsed(ctx context.Context, id int64, t time.Time) error
+	DeleteAccessToken(ctx context.Context, id int64) error
+}
+
+type LocalAccountRepository interface {
+	// CreateLocalAccount fails with ErrAlreadyExists when the user already
+	// has one.
+	CreateLocalAccount(ctx context.Context, account LocalAccount) (LocalAccount, error)
+	// GetLocalAccount returns the account of the user, or ErrRecordNotFound.
+	GetLocalAccount(ctx context.Context, userID int64) (LocalAccount, error)
+	// GetLocalAccountForUpdate is GetLocalAccount that also locks the row
+	// until the surrounding transaction ends.
+	GetLocalAccountForUpdate(ctx context.Context, userID int64) (LocalAccount, error)
+	// UpdateLocalAccount saves the password hash, verification and failed
+	// logins of account.
+	UpdateLocalAccount(ctx context.Context, account LocalAccount) (LocalAccount, error)
+	CreateAccountToken(ctx context.Context, token AccountToken) (AccountToken, error)
+	// GetAccountTokenByHashForUpdate returns the token with hash and locks it
+	// until the transaction ends, or ErrRecordNotFound.
+	GetAccountTokenByHashForUpdate(ctx context.Context, hash string) (AccountToken, error)
+	MarkAccountTokenUsed(ctx context.Context, id int64, t time.Time) error
+	// DeleteAccountTokens deletes the tokens of the user for purpose.
+	DeleteAccountTokens(ctx context.Context, userID int64, purpose string) error
 }
 
 type ListRepository interface {
@@ -53,6 +202,9 @@ type ListRepository interface {
 
 type ListItemRepository interface {
 	GetListItemsByListID(ctx context.Context, listID int64) ([]ListItem, error)
+	// GetListItemBooksByListID returns the items of the list together with
+	// the titles of their books.
+	GetListItemBooksByListID(ctx context.Context, listID int64) ([]ListItemBook, error)
 	CreateListItem(ctx context.Context, listItem ListItem) (ListItem, error)
 	DeleteListItem(ctx context.Context, id int64) error
 }
@@ -64,3 +216,20 @@ type NoteRepository interface {
 	CreateNote(ctx context.Context, note Note) (Note, error)
 	DeleteNote(ctx context.Context, id int64) error
 }
+
+// ImportRepository stores import jobs and the outcome of every row they have
+// processed.
+type ImportRepository interface {
+	GetImportJobByID(ctx context.Context, id int64) (ImportJob, error)
+	GetImportJobByChecksum(ctx context.Context, userID int64, source, checksum string) (ImportJob, error)
+	// GetUnfinishedImportJobs returns the pending and running jobs of every user.
+	GetUnfinishedImportJobs(ctx context.Context) ([]ImportJob, error)
+	CreateImportJob(ctx context.Context, job ImportJob) (ImportJob, error)
+	// UpdateImportJob saves the status, total rows and error of job.
+	UpdateImportJob(ctx context.Context, job ImportJob) (ImportJob, error)
+	GetImportRowsByJobID(ctx context.Context, jobID int64) ([]ImportRow, error)
+	CreateImportRow(ctx context.Context, row ImportRow) (ImportRow, error)
+	// GetImportedBookID returns the book that an earlier job of the user
+	// imported for externalID, or ErrRecordNotFound.
+	GetImportedBookID(ctx context.Context, userID int64, source, externalID string) (int64, error)
+}
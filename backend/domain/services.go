@@ -2,6 +2,8 @@ package domain
 
 import (
 	"context"
+	"io"
+	"time"
 
 	"github.com/rimvydascivilis/book-tracker/backend/dto"
 )
@@ -10,43 +12,146 @@ type ValidationService interface {
 	ValidateStruct(s interface{}) error
 }
 
+// AuthService signs users in on sessions, one per device. Access tokens are
+// short-lived and renewed with the single-use refresh token of the session.
 type AuthService interface {
-	Login(ctx context.Context, googleOauthToken string) (string, error)
+	// Login starts a new session for the owner of the Google token.
+	Login(ctx context.Context, googleOauthToken, userAgent string) (dto.TokenResponse, error)
+	// Refresh exchanges a refresh token for new tokens of the same session.
+	// Presenting a token that was already exchanged revokes its session.
+	Refresh(ctx context.Context, refreshToken string) (dto.TokenResponse, error)
+	// GetSessions returns the active sessions of the user, marking
+	// currentSessionID as the current one.
+	GetSessions(ctx context.Context, userID, currentSessionID int64) ([]dto.SessionResponse, error)
+	// StartSession signs the user in on a new session and issues its first
+	// tokens, for services that authenticate users in other ways.
+	StartSession(ctx context.Context, userID int64, userAgent string) (dto.TokenResponse, error)
+	// RevokeSession signs the session out; its access tokens stay valid
+	// until they expire.
+	RevokeSession(ctx context.Context, userID, sessionID int64) error
+	// RevokeAllSessions signs the user out of every session.
+	RevokeAllSessions(ctx context.Context, userID int64) error
+}
+
+// LocalAuthService signs users in with their email and a password, for
+// installs that cannot reach an OAuth provider. Signing in starts a session
+// like AuthService.Login does.
+type LocalAuthService interface {
+	// Register creates an unverified account and mails a verification link.
+	// When the email already has an account its owner is mailed instead, so
+	// the response does not tell whether the email is taken.
+	Register(ctx context.Context, req dto.PasswordRequest) error
+	// Login fails with ErrAuthentication for a wrong password or an
+	// unverified email, and with ErrTooManyAttempts while the account is
+	// locked after repeated wrong passwords.
+	Login(ctx context.Context, req dto.PasswordRequest, userAgent string) (dto.TokenResponse, error)
+	VerifyEmail(ctx context.Context, token string) error
+	// RequestPasswordReset mails a reset link when the email has an account.
+	RequestPasswordReset(ctx context.Context, email string) error
+	// ResetPassword sets a new password, which also verifies the email, and
+	// signs all sessions of the user out.
+	ResetPassword(ctx context.Context, req dto.PasswordResetRequest) error
+}
+
+// Mailer delivers email.
+type Mailer interface {
+	Send(ctx context.Context, email Email) error
 }
 
 type TokenService interface {
-	GenerateToken(ctx context.Context, userID int64) (string, error)
+	// GenerateToken issues an access token of the session.
+	GenerateToken(ctx context.Context, userID, sessionID int64) (string, error)
+	// TTL is the lifetime of the tokens it issues.
+	TTL() time.Duration
+}
+
+// AccessTokenService manages personal access tokens. The token itself is
+// only returned when it is created.
+type AccessTokenService interface {
+	GetAccessTokens(ctx context.Context, userID int64) ([]AccessToken, error)
+	CreateAccessToken(ctx context.Context, userID int64, req dto.AccessTokenRequest) (AccessToken, string, error)
+	DeleteAccessToken(ctx context.Context, userID, tokenID int64) error
+	// Authenticate returns the unexpired token matching token and records
+	// its use, or fails with ErrAuthentication.
+	Authenticate(ctx context.Context, token string) (AccessToken, error)
 }
 
 type OAuth2Service interface {
 	ValidateToken(token string) (string, error)
 }
 
+// MetadataProvider resolves ISBNs and title/author queries into book data
+// from an external catalogue. The returned books have no ID or UserID.
+type MetadataProvider interface {
+	// LookupISBN returns ErrRecordNotFound when the catalogue has no such ISBN.
+	LookupISBN(ctx context.Context, isbn string) (Book, error)
+	Search(ctx context.Context, title, author string, limit int64) ([]Book, error)
+}
+
 type BookService interface {
 	CreateBook(ctx context.Context, userID int64, book Book) (Book, error)
+	CreateBookFromISBN(ctx context.Context, userID int64, isbn string, book Book) (Book, error)
+	LookupBook(ctx context.Context, isbn string) (Book, error)
+	SearchMetadata(ctx context.Context, title, author string, limit int64) ([]Book, error)
 	GetBooks(ctx context.Context, userID, page, limit int64) ([]Book, bool, error)
-	SearchBooks(ctx context.Context, userID int64, title string, limit int64) ([]Book, error)
+	SearchBooks(ctx context.Context, userID int64, query string, limit int64) ([]Book, error)
 	UpdateBook(ctx context.Context, userID int64, book Book) (Book, error)
 	DeleteBook(ctx context.Context, userID, bookID int64) error
 }
 
 type UserService interface {
 	GetOrCreateUser(ctx context.Context, email string) (User, error)
+	GetUser(ctx context.Context, userID int64) (User, error)
+	UpdateUserSettings(ctx context.Context, userID int64, req dto.UserSettingsRequest) (User, error)
 }
 
 type GoalService interface {
-	GetGoal(ctx context.Context, userID int64) (Goal, error)
+	GetGoals(ctx context.Context, userID int64) ([]Goal, error)
+	CreateGoal(ctx context.Context, userID int64, goal Goal) (Goal, error)
+	// UpdateGoal changes the fields of the goal that are set in goal.
+	UpdateGoal(ctx context.Context, userID, goalID int64, goal Goal) (Goal, error)
+	DeleteGoal(ctx context.Context, userID, goalID int64) error
+	// GetGoalProgress reports the progress of every goal in its current
+	// period.
 	GetGoalProgress(ctx context.Context, userID int64) (dto.GoalProgressResponse, error)
-	SetGoal(ctx context.Context, userID int64, goal Goal) (Goal, error)
+	// GetGoalHistory reports every finished period of every goal, newest
+	// first, against the version of the goal in effect on its last day.
+	GetGoalHistory(ctx context.Context, userID int64) (dto.GoalHistoryResponse, error)
 }
 
 type ReadingService interface {
-	GetReadings(ctx context.Context, userID, page, limit int64) ([]dto.ReadingResponse, bool, error)
+	GetReadings(ctx context.Context, userID int64, status string, page, limit int64) ([]dto.ReadingResponse, bool, error)
+	// GetBookReadings returns every reading of the book, newest first.
+	GetBookReadings(ctx context.Context, userID, bookID int64) ([]dto.ReadingResponse, error)
 	CreateReading(ctx context.Context, userID int64, reading Reading) (Reading, error)
+	UpdateReading(ctx context.Context, userID, readingID int64, req dto.ReadingRequest) (Reading, error)
+	UpdateReadingStatus(ctx context.Context, userID, readingID int64, status string) (Reading, error)
+	DeleteReading(ctx context.Context, userID, readingID int64) error
+	// GetReadingPace returns the pace of the reading over windows of days,
+	// the configured ones when empty, with its progress per day.
+	GetReadingPace(ctx context.Context, userID, readingID int64, windows []int64) (dto.PaceResponse, error)
 }
 
 type ProgressService interface {
+	GetReadingProgress(ctx context.Context, userID, readingID int64) ([]Progress, error)
 	CreateProgress(ctx context.Context, userID, readingID int64, progressReq dto.ProgressRequest) (Progress, error)
+	UpdateProgress(ctx context.Context, userID, progressID int64, progressReq dto.ProgressRequest) (Progress, error)
+	DeleteProgress(ctx context.Context, userID, progressID int64) error
+}
+
+// ReadingSessionService times reading sessions. Sessions left running longer
+// than the configured timeout are closed without progress.
+type ReadingSessionService interface {
+	GetReadingSessions(ctx context.Context, userID, readingID int64) ([]ReadingSession, error)
+	// StartReadingSession fails with ErrAlreadyExists while another session
+	// of the user is running.
+	StartReadingSession(ctx context.Context, userID, readingID int64) (ReadingSession, error)
+	// StopReadingSession ends the running session of the reading on page and
+	// logs the pages read since it started as progress.
+	StopReadingSession(ctx context.Context, userID, readingID, page int64) (ReadingSession, error)
+	// CloseExpiredReadingSessions closes the sessions of every user that ran
+	// past the timeout and returns how many it closed.
+	CloseExpiredReadingSessions(ctx context.Context) (int, error)
 }
 
 type ListService interface {
@@ -63,6 +168,28 @@ type NoteService interface {
 	DeleteNote(ctx context.Context, userID, noteID int64) error
 }
 
+// StreakService counts the consecutive days a user has read.
+type StreakService interface {
+	GetStreaks(ctx context.Context, userID int64) (dto.StreakResponse, error)
+}
+
 type StatService interface {
 	GetProgress(ctx context.Context, userID, year, month int64, isMonthly bool) (dto.StatResponse, error)
 }
+
+type ImportService interface {
+	// ImportGoodreads starts importing a Goodreads library export. Uploading a
+	// file again returns its existing job, resuming it if it was interrupted.
+	ImportGoodreads(ctx context.Context, userID int64, data []byte) (ImportJob, error)
+	GetImportJob(ctx context.Context, userID, jobID int64) (ImportJob, error)
+	// ResumeImports restarts the jobs left unfinished by a previous run.
+	ResumeImports(ctx context.Context) error
+}
+
+type ArchiveService interface {
+	// Export streams every record of the user to w as a zip archive.
+	Export(ctx context.Context, userID int64, w io.Writer) error
+	// Restore loads an archive written by Export into an account that has no
+	// data yet and returns the number of records restored per entity.
+	Restore(ctx context.Context, userID int64, r io.ReaderAt, size int64) (ArchiveManifest, error)
+}
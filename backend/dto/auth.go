@@ -0,0 +1,56 @@
+package dto
+
+import "time"
+
+// TokenResponse is returned when signing in and refreshing. Token is the
+// short-lived access token; RefreshToken can be exchanged once for new
+// tokens at /auth/refresh.
+type TokenResponse struct {
+	Token        string `json:"token"`
+	RefreshToken string `json:"refresh_token"`
+	// ExpiresIn is the lifetime of Token in seconds.
+	ExpiresIn int64 `json:"expires_in"`
+}
+
+type RefreshRequest struct {
+	RefreshToken string `json:"refresh_token"`
+}
+
+// SessionResponse is a signed-in device. Current marks the session of the
+// token the request was made with.
+type SessionResponse struct {
+	ID         int64     `json:"id"`
+	UserAgent  string    `json:"user_agent"`
+	CreatedAt  time.Time `json:"created_at"`
+	LastUsedAt time.Time `json:"last_used_at"`
+	Current    bool      `json:"current"`
+}
+
+// AccessTokenRequest creates a personal access token. Without ExpiresAt the
+// token does not expire.
+type AccessTokenRequest struct {
+	Name      string     `json:"name"`
+	Scope     string     `json:"scope"`
+	ExpiresAt *time.Time `json:"expires_at"`
+}
+
+// PasswordRequest registers or signs in a local account.
+type PasswordRequest struct {
+	Email    string `json:"email" validate:"required,email,max=255"`
+	Password string `json:"password" validate:"required,min=8,max=128"`
+}
+
+type VerifyEmailRequest struct {
+	Token string `json:"token"`
+}
+
+// PasswordResetRequest sets a new password with the token of a reset email.
+type PasswordResetRequest struct {
+	Token    string `json:"token"`
+	Password string `json:"password" validate:"required,min=8,max=128"`
+}
+
+// ForgotPasswordRequest asks for a password reset email.
+type ForgotPasswordRequest struct {
+	Email string `json:"email"`
+}
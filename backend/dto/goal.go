@@ -1,6 +1,66 @@
 package dto
 
+import "time"
+
 type GoalProgressResponse struct {
+	Goals   []GoalProgress `json:"goals"`
+	Streaks StreakResponse `json:"streaks"`
+}
+
+// GoalProgress is the progress of one goal in the period that contains
+// today, given as YYYY-MM-DD dates. Progress can exceed Value; Percentage and
+// Left stop at the goal.
+type GoalProgress struct {
+	GoalID      int64   `json:"goal_id"`
+	Type        string  `json:"type"`
+	Frequency   string  `json:"frequency"`
+	Value       int64   `json:"value"`
+	Progress    int64   `json:"progress"`
+	Percentage  float64 `json:"percentage"`
+	Left        int64   `json:"left"`
+	PeriodStart string  `json:"period_start"`
+	PeriodEnd   string  `json:"period_end"`
+}
+
+// LegacyGoalProgressResponse is the progress of the single goal reported by
+// the deprecated GET /goal/progress.
+type LegacyGoalProgressResponse struct {
 	Percentage float64 `json:"percentage"`
 	Left       int64   `json:"left"`
 }
+
+type GoalHistoryResponse struct {
+	Periods []GoalPeriod `json:"periods"`
+}
+
+// GoalPeriod is a finished period of a goal measured against the target in
+// effect on its last day. GoalID is omitted for goals deleted since.
+type GoalPeriod struct {
+	GoalID      int64  `json:"goal_id,omitempty"`
+	Type        string `json:"type"`
+	Frequency   string `json:"frequency"`
+	Value       int64  `json:"value"`
+	Achieved    int64  `json:"achieved"`
+	Hit         bool   `json:"hit"`
+	PeriodStart string `json:"period_start"`
+	PeriodEnd   string `json:"period_end"`
+}
+
+// PeriodProgress is what a user read in a period, in each unit goals are
+// measured in: pages, with ebook percent converted, audiobook minutes and
+// completed readings.
+type PeriodProgress struct {
+	Pages   int64 `json:"pages"`
+	Minutes int64 `json:"minutes"`
+	Books   int64 `json:"books"`
+}
+
+// DayProgress is what a user read on one day. Pages are counted in hundredths
+// of a page, as ebook percent converts to fractions of pages, so that the days
+// of a period add up to its PeriodProgress.
+type DayProgress struct {
+	Day            time.Time
+	PageHundredths int64
+	Minutes        int64
+	Books          int64
+}
@@ -2,12 +2,22 @@ package dto
 
 import "time"
 
+// ProgressRequest logs either Pages read since the last entry or the Page the
+// reader is on now, both in the unit of the reading. Going back to an earlier
+// page must be marked as a Correction.
 type ProgressRequest struct {
-	Pages int64     `json:"pages"`
-	Date  time.Time `json:"date"`
+	Pages      int64     `json:"pages,omitempty"`
+	Page       *int64    `json:"page,omitempty"`
+	Correction bool      `json:"correction,omitempty"`
+	Date       time.Time `json:"date"`
 }
 
 type Progress struct {
-	Date  string `json:"date"`
-	Pages int64  `json:"pages"`
+	Date string `json:"date"`
+	// Pages counts print pages and ebook percent converted to pages, Minutes
+	// the time spent on audiobooks.
+	Pages   int64 `json:"pages"`
+	Minutes int64 `json:"minutes"`
+	// Books counts the readings finished in the period.
+	Books int64 `json:"books"`
 }
@@ -1,14 +1,76 @@
 package dto
 
+import "time"
+
 type ReadingResponse struct {
 	BookTitle string  `json:"book_title"`
 	Status    string  `json:"status"`
 	Progress  int64   `json:"progress"`
 	Reading   Reading `json:"reading"`
+	// Pace is only given for readings in progress.
+	Pace *Pace `json:"pace,omitempty"`
 }
 
 type Reading struct {
-	ID         int64  `json:"id"`
-	TotalPages int64  `json:"total_pages"`
-	Link       string `json:"link"`
+	ID         int64      `json:"id"`
+	BookID     int64      `json:"book_id"`
+	Format     string     `json:"format"`
+	Unit       string     `json:"unit"`
+	TotalPages int64      `json:"total_pages"`
+	Link       string     `json:"link"`
+	StartedAt  *time.Time `json:"started_at"`
+	FinishedAt *time.Time `json:"finished_at"`
+	TargetDate *time.Time `json:"target_date"`
+}
+
+// ReadingRequest edits a reading. The length of an ebook is always 100
+// percent, so its TotalPages is ignored. A nil TargetDate clears it.
+type ReadingRequest struct {
+	TotalPages int64      `json:"total_pages"`
+	Link       string     `json:"link"`
+	TargetDate *time.Time `json:"target_date"`
+}
+
+type ReadingStatusRequest struct {
+	Status string `json:"status"`
+}
+
+// ReadingSessionStopRequest ends a reading session on Page, in the unit of
+// the reading.
+type ReadingSessionStopRequest struct {
+	Page *int64 `json:"page"`
+}
+
+// Pace describes how fast a reading is going, in the unit of the reading per
+// day.
+type Pace struct {
+	Unit      string       `json:"unit"`
+	Remaining int64        `json:"remaining"`
+	Windows   []PaceWindow `json:"windows"`
+	// EstimatedFinish is when the reading ends at the pace of the first
+	// window with any progress, or nil when none has.
+	EstimatedFinish *time.Time `json:"estimated_finish"`
+	TargetDate      *time.Time `json:"target_date,omitempty"`
+	// NeededPerDay is the pace that finishes the reading on TargetDate,
+	// counting today.
+	NeededPerDay *float64 `json:"needed_per_day,omitempty"`
+}
+
+// PaceWindow is the average progress per day over the last Days days, or
+// since the reading started when that is more recent.
+type PaceWindow struct {
+	Days   int64   `json:"days"`
+	PerDay float64 `json:"per_day"`
+}
+
+// PaceDay is the progress made on Date and the position reached by its end.
+type PaceDay struct {
+	Date     string `json:"date"`
+	Pages    int64  `json:"pages"`
+	Position int64  `json:"position"`
+}
+
+type PaceResponse struct {
+	Pace
+	Daily []PaceDay `json:"daily"`
 }
@@ -2,5 +2,12 @@ package dto
 
 type StatResponse struct {
 	Progress []Progress `json:"progress"`
-	Goal     int64      `json:"goal"`
+	// Goal is the goal line of every day of the daily chart and of the
+	// longest month of the monthly chart.
+	Goal int64 `json:"goal"`
+	// MonthGoals are the goal lines of the months of the monthly chart,
+	// January first.
+	MonthGoals []int64 `json:"month_goals,omitempty"`
+	// GoalType tells whether Goal is a line for the pages or the minutes.
+	GoalType string `json:"goal_type,omitempty"`
 }
@@ -0,0 +1,13 @@
+package dto
+
+// StreakResponse counts days read in a row. Rest days in between neither
+// break a streak nor add to it. The current streak stays alive until a day
+// without reading has passed, so it is kept through today before the user
+// has read.
+type StreakResponse struct {
+	Current   int64 `json:"current"`
+	Longest   int64 `json:"longest"`
+	ReadToday bool  `json:"read_today"`
+	// LastReadOn is the last day read, as YYYY-MM-DD in the user's timezone.
+	LastReadOn string `json:"last_read_on,omitempty"`
+}
@@ -0,0 +1,8 @@
+package dto
+
+// UserSettingsRequest replaces the timezone and rest days of the user. An
+// empty Timezone means UTC.
+type UserSettingsRequest struct {
+	Timezone string   `json:"timezone"`
+	RestDays []string `json:"rest_days"`
+}
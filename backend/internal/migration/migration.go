@@ -0,0 +1,311 @@
+package migration
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"io/fs"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const createTableQuery = `
+CREATE TABLE IF NOT EXISTS schema_migrations (
+	version BIGINT NOT NULL PRIMARY KEY,
+	name VARCHAR(255) NOT NULL,
+	applied_at TIMESTAMP NOT NULL
+)`
+
+var fileNamePattern = regexp.MustCompile(`^(\d+)_([a-z0-9_]+)\.(up|down)\.sql$`)
+
+type Migration struct {
+	Version int64
+	Name    string
+	Up      string
+	Down    string
+}
+
+type Status struct {
+	Version   int64
+	Name      string
+	AppliedAt *time.Time
+}
+
+type Migrator struct {
+	db         *sql.DB
+	migrations []Migration
+}
+
+// NewMigrator loads every "<version>_<name>.(up|down).sql" file from the root
+// of fsys. Each version must have both an up and a down file.
+func NewMigrator(db *sql.DB, fsys fs.FS) (*Migrator, error) {
+	migrations, err := load(fsys)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Migrator{
+		db:         db,
+		migrations: migrations,
+	}, nil
+}
+
+// LatestVersion returns the newest version in fsys without touching a database.
+func LatestVersion(fsys fs.FS) (int64, error) {
+	migrations, err := load(fsys)
+	if err != nil {
+		return 0, err
+	}
+	if len(migrations) == 0 {
+		return 0, nil
+	}
+	return migrations[len(migrations)-1].Version, nil
+}
+
+func load(fsys fs.FS) ([]Migration, error) {
+	entries, err := fs.ReadDir(fsys, ".")
+	if err != nil {
+		return nil, err
+	}
+
+	byVersion := map[int64]*Migration{}
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+
+		match := fileNamePattern.FindStringSubmatch(entry.Name())
+		if match == nil {
+			return nil, fmt.Errorf("invalid migration file name: %s", entry.Name())
+		}
+
+		version, err := strconv.ParseInt(match[1], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid migration version %s: %w", entry.Name(), err)
+		}
+
+		content, err := fs.ReadFile(fsys, entry.Name())
+		if err != nil {
+			return nil, err
+		}
+
+		m, ok := byVersion[version]
+		if !ok {
+			m = &Migration{Version: version, Name: match[2]}
+			byVersion[version] = m
+		} else if m.Name != match[2] {
+			return nil, fmt.Errorf("migration %d has conflicting names: %s and %s", version, m.Name, match[2])
+		}
+
+		if match[3] == "up" {
+			m.Up = string(content)
+		} else {
+			m.Down = string(content)
+		}
+	}
+
+	migrations := make([]Migration, 0, len(byVersion))
+	for _, m := range byVersion {
+		if strings.TrimSpace(m.Up) == "" || strings.TrimSpace(m.Down) == "" {
+			return nil, fmt.Errorf("migration %d_%s must have non-empty up and down files", m.Version, m.Name)
+		}
+		migrations = append(migrations, *m)
+	}
+	sort.Slice(migrations, func(i, j int) bool {
+		return migrations[i].Version < migrations[j].Version
+	})
+
+	return migrations, nil
+}
+
+func (m *Migrator) ensureTable(ctx context.Context) error {
+	_, err := m.db.ExecContext(ctx, createTableQuery)
+	return err
+}
+
+func (m *Migrator) applied(ctx context.Context) (map[int64]time.Time, error) {
+	if err := m.ensureTable(ctx); err != nil {
+		return nil, err
+	}
+
+	rows, err := m.db.QueryContext(ctx, `SELECT version, applied_at FROM schema_migrations`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	applied := map[int64]time.Time{}
+	for rows.Next() {
+		var version int64
+		var appliedAt time.Time
+		if err := rows.Scan(&version, &appliedAt); err != nil {
+			return nil, err
+		}
+		applied[version] = appliedAt
+	}
+
+	return applied, rows.Err()
+}
+
+// Latest returns the newest version known to the binary.
+func (m *Migrator) Latest() int64 {
+	if len(m.migrations) == 0 {
+		return 0
+	}
+	return m.migrations[len(m.migrations)-1].Version
+}
+
+// Version returns the newest version applied to the database.
+func (m *Migrator) Version(ctx context.Context) (int64, error) {
+	applied, err := m.applied(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	var version int64
+	for v := range applied {
+		version = max(version, v)
+	}
+	return version, nil
+}
+
+func (m *Migrator) Pending(ctx context.Context) ([]Migration, error) {
+	applied, err := m.applied(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var pending []Migration
+	for _, migration := range m.migrations {
+		if _, ok := applied[migration.Version]; !ok {
+			pending = append(pending, migration)
+		}
+	}
+
+	return pending, nil
+}
+
+func (m *Migrator) Status(ctx context.Context) ([]Status, error) {
+	applied, err := m.applied(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	statuses := make([]Status, 0, len(m.migrations))
+	for _, migration := range m.migrations {
+		status := Status{Version: migration.Version, Name: migration.Name}
+		if appliedAt, ok := applied[migration.Version]; ok {
+			status.AppliedAt = &appliedAt
+		}
+		statuses = append(statuses, status)
+	}
+
+	return statuses, nil
+}
+
+// Up applies every pending migration in version order and returns the ones
+// that were applied.
+func (m *Migrator) Up(ctx context.Context) ([]Migration, error) {
+	pending, err := m.Pending(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, migration := range pending {
+		err := m.run(ctx, migration.Up, func(tx *sql.Tx) error {
+			_, err := tx.ExecContext(ctx, `INSERT INTO schema_migrations (version, name, applied_at) VALUES (?, ?, ?)`,
+				migration.Version, migration.Name, time.Now().UTC())
+			return err
+		})
+		if err != nil {
+			return pending[:i], fmt.Errorf("migration %d_%s up: %w", migration.Version, migration.Name, err)
+		}
+	}
+
+	return pending, nil
+}
+
+// Down rolls back the most recently applied migration. It returns false when
+// there is nothing to roll back.
+func (m *Migrator) Down(ctx context.Context) (Migration, bool, error) {
+	applied, err := m.applied(ctx)
+	if err != nil {
+		return Migration{}, false, err
+	}
+
+	for i := len(m.migrations) - 1; i >= 0; i-- {
+		migration := m.migrations[i]
+		if _, ok := applied[migration.Version]; !ok {
+			continue
+		}
+
+		err := m.run(ctx, migration.Down, func(tx *sql.Tx) error {
+			_, err := tx.ExecContext(ctx, `DELETE FROM schema_migrations WHERE version = ?`, migration.Version)
+			return err
+		})
+		if err != nil {
+			return Migration{}, false, fmt.Errorf("migration %d_%s down: %w", migration.Version, migration.Name, err)
+		}
+
+		return migration, true, nil
+	}
+
+	return Migration{}, false, nil
+}
+
+// run executes script statement by statement followed by record in a single
+// transaction. MariaDB commits DDL implicitly, so a failing script may still
+// leave earlier statements applied there.
+func (m *Migrator) run(ctx context.Context, script string, record func(tx *sql.Tx) error) error {
+	tx, err := m.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, stmt := range splitStatements(script) {
+		if _, err := tx.ExecContext(ctx, stmt); err != nil {
+			_ = tx.Rollback()
+			return err
+		}
+	}
+
+	if err := record(tx); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// splitStatements splits a script on semicolons that end a line, dropping
+// "--" comment lines. Drivers are not required to support multi-statement
+// execution, so every statement is sent on its own.
+func splitStatements(script string) []string {
+	var statements []string
+	var current strings.Builder
+
+	for _, line := range strings.Split(script, "\n") {
+		trimmed := strings.TrimSpace(line)
+		if trimmed == "" || strings.HasPrefix(trimmed, "--") {
+			continue
+		}
+
+		current.WriteString(line)
+		current.WriteString("\n")
+
+		if strings.HasSuffix(trimmed, ";") {
+			stmt := strings.TrimSuffix(strings.TrimSpace(current.String()), ";")
+			statements = append(statements, stmt)
+			current.Reset()
+		}
+	}
+
+	if stmt := strings.TrimSpace(current.String()); stmt != "" {
+		statements = append(statements, stmt)
+	}
+
+	return statements
+}
@@ -0,0 +1,170 @@
+package migration
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"testing/fstest"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func testFS() fstest.MapFS {
+	return fstest.MapFS{
+		"0002_add_note.up.sql":   {Data: []byte("CREATE TABLE note (id INT);")},
+		"0002_add_note.down.sql": {Data: []byte("DROP TABLE note;")},
+		"0001_init.up.sql":       {Data: []byte("-- users\nCREATE TABLE user (id INT);\nCREATE TABLE book (id INT);\n")},
+		"0001_init.down.sql":     {Data: []byte("DROP TABLE book;\nDROP TABLE user;")},
+	}
+}
+
+func setupMigrator(t *testing.T) (*Migrator, sqlmock.Sqlmock) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	t.Cleanup(func() { db.Close() })
+
+	migrator, err := NewMigrator(db, testFS())
+	assert.NoError(t, err)
+	return migrator, mock
+}
+
+func expectApplied(mock sqlmock.Sqlmock, versions ...int64) {
+	mock.ExpectExec(`CREATE TABLE IF NOT EXISTS schema_migrations`).WillReturnResult(sqlmock.NewResult(0, 0))
+	rows := sqlmock.NewRows([]string{"version", "applied_at"})
+	for _, v := range versions {
+		rows.AddRow(v, time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+	}
+	mock.ExpectQuery(`SELECT version, applied_at FROM schema_migrations`).WillReturnRows(rows)
+}
+
+func TestNewMigrator_SortsByVersion(t *testing.T) {
+	migrator, _ := setupMigrator(t)
+
+	assert.Len(t, migrator.migrations, 2)
+	assert.Equal(t, int64(1), migrator.migrations[0].Version)
+	assert.Equal(t, "init", migrator.migrations[0].Name)
+	assert.Equal(t, int64(2), migrator.Latest())
+}
+
+func TestNewMigrator_InvalidFileName(t *testing.T) {
+	_, err := NewMigrator(nil, fstest.MapFS{"init.sql": {Data: []byte("SELECT 1;")}})
+
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid migration file name")
+}
+
+func TestNewMigrator_MissingDown(t *testing.T) {
+	_, err := NewMigrator(nil, fstest.MapFS{"0001_init.up.sql": {Data: []byte("SELECT 1;")}})
+
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "must have non-empty up and down files")
+}
+
+func TestMigrator_Up_AppliesPending(t *testing.T) {
+	migrator, mock := setupMigrator(t)
+
+	expectApplied(mock, 1)
+	mock.ExpectBegin()
+	mock.ExpectExec(`CREATE TABLE note \(id INT\)`).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(`INSERT INTO schema_migrations \(version, name, applied_at\) VALUES \(\?, \?, \?\)`).
+		WithArgs(int64(2), "add_note", sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	applied, err := migrator.Up(context.Background())
+
+	assert.NoError(t, err)
+	assert.Len(t, applied, 1)
+	assert.Equal(t, int64(2), applied[0].Version)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestMigrator_Up_RollsBackOnError(t *testing.T) {
+	migrator, mock := setupMigrator(t)
+
+	expectApplied(mock)
+	mock.ExpectBegin()
+	mock.ExpectExec(`CREATE TABLE user`).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(`CREATE TABLE book`).WillReturnError(errors.New("boom"))
+	mock.ExpectRollback()
+
+	applied, err := migrator.Up(context.Background())
+
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "migration 1_init up")
+	assert.Empty(t, applied)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestMigrator_Down_RollsBackLatest(t *testing.T) {
+	migrator, mock := setupMigrator(t)
+
+	expectApplied(mock, 1, 2)
+	mock.ExpectBegin()
+	mock.ExpectExec(`DROP TABLE note`).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(`DELETE FROM schema_migrations WHERE version = \?`).
+		WithArgs(int64(2)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	m, ok, err := migrator.Down(context.Background())
+
+	assert.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, int64(2), m.Version)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestMigrator_Down_NothingApplied(t *testing.T) {
+	migrator, mock := setupMigrator(t)
+
+	expectApplied(mock)
+
+	_, ok, err := migrator.Down(context.Background())
+
+	assert.NoError(t, err)
+	assert.False(t, ok)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestMigrator_Status(t *testing.T) {
+	migrator, mock := setupMigrator(t)
+
+	expectApplied(mock, 1)
+
+	statuses, err := migrator.Status(context.Background())
+
+	assert.NoError(t, err)
+	assert.Len(t, statuses, 2)
+	assert.NotNil(t, statuses[0].AppliedAt)
+	assert.Nil(t, statuses[1].AppliedAt)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestSplitStatements(t *testing.T) {
+	script := `-- comment
+CREATE TABLE a (
+    id INT
+);
+
+INSERT INTO a VALUES (1);
+SELECT 1`
+
+	assert.Equal(t, []string{
+		"CREATE TABLE a (\n    id INT\n)",
+		"INSERT INTO a VALUES (1)",
+		"SELECT 1",
+	}, splitStatements(script))
+}
+
+func TestLatestVersion(t *testing.T) {
+	version, err := LatestVersion(testFS())
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), version)
+
+	version, err = LatestVersion(fstest.MapFS{})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(0), version)
+}
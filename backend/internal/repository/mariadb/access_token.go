@@ -0,0 +1,139 @@
+package mariadb
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/rimvydascivilis/book-tracker/backend/domain"
+)
+
+type AccessTokenRepository struct {
+	DB *sql.DB
+}
+
+func NewAccessTokenRepository(db *sql.DB) *AccessTokenRepository {
+	return &AccessTokenRepository{
+		DB: db,
+	}
+}
+
+const accessTokenColumns = `id, user_id, name, scope, token_hash, created_at, expires_at, last_used_at`
+
+func scanAccessToken(row scanner) (domain.AccessToken, error) {
+	var (
+		token      domain.AccessToken
+		expiresAt  sql.NullTime
+		lastUsedAt sql.NullTime
+	)
+	err := row.Scan(&token.ID, &token.UserID, &token.Name, &token.Scope, &token.TokenHash, &token.CreatedAt,
+		&expiresAt, &lastUsedAt)
+	if err != nil {
+		return domain.AccessToken{}, err
+	}
+
+	if expiresAt.Valid {
+		t := expiresAt.Time.UTC()
+		token.ExpiresAt = &t
+	}
+	if lastUsedAt.Valid {
+		t := lastUsedAt.Time.UTC()
+		token.LastUsedAt = &t
+	}
+	token.CreatedAt = token.CreatedAt.UTC()
+	return token, nil
+}
+
+func (r *AccessTokenRepository) getOne(ctx context.Context, query string, args ...interface{}) (domain.AccessToken, error) {
+	token, err := scanAccessToken(conn(ctx, r.DB).QueryRowContext(ctx, query, args...))
+	if err == sql.ErrNoRows {
+		return domain.AccessToken{}, fmt.Errorf("%w: %s", domain.ErrRecordNotFound, "access token")
+	}
+	if err != nil {
+		return domain.AccessToken{}, err
+	}
+	return token, nil
+}
+
+func (r *AccessTokenRepository) GetAccessTokensByUserID(ctx context.Context, userID int64) ([]domain.AccessToken, error) {
+	query := `SELECT ` + accessTokenColumns + ` FROM access_token WHERE user_id = ? ORDER BY id`
+	rows, err := conn(ctx, r.DB).QueryContext(ctx, query, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	tokens := []domain.AccessToken{}
+	for rows.Next() {
+		token, err := scanAccessToken(rows)
+		if err != nil {
+			return nil, err
+		}
+		tokens = append(tokens, token)
+	}
+	return tokens, rows.Err()
+}
+
+func (r *AccessTokenRepository) GetAccessTokenByID(ctx context.Context, id int64) (domain.AccessToken, error) {
+	return r.getOne(ctx, `SELECT `+accessTokenColumns+` FROM access_token WHERE id = ?`, id)
+}
+
+func (r *AccessTokenRepository) GetAccessTokenByHash(ctx context.Context, hash string) (domain.AccessToken, error) {
+	return r.getOne(ctx, `SELECT `+accessTokenColumns+` FROM access_token WHERE token_hash = ?`, hash)
+}
+
+func (r *AccessTokenRepository) CreateAccessToken(ctx context.Context, token domain.AccessToken) (domain.AccessToken, error) {
+	var expiresAt interface{}
+	if token.ExpiresAt != nil {
+		expiresAt = token.ExpiresAt.UTC()
+	}
+
+	query := `
+INSERT INTO access_token (user_id, name, scope, token_hash, created_at, expires_at)
+VALUES (?, ?, ?, ?, ?, ?)`
+	res, err := conn(ctx, r.DB).ExecContext(ctx, query, token.UserID, token.Name, token.Scope, token.TokenHash,
+		token.CreatedAt.UTC(), expiresAt)
+	if err != nil {
+		return domain.AccessToken{}, err
+	}
+
+	token.ID, err = res.LastInsertId()
+	if err != nil {
+		return domain.AccessToken{}, err
+	}
+	token.LastUsedAt = nil
+	return token, nil
+}
+
+func (r *AccessTokenRepository) UpdateAccessTokenLastUsed(ctx context.Context, id int64, t time.Time) error {
+	res, err := conn(ctx, r.DB).ExecContext(ctx, `UPDATE access_token SET last_used_at = ? WHERE id = ?`, t.UTC(), id)
+	if err != nil {
+		return err
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return fmt.Errorf("%w: %s", domain.ErrRecordNotFound, "access token")
+	}
+	return nil
+}
+
+func (r *AccessTokenRepository) DeleteAccessToken(ctx context.Context, id int64) error {
+	res, err := conn(ctx, r.DB).ExecContext(ctx, `DELETE FROM access_token WHERE id = ?`, id)
+	if err != nil {
+		return err
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return fmt.Errorf("%w: %s", domain.ErrRecordNotFound, "access token")
+	}
+	return nil
+}
@@ -0,0 +1,123 @@
+package mariadb
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+
+	"github.com/rimvydascivilis/book-tracker/backend/domain"
+)
+
+type AuthorRepository struct {
+	DB *sql.DB
+}
+
+func NewAuthorRepository(db *sql.DB) *AuthorRepository {
+	return &AuthorRepository{
+		DB: db,
+	}
+}
+
+// placeholders returns "?, ?, ..." with n placeholders for an IN clause.
+func placeholders(n int) string {
+	return strings.TrimSuffix(strings.Repeat("?, ", n), ", ")
+}
+
+func (r *AuthorRepository) GetOrCreateAuthors(ctx context.Context, names []string) ([]domain.Author, error) {
+	// LAST_INSERT_ID(id) makes LastInsertId return the existing row's id when
+	// the name is already taken. The collation compares names ignoring case,
+	// so the stored row is read back for the name it was first given.
+	query := `INSERT INTO author (name) VALUES (?) ON DUPLICATE KEY UPDATE id = LAST_INSERT_ID(id)`
+	stmt, err := conn(ctx, r.DB).PrepareContext(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer stmt.Close()
+
+	selectStmt, err := conn(ctx, r.DB).PrepareContext(ctx, `SELECT name FROM author WHERE id = ?`)
+	if err != nil {
+		return nil, err
+	}
+	defer selectStmt.Close()
+
+	authors := make([]domain.Author, 0, len(names))
+	for _, name := range names {
+		res, err := stmt.ExecContext(ctx, name)
+		if err != nil {
+			return nil, err
+		}
+
+		id, err := res.LastInsertId()
+		if err != nil {
+			return nil, err
+		}
+
+		author := domain.Author{ID: id}
+		if err := selectStmt.QueryRowContext(ctx, id).Scan(&author.Name); err != nil {
+			return nil, err
+		}
+		authors = append(authors, author)
+	}
+
+	return authors, nil
+}
+
+func (r *AuthorRepository) GetAuthorsByBookIDs(ctx context.Context, bookIDs []int64) (map[int64][]domain.Author, error) {
+	authors := map[int64][]domain.Author{}
+	if len(bookIDs) == 0 {
+		return authors, nil
+	}
+
+	query := `
+SELECT book_author.book_id, author.id, author.name
+FROM book_author
+JOIN author ON author.id = book_author.author_id
+WHERE book_author.book_id IN (` + placeholders(len(bookIDs)) + `)
+ORDER BY book_author.book_id, book_author.position`
+	args := make([]interface{}, 0, len(bookIDs))
+	for _, id := range bookIDs {
+		args = append(args, id)
+	}
+
+	rows, err := conn(ctx, r.DB).QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var bookID int64
+		var author domain.Author
+		if err := rows.Scan(&bookID, &author.ID, &author.Name); err != nil {
+			return nil, err
+		}
+		authors[bookID] = append(authors[bookID], author)
+	}
+
+	return authors, rows.Err()
+}
+
+func (r *AuthorRepository) SetBookAuthors(ctx context.Context, bookID int64, authorIDs []int64) error {
+	_, err := conn(ctx, r.DB).ExecContext(ctx, `DELETE FROM book_author WHERE book_id = ?`, bookID)
+	if err != nil {
+		return err
+	}
+
+	if len(authorIDs) == 0 {
+		return nil
+	}
+
+	stmt, err := conn(ctx, r.DB).PrepareContext(ctx, `INSERT INTO book_author (book_id, author_id, position) VALUES (?, ?, ?)`)
+	if err != nil {
+		return err
+	}
+	defer stmt.Close()
+
+	for i, authorID := range authorIDs {
+		if _, err := stmt.ExecContext(ctx, bookID, authorID, i); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
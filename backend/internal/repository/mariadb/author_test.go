@@ -0,0 +1,81 @@
+package mariadb_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/rimvydascivilis/book-tracker/backend/domain"
+	"github.com/rimvydascivilis/book-tracker/backend/internal/repository/mariadb"
+	"github.com/stretchr/testify/assert"
+)
+
+func setupAuthorRepository(t *testing.T) (*mariadb.AuthorRepository, sqlmock.Sqlmock) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	t.Cleanup(func() { db.Close() })
+	return mariadb.NewAuthorRepository(db), mock
+}
+
+func TestAuthorRepository_GetOrCreateAuthors(t *testing.T) {
+	authorRepo, mock := setupAuthorRepository(t)
+
+	insert := mock.ExpectPrepare(`INSERT INTO author \(name\) VALUES \(\?\) ON DUPLICATE KEY UPDATE id = LAST_INSERT_ID\(id\)`)
+	sel := mock.ExpectPrepare(`SELECT name FROM author WHERE id = \?`)
+	insert.ExpectExec().WithArgs("Terry Pratchett").WillReturnResult(sqlmock.NewResult(4, 1))
+	sel.ExpectQuery().WithArgs(int64(4)).WillReturnRows(sqlmock.NewRows([]string{"name"}).AddRow("Terry Pratchett"))
+	// An existing author spelled differently keeps its stored name.
+	insert.ExpectExec().WithArgs("neil gaiman").WillReturnResult(sqlmock.NewResult(2, 0))
+	sel.ExpectQuery().WithArgs(int64(2)).WillReturnRows(sqlmock.NewRows([]string{"name"}).AddRow("Neil Gaiman"))
+
+	authors, err := authorRepo.GetOrCreateAuthors(context.Background(), []string{"Terry Pratchett", "neil gaiman"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, []domain.Author{{ID: 4, Name: "Terry Pratchett"}, {ID: 2, Name: "Neil Gaiman"}}, authors)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestAuthorRepository_GetAuthorsByBookIDs(t *testing.T) {
+	authorRepo, mock := setupAuthorRepository(t)
+
+	rows := sqlmock.NewRows([]string{"book_id", "id", "name"}).
+		AddRow(1, 4, "Terry Pratchett").
+		AddRow(1, 2, "Neil Gaiman").
+		AddRow(3, 2, "Neil Gaiman")
+	mock.ExpectQuery(`SELECT book_author.book_id, author.id, author.name\s+FROM book_author\s+JOIN author .*\s+WHERE book_author.book_id IN \(\?, \?\)\s+ORDER BY book_author.book_id, book_author.position`).
+		WithArgs(int64(1), int64(3)).
+		WillReturnRows(rows)
+
+	authors, err := authorRepo.GetAuthorsByBookIDs(context.Background(), []int64{1, 3})
+
+	assert.NoError(t, err)
+	assert.Equal(t, map[int64][]domain.Author{
+		1: {{ID: 4, Name: "Terry Pratchett"}, {ID: 2, Name: "Neil Gaiman"}},
+		3: {{ID: 2, Name: "Neil Gaiman"}},
+	}, authors)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestAuthorRepository_GetAuthorsByBookIDs_NoBooks(t *testing.T) {
+	authorRepo, mock := setupAuthorRepository(t)
+
+	authors, err := authorRepo.GetAuthorsByBookIDs(context.Background(), nil)
+
+	assert.NoError(t, err)
+	assert.Empty(t, authors)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestAuthorRepository_SetBookAuthors(t *testing.T) {
+	authorRepo, mock := setupAuthorRepository(t)
+
+	mock.ExpectExec(`DELETE FROM book_author WHERE book_id = \?`).WithArgs(int64(1)).WillReturnResult(sqlmock.NewResult(0, 2))
+	prep := mock.ExpectPrepare(`INSERT INTO book_author \(book_id, author_id, position\) VALUES \(\?, \?, \?\)`)
+	prep.ExpectExec().WithArgs(int64(1), int64(4), 0).WillReturnResult(sqlmock.NewResult(0, 1))
+	prep.ExpectExec().WithArgs(int64(1), int64(2), 1).WillReturnResult(sqlmock.NewResult(0, 1))
+
+	err := authorRepo.SetBookAuthors(context.Background(), 1, []int64{4, 2})
+
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -7,8 +7,11 @@ import (
 	"time"
 
 	"github.com/rimvydascivilis/book-tracker/backend/domain"
+	"github.com/rimvydascivilis/book-tracker/backend/utils"
 )
 
+const bookColumns = `id, user_id, title, isbn10, isbn13, publisher, publication_year, language, description, page_count, rating, created_at`
+
 type BookRepository struct {
 	DB *sql.DB
 }
@@ -19,16 +22,25 @@ func NewBookRepository(db *sql.DB) *BookRepository {
 	}
 }
 
+type scanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func scanBook(row scanner) (domain.Book, error) {
+	b := domain.Book{}
+	err := row.Scan(&b.ID, &b.UserID, &b.Title, &b.ISBN10, &b.ISBN13, &b.Publisher, &b.PublicationYear,
+		&b.Language, &b.Description, &b.PageCount, &b.Rating, &b.CreatedAt)
+	return b, err
+}
+
 func (m *BookRepository) getOne(ctx context.Context, query string, args ...interface{}) (domain.Book, error) {
-	stmt, err := m.DB.PrepareContext(ctx, query)
+	stmt, err := conn(ctx, m.DB).PrepareContext(ctx, query)
 	if err != nil {
 		return domain.Book{}, err
 	}
 	defer stmt.Close()
 
-	row := stmt.QueryRowContext(ctx, args...)
-	b := domain.Book{}
-	err = row.Scan(&b.ID, &b.UserID, &b.Title, &b.Rating, &b.CreatedAt)
+	b, err := scanBook(stmt.QueryRowContext(ctx, args...))
 	if err == sql.ErrNoRows {
 		return domain.Book{}, fmt.Errorf("%w: %s", domain.ErrRecordNotFound, "book")
 	}
@@ -40,7 +52,7 @@ func (m *BookRepository) getOne(ctx context.Context, query string, args ...inter
 }
 
 func (m *BookRepository) getAll(ctx context.Context, query string, args ...interface{}) (res []domain.Book, err error) {
-	stmt, err := m.DB.PrepareContext(ctx, query)
+	stmt, err := conn(ctx, m.DB).PrepareContext(ctx, query)
 	if err != nil {
 		return []domain.Book{}, err
 	}
@@ -54,8 +66,7 @@ func (m *BookRepository) getAll(ctx context.Context, query string, args ...inter
 
 	res = []domain.Book{}
 	for rows.Next() {
-		b := domain.Book{}
-		err = rows.Scan(&b.ID, &b.Title, &b.Rating, &b.CreatedAt)
+		b, err := scanBook(rows)
 		if err != nil {
 			return []domain.Book{}, err
 		}
@@ -66,14 +77,14 @@ func (m *BookRepository) getAll(ctx context.Context, query string, args ...inter
 }
 
 func (m *BookRepository) GetBooksByUser(ctx context.Context, userID, offset, limit int64) ([]domain.Book, error) {
-	query := `SELECT id, title, rating, created_at FROM book WHERE user_id = ? LIMIT ? OFFSET ?`
+	query := `SELECT ` + bookColumns + ` FROM book WHERE user_id = ? ORDER BY id LIMIT ? OFFSET ?`
 	return m.getAll(ctx, query, userID, limit, offset)
 }
 
 func (m *BookRepository) CountBooksByUser(ctx context.Context, userID int64) (int64, error) {
 	var count int64
 	query := `SELECT COUNT(*) FROM book WHERE user_id = ?`
-	err := m.DB.QueryRowContext(ctx, query, userID).Scan(&count)
+	err := conn(ctx, m.DB).QueryRowContext(ctx, query, userID).Scan(&count)
 	if err != nil {
 		return 0, err
 	}
@@ -81,15 +92,18 @@ func (m *BookRepository) CountBooksByUser(ctx context.Context, userID int64) (in
 }
 
 func (m *BookRepository) CreateBook(ctx context.Context, b domain.Book) (domain.Book, error) {
-	query := `INSERT INTO book (user_id, title, rating, created_at) VALUES (?, ?, ?, ?)`
-	stmt, err := m.DB.PrepareContext(ctx, query)
+	query := `
+INSERT INTO book (user_id, title, isbn10, isbn13, publisher, publication_year, language, description, page_count, rating, created_at)
+VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`
+	stmt, err := conn(ctx, m.DB).PrepareContext(ctx, query)
 	if err != nil {
 		return domain.Book{}, err
 	}
 	defer stmt.Close()
 
 	b.CreatedAt = time.Now()
-	res, err := stmt.ExecContext(ctx, b.UserID, b.Title, b.Rating, b.CreatedAt)
+	res, err := stmt.ExecContext(ctx, b.UserID, b.Title, b.ISBN10, b.ISBN13, b.Publisher, b.PublicationYear,
+		b.Language, b.Description, b.PageCount, b.Rating, b.CreatedAt)
 	if err != nil {
 		return domain.Book{}, err
 	}
@@ -104,24 +118,46 @@ func (m *BookRepository) CreateBook(ctx context.Context, b domain.Book) (domain.
 }
 
 func (m *BookRepository) GetBookByUserID(ctx context.Context, userID, bookID int64) (domain.Book, error) {
-	query := `SELECT id, user_id, title, rating, created_at FROM book WHERE user_id = ? AND id = ?`
+	query := `SELECT ` + bookColumns + ` FROM book WHERE user_id = ? AND id = ?`
+	return m.getOne(ctx, query, userID, bookID)
+}
+
+func (m *BookRepository) GetBookByUserIDForUpdate(ctx context.Context, userID, bookID int64) (domain.Book, error) {
+	query := `SELECT ` + bookColumns + ` FROM book WHERE user_id = ? AND id = ? FOR UPDATE`
 	return m.getOne(ctx, query, userID, bookID)
 }
 
-func (m *BookRepository) SearchBooksByTitle(ctx context.Context, userID int64, title string, limit int64) ([]domain.Book, error) {
-	query := `SELECT id, title, rating, created_at FROM book WHERE user_id = ? AND title LIKE ? LIMIT ?`
-	return m.getAll(ctx, query, userID, "%"+title+"%", limit)
+func (m *BookRepository) SearchBooks(ctx context.Context, userID int64, search string, limit int64) ([]domain.Book, error) {
+	query := `
+SELECT ` + bookColumns + ` FROM book
+WHERE user_id = ?
+	AND (
+		title LIKE ? OR isbn10 = ? OR isbn13 = ? OR
+		EXISTS (
+			SELECT 1 FROM book_author
+			JOIN author ON author.id = book_author.author_id
+			WHERE book_author.book_id = book.id AND author.name LIKE ?
+		)
+	)
+LIMIT ?`
+	like := "%" + search + "%"
+	isbn := utils.NormalizeISBN(search)
+	return m.getAll(ctx, query, userID, like, isbn, isbn, like, limit)
 }
 
 func (m *BookRepository) UpdateBook(ctx context.Context, b domain.Book) (domain.Book, error) {
-	query := `UPDATE book SET title = ?, rating = ? WHERE user_id = ? AND id = ?`
-	stmt, err := m.DB.PrepareContext(ctx, query)
+	query := `
+UPDATE book
+SET title = ?, isbn10 = ?, isbn13 = ?, publisher = ?, publication_year = ?, language = ?, description = ?, page_count = ?, rating = ?
+WHERE user_id = ? AND id = ?`
+	stmt, err := conn(ctx, m.DB).PrepareContext(ctx, query)
 	if err != nil {
 		return domain.Book{}, err
 	}
 	defer stmt.Close()
 
-	_, err = stmt.ExecContext(ctx, b.Title, b.Rating, b.UserID, b.ID)
+	_, err = stmt.ExecContext(ctx, b.Title, b.ISBN10, b.ISBN13, b.Publisher, b.PublicationYear, b.Language,
+		b.Description, b.PageCount, b.Rating, b.UserID, b.ID)
 	if err != nil {
 		return domain.Book{}, err
 	}
@@ -131,7 +167,7 @@ func (m *BookRepository) UpdateBook(ctx context.Context, b domain.Book) (domain.
 
 func (m *BookRepository) DeleteBook(ctx context.Context, userID, bookID int64) error {
 	query := `DELETE FROM book WHERE user_id = ? AND id = ?`
-	stmt, err := m.DB.PrepareContext(ctx, query)
+	stmt, err := conn(ctx, m.DB).PrepareContext(ctx, query)
 	if err != nil {
 		return err
 	}
@@ -13,6 +13,14 @@ import (
 	"github.com/stretchr/testify/assert"
 )
 
+var bookRowColumns = []string{"id", "user_id", "title", "isbn10", "isbn13", "publisher", "publication_year",
+	"language", "description", "page_count", "rating", "created_at"}
+
+func bookRow(rows *sqlmock.Rows, b domain.Book) *sqlmock.Rows {
+	return rows.AddRow(b.ID, b.UserID, b.Title, b.ISBN10, b.ISBN13, b.Publisher, b.PublicationYear,
+		b.Language, b.Description, b.PageCount, b.Rating, b.CreatedAt)
+}
+
 func setupBookRepository(t *testing.T) (*mariadb.BookRepository, sqlmock.Sqlmock) {
 	db, mock, err := sqlmock.New()
 	assert.NoError(t, err)
@@ -26,15 +34,13 @@ func TestBookRepository_GetBooksByUser_Success(t *testing.T) {
 
 	ctx := context.Background()
 	testBooks := []domain.Book{
-		{ID: 1, Title: "Book 1", Rating: 5, CreatedAt: time.Now()},
-		{ID: 2, Title: "Book 2", Rating: 4, CreatedAt: time.Now()},
+		{ID: 1, UserID: 1, Title: "Book 1", ISBN13: "9780441013593", PageCount: 412, Rating: 5, CreatedAt: time.Now()},
+		{ID: 2, UserID: 1, Title: "Book 2", Rating: 4, CreatedAt: time.Now()},
 	}
 
-	rows := sqlmock.NewRows([]string{"id", "title", "rating", "created_at"}).
-		AddRow(testBooks[0].ID, testBooks[0].Title, testBooks[0].Rating, testBooks[0].CreatedAt).
-		AddRow(testBooks[1].ID, testBooks[1].Title, testBooks[1].Rating, testBooks[1].CreatedAt)
+	rows := bookRow(bookRow(sqlmock.NewRows(bookRowColumns), testBooks[0]), testBooks[1])
 
-	mock.ExpectPrepare(`SELECT id, title, rating, created_at FROM book WHERE user_id = \? LIMIT \? OFFSET \?`).
+	mock.ExpectPrepare(`SELECT id, user_id, title, isbn10, isbn13, .* FROM book WHERE user_id = \? ORDER BY id LIMIT \? OFFSET \?`).
 		ExpectQuery().
 		WithArgs(1, int64(10), int64(0)).
 		WillReturnRows(rows)
@@ -71,13 +77,17 @@ func TestBookRepository_CreateBook_Success(t *testing.T) {
 	newBook := domain.Book{
 		UserID:    1,
 		Title:     "New Book",
+		ISBN13:    "9780441013593",
+		Language:  "en",
+		PageCount: 412,
 		Rating:    5,
 		CreatedAt: time.Now(),
 	}
 
-	mock.ExpectPrepare(`INSERT INTO book \(user_id, title, rating, created_at\) VALUES \(\?, \?, \?, \?\)`).
+	mock.ExpectPrepare(`INSERT INTO book \(user_id, title, isbn10, isbn13, publisher, publication_year, language, description, page_count, rating, created_at\)`).
 		ExpectExec().
-		WithArgs(newBook.UserID, newBook.Title, newBook.Rating, sqlmock.AnyArg()).
+		WithArgs(newBook.UserID, newBook.Title, "", newBook.ISBN13, "", int64(0), newBook.Language, "", newBook.PageCount,
+			newBook.Rating, sqlmock.AnyArg()).
 		WillReturnResult(sqlmock.NewResult(1, 1))
 
 	book, err := bookRepo.CreateBook(ctx, newBook)
@@ -93,12 +103,11 @@ func TestBookRepository_GetBookByUserID_Success(t *testing.T) {
 	bookRepo, mock := setupBookRepository(t)
 
 	ctx := context.Background()
-	testBook := domain.Book{ID: 1, UserID: 1, Title: "Book 1", Rating: 5, CreatedAt: time.Now()}
+	testBook := domain.Book{ID: 1, UserID: 1, Title: "Book 1", Publisher: "Ace", PublicationYear: 1990, Rating: 5, CreatedAt: time.Now()}
 
-	rows := sqlmock.NewRows([]string{"id", "user_id", "title", "rating", "created_at"}).
-		AddRow(testBook.ID, testBook.UserID, testBook.Title, testBook.Rating, testBook.CreatedAt)
+	rows := bookRow(sqlmock.NewRows(bookRowColumns), testBook)
 
-	mock.ExpectPrepare(`SELECT id, user_id, title, rating, created_at FROM book WHERE user_id = \? AND id = \?`).
+	mock.ExpectPrepare(`SELECT id, user_id, title, .* FROM book WHERE user_id = \? AND id = \?`).
 		ExpectQuery().
 		WithArgs(1, 1).
 		WillReturnRows(rows)
@@ -115,7 +124,7 @@ func TestBookRepository_GetBookByUserID_NotFound(t *testing.T) {
 
 	ctx := context.Background()
 
-	mock.ExpectPrepare(`SELECT id, user_id, title, rating, created_at FROM book WHERE user_id = \? AND id = \?`).
+	mock.ExpectPrepare(`SELECT id, user_id, title, .* FROM book WHERE user_id = \? AND id = \?`).
 		ExpectQuery().
 		WithArgs(1, 99).
 		WillReturnError(sql.ErrNoRows)
@@ -139,9 +148,9 @@ func TestBookRepository_UpdateBook_Success(t *testing.T) {
 		Rating: 5,
 	}
 
-	mock.ExpectPrepare(`UPDATE book SET title = \?, rating = \? WHERE user_id = \? AND id = \?`).
+	mock.ExpectPrepare(`UPDATE book\s+SET title = \?, isbn10 = \?, .*, rating = \?\s+WHERE user_id = \? AND id = \?`).
 		ExpectExec().
-		WithArgs(updateBook.Title, updateBook.Rating, updateBook.UserID, updateBook.ID).
+		WithArgs(updateBook.Title, "", "", "", int64(0), "", "", int64(0), updateBook.Rating, updateBook.UserID, updateBook.ID).
 		WillReturnResult(sqlmock.NewResult(1, 1))
 
 	book, err := bookRepo.UpdateBook(ctx, updateBook)
@@ -152,6 +161,24 @@ func TestBookRepository_UpdateBook_Success(t *testing.T) {
 	assert.NoError(t, mock.ExpectationsWereMet())
 }
 
+func TestBookRepository_SearchBooks_MatchesAuthorsAndISBN(t *testing.T) {
+	bookRepo, mock := setupBookRepository(t)
+
+	ctx := context.Background()
+	testBook := domain.Book{ID: 1, UserID: 1, Title: "Dune", ISBN13: "9780441013593", CreatedAt: time.Now()}
+
+	mock.ExpectPrepare(`SELECT id, user_id, title, .* FROM book\s+WHERE user_id = \?\s+AND \(\s+title LIKE \? OR isbn10 = \? OR isbn13 = \? OR\s+EXISTS`).
+		ExpectQuery().
+		WithArgs(1, "%978-0441013593%", "9780441013593", "9780441013593", "%978-0441013593%", 10).
+		WillReturnRows(bookRow(sqlmock.NewRows(bookRowColumns), testBook))
+
+	books, err := bookRepo.SearchBooks(ctx, 1, "978-0441013593", 10)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []domain.Book{testBook}, books)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
 func TestBookRepository_DeleteBook_Success(t *testing.T) {
 	bookRepo, mock := setupBookRepository(t)
 
@@ -3,8 +3,11 @@ package mariadb
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
+	"time"
 
+	"github.com/go-sql-driver/mysql"
 	"github.com/rimvydascivilis/book-tracker/backend/domain"
 )
 
@@ -18,20 +21,58 @@ func NewGoalRepository(db *sql.DB) *GoalRepository {
 	}
 }
 
-func (r *GoalRepository) GetGoalByUserID(ctx context.Context, userID int64) (domain.Goal, error) {
-	query := `SELECT user_id, type, frequency, value FROM goal WHERE user_id = ?`
-	stmt, err := r.DB.PrepareContext(ctx, query)
+const goalColumns = `id, user_id, type, frequency, value`
+
+// goalError reports a second goal of the same type and frequency, caught by
+// the goal_user_type_frequency key, as ErrAlreadyExists.
+func goalError(err error, goal domain.Goal) error {
+	var mysqlErr *mysql.MySQLError
+	if errors.As(err, &mysqlErr) && mysqlErr.Number == errDuplicateEntry {
+		return fmt.Errorf("%w: %s %s goal", domain.ErrAlreadyExists, goal.Frequency, goal.Type)
+	}
+	return err
+}
+
+func (r *GoalRepository) GetGoalsByUserID(ctx context.Context, userID int64) ([]domain.Goal, error) {
+	query := `SELECT ` + goalColumns + ` FROM goal WHERE user_id = ? ORDER BY id`
+	stmt, err := conn(ctx, r.DB).PrepareContext(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer stmt.Close()
+
+	rows, err := stmt.QueryContext(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	goals := []domain.Goal{}
+	for rows.Next() {
+		var goal domain.Goal
+		if err := rows.Scan(&goal.ID, &goal.UserID, &goal.Type, &goal.Frequency, &goal.Value); err != nil {
+			return nil, err
+		}
+		goals = append(goals, goal)
+	}
+
+	return goals, rows.Err()
+}
+
+func (r *GoalRepository) GetGoalByID(ctx context.Context, id int64) (domain.Goal, error) {
+	query := `SELECT ` + goalColumns + ` FROM goal WHERE id = ?`
+	stmt, err := conn(ctx, r.DB).PrepareContext(ctx, query)
 	if err != nil {
 		return domain.Goal{}, err
 	}
 	defer stmt.Close()
 
-	row := stmt.QueryRowContext(ctx, userID)
+	row := stmt.QueryRowContext(ctx, id)
 
 	var goal domain.Goal
-	err = row.Scan(&goal.UserID, &goal.Type, &goal.Frequency, &goal.Value)
+	err = row.Scan(&goal.ID, &goal.UserID, &goal.Type, &goal.Frequency, &goal.Value)
 	if err == sql.ErrNoRows {
-		return domain.Goal{}, fmt.Errorf("%w: goal for user %d not found", domain.ErrRecordNotFound, userID)
+		return domain.Goal{}, fmt.Errorf("%w: goal %d not found", domain.ErrRecordNotFound, id)
 	}
 	if err != nil {
 		return domain.Goal{}, err
@@ -42,32 +83,113 @@ func (r *GoalRepository) GetGoalByUserID(ctx context.Context, userID int64) (dom
 
 func (r *GoalRepository) CreateGoal(ctx context.Context, goal domain.Goal) (domain.Goal, error) {
 	query := `INSERT INTO goal (user_id, type, frequency, value) VALUES (?, ?, ?, ?)`
-	stmt, err := r.DB.PrepareContext(ctx, query)
+	stmt, err := conn(ctx, r.DB).PrepareContext(ctx, query)
 	if err != nil {
 		return domain.Goal{}, err
 	}
 	defer stmt.Close()
 
-	_, err = stmt.ExecContext(ctx, goal.UserID, goal.Type, goal.Frequency, goal.Value)
+	res, err := stmt.ExecContext(ctx, goal.UserID, goal.Type, goal.Frequency, goal.Value)
+	if err != nil {
+		return domain.Goal{}, goalError(err, goal)
+	}
+
+	id, err := res.LastInsertId()
 	if err != nil {
 		return domain.Goal{}, err
 	}
 
+	goal.ID = id
 	return goal, nil
 }
 
 func (r *GoalRepository) UpdateGoal(ctx context.Context, goal domain.Goal) (domain.Goal, error) {
-	query := `UPDATE goal SET type = ?, frequency = ?, value = ? WHERE user_id = ?`
-	stmt, err := r.DB.PrepareContext(ctx, query)
+	query := `UPDATE goal SET type = ?, frequency = ?, value = ? WHERE id = ?`
+	stmt, err := conn(ctx, r.DB).PrepareContext(ctx, query)
 	if err != nil {
 		return domain.Goal{}, err
 	}
 	defer stmt.Close()
 
-	_, err = stmt.ExecContext(ctx, goal.Type, goal.Frequency, goal.Value, goal.UserID)
+	_, err = stmt.ExecContext(ctx, goal.Type, goal.Frequency, goal.Value, goal.ID)
 	if err != nil {
-		return domain.Goal{}, err
+		return domain.Goal{}, goalError(err, goal)
 	}
 
 	return goal, nil
 }
+
+func (r *GoalRepository) DeleteGoal(ctx context.Context, id int64) error {
+	query := `DELETE FROM goal WHERE id = ?`
+	stmt, err := conn(ctx, r.DB).PrepareContext(ctx, query)
+	if err != nil {
+		return err
+	}
+	defer stmt.Close()
+
+	_, err = stmt.ExecContext(ctx, id)
+	return err
+}
+
+const goalVersionColumns = `id, COALESCE(goal_id, 0), user_id, type, frequency, value, effective_from, effective_to`
+
+func (r *GoalRepository) GetGoalVersionsByUserID(ctx context.Context, userID int64) ([]domain.GoalVersion, error) {
+	query := `SELECT ` + goalVersionColumns + ` FROM goal_version WHERE user_id = ? ORDER BY effective_from, id`
+	rows, err := conn(ctx, r.DB).QueryContext(ctx, query, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	versions := []domain.GoalVersion{}
+	for rows.Next() {
+		var version domain.GoalVersion
+		var effectiveTo sql.NullTime
+		err := rows.Scan(&version.ID, &version.GoalID, &version.UserID, &version.Type, &version.Frequency, &version.Value,
+			&version.EffectiveFrom, &effectiveTo)
+		if err != nil {
+			return nil, err
+		}
+		if effectiveTo.Valid {
+			version.EffectiveTo = &effectiveTo.Time
+		}
+		versions = append(versions, version)
+	}
+
+	return versions, rows.Err()
+}
+
+func (r *GoalRepository) CreateGoalVersion(ctx context.Context, version domain.GoalVersion) (domain.GoalVersion, error) {
+	query := `
+INSERT INTO goal_version (user_id, goal_id, type, frequency, value, effective_from, effective_to)
+VALUES (?, ?, ?, ?, ?, ?, ?)`
+	var goalID interface{}
+	if version.GoalID != 0 {
+		goalID = version.GoalID
+	}
+	res, err := conn(ctx, r.DB).ExecContext(ctx, query, version.UserID, goalID, version.Type, version.Frequency,
+		version.Value, version.EffectiveFrom.Format("2006-01-02"), nullDate(version.EffectiveTo))
+	if err != nil {
+		return domain.GoalVersion{}, err
+	}
+
+	id, err := res.LastInsertId()
+	if err != nil {
+		return domain.GoalVersion{}, err
+	}
+
+	version.ID = id
+	return version, nil
+}
+
+func (r *GoalRepository) EndGoalVersion(ctx context.Context, goalID int64, to time.Time) error {
+	day := to.Format("2006-01-02")
+	query := `DELETE FROM goal_version WHERE goal_id = ? AND effective_to IS NULL AND effective_from > ?`
+	if _, err := conn(ctx, r.DB).ExecContext(ctx, query, goalID, day); err != nil {
+		return err
+	}
+
+	query = `UPDATE goal_version SET effective_to = ? WHERE goal_id = ? AND effective_to IS NULL`
+	_, err := conn(ctx, r.DB).ExecContext(ctx, query, day, goalID)
+	return err
+}
@@ -5,8 +5,10 @@ import (
 	"database/sql"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-sql-driver/mysql"
 	"github.com/rimvydascivilis/book-tracker/backend/domain"
 	"github.com/rimvydascivilis/book-tracker/backend/internal/repository/mariadb"
 	"github.com/stretchr/testify/assert"
@@ -20,48 +22,48 @@ func setupGoalRepository(t *testing.T) (*mariadb.GoalRepository, sqlmock.Sqlmock
 	return goalRepo, mock
 }
 
-func TestGoalRepository_GetGoalByUserID_Success(t *testing.T) {
+func TestGoalRepository_GetGoalsByUserID_Success(t *testing.T) {
 	goalRepo, mock := setupGoalRepository(t)
 
 	ctx := context.Background()
 	testUserID := int64(1)
-	testGoal := domain.Goal{
-		UserID:    testUserID,
-		Type:      "books",
-		Frequency: "daily",
-		Value:     10,
+	testGoals := []domain.Goal{
+		{ID: 1, UserID: testUserID, Type: "pages", Frequency: "daily", Value: 20},
+		{ID: 2, UserID: testUserID, Type: "books", Frequency: "yearly", Value: 24},
 	}
 
-	rows := sqlmock.NewRows([]string{"user_id", "type", "frequency", "value"}).
-		AddRow(testGoal.UserID, testGoal.Type, testGoal.Frequency, testGoal.Value)
+	rows := sqlmock.NewRows([]string{"id", "user_id", "type", "frequency", "value"})
+	for _, g := range testGoals {
+		rows.AddRow(g.ID, g.UserID, g.Type, g.Frequency, g.Value)
+	}
 
-	mock.ExpectPrepare(`SELECT user_id, type, frequency, value FROM goal WHERE user_id = \?`).
+	mock.ExpectPrepare(`SELECT id, user_id, type, frequency, value FROM goal WHERE user_id = \? ORDER BY id`).
 		ExpectQuery().
 		WithArgs(testUserID).
 		WillReturnRows(rows)
 
-	goal, err := goalRepo.GetGoalByUserID(ctx, testUserID)
+	goals, err := goalRepo.GetGoalsByUserID(ctx, testUserID)
 
 	assert.NoError(t, err)
-	assert.Equal(t, testGoal, goal)
+	assert.Equal(t, testGoals, goals)
 	assert.NoError(t, mock.ExpectationsWereMet())
 }
 
-func TestGoalRepository_GetGoalByUserID_NotFound(t *testing.T) {
+func TestGoalRepository_GetGoalByID_NotFound(t *testing.T) {
 	goalRepo, mock := setupGoalRepository(t)
 
 	ctx := context.Background()
-	testUserID := int64(999)
+	testID := int64(999)
 
-	mock.ExpectPrepare(`SELECT user_id, type, frequency, value FROM goal WHERE user_id = \?`).
+	mock.ExpectPrepare(`SELECT id, user_id, type, frequency, value FROM goal WHERE id = \?`).
 		ExpectQuery().
-		WithArgs(testUserID).
+		WithArgs(testID).
 		WillReturnError(sql.ErrNoRows)
 
-	goal, err := goalRepo.GetGoalByUserID(ctx, testUserID)
+	goal, err := goalRepo.GetGoalByID(ctx, testID)
 
-	assert.Error(t, err)
-	assert.Contains(t, err.Error(), "goal for user 999 not found")
+	assert.ErrorIs(t, err, domain.ErrRecordNotFound)
+	assert.Contains(t, err.Error(), "goal 999 not found")
 	assert.Equal(t, domain.Goal{}, goal)
 	assert.NoError(t, mock.ExpectationsWereMet())
 }
@@ -80,11 +82,12 @@ func TestGoalRepository_CreateGoal_Success(t *testing.T) {
 	mock.ExpectPrepare(`INSERT INTO goal`).
 		ExpectExec().
 		WithArgs(testGoal.UserID, testGoal.Type, testGoal.Frequency, testGoal.Value).
-		WillReturnResult(sqlmock.NewResult(0, 1))
+		WillReturnResult(sqlmock.NewResult(3, 1))
 
 	createdGoal, err := goalRepo.CreateGoal(ctx, testGoal)
 
 	assert.NoError(t, err)
+	testGoal.ID = 3
 	assert.Equal(t, testGoal, createdGoal)
 	assert.NoError(t, mock.ExpectationsWereMet())
 }
@@ -112,20 +115,43 @@ func TestGoalRepository_CreateGoal_Failure(t *testing.T) {
 	assert.NoError(t, mock.ExpectationsWereMet())
 }
 
+func TestGoalRepository_CreateGoal_Duplicate(t *testing.T) {
+	goalRepo, mock := setupGoalRepository(t)
+
+	ctx := context.Background()
+	testGoal := domain.Goal{
+		UserID:    1,
+		Type:      "pages",
+		Frequency: "monthly",
+		Value:     100,
+	}
+
+	mock.ExpectPrepare(`INSERT INTO goal`).
+		ExpectExec().
+		WithArgs(testGoal.UserID, testGoal.Type, testGoal.Frequency, testGoal.Value).
+		WillReturnError(&mysql.MySQLError{Number: 1062, Message: "Duplicate entry '1-pages-monthly'"})
+
+	_, err := goalRepo.CreateGoal(ctx, testGoal)
+
+	assert.ErrorIs(t, err, domain.ErrAlreadyExists)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
 func TestGoalRepository_UpdateGoal_Success(t *testing.T) {
 	goalRepo, mock := setupGoalRepository(t)
 
 	ctx := context.Background()
 	testGoal := domain.Goal{
+		ID:        5,
 		UserID:    1,
 		Type:      "books",
 		Frequency: "daily",
 		Value:     20,
 	}
 
-	mock.ExpectPrepare(`UPDATE goal SET type = \?, frequency = \?, value = \? WHERE user_id = \?`).
+	mock.ExpectPrepare(`UPDATE goal SET type = \?, frequency = \?, value = \? WHERE id = \?`).
 		ExpectExec().
-		WithArgs(testGoal.Type, testGoal.Frequency, testGoal.Value, testGoal.UserID).
+		WithArgs(testGoal.Type, testGoal.Frequency, testGoal.Value, testGoal.ID).
 		WillReturnResult(sqlmock.NewResult(0, 1))
 
 	updatedGoal, err := goalRepo.UpdateGoal(ctx, testGoal)
@@ -140,15 +166,16 @@ func TestGoalRepository_UpdateGoal_Failure(t *testing.T) {
 
 	ctx := context.Background()
 	testGoal := domain.Goal{
+		ID:        5,
 		UserID:    1,
 		Type:      "books",
 		Frequency: "daily",
 		Value:     20,
 	}
 
-	mock.ExpectPrepare(`UPDATE goal SET type = \?, frequency = \?, value = \? WHERE user_id = \?`).
+	mock.ExpectPrepare(`UPDATE goal SET type = \?, frequency = \?, value = \? WHERE id = \?`).
 		ExpectExec().
-		WithArgs(testGoal.Type, testGoal.Frequency, testGoal.Value, testGoal.UserID).
+		WithArgs(testGoal.Type, testGoal.Frequency, testGoal.Value, testGoal.ID).
 		WillReturnError(errors.New("database error"))
 
 	updatedGoal, err := goalRepo.UpdateGoal(ctx, testGoal)
@@ -157,3 +184,42 @@ func TestGoalRepository_UpdateGoal_Failure(t *testing.T) {
 	assert.Equal(t, domain.Goal{}, updatedGoal)
 	assert.NoError(t, mock.ExpectationsWereMet())
 }
+
+func TestGoalRepository_GetGoalVersionsByUserID(t *testing.T) {
+	goalRepo, mock := setupGoalRepository(t)
+
+	from := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2024, 3, 9, 0, 0, 0, 0, time.UTC)
+	rows := sqlmock.NewRows([]string{"id", "goal_id", "user_id", "type", "frequency", "value", "effective_from", "effective_to"}).
+		AddRow(1, 0, 1, "pages", "daily", 20, from, to).
+		AddRow(2, 3, 1, "pages", "weekly", 100, to.AddDate(0, 0, 1), nil)
+	mock.ExpectQuery(`SELECT id, COALESCE\(goal_id, 0\), user_id, type, frequency, value, effective_from, effective_to FROM goal_version WHERE user_id = \? ORDER BY effective_from, id`).
+		WithArgs(int64(1)).
+		WillReturnRows(rows)
+
+	versions, err := goalRepo.GetGoalVersionsByUserID(context.Background(), 1)
+
+	assert.NoError(t, err)
+	assert.Len(t, versions, 2)
+	assert.Equal(t, &to, versions[0].EffectiveTo)
+	assert.Zero(t, versions[0].GoalID)
+	assert.Nil(t, versions[1].EffectiveTo)
+	assert.Equal(t, int64(3), versions[1].GoalID)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGoalRepository_EndGoalVersion(t *testing.T) {
+	goalRepo, mock := setupGoalRepository(t)
+
+	mock.ExpectExec(`DELETE FROM goal_version WHERE goal_id = \? AND effective_to IS NULL AND effective_from > \?`).
+		WithArgs(int64(3), "2024-03-09").
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(`UPDATE goal_version SET effective_to = \? WHERE goal_id = \? AND effective_to IS NULL`).
+		WithArgs("2024-03-09", int64(3)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	err := goalRepo.EndGoalVersion(context.Background(), 3, time.Date(2024, 3, 9, 0, 0, 0, 0, time.UTC))
+
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
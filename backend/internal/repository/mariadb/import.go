@@ -0,0 +1,172 @@
+package mariadb
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/rimvydascivilis/book-tracker/backend/domain"
+	"github.com/rimvydascivilis/book-tracker/backend/utils"
+)
+
+type ImportRepository struct {
+	DB *sql.DB
+}
+
+func NewImportRepository(db *sql.DB) *ImportRepository {
+	return &ImportRepository{
+		DB: db,
+	}
+}
+
+const importJobColumns = `id, user_id, source, checksum, data, status, total_rows, error, created_at, updated_at`
+
+func scanImportJob(row scanner) (domain.ImportJob, error) {
+	var job domain.ImportJob
+	err := row.Scan(&job.ID, &job.UserID, &job.Source, &job.Checksum, &job.Data, &job.Status,
+		&job.TotalRows, &job.Error, &job.CreatedAt, &job.UpdatedAt)
+	return job, err
+}
+
+func (r *ImportRepository) getOne(ctx context.Context, query string, args ...interface{}) (domain.ImportJob, error) {
+	job, err := scanImportJob(conn(ctx, r.DB).QueryRowContext(ctx, query, args...))
+	if err == sql.ErrNoRows {
+		return domain.ImportJob{}, fmt.Errorf("%w: %s", domain.ErrRecordNotFound, "import job")
+	}
+	if err != nil {
+		return domain.ImportJob{}, err
+	}
+	return job, nil
+}
+
+func (r *ImportRepository) GetImportJobByID(ctx context.Context, id int64) (domain.ImportJob, error) {
+	query := `SELECT ` + importJobColumns + ` FROM import_job WHERE id = ?`
+	return r.getOne(ctx, query, id)
+}
+
+func (r *ImportRepository) GetImportJobByChecksum(ctx context.Context, userID int64, source, checksum string) (domain.ImportJob, error) {
+	query := `SELECT ` + importJobColumns + ` FROM import_job WHERE user_id = ? AND source = ? AND checksum = ?`
+	return r.getOne(ctx, query, userID, source, checksum)
+}
+
+func (r *ImportRepository) GetUnfinishedImportJobs(ctx context.Context) ([]domain.ImportJob, error) {
+	query := `SELECT ` + importJobColumns + ` FROM import_job WHERE status IN (?, ?) ORDER BY id`
+	rows, err := conn(ctx, r.DB).QueryContext(ctx, query, domain.ImportStatusPending, domain.ImportStatusRunning)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	jobs := []domain.ImportJob{}
+	for rows.Next() {
+		job, err := scanImportJob(rows)
+		if err != nil {
+			return nil, err
+		}
+		jobs = append(jobs, job)
+	}
+	return jobs, rows.Err()
+}
+
+func (r *ImportRepository) CreateImportJob(ctx context.Context, job domain.ImportJob) (domain.ImportJob, error) {
+	job.CreatedAt = utils.Now()
+	job.UpdatedAt = job.CreatedAt
+
+	query := `
+INSERT INTO import_job (user_id, source, checksum, data, status, total_rows, error, created_at, updated_at)
+VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)`
+	res, err := conn(ctx, r.DB).ExecContext(ctx, query, job.UserID, job.Source, job.Checksum, job.Data,
+		job.Status, job.TotalRows, job.Error, job.CreatedAt, job.UpdatedAt)
+	if err != nil {
+		return domain.ImportJob{}, err
+	}
+
+	job.ID, err = res.LastInsertId()
+	if err != nil {
+		return domain.ImportJob{}, err
+	}
+	return job, nil
+}
+
+func (r *ImportRepository) UpdateImportJob(ctx context.Context, job domain.ImportJob) (domain.ImportJob, error) {
+	job.UpdatedAt = utils.Now()
+
+	query := `UPDATE import_job SET status = ?, total_rows = ?, error = ?, updated_at = ? WHERE id = ?`
+	res, err := conn(ctx, r.DB).ExecContext(ctx, query, job.Status, job.TotalRows, job.Error, job.UpdatedAt, job.ID)
+	if err != nil {
+		return domain.ImportJob{}, err
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return domain.ImportJob{}, err
+	}
+	if affected == 0 {
+		return domain.ImportJob{}, fmt.Errorf("%w: %s", domain.ErrRecordNotFound, "import job")
+	}
+	return job, nil
+}
+
+func (r *ImportRepository) GetImportRowsByJobID(ctx context.Context, jobID int64) ([]domain.ImportRow, error) {
+	query := `
+SELECT id, job_id, row_num, external_id, status, COALESCE(book_id, 0), error, created_at
+FROM import_row WHERE job_id = ? ORDER BY row_num`
+	rows, err := conn(ctx, r.DB).QueryContext(ctx, query, jobID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	importRows := []domain.ImportRow{}
+	for rows.Next() {
+		var row domain.ImportRow
+		err := rows.Scan(&row.ID, &row.JobID, &row.RowNumber, &row.ExternalID, &row.Status, &row.BookID, &row.Error, &row.CreatedAt)
+		if err != nil {
+			return nil, err
+		}
+		importRows = append(importRows, row)
+	}
+	return importRows, rows.Err()
+}
+
+func (r *ImportRepository) CreateImportRow(ctx context.Context, row domain.ImportRow) (domain.ImportRow, error) {
+	row.CreatedAt = utils.Now()
+
+	var bookID sql.NullInt64
+	if row.BookID != 0 {
+		bookID = sql.NullInt64{Int64: row.BookID, Valid: true}
+	}
+
+	query := `
+INSERT INTO import_row (job_id, row_num, external_id, status, book_id, error, created_at)
+VALUES (?, ?, ?, ?, ?, ?, ?)`
+	res, err := conn(ctx, r.DB).ExecContext(ctx, query, row.JobID, row.RowNumber, row.ExternalID, row.Status,
+		bookID, row.Error, row.CreatedAt)
+	if err != nil {
+		return domain.ImportRow{}, err
+	}
+
+	row.ID, err = res.LastInsertId()
+	if err != nil {
+		return domain.ImportRow{}, err
+	}
+	return row, nil
+}
+
+func (r *ImportRepository) GetImportedBookID(ctx context.Context, userID int64, source, externalID string) (int64, error) {
+	query := `
+SELECT r.book_id FROM import_row r
+JOIN import_job j ON j.id = r.job_id
+WHERE j.user_id = ? AND j.source = ? AND r.external_id = ? AND r.book_id IS NOT NULL
+ORDER BY r.id LIMIT 1`
+
+	var bookID int64
+	err := conn(ctx, r.DB).QueryRowContext(ctx, query, userID, source, externalID).Scan(&bookID)
+	if err == sql.ErrNoRows {
+		return 0, fmt.Errorf("%w: %s", domain.ErrRecordNotFound, "imported book")
+	}
+	if err != nil {
+		return 0, err
+	}
+	return bookID, nil
+}
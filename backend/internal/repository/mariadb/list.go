@@ -17,17 +17,17 @@ func NewListRepository(db *sql.DB) *ListRepository {
 	}
 }
 
-func (r *ListRepository) getOne(query string, args ...interface{}) (domain.List, error) {
+func (r *ListRepository) getOne(ctx context.Context, query string, args ...interface{}) (domain.List, error) {
 	var list domain.List
-	err := r.DB.QueryRow(query, args...).Scan(&list.ID, &list.UserID, &list.Title)
+	err := conn(ctx, r.DB).QueryRowContext(ctx, query, args...).Scan(&list.ID, &list.UserID, &list.Title)
 	if err != nil {
 		return domain.List{}, err
 	}
 	return list, nil
 }
 
-func (r *ListRepository) getAll(query string, args ...interface{}) ([]domain.List, error) {
-	rows, err := r.DB.Query(query, args...)
+func (r *ListRepository) getAll(ctx context.Context, query string, args ...interface{}) ([]domain.List, error) {
+	rows, err := conn(ctx, r.DB).QueryContext(ctx, query, args...)
 	if err != nil {
 		return nil, err
 	}
@@ -47,17 +47,17 @@ func (r *ListRepository) getAll(query string, args ...interface{}) ([]domain.Lis
 
 func (r *ListRepository) GetListByID(ctx context.Context, listID int64) (domain.List, error) {
 	query := "SELECT id, user_id, title FROM list WHERE id = ?"
-	return r.getOne(query, listID)
+	return r.getOne(ctx, query, listID)
 }
 
 func (r *ListRepository) GetListsByUserID(ctx context.Context, userID int64) ([]domain.List, error) {
 	query := "SELECT id, user_id, title FROM list WHERE user_id = ?"
-	return r.getAll(query, userID)
+	return r.getAll(ctx, query, userID)
 }
 
 func (r *ListRepository) CreateList(ctx context.Context, list domain.List) (domain.List, error) {
 	query := "INSERT INTO list (user_id, title) VALUES (?, ?)"
-	res, err := r.DB.Exec(query, list.UserID, list.Title)
+	res, err := conn(ctx, r.DB).ExecContext(ctx, query, list.UserID, list.Title)
 	if err != nil {
 		return domain.List{}, err
 	}
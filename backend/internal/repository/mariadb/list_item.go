@@ -17,8 +17,8 @@ func NewListItemRepository(db *sql.DB) *ListItemRepository {
 	}
 }
 
-func (r *ListItemRepository) getAll(query string, args ...interface{}) ([]domain.ListItem, error) {
-	rows, err := r.DB.Query(query, args...)
+func (r *ListItemRepository) getAll(ctx context.Context, query string, args ...interface{}) ([]domain.ListItem, error) {
+	rows, err := conn(ctx, r.DB).QueryContext(ctx, query, args...)
 	if err != nil {
 		return nil, err
 	}
@@ -38,12 +38,33 @@ func (r *ListItemRepository) getAll(query string, args ...interface{}) ([]domain
 
 func (r *ListItemRepository) GetListItemsByListID(ctx context.Context, listID int64) ([]domain.ListItem, error) {
 	query := "SELECT id, list_id, book_id FROM list_item WHERE list_id = ?"
-	return r.getAll(query, listID)
+	return r.getAll(ctx, query, listID)
+}
+
+func (r *ListItemRepository) GetListItemBooksByListID(ctx context.Context, listID int64) ([]domain.ListItemBook, error) {
+	query := `SELECT list_item.id, list_item.list_id, list_item.book_id, book.title
+FROM list_item JOIN book ON book.id = list_item.book_id
+WHERE list_item.list_id = ? ORDER BY list_item.id`
+	rows, err := conn(ctx, r.DB).QueryContext(ctx, query, listID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	items := []domain.ListItemBook{}
+	for rows.Next() {
+		var item domain.ListItemBook
+		if err := rows.Scan(&item.ID, &item.ListID, &item.BookID, &item.BookTitle); err != nil {
+			return nil, err
+		}
+		items = append(items, item)
+	}
+	return items, rows.Err()
 }
 
 func (r *ListItemRepository) CreateListItem(ctx context.Context, listItem domain.ListItem) (domain.ListItem, error) {
 	query := "INSERT INTO list_item (list_id, book_id) VALUES (?, ?)"
-	res, err := r.DB.Exec(query, listItem.ListID, listItem.BookID)
+	res, err := conn(ctx, r.DB).ExecContext(ctx, query, listItem.ListID, listItem.BookID)
 	if err != nil {
 		return domain.ListItem{}, err
 	}
@@ -57,7 +78,7 @@ func (r *ListItemRepository) CreateListItem(ctx context.Context, listItem domain
 
 func (r *ListItemRepository) DeleteListItem(ctx context.Context, id int64) error {
 	query := "DELETE FROM list_item WHERE id = ?"
-	_, err := r.DB.Exec(query, id)
+	_, err := conn(ctx, r.DB).ExecContext(ctx, query, id)
 	if err != nil {
 		return err
 	}
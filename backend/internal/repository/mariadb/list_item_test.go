@@ -0,0 +1,65 @@
+package mariadb_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/rimvydascivilis/book-tracker/backend/domain"
+	"github.com/rimvydascivilis/book-tracker/backend/internal/repository/mariadb"
+	"github.com/rimvydascivilis/book-tracker/backend/services/list"
+	"github.com/rimvydascivilis/book-tracker/backend/services/validation"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const listItemBooksQuery = `SELECT list_item.id, list_item.list_id, list_item.book_id, book.title\s+` +
+	`FROM list_item JOIN book ON book.id = list_item.book_id\s+WHERE list_item.list_id = \? ORDER BY list_item.id`
+
+func TestListItemRepository_GetListItemBooksByListID(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	t.Cleanup(func() { db.Close() })
+
+	mock.ExpectQuery(listItemBooksQuery).
+		WithArgs(int64(4)).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "list_id", "book_id", "title"}).
+			AddRow(1, 4, 5, "Dune").
+			AddRow(2, 4, 6, "Emma"))
+
+	items, err := mariadb.NewListItemRepository(db).GetListItemBooksByListID(context.Background(), 4)
+
+	require.NoError(t, err)
+	assert.Equal(t, []domain.ListItemBook{
+		{ListItem: domain.ListItem{ID: 1, ListID: 4, BookID: 5}, BookTitle: "Dune"},
+		{ListItem: domain.ListItem{ID: 2, ListID: 4, BookID: 6}, BookTitle: "Emma"},
+	}, items)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+// TestGetList_QueryCount locks in the round trips of a list: the list and
+// its items with their titles, however many items it holds.
+func TestGetList_QueryCount(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	t.Cleanup(func() { db.Close() })
+
+	mock.ExpectQuery(`SELECT id, user_id, title FROM list WHERE id = \?`).
+		WithArgs(int64(4)).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "user_id", "title"}).AddRow(4, 1, "Summer"))
+	mock.ExpectQuery(listItemBooksQuery).
+		WithArgs(int64(4)).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "list_id", "book_id", "title"}).
+			AddRow(1, 4, 5, "Dune").
+			AddRow(2, 4, 6, "Emma").
+			AddRow(3, 4, 7, "Ulysses"))
+
+	svc := list.NewListService(mariadb.NewListRepository(db), mariadb.NewListItemRepository(db),
+		mariadb.NewBookRepository(db), mariadb.NewTxManager(db), validation.NewValidationService())
+	res, err := svc.GetList(context.Background(), 1, 4)
+
+	require.NoError(t, err)
+	require.Len(t, res.ListItems, 3)
+	assert.Equal(t, "Ulysses", res.ListItems[2].BookName)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,167 @@
+package mariadb
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/go-sql-driver/mysql"
+	"github.com/rimvydascivilis/book-tracker/backend/domain"
+)
+
+type LocalAccountRepository struct {
+	DB *sql.DB
+}
+
+func NewLocalAccountRepository(db *sql.DB) *LocalAccountRepository {
+	return &LocalAccountRepository{
+		DB: db,
+	}
+}
+
+const localAccountColumns = `user_id, password_hash, email_verified_at, failed_logins, locked_until, created_at`
+
+// nullTime is t in UTC, or NULL when t is nil.
+func nullTime(t *time.Time) interface{} {
+	if t == nil {
+		return nil
+	}
+	return t.UTC()
+}
+
+func (r *LocalAccountRepository) CreateLocalAccount(ctx context.Context, account domain.LocalAccount) (domain.LocalAccount, error) {
+	query := `
+INSERT INTO local_account (user_id, password_hash, email_verified_at, failed_logins, locked_until, created_at)
+VALUES (?, ?, ?, ?, ?, ?)`
+	_, err := conn(ctx, r.DB).ExecContext(ctx, query, account.UserID, account.PasswordHash,
+		nullTime(account.EmailVerifiedAt), account.FailedLogins, nullTime(account.LockedUntil), account.CreatedAt.UTC())
+	var mysqlErr *mysql.MySQLError
+	if errors.As(err, &mysqlErr) && mysqlErr.Number == errDuplicateEntry {
+		return domain.LocalAccount{}, fmt.Errorf("%w: local account of user %d", domain.ErrAlreadyExists, account.UserID)
+	}
+	if err != nil {
+		return domain.LocalAccount{}, err
+	}
+	return account, nil
+}
+
+func (r *LocalAccountRepository) GetLocalAccount(ctx context.Context, userID int64) (domain.LocalAccount, error) {
+	query := `SELECT ` + localAccountColumns + ` FROM local_account WHERE user_id = ?`
+	return r.getLocalAccount(ctx, query, userID)
+}
+
+func (r *LocalAccountRepository) GetLocalAccountForUpdate(ctx context.Context, userID int64) (domain.LocalAccount, error) {
+	query := `SELECT ` + localAccountColumns + ` FROM local_account WHERE user_id = ? FOR UPDATE`
+	return r.getLocalAccount(ctx, query, userID)
+}
+
+func (r *LocalAccountRepository) getLocalAccount(ctx context.Context, query string, userID int64) (domain.LocalAccount, error) {
+	var (
+		account     domain.LocalAccount
+		verifiedAt  sql.NullTime
+		lockedUntil sql.NullTime
+	)
+	err := conn(ctx, r.DB).QueryRowContext(ctx, query, userID).Scan(&account.UserID, &account.PasswordHash,
+		&verifiedAt, &account.FailedLogins, &lockedUntil, &account.CreatedAt)
+	if err == sql.ErrNoRows {
+		return domain.LocalAccount{}, fmt.Errorf("%w: %s", domain.ErrRecordNotFound, "local account")
+	}
+	if err != nil {
+		return domain.LocalAccount{}, err
+	}
+
+	if verifiedAt.Valid {
+		t := verifiedAt.Time.UTC()
+		account.EmailVerifiedAt = &t
+	}
+	if lockedUntil.Valid {
+		t := lockedUntil.Time.UTC()
+		account.LockedUntil = &t
+	}
+	account.CreatedAt = account.CreatedAt.UTC()
+	return account, nil
+}
+
+func (r *LocalAccountRepository) UpdateLocalAccount(ctx context.Context, account domain.LocalAccount) (domain.LocalAccount, error) {
+	query := `
+UPDATE local_account SET password_hash = ?, email_verified_at = ?, failed_logins = ?, locked_until = ?
+WHERE user_id = ?`
+	res, err := conn(ctx, r.DB).ExecContext(ctx, query, account.PasswordHash, nullTime(account.EmailVerifiedAt),
+		account.FailedLogins, nullTime(account.LockedUntil), account.UserID)
+	if err != nil {
+		return domain.LocalAccount{}, err
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return domain.LocalAccount{}, err
+	}
+	if affected == 0 {
+		return domain.LocalAccount{}, fmt.Errorf("%w: %s", domain.ErrRecordNotFound, "local account")
+	}
+	return account, nil
+}
+
+func (r *LocalAccountRepository) CreateAccountToken(ctx context.Context, token domain.AccountToken) (domain.AccountToken, error) {
+	query := `INSERT INTO account_token (user_id, purpose, token_hash, expires_at) VALUES (?, ?, ?, ?)`
+	res, err := conn(ctx, r.DB).ExecContext(ctx, query, token.UserID, token.Purpose, token.TokenHash,
+		token.ExpiresAt.UTC())
+	if err != nil {
+		return domain.AccountToken{}, err
+	}
+
+	token.ID, err = res.LastInsertId()
+	if err != nil {
+		return domain.AccountToken{}, err
+	}
+	token.UsedAt = nil
+	return token, nil
+}
+
+func (r *LocalAccountRepository) GetAccountTokenByHashForUpdate(ctx context.Context, hash string) (domain.AccountToken, error) {
+	query := `SELECT id, user_id, purpose, token_hash, expires_at, used_at FROM account_token WHERE token_hash = ? FOR UPDATE`
+
+	var (
+		token  domain.AccountToken
+		usedAt sql.NullTime
+	)
+	err := conn(ctx, r.DB).QueryRowContext(ctx, query, hash).
+		Scan(&token.ID, &token.UserID, &token.Purpose, &token.TokenHash, &token.ExpiresAt, &usedAt)
+	if err == sql.ErrNoRows {
+		return domain.AccountToken{}, fmt.Errorf("%w: %s", domain.ErrRecordNotFound, "account token")
+	}
+	if err != nil {
+		return domain.AccountToken{}, err
+	}
+
+	if usedAt.Valid {
+		t := usedAt.Time.UTC()
+		token.UsedAt = &t
+	}
+	token.ExpiresAt = token.ExpiresAt.UTC()
+	return token, nil
+}
+
+func (r *LocalAccountRepository) MarkAccountTokenUsed(ctx context.Context, id int64, t time.Time) error {
+	res, err := conn(ctx, r.DB).ExecContext(ctx, `UPDATE account_token SET used_at = ? WHERE id = ?`, t.UTC(), id)
+	if err != nil {
+		return err
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return fmt.Errorf("%w: %s", domain.ErrRecordNotFound, "account token")
+	}
+	return nil
+}
+
+func (r *LocalAccountRepository) DeleteAccountTokens(ctx context.Context, userID int64, purpose string) error {
+	query := `DELETE FROM account_token WHERE user_id = ? AND purpose = ?`
+	_, err := conn(ctx, r.DB).ExecContext(ctx, query, userID, purpose)
+	return err
+}
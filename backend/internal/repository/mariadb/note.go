@@ -17,8 +17,8 @@ func NewNoteRepository(db *sql.DB) *NoteRepository {
 	}
 }
 
-func (r *NoteRepository) getOne(query string, args ...interface{}) (domain.Note, error) {
-	row := r.DB.QueryRow(query, args...)
+func (r *NoteRepository) getOne(ctx context.Context, query string, args ...interface{}) (domain.Note, error) {
+	row := conn(ctx, r.DB).QueryRowContext(ctx, query, args...)
 	note := domain.Note{}
 	err := row.Scan(&note.ID, &note.UserID, &note.BookID, &note.PageNumber, &note.Content, &note.CreatedAt)
 	if err != nil {
@@ -27,8 +27,8 @@ func (r *NoteRepository) getOne(query string, args ...interface{}) (domain.Note,
 	return note, nil
 }
 
-func (r *NoteRepository) getAll(query string, args ...interface{}) ([]domain.Note, error) {
-	rows, err := r.DB.Query(query, args...)
+func (r *NoteRepository) getAll(ctx context.Context, query string, args ...interface{}) ([]domain.Note, error) {
+	rows, err := conn(ctx, r.DB).QueryContext(ctx, query, args...)
 	if err != nil {
 		return nil, err
 	}
@@ -48,12 +48,12 @@ func (r *NoteRepository) getAll(query string, args ...interface{}) ([]domain.Not
 
 func (r *NoteRepository) GetNoteByUserID(ctx context.Context, noteID, userID int64) (domain.Note, error) {
 	query := "SELECT id, user_id, book_id, page_number, content, created_at FROM note WHERE id = ? AND user_id = ?"
-	return r.getOne(query, noteID, userID)
+	return r.getOne(ctx, query, noteID, userID)
 }
 
 func (r *NoteRepository) GetBookIDsByUserID(ctx context.Context, userID int64) ([]int64, error) {
 	query := "SELECT book_id FROM note WHERE user_id = ? GROUP BY book_id"
-	rows, err := r.DB.Query(query, userID)
+	rows, err := conn(ctx, r.DB).QueryContext(ctx, query, userID)
 	if err != nil {
 		return nil, err
 	}
@@ -73,12 +73,12 @@ func (r *NoteRepository) GetBookIDsByUserID(ctx context.Context, userID int64) (
 
 func (r *NoteRepository) GetNotesByUserIDAndBookID(ctx context.Context, userID, bookID int64) ([]domain.Note, error) {
 	query := "SELECT id, user_id, book_id, page_number, content, created_at FROM note WHERE user_id = ? AND book_id = ?"
-	return r.getAll(query, userID, bookID)
+	return r.getAll(ctx, query, userID, bookID)
 }
 
 func (r *NoteRepository) CreateNote(ctx context.Context, note domain.Note) (domain.Note, error) {
 	query := "INSERT INTO note (user_id, book_id, page_number, content) VALUES (?, ?, ?, ?)"
-	res, err := r.DB.Exec(query, note.UserID, note.BookID, note.PageNumber, note.Content)
+	res, err := conn(ctx, r.DB).ExecContext(ctx, query, note.UserID, note.BookID, note.PageNumber, note.Content)
 	if err != nil {
 		return domain.Note{}, err
 	}
@@ -92,7 +92,7 @@ func (r *NoteRepository) CreateNote(ctx context.Context, note domain.Note) (doma
 
 func (r *NoteRepository) DeleteNote(ctx context.Context, id int64) error {
 	query := "DELETE FROM note WHERE id = ?"
-	_, err := r.DB.Exec(query, id)
+	_, err := conn(ctx, r.DB).ExecContext(ctx, query, id)
 	if err != nil {
 		return err
 	}
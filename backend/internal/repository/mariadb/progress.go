@@ -3,9 +3,12 @@ package mariadb
 import (
 	"context"
 	"database/sql"
+	"fmt"
+	"time"
 
 	"github.com/rimvydascivilis/book-tracker/backend/domain"
 	"github.com/rimvydascivilis/book-tracker/backend/dto"
+	"github.com/rimvydascivilis/book-tracker/backend/utils"
 )
 
 type ProgressRepository struct {
@@ -20,14 +23,14 @@ func NewProgressRepository(db *sql.DB) *ProgressRepository {
 
 func (m *ProgressRepository) GetTotalProgressByReadingID(ctx context.Context, readingID int64) (int64, error) {
 	query := `SELECT COALESCE(SUM(pages), 0) FROM progress WHERE reading_id = ?`
-	stmt, err := m.DB.Prepare(query)
+	stmt, err := conn(ctx, m.DB).PrepareContext(ctx, query)
 	if err != nil {
 		return 0, err
 	}
 	defer stmt.Close()
 
 	var totalProgress int64
-	err = stmt.QueryRow(readingID).Scan(&totalProgress)
+	err = stmt.QueryRowContext(ctx, readingID).Scan(&totalProgress)
 	if err != nil {
 		return 0, err
 	}
@@ -35,82 +38,113 @@ func (m *ProgressRepository) GetTotalProgressByReadingID(ctx context.Context, re
 	return totalProgress, nil
 }
 
-func (m *ProgressRepository) GetUserReadingIDsByPeriod(ctx context.Context, userID int64, period string) ([]int64, error) {
+// normalizedSums adds up the progress of each format in pages and minutes.
+// Ebook percent is converted with the page count of the book.
+const normalizedSums = `
+	COALESCE(SUM(CASE WHEN r.format IN ('ebook', 'audiobook') THEN 0 ELSE p.pages END), 0) +
+		COALESCE(SUM(CASE WHEN r.format = 'ebook' THEN p.pages * b.page_count ELSE 0 END), 0) DIV 100 AS pages,
+	COALESCE(SUM(CASE WHEN r.format = 'audiobook' THEN p.pages ELSE 0 END), 0) AS minutes
+FROM progress p
+JOIN reading r ON r.id = p.reading_id
+JOIN book b ON b.id = r.book_id`
+
+func (m *ProgressRepository) GetPeriodProgress(ctx context.Context, userID int64, from, to time.Time) (dto.PeriodProgress, error) {
 	query := `
-SELECT UNIQUE reading_id
-FROM progress
-WHERE user_id = ?
-	AND (
-		(CHAR_LENGTH(?) = 7 AND DATE_FORMAT(reading_date, '%Y-%m') = ?) OR
-		(CHAR_LENGTH(?) = 10 AND DATE(reading_date) = ?)
-	)
+SELECT
+	(SELECT COUNT(id) FROM reading
+		WHERE user_id = ? AND status = 'completed' AND finished_at BETWEEN ? AND ?) AS books,` + normalizedSums + `
+WHERE p.user_id = ?
+	AND r.status <> 'abandoned'
+	AND DATE(p.reading_date) BETWEEN ? AND ?
 `
-	stmt, err := m.DB.PrepareContext(ctx, query)
+	stmt, err := conn(ctx, m.DB).PrepareContext(ctx, query)
 	if err != nil {
-		return nil, err
+		return dto.PeriodProgress{}, err
 	}
 	defer stmt.Close()
 
-	rows, err := stmt.QueryContext(ctx, userID, period, period, period, period)
+	fromDay, toDay := from.Format("2006-01-02"), to.Format("2006-01-02")
+	var progress dto.PeriodProgress
+	err = stmt.QueryRowContext(ctx, userID, fromDay, toDay, userID, fromDay, toDay).
+		Scan(&progress.Books, &progress.Pages, &progress.Minutes)
 	if err != nil {
-		return nil, err
+		return dto.PeriodProgress{}, err
 	}
-	defer rows.Close()
 
-	var readingIDs []int64
-	for rows.Next() {
-		var readingID int64
-		err = rows.Scan(&readingID)
-		if err != nil {
-			return nil, err
-		}
-		readingIDs = append(readingIDs, readingID)
-	}
-
-	return readingIDs, nil
+	return progress, nil
 }
 
-func (m *ProgressRepository) GetProgressByReadingAndDate(ctx context.Context, readingID int64, date string) (int64, error) {
-	query := `
-SELECT COALESCE(SUM(pages), 0) FROM progress
-WHERE reading_id = ?
-	AND (
-		(CHAR_LENGTH(?) = 7 AND DATE_FORMAT(reading_date, '%Y-%m') = ?) OR
-		(CHAR_LENGTH(?) = 10 AND DATE(reading_date) = ?)
-	)
-`
-	stmt, err := m.DB.Prepare(query)
+// progressByDay adds up the progress of each day in hundredths of a page,
+// minutes and completed readings.
+const progressByDay = `
+SELECT day, SUM(page_hundredths), SUM(minutes), SUM(books)
+FROM (
+	SELECT
+		DATE(p.reading_date) AS day,
+		SUM(CASE
+			WHEN r.format = 'audiobook' THEN 0
+			WHEN r.format = 'ebook' THEN p.pages * b.page_count
+			ELSE p.pages * 100 END) AS page_hundredths,
+		SUM(CASE WHEN r.format = 'audiobook' THEN p.pages ELSE 0 END) AS minutes,
+		0 AS books
+	FROM progress p
+	JOIN reading r ON r.id = p.reading_id
+	JOIN book b ON b.id = r.book_id
+	WHERE p.user_id = ?
+		AND r.status <> 'abandoned'
+		AND DATE(p.reading_date) BETWEEN ? AND ?
+	GROUP BY day
+	UNION ALL
+	SELECT DATE(finished_at) AS day, 0, 0, COUNT(id)
+	FROM reading
+	WHERE user_id = ? AND status = 'completed' AND DATE(finished_at) BETWEEN ? AND ?
+	GROUP BY day
+) AS days
+GROUP BY day
+ORDER BY day`
+
+func (m *ProgressRepository) GetProgressByDay(ctx context.Context, userID int64, from, to time.Time) ([]dto.DayProgress, error) {
+	stmt, err := conn(ctx, m.DB).PrepareContext(ctx, progressByDay)
 	if err != nil {
-		return 0, err
+		return nil, err
 	}
 	defer stmt.Close()
 
-	var progress int64
-	err = stmt.QueryRow(readingID, date, date, date, date).Scan(&progress)
+	fromDay, toDay := from.Format("2006-01-02"), to.Format("2006-01-02")
+	rows, err := stmt.QueryContext(ctx, userID, fromDay, toDay, userID, fromDay, toDay)
 	if err != nil {
-		return 0, err
+		return nil, err
 	}
+	defer rows.Close()
 
-	return progress, nil
+	days := []dto.DayProgress{}
+	for rows.Next() {
+		var p dto.DayProgress
+		if err := rows.Scan(&p.Day, &p.PageHundredths, &p.Minutes, &p.Books); err != nil {
+			return nil, err
+		}
+		p.Day = utils.Date(p.Day)
+		days = append(days, p)
+	}
+
+	return days, rows.Err()
 }
 
 func (m *ProgressRepository) GetMonthlyProgress(ctx context.Context, userID, year int64) ([]dto.Progress, error) {
 	query := `
 SELECT
-	MONTH(reading_date) AS date,
-	COALESCE(SUM(pages), 0) AS pages
-FROM progress
-WHERE user_id = ?
-	AND YEAR(reading_date) = ?
+	MONTH(p.reading_date) AS date,` + normalizedSums + `
+WHERE p.user_id = ?
+	AND YEAR(p.reading_date) = ?
 GROUP BY date
 `
-	stmt, err := m.DB.Prepare(query)
+	stmt, err := conn(ctx, m.DB).PrepareContext(ctx, query)
 	if err != nil {
 		return nil, err
 	}
 	defer stmt.Close()
 
-	rows, err := stmt.Query(userID, year)
+	rows, err := stmt.QueryContext(ctx, userID, year)
 	if err != nil {
 		return nil, err
 	}
@@ -119,7 +153,7 @@ GROUP BY date
 	var monthlyProgress []dto.Progress
 	for rows.Next() {
 		var progress dto.Progress
-		err = rows.Scan(&progress.Date, &progress.Pages)
+		err = rows.Scan(&progress.Date, &progress.Pages, &progress.Minutes)
 		if err != nil {
 			return nil, err
 		}
@@ -132,21 +166,19 @@ GROUP BY date
 func (m *ProgressRepository) GetDailyProgress(ctx context.Context, userID, year, month int64) ([]dto.Progress, error) {
 	query := `
 SELECT
-	DAY(reading_date) AS date,
-	COALESCE(SUM(pages), 0) AS pages
-FROM progress
-WHERE user_id = ?
-	AND YEAR(reading_date) = ?
-	AND MONTH(reading_date) = ?
+	DAY(p.reading_date) AS date,` + normalizedSums + `
+WHERE p.user_id = ?
+	AND YEAR(p.reading_date) = ?
+	AND MONTH(p.reading_date) = ?
 GROUP BY date
 `
-	stmt, err := m.DB.Prepare(query)
+	stmt, err := conn(ctx, m.DB).PrepareContext(ctx, query)
 	if err != nil {
 		return nil, err
 	}
 	defer stmt.Close()
 
-	rows, err := stmt.Query(userID, year, month)
+	rows, err := stmt.QueryContext(ctx, userID, year, month)
 	if err != nil {
 		return nil, err
 	}
@@ -154,7 +186,7 @@ GROUP BY date
 	var dailyProgress []dto.Progress
 	for rows.Next() {
 		var progress dto.Progress
-		err = rows.Scan(&progress.Date, &progress.Pages)
+		err = rows.Scan(&progress.Date, &progress.Pages, &progress.Minutes)
 		if err != nil {
 			return nil, err
 		}
@@ -164,15 +196,89 @@ GROUP BY date
 	return dailyProgress, nil
 }
 
+const progressColumns = `id, reading_id, user_id, pages, position, reading_date`
+
+func (m *ProgressRepository) queryProgress(ctx context.Context, query string, args ...interface{}) ([]domain.Progress, error) {
+	rows, err := conn(ctx, m.DB).QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	progress := []domain.Progress{}
+	for rows.Next() {
+		var p domain.Progress
+		if err := rows.Scan(&p.ID, &p.ReadingID, &p.UserID, &p.Pages, &p.Position, &p.ReadingDate); err != nil {
+			return nil, err
+		}
+		progress = append(progress, p)
+	}
+
+	return progress, rows.Err()
+}
+
+func (m *ProgressRepository) GetProgressByUserID(ctx context.Context, userID, offset, limit int64) ([]domain.Progress, error) {
+	query := `SELECT ` + progressColumns + ` FROM progress WHERE user_id = ? ORDER BY id LIMIT ? OFFSET ?`
+	return m.queryProgress(ctx, query, userID, limit, offset)
+}
+
+func (m *ProgressRepository) GetProgressByID(ctx context.Context, id int64) (domain.Progress, error) {
+	query := `SELECT ` + progressColumns + ` FROM progress WHERE id = ?`
+	stmt, err := conn(ctx, m.DB).PrepareContext(ctx, query)
+	if err != nil {
+		return domain.Progress{}, err
+	}
+	defer stmt.Close()
+
+	var p domain.Progress
+	err = stmt.QueryRowContext(ctx, id).Scan(&p.ID, &p.ReadingID, &p.UserID, &p.Pages, &p.Position, &p.ReadingDate)
+	if err == sql.ErrNoRows {
+		return domain.Progress{}, fmt.Errorf("%w: %s", domain.ErrRecordNotFound, "progress")
+	}
+	if err != nil {
+		return domain.Progress{}, err
+	}
+
+	return p, nil
+}
+
+func (m *ProgressRepository) GetProgressByReadingID(ctx context.Context, readingID int64) ([]domain.Progress, error) {
+	query := `SELECT ` + progressColumns + ` FROM progress WHERE reading_id = ? ORDER BY reading_date, id`
+	return m.queryProgress(ctx, query, readingID)
+}
+
+func (m *ProgressRepository) GetProgressByReadingIDs(ctx context.Context, readingIDs []int64) (map[int64][]domain.Progress, error) {
+	res := map[int64][]domain.Progress{}
+	if len(readingIDs) == 0 {
+		return res, nil
+	}
+
+	query := `SELECT ` + progressColumns + ` FROM progress
+WHERE reading_id IN (` + placeholders(len(readingIDs)) + `) ORDER BY reading_date, id`
+	args := make([]interface{}, 0, len(readingIDs))
+	for _, id := range readingIDs {
+		args = append(args, id)
+	}
+
+	progress, err := m.queryProgress(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	for _, p := range progress {
+		res[p.ReadingID] = append(res[p.ReadingID], p)
+	}
+	return res, nil
+}
+
 func (m *ProgressRepository) CreateProgress(ctx context.Context, progress domain.Progress) (domain.Progress, error) {
-	query := `INSERT INTO progress (reading_id, user_id, pages, reading_date) VALUES (?, ?, ?, ?)`
-	stmt, err := m.DB.Prepare(query)
+	query := `INSERT INTO progress (reading_id, user_id, pages, position, reading_date) VALUES (?, ?, ?, ?, ?)`
+	stmt, err := conn(ctx, m.DB).PrepareContext(ctx, query)
 	if err != nil {
 		return domain.Progress{}, err
 	}
 	defer stmt.Close()
 
-	res, err := stmt.Exec(progress.ReadingID, progress.UserID, progress.Pages, progress.ReadingDate)
+	res, err := stmt.ExecContext(ctx, progress.ReadingID, progress.UserID, progress.Pages, progress.Position, progress.ReadingDate)
 	if err != nil {
 		return domain.Progress{}, err
 	}
@@ -185,3 +291,56 @@ func (m *ProgressRepository) CreateProgress(ctx context.Context, progress domain
 	progress.ID = id
 	return progress, nil
 }
+
+func (m *ProgressRepository) UpdateProgress(ctx context.Context, progress domain.Progress) (domain.Progress, error) {
+	query := `UPDATE progress SET pages = ?, position = ?, reading_date = ? WHERE id = ?`
+	stmt, err := conn(ctx, m.DB).PrepareContext(ctx, query)
+	if err != nil {
+		return domain.Progress{}, err
+	}
+	defer stmt.Close()
+
+	if _, err := stmt.ExecContext(ctx, progress.Pages, progress.Position, progress.ReadingDate, progress.ID); err != nil {
+		return domain.Progress{}, err
+	}
+
+	return progress, nil
+}
+
+func (m *ProgressRepository) DeleteProgress(ctx context.Context, id int64) error {
+	query := `DELETE FROM progress WHERE id = ?`
+	stmt, err := conn(ctx, m.DB).PrepareContext(ctx, query)
+	if err != nil {
+		return err
+	}
+	defer stmt.Close()
+
+	_, err = stmt.ExecContext(ctx, id)
+	return err
+}
+
+func (m *ProgressRepository) GetReadingDays(ctx context.Context, userID int64) ([]time.Time, error) {
+	query := `SELECT DISTINCT DATE(reading_date) AS day FROM progress WHERE user_id = ? AND pages > 0 ORDER BY day`
+	stmt, err := conn(ctx, m.DB).PrepareContext(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer stmt.Close()
+
+	rows, err := stmt.QueryContext(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	days := []time.Time{}
+	for rows.Next() {
+		var day time.Time
+		if err := rows.Scan(&day); err != nil {
+			return nil, err
+		}
+		days = append(days, utils.Date(day))
+	}
+
+	return days, rows.Err()
+}
@@ -3,8 +3,11 @@ package mariadb
 import (
 	"context"
 	"database/sql"
+	"fmt"
+	"time"
 
 	"github.com/rimvydascivilis/book-tracker/backend/domain"
+	"github.com/rimvydascivilis/book-tracker/backend/dto"
 )
 
 type ReadingRepository struct {
@@ -17,8 +20,35 @@ func NewReadingRepository(db *sql.DB) *ReadingRepository {
 	}
 }
 
+const readingColumns = `id, user_id, book_id, format, total_pages, COALESCE(link, ''), status, started_at, finished_at, target_date, created_at, updated_at`
+
+func scanReading(row scanner) (domain.Reading, error) {
+	b := domain.Reading{}
+	var startedAt, finishedAt, targetDate sql.NullTime
+	err := row.Scan(&b.ID, &b.UserID, &b.BookID, &b.Format, &b.TotalPages, &b.Link, &b.Status, &startedAt, &finishedAt,
+		&targetDate, &b.CreatedAt, &b.UpdatedAt)
+	if startedAt.Valid {
+		b.StartedAt = &startedAt.Time
+	}
+	if finishedAt.Valid {
+		b.FinishedAt = &finishedAt.Time
+	}
+	if targetDate.Valid {
+		b.TargetDate = &targetDate.Time
+	}
+	return b, err
+}
+
+// nullDate passes an optional date as a DATE parameter.
+func nullDate(t *time.Time) interface{} {
+	if t == nil {
+		return nil
+	}
+	return t.Format("2006-01-02")
+}
+
 func (r *ReadingRepository) getAll(ctx context.Context, query string, args ...interface{}) (res []domain.Reading, err error) {
-	stmt, err := r.DB.PrepareContext(ctx, query)
+	stmt, err := conn(ctx, r.DB).PrepareContext(ctx, query)
 	if err != nil {
 		return []domain.Reading{}, err
 	}
@@ -32,8 +62,7 @@ func (r *ReadingRepository) getAll(ctx context.Context, query string, args ...in
 
 	res = []domain.Reading{}
 	for rows.Next() {
-		b := domain.Reading{}
-		err = rows.Scan(&b.ID, &b.UserID, &b.BookID, &b.TotalPages, &b.Link, &b.CreatedAt, &b.UpdatedAt)
+		b, err := scanReading(rows)
 		if err != nil {
 			return []domain.Reading{}, err
 		}
@@ -44,16 +73,16 @@ func (r *ReadingRepository) getAll(ctx context.Context, query string, args ...in
 }
 
 func (r *ReadingRepository) getOne(ctx context.Context, query string, args ...interface{}) (domain.Reading, error) {
-	stmt, err := r.DB.PrepareContext(ctx, query)
+	stmt, err := conn(ctx, r.DB).PrepareContext(ctx, query)
 	if err != nil {
 		return domain.Reading{}, err
 	}
 	defer stmt.Close()
 
-	row := stmt.QueryRowContext(ctx, args...)
-
-	b := domain.Reading{}
-	err = row.Scan(&b.ID, &b.UserID, &b.BookID, &b.TotalPages, &b.Link, &b.CreatedAt, &b.UpdatedAt)
+	b, err := scanReading(stmt.QueryRowContext(ctx, args...))
+	if err == sql.ErrNoRows {
+		return domain.Reading{}, fmt.Errorf("%w: %s", domain.ErrRecordNotFound, "reading")
+	}
 	if err != nil {
 		return domain.Reading{}, err
 	}
@@ -61,48 +90,160 @@ func (r *ReadingRepository) getOne(ctx context.Context, query string, args ...in
 	return b, nil
 }
 
-func (r *ReadingRepository) GetReadingsByUserID(ctx context.Context, userID, offset, limit int64) ([]domain.Reading, error) {
-	query := `
-SELECT id, user_id, book_id, total_pages, COALESCE(link, ''), created_at, updated_at
-FROM reading WHERE user_id = ? ORDER BY created_at DESC LIMIT ? OFFSET ?`
-	return r.getAll(ctx, query, userID, limit, offset)
+func (r *ReadingRepository) count(ctx context.Context, query string, args ...interface{}) (int64, error) {
+	stmt, err := conn(ctx, r.DB).PrepareContext(ctx, query)
+	if err != nil {
+		return 0, err
+	}
+	defer stmt.Close()
+
+	var count int64
+	err = stmt.QueryRowContext(ctx, args...).Scan(&count)
+	if err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+func (r *ReadingRepository) GetReadingsByUserID(ctx context.Context, userID int64, status string, offset, limit int64) ([]domain.Reading, error) {
+	query := `SELECT ` + readingColumns + `
+FROM reading WHERE user_id = ? AND (? = '' OR status = ?) ORDER BY created_at DESC, id DESC LIMIT ? OFFSET ?`
+	return r.getAll(ctx, query, userID, status, status, limit, offset)
+}
+
+// summaryColumns follow readingColumns in reading summaries: the title of
+// the book and the pages read so far.
+const summaryColumns = `(SELECT title FROM book WHERE book.id = reading.book_id),
+(SELECT COALESCE(SUM(pages), 0) FROM progress WHERE progress.reading_id = reading.id)`
+
+// withExtra scans the columns after the ones read by the wrapped scan into
+// extra.
+type withExtra struct {
+	scanner
+	extra []interface{}
+}
+
+func (s withExtra) Scan(dest ...interface{}) error {
+	return s.scanner.Scan(append(dest, s.extra...)...)
+}
+
+func (r *ReadingRepository) GetReadingSummariesByUserID(ctx context.Context, userID int64, status string, offset, limit int64) ([]domain.ReadingSummary, error) {
+	query := `SELECT ` + readingColumns + `, ` + summaryColumns + `
+FROM reading WHERE user_id = ? AND (? = '' OR status = ?) ORDER BY created_at DESC, id DESC LIMIT ? OFFSET ?`
+	stmt, err := conn(ctx, r.DB).PrepareContext(ctx, query)
+	if err != nil {
+		return []domain.ReadingSummary{}, err
+	}
+	defer stmt.Close()
+
+	rows, err := stmt.QueryContext(ctx, userID, status, status, limit, offset)
+	if err != nil {
+		return []domain.ReadingSummary{}, err
+	}
+	defer rows.Close()
+
+	res := []domain.ReadingSummary{}
+	for rows.Next() {
+		var summary domain.ReadingSummary
+		var title sql.NullString
+		summary.Reading, err = scanReading(withExtra{rows, []interface{}{&title, &summary.Progress}})
+		if err != nil {
+			return []domain.ReadingSummary{}, err
+		}
+		summary.BookTitle = title.String
+		res = append(res, summary)
+	}
+
+	return res, rows.Err()
 }
 
 func (r *ReadingRepository) GetReadingByID(ctx context.Context, id int64) (domain.Reading, error) {
-	query := `
-SELECT id, user_id, book_id, total_pages, COALESCE(link, ''), created_at, updated_at
-FROM reading WHERE id = ?`
+	query := `SELECT ` + readingColumns + ` FROM reading WHERE id = ?`
 	return r.getOne(ctx, query, id)
 }
 
-func (r *ReadingRepository) CountReadingsByUserID(ctx context.Context, userID int64) (int64, error) {
-	query := `SELECT COUNT(id) FROM reading WHERE user_id = ?`
-	stmt, err := r.DB.PrepareContext(ctx, query)
+func (r *ReadingRepository) GetReadingByIDForUpdate(ctx context.Context, id int64) (domain.Reading, error) {
+	query := `SELECT ` + readingColumns + ` FROM reading WHERE id = ? FOR UPDATE`
+	return r.getOne(ctx, query, id)
+}
+
+func (r *ReadingRepository) GetReadingsByUserIDAndBookID(ctx context.Context, userID, bookID int64) ([]domain.Reading, error) {
+	query := `SELECT ` + readingColumns + `
+FROM reading WHERE user_id = ? AND book_id = ? ORDER BY created_at DESC, id DESC`
+	return r.getAll(ctx, query, userID, bookID)
+}
+
+func (r *ReadingRepository) CountReadingsByUserID(ctx context.Context, userID int64, status string) (int64, error) {
+	return r.count(ctx, `SELECT COUNT(id) FROM reading WHERE user_id = ? AND (? = '' OR status = ?)`, userID, status, status)
+}
+
+func (r *ReadingRepository) getGrouped(ctx context.Context, query string, args ...interface{}) ([]dto.Progress, error) {
+	stmt, err := conn(ctx, r.DB).PrepareContext(ctx, query)
 	if err != nil {
-		return 0, err
+		return nil, err
 	}
 	defer stmt.Close()
 
-	row := stmt.QueryRowContext(ctx, userID)
-
-	var count int64
-	err = row.Scan(&count)
+	rows, err := stmt.QueryContext(ctx, args...)
 	if err != nil {
-		return 0, err
+		return nil, err
 	}
+	defer rows.Close()
 
-	return count, nil
+	var res []dto.Progress
+	for rows.Next() {
+		var p dto.Progress
+		if err := rows.Scan(&p.Date, &p.Books); err != nil {
+			return nil, err
+		}
+		res = append(res, p)
+	}
+
+	return res, nil
+}
+
+func (r *ReadingRepository) GetMonthlyFinishedReadings(ctx context.Context, userID, year int64) ([]dto.Progress, error) {
+	query := `
+SELECT
+	MONTH(finished_at) AS date,
+	COUNT(id) AS books
+FROM reading
+WHERE user_id = ?
+	AND status = 'completed'
+	AND YEAR(finished_at) = ?
+GROUP BY date
+`
+	return r.getGrouped(ctx, query, userID, year)
+}
+
+func (r *ReadingRepository) GetDailyFinishedReadings(ctx context.Context, userID, year, month int64) ([]dto.Progress, error) {
+	query := `
+SELECT
+	DAY(finished_at) AS date,
+	COUNT(id) AS books
+FROM reading
+WHERE user_id = ?
+	AND status = 'completed'
+	AND YEAR(finished_at) = ?
+	AND MONTH(finished_at) = ?
+GROUP BY date
+`
+	return r.getGrouped(ctx, query, userID, year, month)
 }
 
 func (r *ReadingRepository) CreateReading(ctx context.Context, reading domain.Reading) (domain.Reading, error) {
-	query := `INSERT INTO reading (user_id, book_id, total_pages, link, created_at, updated_at) VALUES (?, ?, ?, ?, ?, ?)`
-	stmt, err := r.DB.PrepareContext(ctx, query)
+	query := `
+INSERT INTO reading (user_id, book_id, format, total_pages, link, status, started_at, finished_at, target_date, created_at, updated_at)
+VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`
+	stmt, err := conn(ctx, r.DB).PrepareContext(ctx, query)
 	if err != nil {
 		return domain.Reading{}, err
 	}
 	defer stmt.Close()
 
-	res, err := stmt.ExecContext(ctx, reading.UserID, reading.BookID, reading.TotalPages, reading.Link, reading.CreatedAt, reading.UpdatedAt)
+	res, err := stmt.ExecContext(ctx, reading.UserID, reading.BookID, reading.Format, reading.TotalPages, reading.Link, reading.Status,
+		nullDate(reading.StartedAt), nullDate(reading.FinishedAt), nullDate(reading.TargetDate), reading.CreatedAt, reading.UpdatedAt)
 	if err != nil {
 		return domain.Reading{}, err
 	}
@@ -116,21 +257,34 @@ func (r *ReadingRepository) CreateReading(ctx context.Context, reading domain.Re
 	return reading, nil
 }
 
-func (r *ReadingRepository) CountReadingsByUserIDAndBookID(ctx context.Context, userID, bookID int64) (int64, error) {
-	query := `SELECT COUNT(id) FROM reading WHERE user_id = ? AND book_id = ?`
-	stmt, err := r.DB.PrepareContext(ctx, query)
+func (r *ReadingRepository) UpdateReading(ctx context.Context, reading domain.Reading) (domain.Reading, error) {
+	query := `
+UPDATE reading SET total_pages = ?, link = ?, status = ?, started_at = ?, finished_at = ?, target_date = ?, updated_at = ?
+WHERE id = ?`
+	stmt, err := conn(ctx, r.DB).PrepareContext(ctx, query)
 	if err != nil {
-		return 0, err
+		return domain.Reading{}, err
 	}
 	defer stmt.Close()
 
-	row := stmt.QueryRowContext(ctx, userID, bookID)
+	_, err = stmt.ExecContext(ctx, reading.TotalPages, reading.Link, reading.Status,
+		nullDate(reading.StartedAt), nullDate(reading.FinishedAt), nullDate(reading.TargetDate), reading.UpdatedAt, reading.ID)
+	if err != nil {
+		return domain.Reading{}, err
+	}
 
-	var count int64
-	err = row.Scan(&count)
+	return reading, nil
+}
+
+// DeleteReading relies on the foreign key to delete the progress.
+func (r *ReadingRepository) DeleteReading(ctx context.Context, id int64) error {
+	query := `DELETE FROM reading WHERE id = ?`
+	stmt, err := conn(ctx, r.DB).PrepareContext(ctx, query)
 	if err != nil {
-		return 0, err
+		return err
 	}
+	defer stmt.Close()
 
-	return count, nil
+	_, err = stmt.ExecContext(ctx, id)
+	return err
 }
@@ -0,0 +1,125 @@
+package mariadb_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/rimvydascivilis/book-tracker/backend/domain"
+	"github.com/rimvydascivilis/book-tracker/backend/internal/repository/mariadb"
+	"github.com/rimvydascivilis/book-tracker/backend/services/reading"
+	"github.com/rimvydascivilis/book-tracker/backend/services/validation"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var summaryColumns = []string{"id", "user_id", "book_id", "format", "total_pages", "link", "status", "started_at",
+	"finished_at", "target_date", "created_at", "updated_at", "title", "progress"}
+
+const summaryQuery = `SELECT id, user_id, book_id, .*, \(SELECT title FROM book WHERE book.id = reading.book_id\),\s+` +
+	`\(SELECT COALESCE\(SUM\(pages\), 0\) FROM progress WHERE progress.reading_id = reading.id\)\s+` +
+	`FROM reading WHERE user_id = \? AND \(\? = '' OR status = \?\) ORDER BY created_at DESC, id DESC LIMIT \? OFFSET \?`
+
+func setupReadingDB(t *testing.T) (*sql.DB, sqlmock.Sqlmock) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	t.Cleanup(func() { db.Close() })
+	return db, mock
+}
+
+func TestReadingRepository_GetReadingSummariesByUserID(t *testing.T) {
+	db, mock := setupReadingDB(t)
+	created := time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC)
+	started := time.Date(2024, 3, 2, 0, 0, 0, 0, time.UTC)
+
+	mock.ExpectPrepare(summaryQuery).ExpectQuery().
+		WithArgs(int64(1), "", "", int64(10), int64(0)).
+		WillReturnRows(sqlmock.NewRows(summaryColumns).
+			AddRow(2, 1, 5, "print", 300, "", "reading", started, nil, nil, created, created, "Dune", 120).
+			AddRow(1, 1, 6, "ebook", 100, "", "not_started", nil, nil, nil, created, created, "Emma", 0))
+
+	summaries, err := mariadb.NewReadingRepository(db).GetReadingSummariesByUserID(context.Background(), 1, "", 0, 10)
+
+	require.NoError(t, err)
+	require.Len(t, summaries, 2)
+	assert.Equal(t, "Dune", summaries[0].BookTitle)
+	assert.Equal(t, int64(120), summaries[0].Progress)
+	assert.Equal(t, int64(5), summaries[0].BookID)
+	require.NotNil(t, summaries[0].StartedAt)
+	assert.Equal(t, started, *summaries[0].StartedAt)
+	assert.Equal(t, "Emma", summaries[1].BookTitle)
+	assert.Nil(t, summaries[1].StartedAt)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestProgressRepository_GetProgressByReadingIDs(t *testing.T) {
+	db, mock := setupReadingDB(t)
+	day := time.Date(2024, 3, 2, 0, 0, 0, 0, time.UTC)
+
+	mock.ExpectQuery(`SELECT id, reading_id, user_id, pages, position, reading_date FROM progress\s+`+
+		`WHERE reading_id IN \(\?, \?\) ORDER BY reading_date, id`).
+		WithArgs(int64(2), int64(3)).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "reading_id", "user_id", "pages", "position", "reading_date"}).
+			AddRow(7, 2, 1, 20, 20, day).
+			AddRow(8, 3, 1, 5, 5, day).
+			AddRow(9, 2, 1, 15, 35, day.AddDate(0, 0, 1)))
+
+	progress, err := mariadb.NewProgressRepository(db).GetProgressByReadingIDs(context.Background(), []int64{2, 3})
+
+	require.NoError(t, err)
+	assert.Equal(t, map[int64][]domain.Progress{
+		2: {
+			{ID: 7, ReadingID: 2, UserID: 1, Pages: 20, Position: 20, ReadingDate: day},
+			{ID: 9, ReadingID: 2, UserID: 1, Pages: 15, Position: 35, ReadingDate: day.AddDate(0, 0, 1)},
+		},
+		3: {{ID: 8, ReadingID: 3, UserID: 1, Pages: 5, Position: 5, ReadingDate: day}},
+	}, progress)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+// TestGetReadings_QueryCount locks in the round trips of a page of readings:
+// the count, the readings with their titles and progress, the progress
+// entries of those being read and the user whose day the pace is counted
+// in, however many readings the page holds.
+func TestGetReadings_QueryCount(t *testing.T) {
+	db, mock := setupReadingDB(t)
+	created := time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC)
+
+	mock.ExpectPrepare(`SELECT COUNT\(id\) FROM reading`).ExpectQuery().
+		WithArgs(int64(1), "", "").
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(3))
+	mock.ExpectPrepare(summaryQuery).ExpectQuery().
+		WithArgs(int64(1), "", "", int64(10), int64(0)).
+		WillReturnRows(sqlmock.NewRows(summaryColumns).
+			AddRow(3, 1, 7, "print", 300, "", "reading", created, nil, nil, created, created, "Dune", 40).
+			AddRow(2, 1, 6, "audiobook", 600, "", "reading", created, nil, nil, created, created, "Emma", 90).
+			AddRow(1, 1, 5, "print", 200, "", "completed", created, created, nil, created, created, "Ulysses", 200))
+	mock.ExpectQuery(`FROM progress\s+WHERE reading_id IN \(\?, \?\)`).
+		WithArgs(int64(3), int64(2)).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "reading_id", "user_id", "pages", "position", "reading_date"}).
+			AddRow(1, 3, 1, 40, 40, created).
+			AddRow(2, 2, 1, 90, 90, created))
+	mock.ExpectPrepare(`SELECT id, email, timezone, rest_days, created_at FROM user WHERE id = \?`).ExpectQuery().
+		WithArgs(int64(1)).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "email", "timezone", "rest_days", "created_at"}).
+			AddRow(1, "user@example.com", "Europe/Vilnius", "", created))
+
+	svc := reading.NewReadingService(mariadb.NewReadingRepository(db), mariadb.NewProgressRepository(db),
+		mariadb.NewBookRepository(db), mariadb.NewUserRepository(db), mariadb.NewTxManager(db),
+		validation.NewValidationService(), []int64{7})
+	readings, hasMore, err := svc.GetReadings(context.Background(), 1, "", 1, 10)
+
+	require.NoError(t, err)
+	assert.False(t, hasMore)
+	require.Len(t, readings, 3)
+	assert.Equal(t, "Dune", readings[0].BookTitle)
+	assert.Equal(t, int64(40), readings[0].Progress)
+	require.NotNil(t, readings[0].Pace)
+	assert.Equal(t, int64(260), readings[0].Pace.Remaining)
+	require.NotNil(t, readings[1].Pace)
+	assert.Equal(t, int64(510), readings[1].Pace.Remaining)
+	assert.Nil(t, readings[2].Pace)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,68 @@
+package mariadb
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+)
+
+type txKey struct{}
+
+// querier is the subset of *sql.DB and *sql.Tx used by the repositories.
+type querier interface {
+	PrepareContext(ctx context.Context, query string) (*sql.Stmt, error)
+	ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error)
+	QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row
+}
+
+// conn returns the transaction started by TxManager for ctx, or db when the
+// call is not part of a transaction.
+func conn(ctx context.Context, db *sql.DB) querier {
+	if tx, ok := ctx.Value(txKey{}).(*sql.Tx); ok {
+		return tx
+	}
+	return db
+}
+
+type TxManager struct {
+	DB *sql.DB
+}
+
+func NewTxManager(db *sql.DB) *TxManager {
+	return &TxManager{
+		DB: db,
+	}
+}
+
+func (m *TxManager) WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	return m.within(ctx, nil, fn)
+}
+
+// WithinReadOnlyTransaction runs fn in a REPEATABLE READ transaction that
+// does not write, so every query of fn reads the same snapshot.
+func (m *TxManager) WithinReadOnlyTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	return m.within(ctx, &sql.TxOptions{Isolation: sql.LevelRepeatableRead, ReadOnly: true}, fn)
+}
+
+// within runs fn in a transaction started with opts, or in the one ctx is
+// already part of.
+func (m *TxManager) within(ctx context.Context, opts *sql.TxOptions, fn func(ctx context.Context) error) error {
+	if _, ok := ctx.Value(txKey{}).(*sql.Tx); ok {
+		return fn(ctx)
+	}
+
+	tx, err := m.DB.BeginTx(ctx, opts)
+	if err != nil {
+		return err
+	}
+
+	if err := fn(context.WithValue(ctx, txKey{}, tx)); err != nil {
+		if rbErr := tx.Rollback(); rbErr != nil {
+			return fmt.Errorf("%w (rollback failed: %v)", err, rbErr)
+		}
+		return err
+	}
+
+	return tx.Commit()
+}
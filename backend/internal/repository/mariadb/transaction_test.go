@@ -0,0 +1,108 @@
+package mariadb_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/rimvydascivilis/book-tracker/backend/domain"
+	"github.com/rimvydascivilis/book-tracker/backend/internal/repository/mariadb"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTxManager_CommitsAndRoutesRepositoryCalls(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	t.Cleanup(func() { db.Close() })
+
+	txManager := mariadb.NewTxManager(db)
+	readingRepo := mariadb.NewReadingRepository(db)
+	progressRepo := mariadb.NewProgressRepository(db)
+
+	mock.ExpectBegin()
+	mock.ExpectPrepare(`SELECT .* FROM reading WHERE id = \? FOR UPDATE`).
+		ExpectQuery().
+		WithArgs(int64(1)).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "user_id", "book_id", "format", "total_pages", "link", "status", "started_at", "finished_at", "target_date", "created_at", "updated_at"}).
+			AddRow(1, 1, 1, "print", 100, "", "reading", nil, nil, nil, time.Now(), time.Now()))
+	mock.ExpectPrepare(`INSERT INTO progress`).
+		ExpectExec().
+		WillReturnResult(sqlmock.NewResult(5, 1))
+	mock.ExpectCommit()
+
+	err = txManager.WithinTransaction(context.Background(), func(ctx context.Context) error {
+		if _, err := readingRepo.GetReadingByIDForUpdate(ctx, 1); err != nil {
+			return err
+		}
+		_, err := progressRepo.CreateProgress(ctx, domain.Progress{ReadingID: 1, UserID: 1, Pages: 10})
+		return err
+	})
+
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestTxManager_RollsBackOnError(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	t.Cleanup(func() { db.Close() })
+
+	txManager := mariadb.NewTxManager(db)
+	fnErr := errors.New("boom")
+
+	mock.ExpectBegin()
+	mock.ExpectRollback()
+
+	err = txManager.WithinTransaction(context.Background(), func(ctx context.Context) error {
+		return fnErr
+	})
+
+	assert.ErrorIs(t, err, fnErr)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestTxManager_NestedCallJoinsOuterTransaction(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	t.Cleanup(func() { db.Close() })
+
+	txManager := mariadb.NewTxManager(db)
+
+	mock.ExpectBegin()
+	mock.ExpectCommit()
+
+	err = txManager.WithinTransaction(context.Background(), func(ctx context.Context) error {
+		return txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+			return nil
+		})
+	})
+
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestTxManager_ReadOnlyRoutesRepositoryCalls(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	t.Cleanup(func() { db.Close() })
+
+	txManager := mariadb.NewTxManager(db)
+	bookRepo := mariadb.NewBookRepository(db)
+
+	mock.ExpectBegin()
+	mock.ExpectPrepare(`SELECT .* FROM book WHERE user_id = \? ORDER BY id LIMIT \? OFFSET \?`).
+		ExpectQuery().
+		WithArgs(1, int64(100), int64(0)).
+		WillReturnRows(sqlmock.NewRows(bookRowColumns))
+	mock.ExpectCommit()
+
+	err = txManager.WithinReadOnlyTransaction(context.Background(), func(ctx context.Context) error {
+		_, err := bookRepo.GetBooksByUser(ctx, 1, 0, 100)
+		return err
+	})
+
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -3,6 +3,7 @@ package mariadb
 import (
 	"context"
 	"database/sql"
+	"strings"
 	"time"
 
 	"github.com/rimvydascivilis/book-tracker/backend/domain"
@@ -12,6 +13,24 @@ type UserRepository struct {
 	DB *sql.DB
 }
 
+const userColumns = `id, email, timezone, rest_days, created_at`
+
+// userTimezone is the zone stored for u; users without one count days in UTC.
+func userTimezone(u domain.User) string {
+	if u.Timezone == "" {
+		return "UTC"
+	}
+	return u.Timezone
+}
+
+// splitRestDays reads back the comma separated rest days.
+func splitRestDays(s string) []string {
+	if s == "" {
+		return nil
+	}
+	return strings.Split(s, ",")
+}
+
 func NewUserRepository(db *sql.DB) *UserRepository {
 	return &UserRepository{
 		DB: db,
@@ -19,43 +38,46 @@ func NewUserRepository(db *sql.DB) *UserRepository {
 }
 
 func (m *UserRepository) getOne(ctx context.Context, query string, args ...interface{}) (res domain.User, err error) {
-	stmt, err := m.DB.PrepareContext(ctx, query)
+	stmt, err := conn(ctx, m.DB).PrepareContext(ctx, query)
 	if err != nil {
 		return domain.User{}, err
 	}
 	defer stmt.Close()
 	row := stmt.QueryRowContext(ctx, args...)
 	res = domain.User{}
-	err = row.Scan(&res.ID, &res.Email, &res.CreatedAt)
+	var restDays string
+	err = row.Scan(&res.ID, &res.Email, &res.Timezone, &restDays, &res.CreatedAt)
 	if err == sql.ErrNoRows {
 		return domain.User{}, domain.ErrRecordNotFound
 	}
 	if err != nil {
 		return domain.User{}, err
 	}
+	res.RestDays = splitRestDays(restDays)
 	return res, nil
 }
 
 func (m *UserRepository) GetByEmail(ctx context.Context, email string) (domain.User, error) {
-	query := `SELECT id, email, created_at FROM user WHERE email = ?`
+	query := `SELECT ` + userColumns + ` FROM user WHERE email = ?`
 	return m.getOne(ctx, query, email)
 }
 
 func (m *UserRepository) GetByID(ctx context.Context, id int64) (domain.User, error) {
-	query := `SELECT id, email, created_at FROM user WHERE id = ?`
+	query := `SELECT ` + userColumns + ` FROM user WHERE id = ?`
 	return m.getOne(ctx, query, id)
 }
 
 func (m *UserRepository) CreateUser(ctx context.Context, u domain.User) (domain.User, error) {
-	query := `INSERT INTO user (email, created_at) VALUES (?, ?)`
-	stmt, err := m.DB.PrepareContext(ctx, query)
+	query := `INSERT INTO user (email, timezone, rest_days, created_at) VALUES (?, ?, ?, ?)`
+	stmt, err := conn(ctx, m.DB).PrepareContext(ctx, query)
 	if err != nil {
 		return domain.User{}, err
 	}
 	defer stmt.Close()
 
+	u.Timezone = userTimezone(u)
 	u.CreatedAt = time.Now()
-	res, err := stmt.ExecContext(ctx, u.Email, u.CreatedAt)
+	res, err := stmt.ExecContext(ctx, u.Email, u.Timezone, strings.Join(u.RestDays, ","), u.CreatedAt)
 	if err != nil {
 		return domain.User{}, err
 	}
@@ -68,3 +90,21 @@ func (m *UserRepository) CreateUser(ctx context.Context, u domain.User) (domain.
 
 	return u, nil
 }
+
+func (m *UserRepository) UpdateUser(ctx context.Context, u domain.User) (domain.User, error) {
+	query := `UPDATE user SET timezone = ?, rest_days = ? WHERE id = ?`
+	stmt, err := conn(ctx, m.DB).PrepareContext(ctx, query)
+	if err != nil {
+		return domain.User{}, err
+	}
+	defer stmt.Close()
+
+	// MariaDB reports unchanged rows as not affected, so a missing user is
+	// not detected here.
+	_, err = stmt.ExecContext(ctx, userTimezone(u), strings.Join(u.RestDays, ","), u.ID)
+	if err != nil {
+		return domain.User{}, err
+	}
+	u.Timezone = userTimezone(u)
+	return u, nil
+}
@@ -29,13 +29,15 @@ func TestUserRepository_GetByEmail_Success(t *testing.T) {
 	testUser := domain.User{
 		ID:        1,
 		Email:     testEmail,
+		Timezone:  "Europe/Vilnius",
+		RestDays:  []string{"saturday", "sunday"},
 		CreatedAt: time.Now(),
 	}
 
-	rows := sqlmock.NewRows([]string{"id", "email", "created_at"}).
-		AddRow(testUser.ID, testUser.Email, testUser.CreatedAt)
+	rows := sqlmock.NewRows([]string{"id", "email", "timezone", "rest_days", "created_at"}).
+		AddRow(testUser.ID, testUser.Email, testUser.Timezone, "saturday,sunday", testUser.CreatedAt)
 
-	mock.ExpectPrepare("SELECT id, email, created_at FROM user WHERE email = ?").
+	mock.ExpectPrepare("SELECT id, email, timezone, rest_days, created_at FROM user WHERE email = ?").
 		ExpectQuery().
 		WithArgs(testEmail).
 		WillReturnRows(rows)
@@ -53,7 +55,7 @@ func TestUserRepository_GetByEmail_NotFound(t *testing.T) {
 	ctx := context.Background()
 	testEmail := "nonexistent@example.com"
 
-	mock.ExpectPrepare("SELECT id, email, created_at FROM user WHERE email = ?").
+	mock.ExpectPrepare("SELECT id, email, timezone, rest_days, created_at FROM user WHERE email = ?").
 		ExpectQuery().
 		WithArgs(testEmail).
 		WillReturnError(sql.ErrNoRows)
@@ -72,14 +74,15 @@ func TestUserRepository_GetByID_Success(t *testing.T) {
 	ctx := context.Background()
 	testID := int64(1)
 	testUser := domain.User{
-		ID:    testID,
-		Email: "test@example.com",
+		ID:       testID,
+		Email:    "test@example.com",
+		Timezone: "UTC",
 	}
 
-	rows := sqlmock.NewRows([]string{"id", "email", "created_at"}).
-		AddRow(testUser.ID, testUser.Email, testUser.CreatedAt)
+	rows := sqlmock.NewRows([]string{"id", "email", "timezone", "rest_days", "created_at"}).
+		AddRow(testUser.ID, testUser.Email, testUser.Timezone, "", testUser.CreatedAt)
 
-	mock.ExpectPrepare("SELECT id, email, created_at FROM user WHERE id = ?").
+	mock.ExpectPrepare("SELECT id, email, timezone, rest_days, created_at FROM user WHERE id = ?").
 		ExpectQuery().
 		WithArgs(testID).
 		WillReturnRows(rows)
@@ -97,7 +100,7 @@ func TestUserRepository_GetByID_NotFound(t *testing.T) {
 	ctx := context.Background()
 	testID := int64(999)
 
-	mock.ExpectPrepare("SELECT id, email, created_at FROM user WHERE id = ?").
+	mock.ExpectPrepare("SELECT id, email, timezone, rest_days, created_at FROM user WHERE id = ?").
 		ExpectQuery().
 		WithArgs(testID).
 		WillReturnError(sql.ErrNoRows)
@@ -120,7 +123,7 @@ func TestUserRepository_CreateUser_Success(t *testing.T) {
 
 	mock.ExpectPrepare("INSERT INTO user").
 		ExpectExec().
-		WithArgs(testUser.Email, sqlmock.AnyArg()).
+		WithArgs(testUser.Email, "UTC", "", sqlmock.AnyArg()).
 		WillReturnResult(sqlmock.NewResult(1, 1))
 
 	createdUser, err := userRepo.CreateUser(ctx, testUser)
@@ -128,6 +131,30 @@ func TestUserRepository_CreateUser_Success(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, int64(1), createdUser.ID)
 	assert.Equal(t, testUser.Email, createdUser.Email)
+	assert.Equal(t, "UTC", createdUser.Timezone)
 	assert.NotZero(t, createdUser.CreatedAt)
 	assert.NoError(t, mock.ExpectationsWereMet())
 }
+
+func TestUserRepository_UpdateUser(t *testing.T) {
+	userRepo, mock := setupUserRepository(t)
+
+	ctx := context.Background()
+	testUser := domain.User{
+		ID:       1,
+		Email:    "test@example.com",
+		Timezone: "America/New_York",
+		RestDays: []string{"sunday"},
+	}
+
+	mock.ExpectPrepare("UPDATE user SET timezone = \\?, rest_days = \\? WHERE id = \\?").
+		ExpectExec().
+		WithArgs("America/New_York", "sunday", int64(1)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	updated, err := userRepo.UpdateUser(ctx, testUser)
+
+	assert.NoError(t, err)
+	assert.Equal(t, testUser, updated)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,92 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/rimvydascivilis/book-tracker/backend/domain"
+)
+
+type AccessTokenRepository struct {
+	store *Store
+}
+
+func NewAccessTokenRepository(store *Store) *AccessTokenRepository {
+	return &AccessTokenRepository{
+		store: store,
+	}
+}
+
+func (r *AccessTokenRepository) GetAccessTokensByUserID(ctx context.Context, userID int64) ([]domain.AccessToken, error) {
+	defer r.store.rlock(ctx)()
+
+	tokens := []domain.AccessToken{}
+	for _, id := range sortedIDs(r.store.accessTokens) {
+		if token := r.store.accessTokens[id]; token.UserID == userID {
+			tokens = append(tokens, token)
+		}
+	}
+	return tokens, nil
+}
+
+func (r *AccessTokenRepository) GetAccessTokenByID(ctx context.Context, id int64) (domain.AccessToken, error) {
+	defer r.store.rlock(ctx)()
+
+	token, ok := r.store.accessTokens[id]
+	if !ok {
+		return domain.AccessToken{}, fmt.Errorf("%w: %s", domain.ErrRecordNotFound, "access token")
+	}
+	return token, nil
+}
+
+func (r *AccessTokenRepository) GetAccessTokenByHash(ctx context.Context, hash string) (domain.AccessToken, error) {
+	defer r.store.rlock(ctx)()
+
+	for _, token := range r.store.accessTokens {
+		if token.TokenHash == hash {
+			return token, nil
+		}
+	}
+	return domain.AccessToken{}, fmt.Errorf("%w: %s", domain.ErrRecordNotFound, "access token")
+}
+
+func (r *AccessTokenRepository) CreateAccessToken(ctx context.Context, token domain.AccessToken) (domain.AccessToken, error) {
+	defer r.store.lock(ctx)()
+
+	if err := r.store.requireUser(token.UserID); err != nil {
+		return domain.AccessToken{}, err
+	}
+	for _, existing := range r.store.accessTokens {
+		if existing.TokenHash == token.TokenHash {
+			return domain.AccessToken{}, fmt.Errorf("%w: access token", domain.ErrAlreadyExists)
+		}
+	}
+
+	token.ID = r.store.id("access_token")
+	token.LastUsedAt = nil
+	r.store.accessTokens[token.ID] = token
+	return token, nil
+}
+
+func (r *AccessTokenRepository) UpdateAccessTokenLastUsed(ctx context.Context, id int64, t time.Time) error {
+	defer r.store.lock(ctx)()
+
+	token, ok := r.store.accessTokens[id]
+	if !ok {
+		return fmt.Errorf("%w: %s", domain.ErrRecordNotFound, "access token")
+	}
+	token.LastUsedAt = &t
+	r.store.accessTokens[id] = token
+	return nil
+}
+
+func (r *AccessTokenRepository) DeleteAccessToken(ctx context.Context, id int64) error {
+	defer r.store.lock(ctx)()
+
+	if _, ok := r.store.accessTokens[id]; !ok {
+		return fmt.Errorf("%w: %s", domain.ErrRecordNotFound, "access token")
+	}
+	delete(r.store.accessTokens, id)
+	return nil
+}
@@ -0,0 +1,117 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/rimvydascivilis/book-tracker/backend/domain"
+)
+
+type AuthSessionRepository struct {
+	store *Store
+}
+
+func NewAuthSessionRepository(store *Store) *AuthSessionRepository {
+	return &AuthSessionRepository{
+		store: store,
+	}
+}
+
+func (r *AuthSessionRepository) CreateSession(ctx context.Context, session domain.AuthSession) (domain.AuthSession, error) {
+	defer r.store.lock(ctx)()
+
+	if err := r.store.requireUser(session.UserID); err != nil {
+		return domain.AuthSession{}, err
+	}
+
+	session.ID = r.store.id("auth_session")
+	session.RevokedAt = nil
+	r.store.authSessions[session.ID] = session
+	return session, nil
+}
+
+func (r *AuthSessionRepository) GetSessionByID(ctx context.Context, id int64) (domain.AuthSession, error) {
+	defer r.store.rlock(ctx)()
+
+	session, ok := r.store.authSessions[id]
+	if !ok {
+		return domain.AuthSession{}, fmt.Errorf("%w: %s", domain.ErrRecordNotFound, "session")
+	}
+	return session, nil
+}
+
+func (r *AuthSessionRepository) GetActiveSessionsByUserID(ctx context.Context, userID int64, t time.Time) ([]domain.AuthSession, error) {
+	defer r.store.rlock(ctx)()
+
+	sessions := []domain.AuthSession{}
+	for _, session := range r.store.authSessions {
+		if session.UserID == userID && session.IsActive(t) {
+			sessions = append(sessions, session)
+		}
+	}
+	sort.Slice(sessions, func(i, j int) bool {
+		if !sessions[i].LastUsedAt.Equal(sessions[j].LastUsedAt) {
+			return sessions[i].LastUsedAt.After(sessions[j].LastUsedAt)
+		}
+		return sessions[i].ID > sessions[j].ID
+	})
+	return sessions, nil
+}
+
+func (r *AuthSessionRepository) UpdateSession(ctx context.Context, session domain.AuthSession) (domain.AuthSession, error) {
+	defer r.store.lock(ctx)()
+
+	stored, ok := r.store.authSessions[session.ID]
+	if !ok {
+		return domain.AuthSession{}, fmt.Errorf("%w: %s", domain.ErrRecordNotFound, "session")
+	}
+
+	stored.LastUsedAt = session.LastUsedAt
+	stored.ExpiresAt = session.ExpiresAt
+	stored.RevokedAt = session.RevokedAt
+	r.store.authSessions[session.ID] = stored
+	return stored, nil
+}
+
+func (r *AuthSessionRepository) CreateRefreshToken(ctx context.Context, token domain.RefreshToken) (domain.RefreshToken, error) {
+	defer r.store.lock(ctx)()
+
+	if _, ok := r.store.authSessions[token.SessionID]; !ok {
+		return domain.RefreshToken{}, fmt.Errorf("%w: session %d", errForeignKey, token.SessionID)
+	}
+	for _, existing := range r.store.refreshTokens {
+		if existing.TokenHash == token.TokenHash {
+			return domain.RefreshToken{}, fmt.Errorf("%w: refresh token", domain.ErrAlreadyExists)
+		}
+	}
+
+	token.ID = r.store.id("refresh_token")
+	token.UsedAt = nil
+	r.store.refreshTokens[token.ID] = token
+	return token, nil
+}
+
+func (r *AuthSessionRepository) GetRefreshTokenByHashForUpdate(ctx context.Context, hash string) (domain.RefreshToken, error) {
+	defer r.store.rlock(ctx)()
+
+	for _, token := range r.store.refreshTokens {
+		if token.TokenHash == hash {
+			return token, nil
+		}
+	}
+	return domain.RefreshToken{}, fmt.Errorf("%w: %s", domain.ErrRecordNotFound, "refresh token")
+}
+
+func (r *AuthSessionRepository) MarkRefreshTokenUsed(ctx context.Context, id int64, t time.Time) error {
+	defer r.store.lock(ctx)()
+
+	token, ok := r.store.refreshTokens[id]
+	if !ok {
+		return fmt.Errorf("%w: %s", domain.ErrRecordNotFound, "refresh token")
+	}
+	token.UsedAt = &t
+	r.store.refreshTokens[id] = token
+	return nil
+}
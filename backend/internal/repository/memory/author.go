@@ -0,0 +1,76 @@
+package memory
+
+import (
+	"strings"
+
+	"context"
+	"fmt"
+
+	"github.com/rimvydascivilis/book-tracker/backend/domain"
+)
+
+type AuthorRepository struct {
+	store *Store
+}
+
+func NewAuthorRepository(store *Store) *AuthorRepository {
+	return &AuthorRepository{
+		store: store,
+	}
+}
+
+func (r *AuthorRepository) GetOrCreateAuthors(ctx context.Context, names []string) ([]domain.Author, error) {
+	defer r.store.lock(ctx)()
+
+	// Names are compared ignoring case, like the SQL collations do.
+	byName := map[string]domain.Author{}
+	for _, author := range r.store.authors {
+		byName[strings.ToLower(author.Name)] = author
+	}
+
+	authors := make([]domain.Author, 0, len(names))
+	for _, name := range names {
+		author, ok := byName[strings.ToLower(name)]
+		if !ok {
+			author = domain.Author{ID: r.store.id("author"), Name: name}
+			r.store.authors[author.ID] = author
+			byName[strings.ToLower(name)] = author
+		}
+		authors = append(authors, author)
+	}
+
+	return authors, nil
+}
+
+func (r *AuthorRepository) GetAuthorsByBookIDs(ctx context.Context, bookIDs []int64) (map[int64][]domain.Author, error) {
+	defer r.store.rlock(ctx)()
+
+	authors := map[int64][]domain.Author{}
+	for _, bookID := range bookIDs {
+		for _, authorID := range r.store.bookAuthors[bookID] {
+			authors[bookID] = append(authors[bookID], r.store.authors[authorID])
+		}
+	}
+
+	return authors, nil
+}
+
+func (r *AuthorRepository) SetBookAuthors(ctx context.Context, bookID int64, authorIDs []int64) error {
+	defer r.store.lock(ctx)()
+
+	if err := r.store.requireBook(bookID); err != nil {
+		return err
+	}
+	for _, id := range authorIDs {
+		if _, ok := r.store.authors[id]; !ok {
+			return fmt.Errorf("%w: author %d", errForeignKey, id)
+		}
+	}
+
+	if len(authorIDs) == 0 {
+		delete(r.store.bookAuthors, bookID)
+		return nil
+	}
+	r.store.bookAuthors[bookID] = append([]int64(nil), authorIDs...)
+	return nil
+}
@@ -0,0 +1,118 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/rimvydascivilis/book-tracker/backend/domain"
+	"github.com/rimvydascivilis/book-tracker/backend/utils"
+)
+
+type BookRepository struct {
+	store *Store
+}
+
+func NewBookRepository(store *Store) *BookRepository {
+	return &BookRepository{
+		store: store,
+	}
+}
+
+func (r *BookRepository) userBooks(userID int64, match func(domain.Book) bool) []domain.Book {
+	res := []domain.Book{}
+	for _, id := range sortedIDs(r.store.books) {
+		b := r.store.books[id]
+		if b.UserID == userID && match(b) {
+			res = append(res, b)
+		}
+	}
+	return res
+}
+
+func (r *BookRepository) CountBooksByUser(ctx context.Context, userID int64) (int64, error) {
+	defer r.store.rlock(ctx)()
+
+	return int64(len(r.userBooks(userID, func(domain.Book) bool { return true }))), nil
+}
+
+func (r *BookRepository) GetBooksByUser(ctx context.Context, userID, offset, limit int64) ([]domain.Book, error) {
+	defer r.store.rlock(ctx)()
+
+	return paginate(r.userBooks(userID, func(domain.Book) bool { return true }), offset, limit), nil
+}
+
+func (r *BookRepository) GetBookByUserID(ctx context.Context, userID, bookID int64) (domain.Book, error) {
+	defer r.store.rlock(ctx)()
+
+	b, ok := r.store.books[bookID]
+	if !ok || b.UserID != userID {
+		return domain.Book{}, fmt.Errorf("%w: %s", domain.ErrRecordNotFound, "book")
+	}
+	return b, nil
+}
+
+// GetBookByUserIDForUpdate needs no row lock: a transaction holds the store's write
+// lock until it ends.
+func (r *BookRepository) GetBookByUserIDForUpdate(ctx context.Context, userID, bookID int64) (domain.Book, error) {
+	return r.GetBookByUserID(ctx, userID, bookID)
+}
+
+// SearchBooks matches titles and author names case-insensitively, like LIKE
+// under MariaDB's default collation.
+func (r *BookRepository) SearchBooks(ctx context.Context, userID int64, query string, limit int64) ([]domain.Book, error) {
+	defer r.store.rlock(ctx)()
+
+	isbn := utils.NormalizeISBN(query)
+	query = strings.ToLower(query)
+	books := r.userBooks(userID, func(b domain.Book) bool {
+		if strings.Contains(strings.ToLower(b.Title), query) || b.ISBN10 == isbn || b.ISBN13 == isbn {
+			return true
+		}
+		for _, authorID := range r.store.bookAuthors[b.ID] {
+			if strings.Contains(strings.ToLower(r.store.authors[authorID].Name), query) {
+				return true
+			}
+		}
+		return false
+	})
+	return paginate(books, 0, limit), nil
+}
+
+func (r *BookRepository) UpdateBook(ctx context.Context, book domain.Book) (domain.Book, error) {
+	defer r.store.lock(ctx)()
+
+	current, ok := r.store.books[book.ID]
+	if ok && current.UserID == book.UserID {
+		updated := book
+		updated.Authors = nil
+		updated.CreatedAt = current.CreatedAt
+		r.store.books[book.ID] = updated
+	}
+	return book, nil
+}
+
+func (r *BookRepository) CreateBook(ctx context.Context, book domain.Book) (domain.Book, error) {
+	defer r.store.lock(ctx)()
+
+	if err := r.store.requireUser(book.UserID); err != nil {
+		return domain.Book{}, err
+	}
+
+	book.ID = r.store.id("book")
+	book.CreatedAt = time.Now()
+	stored := book
+	stored.Authors = nil
+	r.store.books[book.ID] = stored
+	return book, nil
+}
+
+func (r *BookRepository) DeleteBook(ctx context.Context, userID, bookID int64) error {
+	defer r.store.lock(ctx)()
+
+	if b, ok := r.store.books[bookID]; ok && b.UserID == userID {
+		r.store.deleteBook(bookID)
+	}
+	return nil
+}
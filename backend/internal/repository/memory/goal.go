@@ -0,0 +1,140 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/rimvydascivilis/book-tracker/backend/domain"
+	"github.com/rimvydascivilis/book-tracker/backend/utils"
+)
+
+type GoalRepository struct {
+	store *Store
+}
+
+func NewGoalRepository(store *Store) *GoalRepository {
+	return &GoalRepository{
+		store: store,
+	}
+}
+
+func (r *GoalRepository) GetGoalsByUserID(ctx context.Context, userID int64) ([]domain.Goal, error) {
+	defer r.store.rlock(ctx)()
+
+	goals := []domain.Goal{}
+	for _, id := range sortedIDs(r.store.goals) {
+		if goal := r.store.goals[id]; goal.UserID == userID {
+			goals = append(goals, goal)
+		}
+	}
+	return goals, nil
+}
+
+func (r *GoalRepository) GetGoalByID(ctx context.Context, id int64) (domain.Goal, error) {
+	defer r.store.rlock(ctx)()
+
+	goal, ok := r.store.goals[id]
+	if !ok {
+		return domain.Goal{}, fmt.Errorf("%w: goal %d not found", domain.ErrRecordNotFound, id)
+	}
+	return goal, nil
+}
+
+// requireUniqueGoal mirrors the unique key on user, type and frequency.
+func (r *GoalRepository) requireUniqueGoal(goal domain.Goal) error {
+	for id, other := range r.store.goals {
+		if id != goal.ID && other.UserID == goal.UserID && other.Type == goal.Type && other.Frequency == goal.Frequency {
+			return fmt.Errorf("%w: %s %s goal", domain.ErrAlreadyExists, goal.Frequency, goal.Type)
+		}
+	}
+	return nil
+}
+
+func (r *GoalRepository) CreateGoal(ctx context.Context, goal domain.Goal) (domain.Goal, error) {
+	defer r.store.lock(ctx)()
+
+	if err := r.store.requireUser(goal.UserID); err != nil {
+		return domain.Goal{}, err
+	}
+	goal.ID = 0
+	if err := r.requireUniqueGoal(goal); err != nil {
+		return domain.Goal{}, err
+	}
+
+	goal.ID = r.store.id("goal")
+	r.store.goals[goal.ID] = goal
+	return goal, nil
+}
+
+func (r *GoalRepository) UpdateGoal(ctx context.Context, goal domain.Goal) (domain.Goal, error) {
+	defer r.store.lock(ctx)()
+
+	if _, ok := r.store.goals[goal.ID]; !ok {
+		return goal, nil
+	}
+	if err := r.requireUniqueGoal(goal); err != nil {
+		return domain.Goal{}, err
+	}
+	r.store.goals[goal.ID] = goal
+	return goal, nil
+}
+
+func (r *GoalRepository) DeleteGoal(ctx context.Context, id int64) error {
+	defer r.store.lock(ctx)()
+
+	delete(r.store.goals, id)
+	for versionID, version := range r.store.goalVersions {
+		if version.GoalID == id {
+			version.GoalID = 0
+			r.store.goalVersions[versionID] = version
+		}
+	}
+	return nil
+}
+
+func (r *GoalRepository) GetGoalVersionsByUserID(ctx context.Context, userID int64) ([]domain.GoalVersion, error) {
+	defer r.store.rlock(ctx)()
+
+	versions := []domain.GoalVersion{}
+	for _, id := range sortedIDs(r.store.goalVersions) {
+		if version := r.store.goalVersions[id]; version.UserID == userID {
+			versions = append(versions, version)
+		}
+	}
+	sort.SliceStable(versions, func(i, j int) bool {
+		return versions[i].EffectiveFrom.Before(versions[j].EffectiveFrom)
+	})
+	return versions, nil
+}
+
+func (r *GoalRepository) CreateGoalVersion(ctx context.Context, version domain.GoalVersion) (domain.GoalVersion, error) {
+	defer r.store.lock(ctx)()
+
+	if err := r.store.requireUser(version.UserID); err != nil {
+		return domain.GoalVersion{}, err
+	}
+	version.ID = r.store.id("goal_version")
+	version.EffectiveFrom = utils.Date(version.EffectiveFrom)
+	r.store.goalVersions[version.ID] = version
+	return version, nil
+}
+
+func (r *GoalRepository) EndGoalVersion(ctx context.Context, goalID int64, to time.Time) error {
+	defer r.store.lock(ctx)()
+
+	to = utils.Date(to)
+	for id, version := range r.store.goalVersions {
+		if version.GoalID != goalID || version.EffectiveTo != nil {
+			continue
+		}
+		if version.EffectiveFrom.After(to) {
+			delete(r.store.goalVersions, id)
+			continue
+		}
+		version.EffectiveTo = &to
+		r.store.goalVersions[id] = version
+	}
+	return nil
+}
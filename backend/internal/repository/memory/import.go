@@ -0,0 +1,146 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/rimvydascivilis/book-tracker/backend/domain"
+	"github.com/rimvydascivilis/book-tracker/backend/utils"
+)
+
+type ImportRepository struct {
+	store *Store
+}
+
+func NewImportRepository(store *Store) *ImportRepository {
+	return &ImportRepository{
+		store: store,
+	}
+}
+
+func (r *ImportRepository) GetImportJobByID(ctx context.Context, id int64) (domain.ImportJob, error) {
+	defer r.store.rlock(ctx)()
+
+	job, ok := r.store.importJobs[id]
+	if !ok {
+		return domain.ImportJob{}, fmt.Errorf("%w: %s", domain.ErrRecordNotFound, "import job")
+	}
+	return job, nil
+}
+
+func (r *ImportRepository) GetImportJobByChecksum(ctx context.Context, userID int64, source, checksum string) (domain.ImportJob, error) {
+	defer r.store.rlock(ctx)()
+
+	for _, id := range sortedIDs(r.store.importJobs) {
+		job := r.store.importJobs[id]
+		if job.UserID == userID && job.Source == source && job.Checksum == checksum {
+			return job, nil
+		}
+	}
+	return domain.ImportJob{}, fmt.Errorf("%w: %s", domain.ErrRecordNotFound, "import job")
+}
+
+func (r *ImportRepository) GetUnfinishedImportJobs(ctx context.Context) ([]domain.ImportJob, error) {
+	defer r.store.rlock(ctx)()
+
+	jobs := []domain.ImportJob{}
+	for _, id := range sortedIDs(r.store.importJobs) {
+		job := r.store.importJobs[id]
+		if job.Status == domain.ImportStatusPending || job.Status == domain.ImportStatusRunning {
+			jobs = append(jobs, job)
+		}
+	}
+	return jobs, nil
+}
+
+func (r *ImportRepository) CreateImportJob(ctx context.Context, job domain.ImportJob) (domain.ImportJob, error) {
+	defer r.store.lock(ctx)()
+
+	if err := r.store.requireUser(job.UserID); err != nil {
+		return domain.ImportJob{}, err
+	}
+	for _, existing := range r.store.importJobs {
+		if existing.UserID == job.UserID && existing.Source == job.Source && existing.Checksum == job.Checksum {
+			return domain.ImportJob{}, fmt.Errorf("%w: %s", domain.ErrAlreadyExists, "import job")
+		}
+	}
+
+	job.ID = r.store.id("import_job")
+	job.Data = slices.Clone(job.Data)
+	job.CreatedAt = utils.Now()
+	job.UpdatedAt = job.CreatedAt
+	r.store.importJobs[job.ID] = job
+	return job, nil
+}
+
+func (r *ImportRepository) UpdateImportJob(ctx context.Context, job domain.ImportJob) (domain.ImportJob, error) {
+	defer r.store.lock(ctx)()
+
+	stored, ok := r.store.importJobs[job.ID]
+	if !ok {
+		return domain.ImportJob{}, fmt.Errorf("%w: %s", domain.ErrRecordNotFound, "import job")
+	}
+
+	stored.Status = job.Status
+	stored.TotalRows = job.TotalRows
+	stored.Error = job.Error
+	stored.UpdatedAt = utils.Now()
+	r.store.importJobs[job.ID] = stored
+
+	job.UpdatedAt = stored.UpdatedAt
+	return job, nil
+}
+
+func (r *ImportRepository) GetImportRowsByJobID(ctx context.Context, jobID int64) ([]domain.ImportRow, error) {
+	defer r.store.rlock(ctx)()
+
+	rows := []domain.ImportRow{}
+	for _, id := range sortedIDs(r.store.importRows) {
+		if row := r.store.importRows[id]; row.JobID == jobID {
+			rows = append(rows, row)
+		}
+	}
+	slices.SortStableFunc(rows, func(a, b domain.ImportRow) int {
+		return int(a.RowNumber - b.RowNumber)
+	})
+	return rows, nil
+}
+
+func (r *ImportRepository) CreateImportRow(ctx context.Context, row domain.ImportRow) (domain.ImportRow, error) {
+	defer r.store.lock(ctx)()
+
+	if _, ok := r.store.importJobs[row.JobID]; !ok {
+		return domain.ImportRow{}, fmt.Errorf("%w: import job %d", errForeignKey, row.JobID)
+	}
+	if row.BookID != 0 {
+		if err := r.store.requireBook(row.BookID); err != nil {
+			return domain.ImportRow{}, err
+		}
+	}
+	for _, existing := range r.store.importRows {
+		if existing.JobID == row.JobID && existing.RowNumber == row.RowNumber {
+			return domain.ImportRow{}, fmt.Errorf("%w: %s", domain.ErrAlreadyExists, "import row")
+		}
+	}
+
+	row.ID = r.store.id("import_row")
+	row.CreatedAt = utils.Now()
+	r.store.importRows[row.ID] = row
+	return row, nil
+}
+
+func (r *ImportRepository) GetImportedBookID(ctx context.Context, userID int64, source, externalID string) (int64, error) {
+	defer r.store.rlock(ctx)()
+
+	for _, id := range sortedIDs(r.store.importRows) {
+		row := r.store.importRows[id]
+		if row.ExternalID != externalID || row.BookID == 0 {
+			continue
+		}
+		if job := r.store.importJobs[row.JobID]; job.UserID == userID && job.Source == source {
+			return row.BookID, nil
+		}
+	}
+	return 0, fmt.Errorf("%w: %s", domain.ErrRecordNotFound, "imported book")
+}
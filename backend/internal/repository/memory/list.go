@@ -0,0 +1,54 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/rimvydascivilis/book-tracker/backend/domain"
+)
+
+type ListRepository struct {
+	store *Store
+}
+
+func NewListRepository(store *Store) *ListRepository {
+	return &ListRepository{
+		store: store,
+	}
+}
+
+func (r *ListRepository) GetListByID(ctx context.Context, listID int64) (domain.List, error) {
+	defer r.store.rlock(ctx)()
+
+	list, ok := r.store.lists[listID]
+	if !ok {
+		return domain.List{}, fmt.Errorf("%w: %s", domain.ErrRecordNotFound, "list")
+	}
+	return list, nil
+}
+
+func (r *ListRepository) GetListsByUserID(ctx context.Context, userID int64) ([]domain.List, error) {
+	defer r.store.rlock(ctx)()
+
+	var lists []domain.List
+	for _, id := range sortedIDs(r.store.lists) {
+		if list := r.store.lists[id]; list.UserID == userID {
+			lists = append(lists, list)
+		}
+	}
+	return lists, nil
+}
+
+func (r *ListRepository) CreateList(ctx context.Context, list domain.List) (domain.List, error) {
+	defer r.store.lock(ctx)()
+
+	if err := r.store.requireUser(list.UserID); err != nil {
+		return domain.List{}, err
+	}
+
+	list.ID = r.store.id("list")
+	list.CreatedAt = time.Now()
+	r.store.lists[list.ID] = list
+	return list, nil
+}
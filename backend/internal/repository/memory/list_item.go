@@ -0,0 +1,67 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/rimvydascivilis/book-tracker/backend/domain"
+)
+
+type ListItemRepository struct {
+	store *Store
+}
+
+func NewListItemRepository(store *Store) *ListItemRepository {
+	return &ListItemRepository{
+		store: store,
+	}
+}
+
+func (r *ListItemRepository) GetListItemsByListID(ctx context.Context, listID int64) ([]domain.ListItem, error) {
+	defer r.store.rlock(ctx)()
+
+	var items []domain.ListItem
+	for _, id := range sortedIDs(r.store.listItems) {
+		if item := r.store.listItems[id]; item.ListID == listID {
+			items = append(items, item)
+		}
+	}
+	return items, nil
+}
+
+func (r *ListItemRepository) GetListItemBooksByListID(ctx context.Context, listID int64) ([]domain.ListItemBook, error) {
+	defer r.store.rlock(ctx)()
+
+	items := []domain.ListItemBook{}
+	for _, id := range sortedIDs(r.store.listItems) {
+		item := r.store.listItems[id]
+		if book, ok := r.store.books[item.BookID]; ok && item.ListID == listID {
+			items = append(items, domain.ListItemBook{ListItem: item, BookTitle: book.Title})
+		}
+	}
+	return items, nil
+}
+
+func (r *ListItemRepository) CreateListItem(ctx context.Context, listItem domain.ListItem) (domain.ListItem, error) {
+	defer r.store.lock(ctx)()
+
+	if _, ok := r.store.lists[listItem.ListID]; !ok {
+		return domain.ListItem{}, fmt.Errorf("%w: list %d", errForeignKey, listItem.ListID)
+	}
+	if err := r.store.requireBook(listItem.BookID); err != nil {
+		return domain.ListItem{}, err
+	}
+
+	listItem.ID = r.store.id("list_item")
+	listItem.CreatedAt = time.Now()
+	r.store.listItems[listItem.ID] = listItem
+	return listItem, nil
+}
+
+func (r *ListItemRepository) DeleteListItem(ctx context.Context, id int64) error {
+	defer r.store.lock(ctx)()
+
+	delete(r.store.listItems, id)
+	return nil
+}
@@ -0,0 +1,117 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/rimvydascivilis/book-tracker/backend/domain"
+)
+
+type LocalAccountRepository struct {
+	store *Store
+}
+
+func NewLocalAccountRepository(store *Store) *LocalAccountRepository {
+	return &LocalAccountRepository{
+		store: store,
+	}
+}
+
+func (r *LocalAccountRepository) CreateLocalAccount(ctx context.Context, account domain.LocalAccount) (domain.LocalAccount, error) {
+	defer r.store.lock(ctx)()
+
+	if err := r.store.requireUser(account.UserID); err != nil {
+		return domain.LocalAccount{}, err
+	}
+	if _, ok := r.store.localAccounts[account.UserID]; ok {
+		return domain.LocalAccount{}, fmt.Errorf("%w: local account of user %d", domain.ErrAlreadyExists, account.UserID)
+	}
+
+	r.store.localAccounts[account.UserID] = account
+	return account, nil
+}
+
+func (r *LocalAccountRepository) GetLocalAccount(ctx context.Context, userID int64) (domain.LocalAccount, error) {
+	defer r.store.rlock(ctx)()
+
+	account, ok := r.store.localAccounts[userID]
+	if !ok {
+		return domain.LocalAccount{}, fmt.Errorf("%w: %s", domain.ErrRecordNotFound, "local account")
+	}
+	return account, nil
+}
+
+// GetLocalAccountForUpdate needs no row lock: a transaction holds the store's write
+// lock until it ends.
+func (r *LocalAccountRepository) GetLocalAccountForUpdate(ctx context.Context, userID int64) (domain.LocalAccount, error) {
+	return r.GetLocalAccount(ctx, userID)
+}
+
+func (r *LocalAccountRepository) UpdateLocalAccount(ctx context.Context, account domain.LocalAccount) (domain.LocalAccount, error) {
+	defer r.store.lock(ctx)()
+
+	stored, ok := r.store.localAccounts[account.UserID]
+	if !ok {
+		return domain.LocalAccount{}, fmt.Errorf("%w: %s", domain.ErrRecordNotFound, "local account")
+	}
+
+	stored.PasswordHash = account.PasswordHash
+	stored.EmailVerifiedAt = account.EmailVerifiedAt
+	stored.FailedLogins = account.FailedLogins
+	stored.LockedUntil = account.LockedUntil
+	r.store.localAccounts[account.UserID] = stored
+	return stored, nil
+}
+
+func (r *LocalAccountRepository) CreateAccountToken(ctx context.Context, token domain.AccountToken) (domain.AccountToken, error) {
+	defer r.store.lock(ctx)()
+
+	if err := r.store.requireUser(token.UserID); err != nil {
+		return domain.AccountToken{}, err
+	}
+	for _, existing := range r.store.accountTokens {
+		if existing.TokenHash == token.TokenHash {
+			return domain.AccountToken{}, fmt.Errorf("%w: account token", domain.ErrAlreadyExists)
+		}
+	}
+
+	token.ID = r.store.id("account_token")
+	token.UsedAt = nil
+	r.store.accountTokens[token.ID] = token
+	return token, nil
+}
+
+func (r *LocalAccountRepository) GetAccountTokenByHashForUpdate(ctx context.Context, hash string) (domain.AccountToken, error) {
+	defer r.store.rlock(ctx)()
+
+	for _, token := range r.store.accountTokens {
+		if token.TokenHash == hash {
+			return token, nil
+		}
+	}
+	return domain.AccountToken{}, fmt.Errorf("%w: %s", domain.ErrRecordNotFound, "account token")
+}
+
+func (r *LocalAccountRepository) MarkAccountTokenUsed(ctx context.Context, id int64, t time.Time) error {
+	defer r.store.lock(ctx)()
+
+	token, ok := r.store.accountTokens[id]
+	if !ok {
+		return fmt.Errorf("%w: %s", domain.ErrRecordNotFound, "account token")
+	}
+	token.UsedAt = &t
+	r.store.accountTokens[id] = token
+	return nil
+}
+
+func (r *LocalAccountRepository) DeleteAccountTokens(ctx context.Context, userID int64, purpose string) error {
+	defer r.store.lock(ctx)()
+
+	for id, token := range r.store.accountTokens {
+		if token.UserID == userID && token.Purpose == purpose {
+			delete(r.store.accountTokens, id)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,292 @@
+package memory_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/rimvydascivilis/book-tracker/backend/domain"
+	"github.com/rimvydascivilis/book-tracker/backend/dto"
+	"github.com/rimvydascivilis/book-tracker/backend/internal/repository/memory"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	_ domain.UserRepository     = (*memory.UserRepository)(nil)
+	_ domain.BookRepository     = (*memory.BookRepository)(nil)
+	_ domain.GoalRepository     = (*memory.GoalRepository)(nil)
+	_ domain.ReadingRepository  = (*memory.ReadingRepository)(nil)
+	_ domain.ProgressRepository = (*memory.ProgressRepository)(nil)
+	_ domain.ListRepository     = (*memory.ListRepository)(nil)
+	_ domain.ListItemRepository = (*memory.ListItemRepository)(nil)
+	_ domain.NoteRepository     = (*memory.NoteRepository)(nil)
+	_ domain.ImportRepository   = (*memory.ImportRepository)(nil)
+)
+
+func setupUserAndBook(t *testing.T, store *memory.Store) (domain.User, domain.Book) {
+	ctx := context.Background()
+	user, err := memory.NewUserRepository(store).CreateUser(ctx, domain.User{Email: "test@example.com"})
+	require.NoError(t, err)
+	book, err := memory.NewBookRepository(store).CreateBook(ctx, domain.Book{UserID: user.ID, Title: "Dune"})
+	require.NoError(t, err)
+	return user, book
+}
+
+func TestUserRepository_NotFound(t *testing.T) {
+	repo := memory.NewUserRepository(memory.NewStore())
+
+	_, err := repo.GetByEmail(context.Background(), "missing@example.com")
+	assert.ErrorIs(t, err, domain.ErrRecordNotFound)
+
+	_, err = repo.GetByID(context.Background(), 1)
+	assert.ErrorIs(t, err, domain.ErrRecordNotFound)
+}
+
+func TestBookRepository_OwnershipAndSearch(t *testing.T) {
+	store := memory.NewStore()
+	repo := memory.NewBookRepository(store)
+	ctx := context.Background()
+	user, book := setupUserAndBook(t, store)
+
+	_, err := repo.GetBookByUserID(ctx, user.ID+1, book.ID)
+	assert.ErrorIs(t, err, domain.ErrRecordNotFound)
+
+	found, err := repo.SearchBooks(ctx, user.ID, "DUN", 10)
+	assert.NoError(t, err)
+	assert.Len(t, found, 1)
+
+	_, err = repo.CreateBook(ctx, domain.Book{UserID: 99, Title: "Orphan"})
+	assert.Error(t, err)
+}
+
+func TestAuthorRepository_BookAuthors(t *testing.T) {
+	store := memory.NewStore()
+	user, book := setupUserAndBook(t, store)
+	repo := memory.NewAuthorRepository(store)
+	ctx := context.Background()
+
+	authors, err := repo.GetOrCreateAuthors(ctx, []string{"Frank Herbert", "Frank Herbert"})
+	require.NoError(t, err)
+	assert.Equal(t, authors[0], authors[1])
+	require.NoError(t, repo.SetBookAuthors(ctx, book.ID, []int64{authors[0].ID}))
+
+	byBook, err := repo.GetAuthorsByBookIDs(ctx, []int64{book.ID})
+	assert.NoError(t, err)
+	assert.Equal(t, []domain.Author{authors[0]}, byBook[book.ID])
+
+	found, err := memory.NewBookRepository(store).SearchBooks(ctx, user.ID, "herbert", 10)
+	assert.NoError(t, err)
+	assert.Len(t, found, 1)
+
+	assert.Error(t, repo.SetBookAuthors(ctx, book.ID, []int64{99}))
+}
+
+func TestAuthorRepository_IgnoresCaseAcrossBooks(t *testing.T) {
+	store := memory.NewStore()
+	user, hobbit := setupUserAndBook(t, store)
+	repo := memory.NewAuthorRepository(store)
+	ctx := context.Background()
+	silmarillion, err := memory.NewBookRepository(store).CreateBook(ctx, domain.Book{UserID: user.ID, Title: "The Silmarillion"})
+	require.NoError(t, err)
+
+	first, err := repo.GetOrCreateAuthors(ctx, []string{"J. R. R. Tolkien"})
+	require.NoError(t, err)
+	require.NoError(t, repo.SetBookAuthors(ctx, hobbit.ID, []int64{first[0].ID}))
+	second, err := repo.GetOrCreateAuthors(ctx, []string{"j. r. r. TOLKIEN"})
+	require.NoError(t, err)
+	require.NoError(t, repo.SetBookAuthors(ctx, silmarillion.ID, []int64{second[0].ID}))
+
+	assert.Equal(t, first, second)
+	byBook, err := repo.GetAuthorsByBookIDs(ctx, []int64{hobbit.ID, silmarillion.ID})
+	assert.NoError(t, err)
+	assert.Equal(t, map[int64][]domain.Author{
+		hobbit.ID:       {{ID: first[0].ID, Name: "J. R. R. Tolkien"}},
+		silmarillion.ID: {{ID: first[0].ID, Name: "J. R. R. Tolkien"}},
+	}, byBook)
+}
+
+func TestBookRepository_GetBooksByUser_Paginates(t *testing.T) {
+	store := memory.NewStore()
+	repo := memory.NewBookRepository(store)
+	ctx := context.Background()
+	user, _ := setupUserAndBook(t, store)
+	_, err := repo.CreateBook(ctx, domain.Book{UserID: user.ID, Title: "Emma"})
+	require.NoError(t, err)
+
+	books, err := repo.GetBooksByUser(ctx, user.ID, 1, 10)
+	assert.NoError(t, err)
+	assert.Len(t, books, 1)
+	assert.Equal(t, "Emma", books[0].Title)
+
+	books, err = repo.GetBooksByUser(ctx, user.ID, 5, 10)
+	assert.NoError(t, err)
+	assert.Empty(t, books)
+}
+
+func TestBookRepository_DeleteBook_Cascades(t *testing.T) {
+	store := memory.NewStore()
+	ctx := context.Background()
+	user, book := setupUserAndBook(t, store)
+
+	reading, err := memory.NewReadingRepository(store).CreateReading(ctx, domain.Reading{UserID: user.ID, BookID: book.ID, TotalPages: 100})
+	require.NoError(t, err)
+	_, err = memory.NewProgressRepository(store).CreateProgress(ctx, domain.Progress{UserID: user.ID, ReadingID: reading.ID, Pages: 10, ReadingDate: time.Now()})
+	require.NoError(t, err)
+	list, err := memory.NewListRepository(store).CreateList(ctx, domain.List{UserID: user.ID, Title: "Favourites"})
+	require.NoError(t, err)
+	_, err = memory.NewListItemRepository(store).CreateListItem(ctx, domain.ListItem{ListID: list.ID, BookID: book.ID})
+	require.NoError(t, err)
+	_, err = memory.NewNoteRepository(store).CreateNote(ctx, domain.Note{UserID: user.ID, BookID: book.ID, PageNumber: 1, Content: "x"})
+	require.NoError(t, err)
+
+	require.NoError(t, memory.NewBookRepository(store).DeleteBook(ctx, user.ID, book.ID))
+
+	_, err = memory.NewReadingRepository(store).GetReadingByID(ctx, reading.ID)
+	assert.ErrorIs(t, err, domain.ErrRecordNotFound)
+	total, err := memory.NewProgressRepository(store).GetTotalProgressByReadingID(ctx, reading.ID)
+	assert.NoError(t, err)
+	assert.Zero(t, total)
+	items, err := memory.NewListItemRepository(store).GetListItemsByListID(ctx, list.ID)
+	assert.NoError(t, err)
+	assert.Empty(t, items)
+	notes, err := memory.NewNoteRepository(store).GetNotesByUserIDAndBookID(ctx, user.ID, book.ID)
+	assert.NoError(t, err)
+	assert.Empty(t, notes)
+}
+
+func TestGoalRepository_CreateTwice(t *testing.T) {
+	store := memory.NewStore()
+	repo := memory.NewGoalRepository(store)
+	ctx := context.Background()
+	user, _ := setupUserAndBook(t, store)
+	goal := domain.Goal{UserID: user.ID, Type: domain.GoalTypePages, Frequency: domain.GoalFrequencyDaily, Value: 10}
+
+	_, err := repo.CreateGoal(ctx, goal)
+	assert.NoError(t, err)
+	_, err = repo.CreateGoal(ctx, goal)
+	assert.ErrorIs(t, err, domain.ErrAlreadyExists)
+
+	goal.Frequency = domain.GoalFrequencyWeekly
+	weekly, err := repo.CreateGoal(ctx, goal)
+	assert.NoError(t, err)
+	weekly.Frequency = domain.GoalFrequencyDaily
+	_, err = repo.UpdateGoal(ctx, weekly)
+	assert.ErrorIs(t, err, domain.ErrAlreadyExists)
+}
+
+func TestProgressRepository_Aggregates(t *testing.T) {
+	store := memory.NewStore()
+	repo := memory.NewProgressRepository(store)
+	ctx := context.Background()
+	user, book := setupUserAndBook(t, store)
+	reading, err := memory.NewReadingRepository(store).CreateReading(ctx, domain.Reading{UserID: user.ID, BookID: book.ID, TotalPages: 500})
+	require.NoError(t, err)
+
+	for _, p := range []struct {
+		date  time.Time
+		pages int64
+	}{
+		{time.Date(2024, 1, 31, 0, 0, 0, 0, time.UTC), 10},
+		{time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC), 20},
+		{time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC), 5},
+		{time.Date(2024, 2, 14, 0, 0, 0, 0, time.UTC), 7},
+	} {
+		_, err := repo.CreateProgress(ctx, domain.Progress{UserID: user.ID, ReadingID: reading.ID, Pages: p.pages, ReadingDate: p.date})
+		require.NoError(t, err)
+	}
+
+	feb1 := time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)
+	day, err := repo.GetPeriodProgress(ctx, user.ID, feb1, feb1)
+	assert.NoError(t, err)
+	assert.Equal(t, dto.PeriodProgress{Pages: 25}, day)
+
+	month, err := repo.GetPeriodProgress(ctx, user.ID, feb1, time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC))
+	assert.NoError(t, err)
+	assert.Equal(t, int64(32), month.Pages)
+
+	days, err := repo.GetProgressByDay(ctx, user.ID, feb1, time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC))
+	assert.NoError(t, err)
+	assert.Equal(t, []dto.DayProgress{
+		{Day: feb1, PageHundredths: 2500},
+		{Day: time.Date(2024, 2, 14, 0, 0, 0, 0, time.UTC), PageHundredths: 700},
+	}, days)
+
+	monthly, err := repo.GetMonthlyProgress(ctx, user.ID, 2024)
+	assert.NoError(t, err)
+	assert.Equal(t, []dto.Progress{{Date: "1", Pages: 10}, {Date: "2", Pages: 32}}, monthly)
+
+	daily, err := repo.GetDailyProgress(ctx, user.ID, 2024, 2)
+	assert.NoError(t, err)
+	assert.Equal(t, []dto.Progress{{Date: "1", Pages: 25}, {Date: "14", Pages: 7}}, daily)
+}
+
+func TestProgressRepository_GetMonthlyProgress_NormalizesFormats(t *testing.T) {
+	store := memory.NewStore()
+	repo := memory.NewProgressRepository(store)
+	ctx := context.Background()
+	user, _ := setupUserAndBook(t, store)
+	book, err := memory.NewBookRepository(store).CreateBook(ctx, domain.Book{UserID: user.ID, Title: "Emma", PageCount: 474})
+	require.NoError(t, err)
+	date := time.Date(2024, 3, 5, 0, 0, 0, 0, time.UTC)
+
+	for format, pages := range map[string][]int64{
+		domain.ReadingFormatPrint:     {30},
+		domain.ReadingFormatEbook:     {10, 15},
+		domain.ReadingFormatAudiobook: {45},
+	} {
+		reading, err := memory.NewReadingRepository(store).CreateReading(ctx, domain.Reading{
+			UserID: user.ID, BookID: book.ID, Format: format, TotalPages: 1000,
+		})
+		require.NoError(t, err)
+		for _, p := range pages {
+			_, err := repo.CreateProgress(ctx, domain.Progress{UserID: user.ID, ReadingID: reading.ID, Pages: p, ReadingDate: date})
+			require.NoError(t, err)
+		}
+	}
+
+	monthly, err := repo.GetMonthlyProgress(ctx, user.ID, 2024)
+	assert.NoError(t, err)
+	assert.Equal(t, []dto.Progress{{Date: "3", Pages: 148, Minutes: 45}}, monthly)
+}
+
+func TestStore_ConcurrentWrites(t *testing.T) {
+	store := memory.NewStore()
+	ctx := context.Background()
+	user, book := setupUserAndBook(t, store)
+	reading, err := memory.NewReadingRepository(store).CreateReading(ctx, domain.Reading{UserID: user.ID, BookID: book.ID, TotalPages: 1000})
+	require.NoError(t, err)
+	repo := memory.NewProgressRepository(store)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := repo.CreateProgress(ctx, domain.Progress{UserID: user.ID, ReadingID: reading.ID, Pages: 1, ReadingDate: time.Now()})
+			assert.NoError(t, err)
+		}()
+	}
+	wg.Wait()
+
+	total, err := repo.GetTotalProgressByReadingID(ctx, reading.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(50), total)
+}
+
+func TestSeed(t *testing.T) {
+	store := memory.NewStore()
+	ctx := context.Background()
+
+	user, err := memory.Seed(ctx, store, time.Date(2024, 6, 15, 12, 0, 0, 0, time.UTC))
+	require.NoError(t, err)
+
+	assert.Equal(t, memory.DemoEmail, user.Email)
+	count, err := memory.NewBookRepository(store).CountBooksByUser(ctx, user.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(4), count)
+	goals, err := memory.NewGoalRepository(store).GetGoalsByUserID(ctx, user.ID)
+	assert.NoError(t, err)
+	assert.Len(t, goals, 2)
+}
@@ -0,0 +1,79 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/rimvydascivilis/book-tracker/backend/domain"
+)
+
+type NoteRepository struct {
+	store *Store
+}
+
+func NewNoteRepository(store *Store) *NoteRepository {
+	return &NoteRepository{
+		store: store,
+	}
+}
+
+func (r *NoteRepository) GetNoteByUserID(ctx context.Context, noteID, userID int64) (domain.Note, error) {
+	defer r.store.rlock(ctx)()
+
+	note, ok := r.store.notes[noteID]
+	if !ok || note.UserID != userID {
+		return domain.Note{}, fmt.Errorf("%w: %s", domain.ErrRecordNotFound, "note")
+	}
+	return note, nil
+}
+
+func (r *NoteRepository) GetBookIDsByUserID(ctx context.Context, userID int64) ([]int64, error) {
+	defer r.store.rlock(ctx)()
+
+	var bookIDs []int64
+	seen := map[int64]bool{}
+	for _, id := range sortedIDs(r.store.notes) {
+		note := r.store.notes[id]
+		if note.UserID == userID && !seen[note.BookID] {
+			seen[note.BookID] = true
+			bookIDs = append(bookIDs, note.BookID)
+		}
+	}
+	return bookIDs, nil
+}
+
+func (r *NoteRepository) GetNotesByUserIDAndBookID(ctx context.Context, userID, bookID int64) ([]domain.Note, error) {
+	defer r.store.rlock(ctx)()
+
+	var notes []domain.Note
+	for _, id := range sortedIDs(r.store.notes) {
+		if note := r.store.notes[id]; note.UserID == userID && note.BookID == bookID {
+			notes = append(notes, note)
+		}
+	}
+	return notes, nil
+}
+
+func (r *NoteRepository) CreateNote(ctx context.Context, note domain.Note) (domain.Note, error) {
+	defer r.store.lock(ctx)()
+
+	if err := r.store.requireUser(note.UserID); err != nil {
+		return domain.Note{}, err
+	}
+	if err := r.store.requireBook(note.BookID); err != nil {
+		return domain.Note{}, err
+	}
+
+	note.ID = r.store.id("note")
+	note.CreatedAt = time.Now()
+	r.store.notes[note.ID] = note
+	return note, nil
+}
+
+func (r *NoteRepository) DeleteNote(ctx context.Context, id int64) error {
+	defer r.store.lock(ctx)()
+
+	delete(r.store.notes, id)
+	return nil
+}
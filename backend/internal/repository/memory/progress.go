@@ -0,0 +1,265 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/rimvydascivilis/book-tracker/backend/domain"
+	"github.com/rimvydascivilis/book-tracker/backend/dto"
+	"github.com/rimvydascivilis/book-tracker/backend/utils"
+)
+
+type ProgressRepository struct {
+	store *Store
+}
+
+func NewProgressRepository(store *Store) *ProgressRepository {
+	return &ProgressRepository{
+		store: store,
+	}
+}
+
+// between reports whether the day of t is from one day to another, both
+// included.
+func between(t, from, to time.Time) bool {
+	day := utils.Date(t)
+	return !day.Before(utils.Date(from)) && !day.After(utils.Date(to))
+}
+
+func (r *ProgressRepository) filter(match func(domain.Progress) bool) []domain.Progress {
+	var res []domain.Progress
+	for _, id := range sortedIDs(r.store.progress) {
+		if p := r.store.progress[id]; match(p) {
+			res = append(res, p)
+		}
+	}
+	return res
+}
+
+func (r *ProgressRepository) GetTotalProgressByReadingID(ctx context.Context, readingID int64) (int64, error) {
+	defer r.store.rlock(ctx)()
+
+	var total int64
+	for _, p := range r.filter(func(p domain.Progress) bool { return p.ReadingID == readingID }) {
+		total += p.Pages
+	}
+	return total, nil
+}
+
+// grouped adds up the progress of each format like the SQL repositories do,
+// converting the ebook percent of a period to pages in one step.
+func (r *ProgressRepository) grouped(match func(domain.Progress) bool, key func(domain.Progress) int) []dto.Progress {
+	type sum struct{ pages, ebookPages, minutes int64 }
+	sums := map[int]*sum{}
+	for _, p := range r.filter(match) {
+		k := key(p)
+		if sums[k] == nil {
+			sums[k] = &sum{}
+		}
+
+		reading := r.store.readings[p.ReadingID]
+		switch reading.Format {
+		case domain.ReadingFormatEbook:
+			sums[k].ebookPages += p.Pages * r.store.books[reading.BookID].PageCount
+		case domain.ReadingFormatAudiobook:
+			sums[k].minutes += p.Pages
+		default:
+			sums[k].pages += p.Pages
+		}
+	}
+
+	keys := make([]int, 0, len(sums))
+	for k := range sums {
+		keys = append(keys, k)
+	}
+	sort.Ints(keys)
+
+	var res []dto.Progress
+	for _, k := range keys {
+		res = append(res, dto.Progress{
+			Date:    strconv.Itoa(k),
+			Pages:   sums[k].pages + sums[k].ebookPages/100,
+			Minutes: sums[k].minutes,
+		})
+	}
+	return res
+}
+
+func (r *ProgressRepository) GetPeriodProgress(ctx context.Context, userID int64, from, to time.Time) (dto.PeriodProgress, error) {
+	defer r.store.rlock(ctx)()
+
+	var progress dto.PeriodProgress
+	sums := r.grouped(func(p domain.Progress) bool {
+		return p.UserID == userID && between(p.ReadingDate, from, to) &&
+			r.store.readings[p.ReadingID].Status != domain.ReadingStatusAbandoned
+	}, func(domain.Progress) int { return 0 })
+	if len(sums) > 0 {
+		progress.Pages, progress.Minutes = sums[0].Pages, sums[0].Minutes
+	}
+
+	for _, reading := range r.store.readings {
+		if reading.UserID == userID && reading.Status == domain.ReadingStatusCompleted &&
+			reading.FinishedAt != nil && between(*reading.FinishedAt, from, to) {
+			progress.Books++
+		}
+	}
+	return progress, nil
+}
+
+func (r *ProgressRepository) GetProgressByDay(ctx context.Context, userID int64, from, to time.Time) ([]dto.DayProgress, error) {
+	defer r.store.rlock(ctx)()
+
+	sums := map[time.Time]*dto.DayProgress{}
+	day := func(t time.Time) *dto.DayProgress {
+		d := utils.Date(t)
+		if sums[d] == nil {
+			sums[d] = &dto.DayProgress{Day: d}
+		}
+		return sums[d]
+	}
+
+	for _, p := range r.filter(func(p domain.Progress) bool {
+		return p.UserID == userID && between(p.ReadingDate, from, to) &&
+			r.store.readings[p.ReadingID].Status != domain.ReadingStatusAbandoned
+	}) {
+		reading := r.store.readings[p.ReadingID]
+		switch reading.Format {
+		case domain.ReadingFormatEbook:
+			day(p.ReadingDate).PageHundredths += p.Pages * r.store.books[reading.BookID].PageCount
+		case domain.ReadingFormatAudiobook:
+			day(p.ReadingDate).Minutes += p.Pages
+		default:
+			day(p.ReadingDate).PageHundredths += p.Pages * 100
+		}
+	}
+	for _, reading := range r.store.readings {
+		if reading.UserID == userID && reading.Status == domain.ReadingStatusCompleted &&
+			reading.FinishedAt != nil && between(*reading.FinishedAt, from, to) {
+			day(*reading.FinishedAt).Books++
+		}
+	}
+
+	days := make([]dto.DayProgress, 0, len(sums))
+	for _, p := range sums {
+		days = append(days, *p)
+	}
+	sort.Slice(days, func(i, j int) bool { return days[i].Day.Before(days[j].Day) })
+	return days, nil
+}
+
+func (r *ProgressRepository) GetMonthlyProgress(ctx context.Context, userID, year int64) ([]dto.Progress, error) {
+	defer r.store.rlock(ctx)()
+
+	return r.grouped(func(p domain.Progress) bool {
+		return p.UserID == userID && int64(p.ReadingDate.Year()) == year
+	}, func(p domain.Progress) int {
+		return int(p.ReadingDate.Month())
+	}), nil
+}
+
+func (r *ProgressRepository) GetDailyProgress(ctx context.Context, userID, year, month int64) ([]dto.Progress, error) {
+	defer r.store.rlock(ctx)()
+
+	return r.grouped(func(p domain.Progress) bool {
+		return p.UserID == userID && int64(p.ReadingDate.Year()) == year && int64(p.ReadingDate.Month()) == month
+	}, func(p domain.Progress) int {
+		return p.ReadingDate.Day()
+	}), nil
+}
+
+func (r *ProgressRepository) GetProgressByUserID(ctx context.Context, userID, offset, limit int64) ([]domain.Progress, error) {
+	defer r.store.rlock(ctx)()
+
+	progress := r.filter(func(p domain.Progress) bool { return p.UserID == userID })
+	return append([]domain.Progress{}, paginate(progress, offset, limit)...), nil
+}
+
+func (r *ProgressRepository) CreateProgress(ctx context.Context, progress domain.Progress) (domain.Progress, error) {
+	defer r.store.lock(ctx)()
+
+	if err := r.store.requireUser(progress.UserID); err != nil {
+		return domain.Progress{}, err
+	}
+	if _, ok := r.store.readings[progress.ReadingID]; !ok {
+		return domain.Progress{}, fmt.Errorf("%w: reading %d", errForeignKey, progress.ReadingID)
+	}
+
+	progress.ID = r.store.id("progress")
+	r.store.progress[progress.ID] = progress
+	return progress, nil
+}
+
+func (r *ProgressRepository) GetProgressByID(ctx context.Context, id int64) (domain.Progress, error) {
+	defer r.store.rlock(ctx)()
+
+	p, ok := r.store.progress[id]
+	if !ok {
+		return domain.Progress{}, fmt.Errorf("%w: %s", domain.ErrRecordNotFound, "progress")
+	}
+	return p, nil
+}
+
+func (r *ProgressRepository) GetProgressByReadingID(ctx context.Context, readingID int64) ([]domain.Progress, error) {
+	defer r.store.rlock(ctx)()
+
+	progress := append([]domain.Progress{}, r.filter(func(p domain.Progress) bool { return p.ReadingID == readingID })...)
+	sort.SliceStable(progress, func(i, j int) bool {
+		return progress[i].ReadingDate.Before(progress[j].ReadingDate)
+	})
+	return progress, nil
+}
+
+func (r *ProgressRepository) GetProgressByReadingIDs(ctx context.Context, readingIDs []int64) (map[int64][]domain.Progress, error) {
+	res := map[int64][]domain.Progress{}
+	for _, id := range readingIDs {
+		progress, err := r.GetProgressByReadingID(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		if len(progress) > 0 {
+			res[id] = progress
+		}
+	}
+	return res, nil
+}
+
+func (r *ProgressRepository) UpdateProgress(ctx context.Context, progress domain.Progress) (domain.Progress, error) {
+	defer r.store.lock(ctx)()
+
+	current, ok := r.store.progress[progress.ID]
+	if !ok {
+		return domain.Progress{}, fmt.Errorf("%w: %s", domain.ErrRecordNotFound, "progress")
+	}
+
+	current.Pages = progress.Pages
+	current.Position = progress.Position
+	current.ReadingDate = progress.ReadingDate
+	r.store.progress[progress.ID] = current
+	return current, nil
+}
+
+func (r *ProgressRepository) DeleteProgress(ctx context.Context, id int64) error {
+	defer r.store.lock(ctx)()
+
+	r.store.deleteProgress(id)
+	return nil
+}
+
+func (r *ProgressRepository) GetReadingDays(ctx context.Context, userID int64) ([]time.Time, error) {
+	defer r.store.rlock(ctx)()
+
+	seen := map[time.Time]bool{}
+	days := []time.Time{}
+	for _, p := range r.filter(func(p domain.Progress) bool { return p.UserID == userID && p.Pages > 0 }) {
+		day := utils.Date(p.ReadingDate)
+		if !seen[day] {
+			seen[day] = true
+			days = append(days, day)
+		}
+	}
+	sort.Slice(days, func(i, j int) bool { return days[i].Before(days[j]) })
+	return days, nil
+}
@@ -0,0 +1,199 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/rimvydascivilis/book-tracker/backend/domain"
+	"github.com/rimvydascivilis/book-tracker/backend/dto"
+)
+
+type ReadingRepository struct {
+	store *Store
+}
+
+func NewReadingRepository(store *Store) *ReadingRepository {
+	return &ReadingRepository{
+		store: store,
+	}
+}
+
+func (r *ReadingRepository) userReadings(userID int64) []domain.Reading {
+	res := []domain.Reading{}
+	for _, id := range sortedIDs(r.store.readings) {
+		if reading := r.store.readings[id]; reading.UserID == userID {
+			res = append(res, reading)
+		}
+	}
+	return res
+}
+
+// userReadingsByStatus returns the readings of the user with status, or all
+// of them when status is empty.
+func (r *ReadingRepository) userReadingsByStatus(userID int64, status string) []domain.Reading {
+	res := []domain.Reading{}
+	for _, reading := range r.userReadings(userID) {
+		if status == "" || reading.Status == status {
+			res = append(res, reading)
+		}
+	}
+	return res
+}
+
+func (r *ReadingRepository) GetReadingsByUserID(ctx context.Context, userID int64, status string, offset, limit int64) ([]domain.Reading, error) {
+	defer r.store.rlock(ctx)()
+
+	readings := r.userReadingsByStatus(userID, status)
+	sort.Slice(readings, func(i, j int) bool {
+		if !readings[i].CreatedAt.Equal(readings[j].CreatedAt) {
+			return readings[i].CreatedAt.After(readings[j].CreatedAt)
+		}
+		return readings[i].ID > readings[j].ID
+	})
+	return paginate(readings, offset, limit), nil
+}
+
+func (r *ReadingRepository) GetReadingSummariesByUserID(ctx context.Context, userID int64, status string, offset, limit int64) ([]domain.ReadingSummary, error) {
+	readings, err := r.GetReadingsByUserID(ctx, userID, status, offset, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	defer r.store.rlock(ctx)()
+	res := make([]domain.ReadingSummary, 0, len(readings))
+	for _, reading := range readings {
+		summary := domain.ReadingSummary{Reading: reading, BookTitle: r.store.books[reading.BookID].Title}
+		for _, p := range r.store.progress {
+			if p.ReadingID == reading.ID {
+				summary.Progress += p.Pages
+			}
+		}
+		res = append(res, summary)
+	}
+	return res, nil
+}
+
+func (r *ReadingRepository) GetReadingByID(ctx context.Context, id int64) (domain.Reading, error) {
+	defer r.store.rlock(ctx)()
+
+	reading, ok := r.store.readings[id]
+	if !ok {
+		return domain.Reading{}, fmt.Errorf("%w: %s", domain.ErrRecordNotFound, "reading")
+	}
+	return reading, nil
+}
+
+// GetReadingByIDForUpdate needs no row lock: a transaction holds the store's write
+// lock until it ends.
+func (r *ReadingRepository) GetReadingByIDForUpdate(ctx context.Context, id int64) (domain.Reading, error) {
+	return r.GetReadingByID(ctx, id)
+}
+
+func (r *ReadingRepository) CountReadingsByUserID(ctx context.Context, userID int64, status string) (int64, error) {
+	defer r.store.rlock(ctx)()
+
+	return int64(len(r.userReadingsByStatus(userID, status))), nil
+}
+
+func (r *ReadingRepository) GetReadingsByUserIDAndBookID(ctx context.Context, userID, bookID int64) ([]domain.Reading, error) {
+	defer r.store.rlock(ctx)()
+
+	readings := []domain.Reading{}
+	for _, reading := range r.userReadings(userID) {
+		if reading.BookID == bookID {
+			readings = append(readings, reading)
+		}
+	}
+	sort.SliceStable(readings, func(i, j int) bool {
+		if readings[i].CreatedAt.Equal(readings[j].CreatedAt) {
+			return readings[i].ID > readings[j].ID
+		}
+		return readings[i].CreatedAt.After(readings[j].CreatedAt)
+	})
+	return readings, nil
+}
+
+func (r *ReadingRepository) groupedFinished(userID int64, match func(time.Time) bool, key func(time.Time) int) []dto.Progress {
+	counts := map[int]int64{}
+	for _, reading := range r.userReadings(userID) {
+		if reading.Status == domain.ReadingStatusCompleted && reading.FinishedAt != nil && match(*reading.FinishedAt) {
+			counts[key(*reading.FinishedAt)]++
+		}
+	}
+
+	keys := make([]int, 0, len(counts))
+	for k := range counts {
+		keys = append(keys, k)
+	}
+	sort.Ints(keys)
+
+	var res []dto.Progress
+	for _, k := range keys {
+		res = append(res, dto.Progress{Date: strconv.Itoa(k), Books: counts[k]})
+	}
+	return res
+}
+
+func (r *ReadingRepository) GetMonthlyFinishedReadings(ctx context.Context, userID, year int64) ([]dto.Progress, error) {
+	defer r.store.rlock(ctx)()
+
+	return r.groupedFinished(userID, func(t time.Time) bool {
+		return int64(t.Year()) == year
+	}, func(t time.Time) int {
+		return int(t.Month())
+	}), nil
+}
+
+func (r *ReadingRepository) GetDailyFinishedReadings(ctx context.Context, userID, year, month int64) ([]dto.Progress, error) {
+	defer r.store.rlock(ctx)()
+
+	return r.groupedFinished(userID, func(t time.Time) bool {
+		return int64(t.Year()) == year && int64(t.Month()) == month
+	}, func(t time.Time) int {
+		return t.Day()
+	}), nil
+}
+
+func (r *ReadingRepository) CreateReading(ctx context.Context, reading domain.Reading) (domain.Reading, error) {
+	defer r.store.lock(ctx)()
+
+	if err := r.store.requireUser(reading.UserID); err != nil {
+		return domain.Reading{}, err
+	}
+	if err := r.store.requireBook(reading.BookID); err != nil {
+		return domain.Reading{}, err
+	}
+
+	reading.ID = r.store.id("reading")
+	r.store.readings[reading.ID] = reading
+	return reading, nil
+}
+
+func (r *ReadingRepository) UpdateReading(ctx context.Context, reading domain.Reading) (domain.Reading, error) {
+	defer r.store.lock(ctx)()
+
+	current, ok := r.store.readings[reading.ID]
+	if !ok {
+		return domain.Reading{}, fmt.Errorf("%w: %s", domain.ErrRecordNotFound, "reading")
+	}
+
+	current.TotalPages = reading.TotalPages
+	current.Link = reading.Link
+	current.Status = reading.Status
+	current.StartedAt = reading.StartedAt
+	current.FinishedAt = reading.FinishedAt
+	current.TargetDate = reading.TargetDate
+	current.UpdatedAt = reading.UpdatedAt
+	r.store.readings[reading.ID] = current
+	return current, nil
+}
+
+func (r *ReadingRepository) DeleteReading(ctx context.Context, id int64) error {
+	defer r.store.lock(ctx)()
+
+	r.store.deleteReading(id)
+	return nil
+}
@@ -0,0 +1,109 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/rimvydascivilis/book-tracker/backend/domain"
+)
+
+type ReadingSessionRepository struct {
+	store *Store
+}
+
+func NewReadingSessionRepository(store *Store) *ReadingSessionRepository {
+	return &ReadingSessionRepository{
+		store: store,
+	}
+}
+
+func (r *ReadingSessionRepository) GetReadingSessionsByReadingID(ctx context.Context, readingID int64) ([]domain.ReadingSession, error) {
+	defer r.store.rlock(ctx)()
+
+	sessions := []domain.ReadingSession{}
+	for _, session := range r.store.readingSessions {
+		if session.ReadingID == readingID {
+			sessions = append(sessions, session)
+		}
+	}
+	sort.Slice(sessions, func(i, j int) bool {
+		if !sessions[i].StartedAt.Equal(sessions[j].StartedAt) {
+			return sessions[i].StartedAt.After(sessions[j].StartedAt)
+		}
+		return sessions[i].ID > sessions[j].ID
+	})
+	return sessions, nil
+}
+
+func (r *ReadingSessionRepository) GetOpenReadingSessionForUpdate(ctx context.Context, userID int64) (domain.ReadingSession, error) {
+	defer r.store.rlock(ctx)()
+
+	for _, session := range r.store.readingSessions {
+		if session.UserID == userID && session.IsOpen() {
+			return session, nil
+		}
+	}
+	return domain.ReadingSession{}, fmt.Errorf("%w: %s", domain.ErrRecordNotFound, "open reading session")
+}
+
+func (r *ReadingSessionRepository) GetOpenReadingSessionsStartedBefore(ctx context.Context, t time.Time) ([]domain.ReadingSession, error) {
+	defer r.store.rlock(ctx)()
+
+	sessions := []domain.ReadingSession{}
+	for _, id := range sortedIDs(r.store.readingSessions) {
+		session := r.store.readingSessions[id]
+		if session.IsOpen() && session.StartedAt.Before(t) {
+			sessions = append(sessions, session)
+		}
+	}
+	return sessions, nil
+}
+
+func (r *ReadingSessionRepository) CreateReadingSession(ctx context.Context, session domain.ReadingSession) (domain.ReadingSession, error) {
+	defer r.store.lock(ctx)()
+
+	if err := r.store.requireUser(session.UserID); err != nil {
+		return domain.ReadingSession{}, err
+	}
+	if _, ok := r.store.readings[session.ReadingID]; !ok {
+		return domain.ReadingSession{}, fmt.Errorf("%w: reading %d", errForeignKey, session.ReadingID)
+	}
+	for _, existing := range r.store.readingSessions {
+		if existing.UserID == session.UserID && existing.IsOpen() {
+			return domain.ReadingSession{}, fmt.Errorf("%w: open reading session of user %d", domain.ErrAlreadyExists, session.UserID)
+		}
+	}
+
+	session.ID = r.store.id("reading_session")
+	session.EndPosition = nil
+	session.EndedAt = nil
+	session.Duration = 0
+	session.ProgressID = nil
+	session.AutoClosed = false
+	r.store.readingSessions[session.ID] = session
+	return session, nil
+}
+
+func (r *ReadingSessionRepository) UpdateReadingSession(ctx context.Context, session domain.ReadingSession) (domain.ReadingSession, error) {
+	defer r.store.lock(ctx)()
+
+	stored, ok := r.store.readingSessions[session.ID]
+	if !ok {
+		return domain.ReadingSession{}, fmt.Errorf("%w: %s", domain.ErrRecordNotFound, "reading session")
+	}
+	if session.ProgressID != nil {
+		if _, ok := r.store.progress[*session.ProgressID]; !ok {
+			return domain.ReadingSession{}, fmt.Errorf("%w: progress %d", errForeignKey, *session.ProgressID)
+		}
+	}
+
+	stored.EndPosition = session.EndPosition
+	stored.EndedAt = session.EndedAt
+	stored.Duration = session.Duration
+	stored.ProgressID = session.ProgressID
+	stored.AutoClosed = session.AutoClosed
+	r.store.readingSessions[session.ID] = stored
+	return stored, nil
+}
@@ -0,0 +1,153 @@
+package memory
+
+import (
+	"context"
+	"time"
+
+	"github.com/rimvydascivilis/book-tracker/backend/domain"
+)
+
+const DemoEmail = "demo@example.com"
+
+// Seed fills store with a demo account that has books, readings with a few
+// weeks of progress before now, a goal, a list and notes.
+func Seed(ctx context.Context, store *Store, now time.Time) (domain.User, error) {
+	user, err := NewUserRepository(store).CreateUser(ctx, domain.User{Email: DemoEmail})
+	if err != nil {
+		return domain.User{}, err
+	}
+
+	bookRepo := NewBookRepository(store)
+	authorRepo := NewAuthorRepository(store)
+	var books []domain.Book
+	for _, b := range []struct {
+		book    domain.Book
+		authors []string
+	}{
+		{domain.Book{Title: "The Hobbit", ISBN13: "9780547928227", PublicationYear: 1937, PageCount: 310, Rating: 5}, []string{"J. R. R. Tolkien"}},
+		{domain.Book{Title: "Dune", ISBN13: "9780441013593", PublicationYear: 1965, PageCount: 612, Rating: 4.5}, []string{"Frank Herbert"}},
+		{domain.Book{Title: "Pride and Prejudice", PublicationYear: 1813, PageCount: 432, Rating: 4}, []string{"Jane Austen"}},
+		{domain.Book{Title: "The Pragmatic Programmer", PublicationYear: 1999, PageCount: 352}, []string{"Andrew Hunt", "David Thomas"}},
+	} {
+		b.book.UserID = user.ID
+		b.book.Language = "en"
+		book, err := bookRepo.CreateBook(ctx, b.book)
+		if err != nil {
+			return domain.User{}, err
+		}
+
+		authors, err := authorRepo.GetOrCreateAuthors(ctx, b.authors)
+		if err != nil {
+			return domain.User{}, err
+		}
+		ids := make([]int64, 0, len(authors))
+		for _, a := range authors {
+			ids = append(ids, a.ID)
+		}
+		if err := authorRepo.SetBookAuthors(ctx, book.ID, ids); err != nil {
+			return domain.User{}, err
+		}
+
+		books = append(books, book)
+	}
+
+	readingRepo := NewReadingRepository(store)
+	progressRepo := NewProgressRepository(store)
+	for i, r := range []struct {
+		book      domain.Book
+		daysAgo   int
+		dailyRead int64
+	}{
+		{books[0], 30, 31},
+		{books[1], 14, 25},
+		{books[2], 0, 0},
+	} {
+		created := now.AddDate(0, 0, -r.daysAgo-1)
+		reading, err := readingRepo.CreateReading(ctx, domain.Reading{
+			UserID:     user.ID,
+			BookID:     r.book.ID,
+			Format:     domain.ReadingFormatPrint,
+			TotalPages: r.book.PageCount,
+			Status:     domain.ReadingStatusNotStarted,
+			CreatedAt:  created.Add(time.Duration(i) * time.Second),
+			UpdatedAt:  created,
+		})
+		if err != nil {
+			return domain.User{}, err
+		}
+
+		var read int64
+		for day := r.daysAgo; day >= 0 && r.dailyRead > 0 && read < r.book.PageCount; day-- {
+			pages := min(r.dailyRead, r.book.PageCount-read)
+			_, err := progressRepo.CreateProgress(ctx, domain.Progress{
+				UserID:      user.ID,
+				ReadingID:   reading.ID,
+				Pages:       pages,
+				Position:    read + pages,
+				ReadingDate: now.AddDate(0, 0, -day),
+			})
+			if err != nil {
+				return domain.User{}, err
+			}
+			read += pages
+
+			date := time.Date(now.Year(), now.Month(), now.Day()-day, 0, 0, 0, 0, time.UTC)
+			if reading.StartedAt == nil {
+				reading.StartedAt = &date
+				reading.Status = domain.ReadingStatusReading
+			}
+			if read == r.book.PageCount {
+				reading.FinishedAt = &date
+				reading.Status = domain.ReadingStatusCompleted
+			}
+		}
+		if reading.StartedAt != nil {
+			if _, err := readingRepo.UpdateReading(ctx, reading); err != nil {
+				return domain.User{}, err
+			}
+		}
+	}
+
+	goalRepo := NewGoalRepository(store)
+	for _, goal := range []domain.Goal{
+		{UserID: user.ID, Type: domain.GoalTypePages, Frequency: domain.GoalFrequencyDaily, Value: 20},
+		{UserID: user.ID, Type: domain.GoalTypeBooks, Frequency: domain.GoalFrequencyYearly, Value: 24},
+	} {
+		goal, err := goalRepo.CreateGoal(ctx, goal)
+		if err != nil {
+			return domain.User{}, err
+		}
+		// The goals were set when the first reading started, so the goal
+		// history has a few weeks of periods.
+		_, err = goalRepo.CreateGoalVersion(ctx, domain.GoalVersion{
+			GoalID: goal.ID, UserID: user.ID, Type: goal.Type, Frequency: goal.Frequency, Value: goal.Value,
+			EffectiveFrom: now.AddDate(0, 0, -31),
+		})
+		if err != nil {
+			return domain.User{}, err
+		}
+	}
+
+	list, err := NewListRepository(store).CreateList(ctx, domain.List{UserID: user.ID, Title: "Favourites"})
+	if err != nil {
+		return domain.User{}, err
+	}
+	for _, book := range books[:2] {
+		_, err := NewListItemRepository(store).CreateListItem(ctx, domain.ListItem{ListID: list.ID, BookID: book.ID})
+		if err != nil {
+			return domain.User{}, err
+		}
+	}
+
+	_, err = NewNoteRepository(store).CreateNote(ctx, domain.Note{
+		UserID:     user.ID,
+		BookID:     books[0].ID,
+		PageNumber: 1,
+		Content:    "In a hole in the ground there lived a hobbit.",
+	})
+	if err != nil {
+		return domain.User{}, err
+	}
+
+	return user, nil
+}
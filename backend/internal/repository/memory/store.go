@@ -0,0 +1,179 @@
+package memory
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/rimvydascivilis/book-tracker/backend/domain"
+)
+
+var errForeignKey = errors.New("foreign key constraint fails")
+
+// Store holds every table in memory. Repositories created from the same Store
+// share data, so foreign keys and ON DELETE CASCADE behave like the SQL schema.
+type Store struct {
+	mu sync.RWMutex
+
+	lastIDs map[string]int64
+	users   map[int64]domain.User
+	books   map[int64]domain.Book
+	authors map[int64]domain.Author
+	// bookAuthors holds the ordered author ids of each book.
+	bookAuthors map[int64][]int64
+	goals       map[int64]domain.Goal
+	readings    map[int64]domain.Reading
+	progress    map[int64]domain.Progress
+	lists       map[int64]domain.List
+	listItems   map[int64]domain.ListItem
+	notes       map[int64]domain.Note
+	importJobs  map[int64]domain.ImportJob
+	importRows  map[int64]domain.ImportRow
+	// goalVersions is the goal_version table.
+	goalVersions map[int64]domain.GoalVersion
+	// readingSessions is the reading_session table.
+	readingSessions map[int64]domain.ReadingSession
+	// authSessions and refreshTokens are the auth_session and refresh_token
+	// tables.
+	authSessions  map[int64]domain.AuthSession
+	refreshTokens map[int64]domain.RefreshToken
+	accessTokens  map[int64]domain.AccessToken
+	// localAccounts are keyed by user id.
+	localAccounts map[int64]domain.LocalAccount
+	accountTokens map[int64]domain.AccountToken
+}
+
+func NewStore() *Store {
+	return &Store{
+		lastIDs:     map[string]int64{},
+		users:       map[int64]domain.User{},
+		books:       map[int64]domain.Book{},
+		authors:     map[int64]domain.Author{},
+		bookAuthors: map[int64][]int64{},
+		goals:       map[int64]domain.Goal{},
+		readings:    map[int64]domain.Reading{},
+		progress:    map[int64]domain.Progress{},
+		lists:       map[int64]domain.List{},
+		listItems:   map[int64]domain.ListItem{},
+		notes:       map[int64]domain.Note{},
+		importJobs:  map[int64]domain.ImportJob{},
+		importRows:  map[int64]domain.ImportRow{},
+
+		goalVersions:    map[int64]domain.GoalVersion{},
+		readingSessions: map[int64]domain.ReadingSession{},
+		authSessions:    map[int64]domain.AuthSession{},
+		refreshTokens:   map[int64]domain.RefreshToken{},
+		accessTokens:    map[int64]domain.AccessToken{},
+		localAccounts:   map[int64]domain.LocalAccount{},
+		accountTokens:   map[int64]domain.AccountToken{},
+	}
+}
+
+// lock takes the write lock and returns the func that releases it. Calls made
+// inside a transaction already hold the lock, so nothing is taken for them.
+func (s *Store) lock(ctx context.Context) func() {
+	if s.inTx(ctx) {
+		return func() {}
+	}
+	s.mu.Lock()
+	return s.mu.Unlock
+}
+
+func (s *Store) rlock(ctx context.Context) func() {
+	if s.inTx(ctx) {
+		return func() {}
+	}
+	s.mu.RLock()
+	return s.mu.RUnlock
+}
+
+// id returns the next AUTO_INCREMENT value for table.
+func (s *Store) id(table string) int64 {
+	s.lastIDs[table]++
+	return s.lastIDs[table]
+}
+
+func (s *Store) requireUser(id int64) error {
+	if _, ok := s.users[id]; !ok {
+		return fmt.Errorf("%w: user %d", errForeignKey, id)
+	}
+	return nil
+}
+
+func (s *Store) requireBook(id int64) error {
+	if _, ok := s.books[id]; !ok {
+		return fmt.Errorf("%w: book %d", errForeignKey, id)
+	}
+	return nil
+}
+
+func (s *Store) deleteBook(id int64) {
+	delete(s.books, id)
+	delete(s.bookAuthors, id)
+	for readingID, r := range s.readings {
+		if r.BookID == id {
+			s.deleteReading(readingID)
+		}
+	}
+	for itemID, item := range s.listItems {
+		if item.BookID == id {
+			delete(s.listItems, itemID)
+		}
+	}
+	for noteID, n := range s.notes {
+		if n.BookID == id {
+			delete(s.notes, noteID)
+		}
+	}
+	for rowID, row := range s.importRows {
+		if row.BookID == id {
+			row.BookID = 0
+			s.importRows[rowID] = row
+		}
+	}
+}
+
+func (s *Store) deleteReading(id int64) {
+	delete(s.readings, id)
+	for progressID, p := range s.progress {
+		if p.ReadingID == id {
+			s.deleteProgress(progressID)
+		}
+	}
+	for sessionID, session := range s.readingSessions {
+		if session.ReadingID == id {
+			delete(s.readingSessions, sessionID)
+		}
+	}
+}
+
+func (s *Store) deleteProgress(id int64) {
+	delete(s.progress, id)
+	for sessionID, session := range s.readingSessions {
+		if session.ProgressID != nil && *session.ProgressID == id {
+			session.ProgressID = nil
+			s.readingSessions[sessionID] = session
+		}
+	}
+}
+
+// sortedIDs returns the keys of m in ascending order, mirroring the insertion
+// order MariaDB returns rows in when no ORDER BY is given.
+func sortedIDs[T any](m map[int64]T) []int64 {
+	ids := make([]int64, 0, len(m))
+	for id := range m {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	return ids
+}
+
+func paginate[T any](items []T, offset, limit int64) []T {
+	if offset >= int64(len(items)) {
+		return items[:0]
+	}
+	end := min(offset+limit, int64(len(items)))
+	return items[offset:end]
+}
@@ -0,0 +1,104 @@
+package memory
+
+import (
+	"context"
+	"maps"
+)
+
+type txKey struct{}
+
+func (s *Store) inTx(ctx context.Context) bool {
+	store, ok := ctx.Value(txKey{}).(*Store)
+	return ok && store == s
+}
+
+type TxManager struct {
+	store *Store
+}
+
+func NewTxManager(store *Store) *TxManager {
+	return &TxManager{
+		store: store,
+	}
+}
+
+// WithinTransaction holds the store's write lock while fn runs and restores
+// every table when fn fails, so transactions are fully serialized.
+func (m *TxManager) WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	if m.store.inTx(ctx) {
+		return fn(ctx)
+	}
+
+	m.store.mu.Lock()
+	defer m.store.mu.Unlock()
+
+	snapshot := m.store.clone()
+	if err := fn(context.WithValue(ctx, txKey{}, m.store)); err != nil {
+		m.store.restore(snapshot)
+		return err
+	}
+
+	return nil
+}
+
+// WithinReadOnlyTransaction holds the store's read lock while fn runs, so fn
+// sees no writes made meanwhile. fn must not write.
+func (m *TxManager) WithinReadOnlyTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	if m.store.inTx(ctx) {
+		return fn(ctx)
+	}
+
+	m.store.mu.RLock()
+	defer m.store.mu.RUnlock()
+
+	return fn(context.WithValue(ctx, txKey{}, m.store))
+}
+
+func (s *Store) clone() *Store {
+	return &Store{
+		lastIDs:     maps.Clone(s.lastIDs),
+		users:       maps.Clone(s.users),
+		books:       maps.Clone(s.books),
+		authors:     maps.Clone(s.authors),
+		bookAuthors: maps.Clone(s.bookAuthors),
+		goals:       maps.Clone(s.goals),
+		readings:    maps.Clone(s.readings),
+		progress:    maps.Clone(s.progress),
+		lists:       maps.Clone(s.lists),
+		listItems:   maps.Clone(s.listItems),
+		notes:       maps.Clone(s.notes),
+		importJobs:  maps.Clone(s.importJobs),
+		importRows:  maps.Clone(s.importRows),
+
+		goalVersions:    maps.Clone(s.goalVersions),
+		readingSessions: maps.Clone(s.readingSessions),
+		authSessions:    maps.Clone(s.authSessions),
+		refreshTokens:   maps.Clone(s.refreshTokens),
+		accessTokens:    maps.Clone(s.accessTokens),
+		localAccounts:   maps.Clone(s.localAccounts),
+		accountTokens:   maps.Clone(s.accountTokens),
+	}
+}
+
+func (s *Store) restore(from *Store) {
+	s.lastIDs = from.lastIDs
+	s.users = from.users
+	s.books = from.books
+	s.authors = from.authors
+	s.bookAuthors = from.bookAuthors
+	s.goals = from.goals
+	s.readings = from.readings
+	s.progress = from.progress
+	s.lists = from.lists
+	s.listItems = from.listItems
+	s.notes = from.notes
+	s.importJobs = from.importJobs
+	s.importRows = from.importRows
+	s.goalVersions = from.goalVersions
+	s.readingSessions = from.readingSessions
+	s.authSessions = from.authSessions
+	s.refreshTokens = from.refreshTokens
+	s.accessTokens = from.accessTokens
+	s.localAccounts = from.localAccounts
+	s.accountTokens = from.accountTokens
+}
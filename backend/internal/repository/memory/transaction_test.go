@@ -0,0 +1,113 @@
+package memory_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/rimvydascivilis/book-tracker/backend/domain"
+	"github.com/rimvydascivilis/book-tracker/backend/internal/repository/memory"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTxManager_RollsBackOnError(t *testing.T) {
+	store := memory.NewStore()
+	user, book := setupUserAndBook(t, store)
+	bookRepo := memory.NewBookRepository(store)
+	fnErr := errors.New("boom")
+
+	err := memory.NewTxManager(store).WithinTransaction(context.Background(), func(ctx context.Context) error {
+		if _, err := bookRepo.CreateBook(ctx, domain.Book{UserID: user.ID, Title: "Emma"}); err != nil {
+			return err
+		}
+		if err := bookRepo.DeleteBook(ctx, user.ID, book.ID); err != nil {
+			return err
+		}
+		return fnErr
+	})
+
+	assert.ErrorIs(t, err, fnErr)
+	count, err := bookRepo.CountBooksByUser(context.Background(), user.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), count)
+	_, err = bookRepo.GetBookByUserID(context.Background(), user.ID, book.ID)
+	assert.NoError(t, err)
+}
+
+func TestTxManager_RollsBackGoalVersions(t *testing.T) {
+	store := memory.NewStore()
+	user, _ := setupUserAndBook(t, store)
+	goalRepo := memory.NewGoalRepository(store)
+	fnErr := errors.New("boom")
+
+	err := memory.NewTxManager(store).WithinTransaction(context.Background(), func(ctx context.Context) error {
+		goal, err := goalRepo.CreateGoal(ctx, domain.Goal{UserID: user.ID, Type: domain.GoalTypeBooks, Frequency: domain.GoalFrequencyYearly, Value: 12})
+		if err != nil {
+			return err
+		}
+		_, err = goalRepo.CreateGoalVersion(ctx, domain.GoalVersion{
+			GoalID: goal.ID, UserID: user.ID, Type: goal.Type, Frequency: goal.Frequency, Value: goal.Value, EffectiveFrom: time.Now(),
+		})
+		if err != nil {
+			return err
+		}
+		return fnErr
+	})
+
+	assert.ErrorIs(t, err, fnErr)
+	versions, err := goalRepo.GetGoalVersionsByUserID(context.Background(), user.ID)
+	assert.NoError(t, err)
+	assert.Empty(t, versions)
+}
+
+func TestTxManager_Commits(t *testing.T) {
+	store := memory.NewStore()
+	user, _ := setupUserAndBook(t, store)
+	bookRepo := memory.NewBookRepository(store)
+	txManager := memory.NewTxManager(store)
+
+	err := txManager.WithinTransaction(context.Background(), func(ctx context.Context) error {
+		return txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+			_, err := bookRepo.CreateBook(ctx, domain.Book{UserID: user.ID, Title: "Emma"})
+			return err
+		})
+	})
+
+	assert.NoError(t, err)
+	count, err := bookRepo.CountBooksByUser(context.Background(), user.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), count)
+}
+
+func TestTxManager_ReadOnlySeesOneSnapshot(t *testing.T) {
+	store := memory.NewStore()
+	user, _ := setupUserAndBook(t, store)
+	bookRepo := memory.NewBookRepository(store)
+	txManager := memory.NewTxManager(store)
+	before := int64(1)
+
+	written := make(chan error, 1)
+	err := txManager.WithinReadOnlyTransaction(context.Background(), func(ctx context.Context) error {
+		count, err := bookRepo.CountBooksByUser(ctx, user.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, before, count)
+
+		go func() {
+			_, err := bookRepo.CreateBook(context.Background(), domain.Book{UserID: user.ID, Title: "Emma"})
+			written <- err
+		}()
+		time.Sleep(50 * time.Millisecond)
+
+		count, err = bookRepo.CountBooksByUser(ctx, user.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, before, count, "the book is written once the transaction ends")
+		return nil
+	})
+
+	assert.NoError(t, err)
+	assert.NoError(t, <-written)
+	count, err := bookRepo.CountBooksByUser(context.Background(), user.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, before+1, count)
+}
@@ -0,0 +1,67 @@
+package memory
+
+import (
+	"context"
+	"time"
+
+	"github.com/rimvydascivilis/book-tracker/backend/domain"
+)
+
+type UserRepository struct {
+	store *Store
+}
+
+func NewUserRepository(store *Store) *UserRepository {
+	return &UserRepository{
+		store: store,
+	}
+}
+
+func (r *UserRepository) GetByID(ctx context.Context, id int64) (domain.User, error) {
+	defer r.store.rlock(ctx)()
+
+	u, ok := r.store.users[id]
+	if !ok {
+		return domain.User{}, domain.ErrRecordNotFound
+	}
+	return u, nil
+}
+
+func (r *UserRepository) GetByEmail(ctx context.Context, email string) (domain.User, error) {
+	defer r.store.rlock(ctx)()
+
+	for _, id := range sortedIDs(r.store.users) {
+		if u := r.store.users[id]; u.Email == email {
+			return u, nil
+		}
+	}
+	return domain.User{}, domain.ErrRecordNotFound
+}
+
+func (r *UserRepository) CreateUser(ctx context.Context, u domain.User) (domain.User, error) {
+	defer r.store.lock(ctx)()
+
+	u.ID = r.store.id("user")
+	if u.Timezone == "" {
+		u.Timezone = "UTC"
+	}
+	u.CreatedAt = time.Now()
+	r.store.users[u.ID] = u
+	return u, nil
+}
+
+func (r *UserRepository) UpdateUser(ctx context.Context, u domain.User) (domain.User, error) {
+	defer r.store.lock(ctx)()
+
+	stored, ok := r.store.users[u.ID]
+	if !ok {
+		return domain.User{}, domain.ErrRecordNotFound
+	}
+	stored.Timezone = u.Timezone
+	if stored.Timezone == "" {
+		stored.Timezone = "UTC"
+	}
+	stored.RestDays = u.RestDays
+	r.store.users[u.ID] = stored
+	return stored, nil
+}
@@ -0,0 +1,56 @@
+package sqlite_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/rimvydascivilis/book-tracker/backend/domain"
+	"github.com/rimvydascivilis/book-tracker/backend/internal/repository/sqlite"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAccessTokenRepository(t *testing.T) {
+	db := setupDB(t)
+	ctx := context.Background()
+	user := createUser(t, db, "test@example.com")
+	repo := sqlite.NewAccessTokenRepository(db)
+	now := time.Date(2024, 3, 1, 20, 0, 0, 0, time.UTC)
+	expiresAt := now.AddDate(0, 1, 0)
+
+	kobo, err := repo.CreateAccessToken(ctx, domain.AccessToken{
+		UserID: user.ID, Name: "Kobo sync", Scope: domain.AccessTokenScopeProgressWrite, TokenHash: "abc",
+		CreatedAt: now, ExpiresAt: &expiresAt,
+	})
+	require.NoError(t, err)
+	_, err = repo.CreateAccessToken(ctx, domain.AccessToken{
+		UserID: user.ID, Name: "Backup", Scope: domain.AccessTokenScopeReadOnly, TokenHash: "def", CreatedAt: now,
+	})
+	require.NoError(t, err)
+	_, err = repo.CreateAccessToken(ctx, domain.AccessToken{
+		UserID: user.ID, Name: "Admin", Scope: "admin", TokenHash: "ghi", CreatedAt: now,
+	})
+	assert.Error(t, err, "unknown scopes are refused")
+
+	require.NoError(t, repo.UpdateAccessTokenLastUsed(ctx, kobo.ID, now.Add(time.Hour)))
+	stored, err := repo.GetAccessTokenByHash(ctx, "abc")
+	require.NoError(t, err)
+	assert.Equal(t, kobo.ID, stored.ID)
+	assert.Equal(t, "Kobo sync", stored.Name)
+	require.NotNil(t, stored.ExpiresAt)
+	assert.Equal(t, expiresAt, *stored.ExpiresAt)
+	require.NotNil(t, stored.LastUsedAt)
+	assert.Equal(t, now.Add(time.Hour), *stored.LastUsedAt)
+
+	tokens, err := repo.GetAccessTokensByUserID(ctx, user.ID)
+	require.NoError(t, err)
+	require.Len(t, tokens, 2)
+	assert.Equal(t, kobo.ID, tokens[0].ID)
+	assert.Nil(t, tokens[1].ExpiresAt)
+
+	require.NoError(t, repo.DeleteAccessToken(ctx, kobo.ID))
+	_, err = repo.GetAccessTokenByID(ctx, kobo.ID)
+	assert.ErrorIs(t, err, domain.ErrRecordNotFound)
+	assert.ErrorIs(t, repo.DeleteAccessToken(ctx, kobo.ID), domain.ErrRecordNotFound)
+}
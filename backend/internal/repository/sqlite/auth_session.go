@@ -0,0 +1,176 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/rimvydascivilis/book-tracker/backend/domain"
+)
+
+type AuthSessionRepository struct {
+	DB *sql.DB
+}
+
+func NewAuthSessionRepository(db *sql.DB) *AuthSessionRepository {
+	return &AuthSessionRepository{
+		DB: db,
+	}
+}
+
+const authSessionColumns = `id, user_id, user_agent, created_at, last_used_at, expires_at, revoked_at`
+
+func scanAuthSession(row scanner) (domain.AuthSession, error) {
+	var (
+		session   domain.AuthSession
+		revokedAt sql.NullTime
+	)
+	err := row.Scan(&session.ID, &session.UserID, &session.UserAgent, &session.CreatedAt, &session.LastUsedAt,
+		&session.ExpiresAt, &revokedAt)
+	if err != nil {
+		return domain.AuthSession{}, err
+	}
+
+	if revokedAt.Valid {
+		t := revokedAt.Time.UTC()
+		session.RevokedAt = &t
+	}
+	session.CreatedAt = session.CreatedAt.UTC()
+	session.LastUsedAt = session.LastUsedAt.UTC()
+	session.ExpiresAt = session.ExpiresAt.UTC()
+	return session, nil
+}
+
+func (r *AuthSessionRepository) CreateSession(ctx context.Context, session domain.AuthSession) (domain.AuthSession, error) {
+	query := `
+INSERT INTO auth_session (user_id, user_agent, created_at, last_used_at, expires_at)
+VALUES (?, ?, ?, ?, ?)`
+	res, err := conn(ctx, r.DB).ExecContext(ctx, query, session.UserID, session.UserAgent, session.CreatedAt.UTC(),
+		session.LastUsedAt.UTC(), session.ExpiresAt.UTC())
+	if err != nil {
+		return domain.AuthSession{}, err
+	}
+
+	session.ID, err = res.LastInsertId()
+	if err != nil {
+		return domain.AuthSession{}, err
+	}
+	session.RevokedAt = nil
+	return session, nil
+}
+
+func (r *AuthSessionRepository) GetSessionByID(ctx context.Context, id int64) (domain.AuthSession, error) {
+	query := `SELECT ` + authSessionColumns + ` FROM auth_session WHERE id = ?`
+
+	session, err := scanAuthSession(conn(ctx, r.DB).QueryRowContext(ctx, query, id))
+	if err == sql.ErrNoRows {
+		return domain.AuthSession{}, fmt.Errorf("%w: %s", domain.ErrRecordNotFound, "session")
+	}
+	if err != nil {
+		return domain.AuthSession{}, err
+	}
+	return session, nil
+}
+
+func (r *AuthSessionRepository) GetActiveSessionsByUserID(ctx context.Context, userID int64, t time.Time) ([]domain.AuthSession, error) {
+	query := `SELECT ` + authSessionColumns + `
+FROM auth_session WHERE user_id = ? AND revoked_at IS NULL AND expires_at > ?
+ORDER BY last_used_at DESC, id DESC`
+	rows, err := conn(ctx, r.DB).QueryContext(ctx, query, userID, t.UTC())
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	sessions := []domain.AuthSession{}
+	for rows.Next() {
+		session, err := scanAuthSession(rows)
+		if err != nil {
+			return nil, err
+		}
+		sessions = append(sessions, session)
+	}
+	return sessions, rows.Err()
+}
+
+func (r *AuthSessionRepository) UpdateSession(ctx context.Context, session domain.AuthSession) (domain.AuthSession, error) {
+	var revokedAt interface{}
+	if session.RevokedAt != nil {
+		revokedAt = session.RevokedAt.UTC()
+	}
+
+	query := `UPDATE auth_session SET last_used_at = ?, expires_at = ?, revoked_at = ? WHERE id = ?`
+	res, err := conn(ctx, r.DB).ExecContext(ctx, query, session.LastUsedAt.UTC(), session.ExpiresAt.UTC(), revokedAt,
+		session.ID)
+	if err != nil {
+		return domain.AuthSession{}, err
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return domain.AuthSession{}, err
+	}
+	if affected == 0 {
+		return domain.AuthSession{}, fmt.Errorf("%w: %s", domain.ErrRecordNotFound, "session")
+	}
+	return session, nil
+}
+
+func (r *AuthSessionRepository) CreateRefreshToken(ctx context.Context, token domain.RefreshToken) (domain.RefreshToken, error) {
+	query := `INSERT INTO refresh_token (session_id, token_hash, expires_at) VALUES (?, ?, ?)`
+	res, err := conn(ctx, r.DB).ExecContext(ctx, query, token.SessionID, token.TokenHash, token.ExpiresAt.UTC())
+	if err != nil {
+		return domain.RefreshToken{}, err
+	}
+
+	token.ID, err = res.LastInsertId()
+	if err != nil {
+		return domain.RefreshToken{}, err
+	}
+	token.UsedAt = nil
+	return token, nil
+}
+
+// GetRefreshTokenByHashForUpdate needs no row lock: transactions hold the
+// only connection, so they cannot interleave.
+func (r *AuthSessionRepository) GetRefreshTokenByHashForUpdate(ctx context.Context, hash string) (domain.RefreshToken, error) {
+	query := `SELECT id, session_id, token_hash, expires_at, used_at FROM refresh_token WHERE token_hash = ?`
+
+	var (
+		token  domain.RefreshToken
+		usedAt sql.NullTime
+	)
+	err := conn(ctx, r.DB).QueryRowContext(ctx, query, hash).
+		Scan(&token.ID, &token.SessionID, &token.TokenHash, &token.ExpiresAt, &usedAt)
+	if err == sql.ErrNoRows {
+		return domain.RefreshToken{}, fmt.Errorf("%w: %s", domain.ErrRecordNotFound, "refresh token")
+	}
+	if err != nil {
+		return domain.RefreshToken{}, err
+	}
+
+	if usedAt.Valid {
+		t := usedAt.Time.UTC()
+		token.UsedAt = &t
+	}
+	token.ExpiresAt = token.ExpiresAt.UTC()
+	return token, nil
+}
+
+func (r *AuthSessionRepository) MarkRefreshTokenUsed(ctx context.Context, id int64, t time.Time) error {
+	query := `UPDATE refresh_token SET used_at = ? WHERE id = ?`
+	res, err := conn(ctx, r.DB).ExecContext(ctx, query, t.UTC(), id)
+	if err != nil {
+		return err
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return fmt.Errorf("%w: %s", domain.ErrRecordNotFound, "refresh token")
+	}
+	return nil
+}
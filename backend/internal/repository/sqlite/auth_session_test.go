@@ -0,0 +1,75 @@
+package sqlite_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/rimvydascivilis/book-tracker/backend/domain"
+	"github.com/rimvydascivilis/book-tracker/backend/internal/repository/sqlite"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuthSessionRepository(t *testing.T) {
+	db := setupDB(t)
+	ctx := context.Background()
+	user := createUser(t, db, "test@example.com")
+	repo := sqlite.NewAuthSessionRepository(db)
+	now := time.Date(2024, 3, 1, 20, 0, 0, 0, time.UTC)
+
+	laptop, err := repo.CreateSession(ctx, domain.AuthSession{
+		UserID: user.ID, UserAgent: "Firefox", CreatedAt: now, LastUsedAt: now, ExpiresAt: now.Add(time.Hour),
+	})
+	require.NoError(t, err)
+	phone, err := repo.CreateSession(ctx, domain.AuthSession{
+		UserID: user.ID, UserAgent: "Safari", CreatedAt: now, LastUsedAt: now.Add(time.Minute), ExpiresAt: now.Add(time.Hour),
+	})
+	require.NoError(t, err)
+
+	token, err := repo.CreateRefreshToken(ctx, domain.RefreshToken{
+		SessionID: laptop.ID, TokenHash: "abc", ExpiresAt: now.Add(time.Hour),
+	})
+	require.NoError(t, err)
+	_, err = repo.CreateRefreshToken(ctx, domain.RefreshToken{
+		SessionID: phone.ID, TokenHash: "abc", ExpiresAt: now.Add(time.Hour),
+	})
+	assert.Error(t, err, "token hashes are unique")
+
+	require.NoError(t, repo.MarkRefreshTokenUsed(ctx, token.ID, now))
+	stored, err := repo.GetRefreshTokenByHashForUpdate(ctx, "abc")
+	require.NoError(t, err)
+	assert.Equal(t, laptop.ID, stored.SessionID)
+	require.NotNil(t, stored.UsedAt)
+	assert.Equal(t, now, *stored.UsedAt)
+	_, err = repo.GetRefreshTokenByHashForUpdate(ctx, "def")
+	assert.ErrorIs(t, err, domain.ErrRecordNotFound)
+
+	sessions, err := repo.GetActiveSessionsByUserID(ctx, user.ID, now)
+	require.NoError(t, err)
+	require.Len(t, sessions, 2)
+	assert.Equal(t, phone.ID, sessions[0].ID, "most recently used first")
+	assert.Equal(t, "Firefox", sessions[1].UserAgent)
+
+	phone.RevokedAt = &now
+	_, err = repo.UpdateSession(ctx, phone)
+	require.NoError(t, err)
+	laptop.LastUsedAt = now.Add(2 * time.Hour)
+	laptop.ExpiresAt = now.Add(3 * time.Hour)
+	_, err = repo.UpdateSession(ctx, laptop)
+	require.NoError(t, err)
+
+	sessions, err = repo.GetActiveSessionsByUserID(ctx, user.ID, now.Add(2*time.Hour))
+	require.NoError(t, err)
+	require.Len(t, sessions, 1)
+	assert.Equal(t, laptop.ID, sessions[0].ID)
+	assert.Equal(t, laptop.ExpiresAt, sessions[0].ExpiresAt)
+	revoked, err := repo.GetSessionByID(ctx, phone.ID)
+	require.NoError(t, err)
+	require.NotNil(t, revoked.RevokedAt)
+	assert.Equal(t, now, *revoked.RevokedAt)
+
+	sessions, err = repo.GetActiveSessionsByUserID(ctx, user.ID, now.Add(3*time.Hour))
+	require.NoError(t, err)
+	assert.Empty(t, sessions, "expired sessions are not active")
+}
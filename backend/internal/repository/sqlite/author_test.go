@@ -0,0 +1,148 @@
+package sqlite_test
+
+import (
+	"context"
+	"io/fs"
+	"testing"
+
+	"github.com/rimvydascivilis/book-tracker/backend/domain"
+	"github.com/rimvydascivilis/book-tracker/backend/internal/migration"
+	"github.com/rimvydascivilis/book-tracker/backend/internal/repository/sqlite"
+	"github.com/rimvydascivilis/book-tracker/backend/migrations"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuthorRepository_GetOrCreateAuthors_ReusesExisting(t *testing.T) {
+	db := setupDB(t)
+	repo := sqlite.NewAuthorRepository(db)
+	ctx := context.Background()
+
+	first, err := repo.GetOrCreateAuthors(ctx, []string{"Terry Pratchett"})
+	require.NoError(t, err)
+
+	authors, err := repo.GetOrCreateAuthors(ctx, []string{"Neil Gaiman", "Terry Pratchett"})
+
+	assert.NoError(t, err)
+	assert.Len(t, authors, 2)
+	assert.Equal(t, "Neil Gaiman", authors[0].Name)
+	assert.Equal(t, first[0], authors[1])
+}
+
+func TestAuthorRepository_BookAuthors(t *testing.T) {
+	db := setupDB(t)
+	repo := sqlite.NewAuthorRepository(db)
+	ctx := context.Background()
+	user := createUser(t, db, "test@example.com")
+	omens := createBook(t, db, user.ID, "Good Omens")
+	dune := createBook(t, db, user.ID, "Dune")
+
+	authors, err := repo.GetOrCreateAuthors(ctx, []string{"Terry Pratchett", "Neil Gaiman"})
+	require.NoError(t, err)
+	require.NoError(t, repo.SetBookAuthors(ctx, omens.ID, []int64{authors[1].ID, authors[0].ID}))
+
+	byBook, err := repo.GetAuthorsByBookIDs(ctx, []int64{omens.ID, dune.ID})
+	assert.NoError(t, err)
+	assert.Equal(t, map[int64][]domain.Author{omens.ID: {authors[1], authors[0]}}, byBook)
+
+	found, err := sqlite.NewBookRepository(db).SearchBooks(ctx, user.ID, "gaiman", 10)
+	assert.NoError(t, err)
+	assert.Len(t, found, 1)
+	assert.Equal(t, omens.ID, found[0].ID)
+
+	require.NoError(t, repo.SetBookAuthors(ctx, omens.ID, []int64{authors[0].ID}))
+	byBook, err = repo.GetAuthorsByBookIDs(ctx, []int64{omens.ID})
+	assert.NoError(t, err)
+	assert.Equal(t, []domain.Author{authors[0]}, byBook[omens.ID])
+}
+
+func TestBookRepository_Metadata(t *testing.T) {
+	db := setupDB(t)
+	repo := sqlite.NewBookRepository(db)
+	ctx := context.Background()
+	user := createUser(t, db, "test@example.com")
+
+	book, err := repo.CreateBook(ctx, domain.Book{
+		UserID:          user.ID,
+		Title:           "Dune: The Graphic Novel, Book 1 — a much longer title than fifty characters",
+		ISBN10:          "0441013597",
+		ISBN13:          "9780441013593",
+		Publisher:       "Ace",
+		PublicationYear: 1990,
+		Language:        "en",
+		Description:     "Desert planet.",
+		PageCount:       412,
+	})
+	require.NoError(t, err)
+
+	stored, err := repo.GetBookByUserID(ctx, user.ID, book.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, book.Title, stored.Title)
+	assert.Equal(t, "Ace", stored.Publisher)
+	assert.Equal(t, int64(1990), stored.PublicationYear)
+	assert.Equal(t, int64(412), stored.PageCount)
+
+	found, err := repo.SearchBooks(ctx, user.ID, "0-441-01359-7", 10)
+	assert.NoError(t, err)
+	assert.Len(t, found, 1)
+}
+
+func TestAuthorRepository_IgnoresCaseAcrossBooks(t *testing.T) {
+	db := setupDB(t)
+	repo := sqlite.NewAuthorRepository(db)
+	ctx := context.Background()
+	user := createUser(t, db, "test@example.com")
+	hobbit := createBook(t, db, user.ID, "The Hobbit")
+	silmarillion := createBook(t, db, user.ID, "The Silmarillion")
+
+	first, err := repo.GetOrCreateAuthors(ctx, []string{"J. R. R. Tolkien"})
+	require.NoError(t, err)
+	require.NoError(t, repo.SetBookAuthors(ctx, hobbit.ID, []int64{first[0].ID}))
+	second, err := repo.GetOrCreateAuthors(ctx, []string{"j. r. r. TOLKIEN"})
+	require.NoError(t, err)
+	require.NoError(t, repo.SetBookAuthors(ctx, silmarillion.ID, []int64{second[0].ID}))
+
+	assert.Equal(t, first, second)
+	byBook, err := repo.GetAuthorsByBookIDs(ctx, []int64{hobbit.ID, silmarillion.ID})
+	assert.NoError(t, err)
+	assert.Equal(t, map[int64][]domain.Author{
+		hobbit.ID:       {{ID: first[0].ID, Name: "J. R. R. Tolkien"}},
+		silmarillion.ID: {{ID: first[0].ID, Name: "J. R. R. Tolkien"}},
+	}, byBook)
+}
+
+func TestAuthorNocaseMigration(t *testing.T) {
+	db := setupDB(t)
+	ctx := context.Background()
+	user := createUser(t, db, "test@example.com")
+	hobbit := createBook(t, db, user.ID, "The Hobbit")
+	letters := createBook(t, db, user.ID, "Letters")
+
+	migrationsFS, err := fs.Sub(migrations.SQLite, "sqlite")
+	require.NoError(t, err)
+	migrator, err := migration.NewMigrator(db, migrationsFS)
+	require.NoError(t, err)
+	_, _, err = migrator.Down(ctx)
+	require.NoError(t, err)
+
+	// Letters lists both spellings, the oldest second.
+	_, err = db.Exec(`INSERT INTO author (id, name) VALUES (1, 'J. R. R. Tolkien'), (2, 'j. r. r. tolkien'), (3, 'Christopher Tolkien')`)
+	require.NoError(t, err)
+	_, err = db.Exec(`INSERT INTO book_author (book_id, author_id, position) VALUES (?, 2, 0), (?, 2, 0), (?, 3, 1), (?, 1, 2)`,
+		hobbit.ID, letters.ID, letters.ID, letters.ID)
+	require.NoError(t, err)
+	_, err = migrator.Up(ctx)
+	require.NoError(t, err)
+
+	byBook, err := sqlite.NewAuthorRepository(db).GetAuthorsByBookIDs(ctx, []int64{hobbit.ID, letters.ID})
+	require.NoError(t, err)
+	tolkien := domain.Author{ID: 1, Name: "J. R. R. Tolkien"}
+	assert.Equal(t, map[int64][]domain.Author{
+		hobbit.ID:  {tolkien},
+		letters.ID: {tolkien, {ID: 3, Name: "Christopher Tolkien"}},
+	}, byBook)
+
+	authors, err := sqlite.NewAuthorRepository(db).GetOrCreateAuthors(ctx, []string{"J. R. R. TOLKIEN"})
+	require.NoError(t, err)
+	assert.Equal(t, []domain.Author{tolkien}, authors)
+}
@@ -0,0 +1,150 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/rimvydascivilis/book-tracker/backend/domain"
+	"github.com/rimvydascivilis/book-tracker/backend/utils"
+)
+
+const bookColumns = `id, user_id, title, isbn10, isbn13, publisher, publication_year, language, description, page_count, COALESCE(rating, 0), created_at`
+
+type BookRepository struct {
+	DB *sql.DB
+}
+
+func NewBookRepository(db *sql.DB) *BookRepository {
+	return &BookRepository{
+		DB: db,
+	}
+}
+
+type scanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func scanBook(row scanner) (domain.Book, error) {
+	b := domain.Book{}
+	err := row.Scan(&b.ID, &b.UserID, &b.Title, &b.ISBN10, &b.ISBN13, &b.Publisher, &b.PublicationYear,
+		&b.Language, &b.Description, &b.PageCount, &b.Rating, &b.CreatedAt)
+	return b, err
+}
+
+func (r *BookRepository) getOne(ctx context.Context, query string, args ...interface{}) (domain.Book, error) {
+	b, err := scanBook(conn(ctx, r.DB).QueryRowContext(ctx, query, args...))
+	if err == sql.ErrNoRows {
+		return domain.Book{}, fmt.Errorf("%w: %s", domain.ErrRecordNotFound, "book")
+	}
+	if err != nil {
+		return domain.Book{}, err
+	}
+
+	return b, nil
+}
+
+func (r *BookRepository) getAll(ctx context.Context, query string, args ...interface{}) ([]domain.Book, error) {
+	rows, err := conn(ctx, r.DB).QueryContext(ctx, query, args...)
+	if err != nil {
+		return []domain.Book{}, err
+	}
+	defer rows.Close()
+
+	res := []domain.Book{}
+	for rows.Next() {
+		b, err := scanBook(rows)
+		if err != nil {
+			return []domain.Book{}, err
+		}
+		res = append(res, b)
+	}
+
+	return res, rows.Err()
+}
+
+func (r *BookRepository) GetBooksByUser(ctx context.Context, userID, offset, limit int64) ([]domain.Book, error) {
+	query := `SELECT ` + bookColumns + ` FROM book WHERE user_id = ? ORDER BY id LIMIT ? OFFSET ?`
+	return r.getAll(ctx, query, userID, limit, offset)
+}
+
+func (r *BookRepository) CountBooksByUser(ctx context.Context, userID int64) (int64, error) {
+	var count int64
+	query := `SELECT COUNT(*) FROM book WHERE user_id = ?`
+	err := conn(ctx, r.DB).QueryRowContext(ctx, query, userID).Scan(&count)
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+func (r *BookRepository) CreateBook(ctx context.Context, b domain.Book) (domain.Book, error) {
+	query := `
+INSERT INTO book (user_id, title, isbn10, isbn13, publisher, publication_year, language, description, page_count, rating, created_at)
+VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`
+	b.CreatedAt = time.Now()
+	res, err := conn(ctx, r.DB).ExecContext(ctx, query, b.UserID, b.Title, b.ISBN10, b.ISBN13, b.Publisher,
+		b.PublicationYear, b.Language, b.Description, b.PageCount, b.Rating, b.CreatedAt)
+	if err != nil {
+		return domain.Book{}, err
+	}
+
+	id, err := res.LastInsertId()
+	if err != nil {
+		return domain.Book{}, err
+	}
+
+	b.ID = id
+	return b, nil
+}
+
+func (r *BookRepository) GetBookByUserID(ctx context.Context, userID, bookID int64) (domain.Book, error) {
+	query := `SELECT ` + bookColumns + ` FROM book WHERE user_id = ? AND id = ?`
+	return r.getOne(ctx, query, userID, bookID)
+}
+
+// GetBookByUserIDForUpdate needs no row lock: transactions hold the only
+// connection, so they cannot interleave.
+func (r *BookRepository) GetBookByUserIDForUpdate(ctx context.Context, userID, bookID int64) (domain.Book, error) {
+	return r.GetBookByUserID(ctx, userID, bookID)
+}
+
+func (r *BookRepository) SearchBooks(ctx context.Context, userID int64, search string, limit int64) ([]domain.Book, error) {
+	query := `
+SELECT ` + bookColumns + ` FROM book
+WHERE user_id = ?
+	AND (
+		title LIKE ? OR isbn10 = ? OR isbn13 = ? OR
+		EXISTS (
+			SELECT 1 FROM book_author
+			JOIN author ON author.id = book_author.author_id
+			WHERE book_author.book_id = book.id AND author.name LIKE ?
+		)
+	)
+ORDER BY id
+LIMIT ?`
+	like := "%" + search + "%"
+	isbn := utils.NormalizeISBN(search)
+	return r.getAll(ctx, query, userID, like, isbn, isbn, like, limit)
+}
+
+func (r *BookRepository) UpdateBook(ctx context.Context, b domain.Book) (domain.Book, error) {
+	query := `
+UPDATE book
+SET title = ?, isbn10 = ?, isbn13 = ?, publisher = ?, publication_year = ?, language = ?, description = ?, page_count = ?, rating = ?
+WHERE user_id = ? AND id = ?`
+	_, err := conn(ctx, r.DB).ExecContext(ctx, query, b.Title, b.ISBN10, b.ISBN13, b.Publisher, b.PublicationYear,
+		b.Language, b.Description, b.PageCount, b.Rating, b.UserID, b.ID)
+	if err != nil {
+		return domain.Book{}, err
+	}
+
+	return b, nil
+}
+
+func (r *BookRepository) DeleteBook(ctx context.Context, userID, bookID int64) error {
+	query := `DELETE FROM book WHERE user_id = ? AND id = ?`
+	_, err := conn(ctx, r.DB).ExecContext(ctx, query, userID, bookID)
+	return err
+}
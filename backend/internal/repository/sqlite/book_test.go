@@ -0,0 +1,83 @@
+package sqlite_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/rimvydascivilis/book-tracker/backend/domain"
+	"github.com/rimvydascivilis/book-tracker/backend/internal/repository/sqlite"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBookRepository_CRUD(t *testing.T) {
+	db := setupDB(t)
+	repo := sqlite.NewBookRepository(db)
+	ctx := context.Background()
+	user := createUser(t, db, "test@example.com")
+
+	first := createBook(t, db, user.ID, "Dune")
+	createBook(t, db, user.ID, "Emma")
+
+	count, err := repo.CountBooksByUser(ctx, user.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), count)
+
+	books, err := repo.GetBooksByUser(ctx, user.ID, 1, 10)
+	assert.NoError(t, err)
+	assert.Len(t, books, 1)
+	assert.Equal(t, "Emma", books[0].Title)
+
+	found, err := repo.SearchBooks(ctx, user.ID, "un", 10)
+	assert.NoError(t, err)
+	assert.Len(t, found, 1)
+	assert.Equal(t, first.ID, found[0].ID)
+
+	first.Title = "Dune Messiah"
+	first.Rating = 3
+	_, err = repo.UpdateBook(ctx, first)
+	assert.NoError(t, err)
+
+	updated, err := repo.GetBookByUserID(ctx, user.ID, first.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, "Dune Messiah", updated.Title)
+	assert.Equal(t, float64(3), updated.Rating)
+
+	assert.NoError(t, repo.DeleteBook(ctx, user.ID, first.ID))
+	_, err = repo.GetBookByUserID(ctx, user.ID, first.ID)
+	assert.ErrorIs(t, err, domain.ErrRecordNotFound)
+}
+
+func TestBookRepository_GetBookByUserID_OtherUser(t *testing.T) {
+	db := setupDB(t)
+	repo := sqlite.NewBookRepository(db)
+	owner := createUser(t, db, "owner@example.com")
+	other := createUser(t, db, "other@example.com")
+	book := createBook(t, db, owner.ID, "Dune")
+
+	_, err := repo.GetBookByUserID(context.Background(), other.ID, book.ID)
+
+	assert.ErrorIs(t, err, domain.ErrRecordNotFound)
+}
+
+func TestBookRepository_DeleteBook_CascadesToNotesAndListItems(t *testing.T) {
+	db := setupDB(t)
+	ctx := context.Background()
+	user := createUser(t, db, "test@example.com")
+	book := createBook(t, db, user.ID, "Dune")
+
+	list, err := sqlite.NewListRepository(db).CreateList(ctx, domain.List{UserID: user.ID, Title: "Favourites"})
+	assert.NoError(t, err)
+	_, err = sqlite.NewListItemRepository(db).CreateListItem(ctx, domain.ListItem{ListID: list.ID, BookID: book.ID})
+	assert.NoError(t, err)
+	_, err = sqlite.NewNoteRepository(db).CreateNote(ctx, domain.Note{UserID: user.ID, BookID: book.ID, PageNumber: 1, Content: "x"})
+	assert.NoError(t, err)
+
+	assert.NoError(t, sqlite.NewBookRepository(db).DeleteBook(ctx, user.ID, book.ID))
+
+	items, err := sqlite.NewListItemRepository(db).GetListItemsByListID(ctx, list.ID)
+	assert.NoError(t, err)
+	assert.Empty(t, items)
+	notes, err := sqlite.NewNoteRepository(db).GetNotesByUserIDAndBookID(ctx, user.ID, book.ID)
+	assert.NoError(t, err)
+	assert.Empty(t, notes)
+}
@@ -0,0 +1,175 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/rimvydascivilis/book-tracker/backend/domain"
+	sqlite "modernc.org/sqlite"
+	sqlite3 "modernc.org/sqlite/lib"
+)
+
+type GoalRepository struct {
+	DB *sql.DB
+}
+
+func NewGoalRepository(db *sql.DB) *GoalRepository {
+	return &GoalRepository{
+		DB: db,
+	}
+}
+
+const goalColumns = `id, user_id, type, frequency, value`
+
+func scanGoal(row scanner) (domain.Goal, error) {
+	var goal domain.Goal
+	err := row.Scan(&goal.ID, &goal.UserID, &goal.Type, &goal.Frequency, &goal.Value)
+	return goal, err
+}
+
+// goalError reports a second goal of the same type and frequency as
+// ErrAlreadyExists.
+func goalError(err error, goal domain.Goal) error {
+	var sqliteErr *sqlite.Error
+	if errors.As(err, &sqliteErr) && sqliteErr.Code() == sqlite3.SQLITE_CONSTRAINT_UNIQUE {
+		return fmt.Errorf("%w: %s %s goal", domain.ErrAlreadyExists, goal.Frequency, goal.Type)
+	}
+	return err
+}
+
+func (r *GoalRepository) GetGoalsByUserID(ctx context.Context, userID int64) ([]domain.Goal, error) {
+	query := `SELECT ` + goalColumns + ` FROM goal WHERE user_id = ? ORDER BY id`
+	rows, err := conn(ctx, r.DB).QueryContext(ctx, query, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	goals := []domain.Goal{}
+	for rows.Next() {
+		goal, err := scanGoal(rows)
+		if err != nil {
+			return nil, err
+		}
+		goals = append(goals, goal)
+	}
+
+	return goals, rows.Err()
+}
+
+func (r *GoalRepository) GetGoalByID(ctx context.Context, id int64) (domain.Goal, error) {
+	query := `SELECT ` + goalColumns + ` FROM goal WHERE id = ?`
+	goal, err := scanGoal(conn(ctx, r.DB).QueryRowContext(ctx, query, id))
+	if err == sql.ErrNoRows {
+		return domain.Goal{}, fmt.Errorf("%w: goal %d not found", domain.ErrRecordNotFound, id)
+	}
+	if err != nil {
+		return domain.Goal{}, err
+	}
+
+	return goal, nil
+}
+
+func (r *GoalRepository) CreateGoal(ctx context.Context, goal domain.Goal) (domain.Goal, error) {
+	query := `INSERT INTO goal (user_id, type, frequency, value) VALUES (?, ?, ?, ?)`
+	res, err := conn(ctx, r.DB).ExecContext(ctx, query, goal.UserID, goal.Type, goal.Frequency, goal.Value)
+	if err != nil {
+		return domain.Goal{}, goalError(err, goal)
+	}
+
+	id, err := res.LastInsertId()
+	if err != nil {
+		return domain.Goal{}, err
+	}
+
+	goal.ID = id
+	return goal, nil
+}
+
+func (r *GoalRepository) UpdateGoal(ctx context.Context, goal domain.Goal) (domain.Goal, error) {
+	query := `UPDATE goal SET type = ?, frequency = ?, value = ? WHERE id = ?`
+	_, err := conn(ctx, r.DB).ExecContext(ctx, query, goal.Type, goal.Frequency, goal.Value, goal.ID)
+	if err != nil {
+		return domain.Goal{}, goalError(err, goal)
+	}
+
+	return goal, nil
+}
+
+func (r *GoalRepository) DeleteGoal(ctx context.Context, id int64) error {
+	query := `DELETE FROM goal WHERE id = ?`
+	_, err := conn(ctx, r.DB).ExecContext(ctx, query, id)
+	return err
+}
+
+const goalVersionColumns = `id, COALESCE(goal_id, 0), user_id, type, frequency, value, effective_from, effective_to`
+
+func (r *GoalRepository) GetGoalVersionsByUserID(ctx context.Context, userID int64) ([]domain.GoalVersion, error) {
+	query := `SELECT ` + goalVersionColumns + ` FROM goal_version WHERE user_id = ? ORDER BY effective_from, id`
+	rows, err := conn(ctx, r.DB).QueryContext(ctx, query, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	versions := []domain.GoalVersion{}
+	for rows.Next() {
+		var version domain.GoalVersion
+		var effectiveFrom, effectiveTo sql.NullString
+		err := rows.Scan(&version.ID, &version.GoalID, &version.UserID, &version.Type, &version.Frequency, &version.Value,
+			&effectiveFrom, &effectiveTo)
+		if err != nil {
+			return nil, err
+		}
+
+		from, err := parseNullDate(effectiveFrom)
+		if err != nil {
+			return nil, err
+		}
+		version.EffectiveFrom = *from
+		if version.EffectiveTo, err = parseNullDate(effectiveTo); err != nil {
+			return nil, err
+		}
+		versions = append(versions, version)
+	}
+
+	return versions, rows.Err()
+}
+
+func (r *GoalRepository) CreateGoalVersion(ctx context.Context, version domain.GoalVersion) (domain.GoalVersion, error) {
+	query := `
+INSERT INTO goal_version (user_id, goal_id, type, frequency, value, effective_from, effective_to)
+VALUES (?, ?, ?, ?, ?, ?, ?)`
+	var goalID interface{}
+	if version.GoalID != 0 {
+		goalID = version.GoalID
+	}
+	res, err := conn(ctx, r.DB).ExecContext(ctx, query, version.UserID, goalID, version.Type, version.Frequency,
+		version.Value, version.EffectiveFrom.Format(dateFormat), nullDate(version.EffectiveTo))
+	if err != nil {
+		return domain.GoalVersion{}, err
+	}
+
+	id, err := res.LastInsertId()
+	if err != nil {
+		return domain.GoalVersion{}, err
+	}
+
+	version.ID = id
+	return version, nil
+}
+
+func (r *GoalRepository) EndGoalVersion(ctx context.Context, goalID int64, to time.Time) error {
+	day := to.Format(dateFormat)
+	query := `DELETE FROM goal_version WHERE goal_id = ? AND effective_to IS NULL AND effective_from > ?`
+	if _, err := conn(ctx, r.DB).ExecContext(ctx, query, goalID, day); err != nil {
+		return err
+	}
+
+	query = `UPDATE goal_version SET effective_to = ? WHERE goal_id = ? AND effective_to IS NULL`
+	_, err := conn(ctx, r.DB).ExecContext(ctx, query, day, goalID)
+	return err
+}
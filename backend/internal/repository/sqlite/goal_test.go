@@ -0,0 +1,75 @@
+package sqlite_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/rimvydascivilis/book-tracker/backend/domain"
+	"github.com/rimvydascivilis/book-tracker/backend/internal/repository/sqlite"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGoalRepository(t *testing.T) {
+	db := setupDB(t)
+	ctx := context.Background()
+	user := createUser(t, db, "test@example.com")
+	repo := sqlite.NewGoalRepository(db)
+
+	daily, err := repo.CreateGoal(ctx, domain.Goal{UserID: user.ID, Type: "pages", Frequency: "daily", Value: 20})
+	require.NoError(t, err)
+	yearly, err := repo.CreateGoal(ctx, domain.Goal{UserID: user.ID, Type: "books", Frequency: "yearly", Value: 24})
+	require.NoError(t, err)
+	assert.NotEqual(t, daily.ID, yearly.ID)
+
+	_, err = repo.CreateGoal(ctx, domain.Goal{UserID: user.ID, Type: "pages", Frequency: "daily", Value: 30})
+	assert.ErrorIs(t, err, domain.ErrAlreadyExists)
+
+	yearly.Frequency = "daily"
+	yearly.Type = "pages"
+	_, err = repo.UpdateGoal(ctx, yearly)
+	assert.ErrorIs(t, err, domain.ErrAlreadyExists)
+
+	goals, err := repo.GetGoalsByUserID(ctx, user.ID)
+	require.NoError(t, err)
+	require.Len(t, goals, 2)
+	assert.Equal(t, daily.ID, goals[0].ID)
+	assert.Equal(t, "yearly", goals[1].Frequency)
+
+	require.NoError(t, repo.DeleteGoal(ctx, daily.ID))
+	_, err = repo.GetGoalByID(ctx, daily.ID)
+	assert.ErrorIs(t, err, domain.ErrRecordNotFound)
+}
+
+func TestGoalRepository_Versions(t *testing.T) {
+	db := setupDB(t)
+	ctx := context.Background()
+	user := createUser(t, db, "test@example.com")
+	repo := sqlite.NewGoalRepository(db)
+	day := func(d int) time.Time { return time.Date(2024, 3, d, 0, 0, 0, 0, time.UTC) }
+
+	goal, err := repo.CreateGoal(ctx, domain.Goal{UserID: user.ID, Type: "pages", Frequency: "daily", Value: 20})
+	require.NoError(t, err)
+	version := domain.GoalVersion{GoalID: goal.ID, UserID: user.ID, Type: "pages", Frequency: "daily", Value: 20, EffectiveFrom: day(1)}
+	_, err = repo.CreateGoalVersion(ctx, version)
+	require.NoError(t, err)
+
+	require.NoError(t, repo.EndGoalVersion(ctx, goal.ID, day(9)))
+	version.Value = 30
+	version.EffectiveFrom = day(10)
+	_, err = repo.CreateGoalVersion(ctx, version)
+	require.NoError(t, err)
+	// Ending a version before it started removes it.
+	require.NoError(t, repo.EndGoalVersion(ctx, goal.ID, day(9)))
+	require.NoError(t, repo.DeleteGoal(ctx, goal.ID))
+
+	versions, err := repo.GetGoalVersionsByUserID(ctx, user.ID)
+	require.NoError(t, err)
+	require.Len(t, versions, 1)
+	assert.Zero(t, versions[0].GoalID, "the history outlives the goal")
+	assert.Equal(t, int64(20), versions[0].Value)
+	assert.Equal(t, day(1), versions[0].EffectiveFrom)
+	require.NotNil(t, versions[0].EffectiveTo)
+	assert.Equal(t, day(9), *versions[0].EffectiveTo)
+}
@@ -0,0 +1,98 @@
+package sqlite_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/rimvydascivilis/book-tracker/backend/domain"
+	"github.com/rimvydascivilis/book-tracker/backend/internal/repository/sqlite"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestImportRepository_Jobs(t *testing.T) {
+	db := setupDB(t)
+	repo := sqlite.NewImportRepository(db)
+	ctx := context.Background()
+	user := createUser(t, db, "user@example.com")
+
+	job, err := repo.CreateImportJob(ctx, domain.ImportJob{
+		UserID:    user.ID,
+		Source:    domain.ImportSourceGoodreads,
+		Checksum:  "abc",
+		Data:      []byte("Book Id,Title\n"),
+		Status:    domain.ImportStatusPending,
+		TotalRows: 2,
+	})
+	require.NoError(t, err)
+
+	_, err = repo.CreateImportJob(ctx, job)
+	assert.Error(t, err, "checksum is unique per user and source")
+
+	found, err := repo.GetImportJobByChecksum(ctx, user.ID, domain.ImportSourceGoodreads, "abc")
+	require.NoError(t, err)
+	assert.Equal(t, job.ID, found.ID)
+	assert.Equal(t, []byte("Book Id,Title\n"), found.Data)
+
+	unfinished, err := repo.GetUnfinishedImportJobs(ctx)
+	require.NoError(t, err)
+	assert.Len(t, unfinished, 1)
+
+	job.Status = domain.ImportStatusCompleted
+	_, err = repo.UpdateImportJob(ctx, job)
+	require.NoError(t, err)
+
+	found, err = repo.GetImportJobByID(ctx, job.ID)
+	require.NoError(t, err)
+	assert.Equal(t, domain.ImportStatusCompleted, found.Status)
+
+	unfinished, err = repo.GetUnfinishedImportJobs(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, unfinished)
+
+	_, err = repo.GetImportJobByID(ctx, job.ID+1)
+	assert.ErrorIs(t, err, domain.ErrRecordNotFound)
+}
+
+func TestImportRepository_Rows(t *testing.T) {
+	db := setupDB(t)
+	repo := sqlite.NewImportRepository(db)
+	ctx := context.Background()
+	user := createUser(t, db, "user@example.com")
+	other := createUser(t, db, "other@example.com")
+	book := createBook(t, db, user.ID, "Dune")
+
+	job, err := repo.CreateImportJob(ctx, domain.ImportJob{UserID: user.ID, Source: domain.ImportSourceGoodreads,
+		Checksum: "abc", Data: []byte{}, Status: domain.ImportStatusRunning})
+	require.NoError(t, err)
+
+	_, err = repo.CreateImportRow(ctx, domain.ImportRow{JobID: job.ID, RowNumber: 2, ExternalID: "2",
+		Status: domain.ImportRowStatusFailed, Error: "validation error"})
+	require.NoError(t, err)
+	_, err = repo.CreateImportRow(ctx, domain.ImportRow{JobID: job.ID, RowNumber: 1, ExternalID: "1",
+		Status: domain.ImportRowStatusImported, BookID: book.ID})
+	require.NoError(t, err)
+
+	_, err = repo.CreateImportRow(ctx, domain.ImportRow{JobID: job.ID, RowNumber: 1, Status: domain.ImportRowStatusFailed})
+	assert.Error(t, err, "a row is recorded once per job")
+
+	rows, err := repo.GetImportRowsByJobID(ctx, job.ID)
+	require.NoError(t, err)
+	require.Len(t, rows, 2)
+	assert.Equal(t, int64(1), rows[0].RowNumber)
+	assert.Equal(t, book.ID, rows[0].BookID)
+	assert.Equal(t, int64(0), rows[1].BookID)
+	assert.Equal(t, "validation error", rows[1].Error)
+
+	bookID, err := repo.GetImportedBookID(ctx, user.ID, domain.ImportSourceGoodreads, "1")
+	require.NoError(t, err)
+	assert.Equal(t, book.ID, bookID)
+
+	_, err = repo.GetImportedBookID(ctx, other.ID, domain.ImportSourceGoodreads, "1")
+	assert.ErrorIs(t, err, domain.ErrRecordNotFound)
+
+	// Deleting the book lets a later import create it again.
+	require.NoError(t, sqlite.NewBookRepository(db).DeleteBook(ctx, user.ID, book.ID))
+	_, err = repo.GetImportedBookID(ctx, user.ID, domain.ImportSourceGoodreads, "1")
+	assert.ErrorIs(t, err, domain.ErrRecordNotFound)
+}
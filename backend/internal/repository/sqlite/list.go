@@ -0,0 +1,67 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/rimvydascivilis/book-tracker/backend/domain"
+)
+
+type ListRepository struct {
+	DB *sql.DB
+}
+
+func NewListRepository(db *sql.DB) *ListRepository {
+	return &ListRepository{
+		DB: db,
+	}
+}
+
+func (r *ListRepository) GetListByID(ctx context.Context, listID int64) (domain.List, error) {
+	query := "SELECT id, user_id, title FROM list WHERE id = ?"
+
+	var list domain.List
+	err := conn(ctx, r.DB).QueryRowContext(ctx, query, listID).Scan(&list.ID, &list.UserID, &list.Title)
+	if err == sql.ErrNoRows {
+		return domain.List{}, fmt.Errorf("%w: %s", domain.ErrRecordNotFound, "list")
+	}
+	if err != nil {
+		return domain.List{}, err
+	}
+	return list, nil
+}
+
+func (r *ListRepository) GetListsByUserID(ctx context.Context, userID int64) ([]domain.List, error) {
+	query := "SELECT id, user_id, title FROM list WHERE user_id = ?"
+	rows, err := conn(ctx, r.DB).QueryContext(ctx, query, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var lists []domain.List
+	for rows.Next() {
+		var list domain.List
+		err := rows.Scan(&list.ID, &list.UserID, &list.Title)
+		if err != nil {
+			return nil, err
+		}
+		lists = append(lists, list)
+	}
+	return lists, rows.Err()
+}
+
+func (r *ListRepository) CreateList(ctx context.Context, list domain.List) (domain.List, error) {
+	query := "INSERT INTO list (user_id, title) VALUES (?, ?)"
+	res, err := conn(ctx, r.DB).ExecContext(ctx, query, list.UserID, list.Title)
+	if err != nil {
+		return domain.List{}, err
+	}
+	id, err := res.LastInsertId()
+	if err != nil {
+		return domain.List{}, err
+	}
+	list.ID = id
+	return list, nil
+}
@@ -0,0 +1,79 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/rimvydascivilis/book-tracker/backend/domain"
+)
+
+type ListItemRepository struct {
+	DB *sql.DB
+}
+
+func NewListItemRepository(db *sql.DB) *ListItemRepository {
+	return &ListItemRepository{
+		DB: db,
+	}
+}
+
+func (r *ListItemRepository) GetListItemsByListID(ctx context.Context, listID int64) ([]domain.ListItem, error) {
+	query := "SELECT id, list_id, book_id FROM list_item WHERE list_id = ?"
+	rows, err := conn(ctx, r.DB).QueryContext(ctx, query, listID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var listItems []domain.ListItem
+	for rows.Next() {
+		var listItem domain.ListItem
+		err := rows.Scan(&listItem.ID, &listItem.ListID, &listItem.BookID)
+		if err != nil {
+			return nil, err
+		}
+		listItems = append(listItems, listItem)
+	}
+	return listItems, rows.Err()
+}
+
+func (r *ListItemRepository) GetListItemBooksByListID(ctx context.Context, listID int64) ([]domain.ListItemBook, error) {
+	query := `SELECT list_item.id, list_item.list_id, list_item.book_id, book.title
+FROM list_item JOIN book ON book.id = list_item.book_id
+WHERE list_item.list_id = ? ORDER BY list_item.id`
+	rows, err := conn(ctx, r.DB).QueryContext(ctx, query, listID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	items := []domain.ListItemBook{}
+	for rows.Next() {
+		var item domain.ListItemBook
+		if err := rows.Scan(&item.ID, &item.ListID, &item.BookID, &item.BookTitle); err != nil {
+			return nil, err
+		}
+		items = append(items, item)
+	}
+	return items, rows.Err()
+}
+
+func (r *ListItemRepository) CreateListItem(ctx context.Context, listItem domain.ListItem) (domain.ListItem, error) {
+	query := "INSERT INTO list_item (list_id, book_id) VALUES (?, ?)"
+	res, err := conn(ctx, r.DB).ExecContext(ctx, query, listItem.ListID, listItem.BookID)
+	if err != nil {
+		return domain.ListItem{}, err
+	}
+	id, err := res.LastInsertId()
+	if err != nil {
+		return domain.ListItem{}, err
+	}
+	listItem.ID = id
+	return listItem, nil
+}
+
+func (r *ListItemRepository) DeleteListItem(ctx context.Context, id int64) error {
+	query := "DELETE FROM list_item WHERE id = ?"
+	_, err := conn(ctx, r.DB).ExecContext(ctx, query, id)
+	return err
+}
@@ -0,0 +1,88 @@
+package sqlite_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/rimvydascivilis/book-tracker/backend/domain"
+	"github.com/rimvydascivilis/book-tracker/backend/internal/repository/sqlite"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLocalAccountRepository(t *testing.T) {
+	db := setupDB(t)
+	ctx := context.Background()
+	user := createUser(t, db, "test@example.com")
+	repo := sqlite.NewLocalAccountRepository(db)
+	now := time.Date(2024, 3, 1, 20, 0, 0, 0, time.UTC)
+
+	_, err := repo.GetLocalAccountForUpdate(ctx, user.ID)
+	assert.ErrorIs(t, err, domain.ErrRecordNotFound)
+
+	_, err = repo.CreateLocalAccount(ctx, domain.LocalAccount{UserID: user.ID, PasswordHash: "hash", CreatedAt: now})
+	require.NoError(t, err)
+	_, err = repo.CreateLocalAccount(ctx, domain.LocalAccount{UserID: user.ID, PasswordHash: "other", CreatedAt: now})
+	assert.ErrorIs(t, err, domain.ErrAlreadyExists)
+
+	account, err := repo.GetLocalAccountForUpdate(ctx, user.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "hash", account.PasswordHash)
+	assert.Nil(t, account.EmailVerifiedAt)
+	assert.Nil(t, account.LockedUntil)
+	assert.Equal(t, now, account.CreatedAt)
+
+	lockedUntil := now.Add(15 * time.Minute)
+	account.PasswordHash = "new hash"
+	account.EmailVerifiedAt = &now
+	account.FailedLogins = 3
+	account.LockedUntil = &lockedUntil
+	_, err = repo.UpdateLocalAccount(ctx, account)
+	require.NoError(t, err)
+
+	account, err = repo.GetLocalAccountForUpdate(ctx, user.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "new hash", account.PasswordHash)
+	require.NotNil(t, account.EmailVerifiedAt)
+	assert.Equal(t, now, *account.EmailVerifiedAt)
+	assert.Equal(t, int64(3), account.FailedLogins)
+	require.NotNil(t, account.LockedUntil)
+	assert.Equal(t, lockedUntil, *account.LockedUntil)
+}
+
+func TestLocalAccountRepository_Tokens(t *testing.T) {
+	db := setupDB(t)
+	ctx := context.Background()
+	user := createUser(t, db, "test@example.com")
+	repo := sqlite.NewLocalAccountRepository(db)
+	now := time.Date(2024, 3, 1, 20, 0, 0, 0, time.UTC)
+
+	verify, err := repo.CreateAccountToken(ctx, domain.AccountToken{
+		UserID: user.ID, Purpose: domain.AccountTokenVerifyEmail, TokenHash: "abc", ExpiresAt: now.Add(time.Hour),
+	})
+	require.NoError(t, err)
+	_, err = repo.CreateAccountToken(ctx, domain.AccountToken{
+		UserID: user.ID, Purpose: domain.AccountTokenResetPassword, TokenHash: "abc", ExpiresAt: now.Add(time.Hour),
+	})
+	assert.Error(t, err, "token hashes are unique")
+	_, err = repo.CreateAccountToken(ctx, domain.AccountToken{
+		UserID: user.ID, Purpose: domain.AccountTokenResetPassword, TokenHash: "def", ExpiresAt: now.Add(time.Hour),
+	})
+	require.NoError(t, err)
+
+	require.NoError(t, repo.MarkAccountTokenUsed(ctx, verify.ID, now))
+	stored, err := repo.GetAccountTokenByHashForUpdate(ctx, "abc")
+	require.NoError(t, err)
+	assert.Equal(t, user.ID, stored.UserID)
+	assert.Equal(t, domain.AccountTokenVerifyEmail, stored.Purpose)
+	assert.Equal(t, now.Add(time.Hour), stored.ExpiresAt)
+	require.NotNil(t, stored.UsedAt)
+	assert.Equal(t, now, *stored.UsedAt)
+
+	require.NoError(t, repo.DeleteAccountTokens(ctx, user.ID, domain.AccountTokenVerifyEmail))
+	_, err = repo.GetAccountTokenByHashForUpdate(ctx, "abc")
+	assert.ErrorIs(t, err, domain.ErrRecordNotFound)
+	_, err = repo.GetAccountTokenByHashForUpdate(ctx, "def")
+	assert.NoError(t, err, "tokens for other purposes are kept")
+}
@@ -0,0 +1,98 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/rimvydascivilis/book-tracker/backend/domain"
+)
+
+type NoteRepository struct {
+	DB *sql.DB
+}
+
+func NewNoteRepository(db *sql.DB) *NoteRepository {
+	return &NoteRepository{
+		DB: db,
+	}
+}
+
+func (r *NoteRepository) getAll(ctx context.Context, query string, args ...interface{}) ([]domain.Note, error) {
+	rows, err := conn(ctx, r.DB).QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var notes []domain.Note
+	for rows.Next() {
+		var note domain.Note
+		err := rows.Scan(&note.ID, &note.UserID, &note.BookID, &note.PageNumber, &note.Content, &note.CreatedAt)
+		if err != nil {
+			return nil, err
+		}
+		notes = append(notes, note)
+	}
+	return notes, rows.Err()
+}
+
+func (r *NoteRepository) GetNoteByUserID(ctx context.Context, noteID, userID int64) (domain.Note, error) {
+	query := "SELECT id, user_id, book_id, page_number, content, created_at FROM note WHERE id = ? AND user_id = ?"
+
+	note := domain.Note{}
+	err := conn(ctx, r.DB).QueryRowContext(ctx, query, noteID, userID).
+		Scan(&note.ID, &note.UserID, &note.BookID, &note.PageNumber, &note.Content, &note.CreatedAt)
+	if err == sql.ErrNoRows {
+		return domain.Note{}, fmt.Errorf("%w: %s", domain.ErrRecordNotFound, "note")
+	}
+	if err != nil {
+		return domain.Note{}, err
+	}
+	return note, nil
+}
+
+func (r *NoteRepository) GetBookIDsByUserID(ctx context.Context, userID int64) ([]int64, error) {
+	query := "SELECT book_id FROM note WHERE user_id = ? GROUP BY book_id"
+	rows, err := conn(ctx, r.DB).QueryContext(ctx, query, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var bookIDs []int64
+	for rows.Next() {
+		var bookID int64
+		err := rows.Scan(&bookID)
+		if err != nil {
+			return nil, err
+		}
+		bookIDs = append(bookIDs, bookID)
+	}
+	return bookIDs, rows.Err()
+}
+
+func (r *NoteRepository) GetNotesByUserIDAndBookID(ctx context.Context, userID, bookID int64) ([]domain.Note, error) {
+	query := "SELECT id, user_id, book_id, page_number, content, created_at FROM note WHERE user_id = ? AND book_id = ?"
+	return r.getAll(ctx, query, userID, bookID)
+}
+
+func (r *NoteRepository) CreateNote(ctx context.Context, note domain.Note) (domain.Note, error) {
+	query := "INSERT INTO note (user_id, book_id, page_number, content) VALUES (?, ?, ?, ?)"
+	res, err := conn(ctx, r.DB).ExecContext(ctx, query, note.UserID, note.BookID, note.PageNumber, note.Content)
+	if err != nil {
+		return domain.Note{}, err
+	}
+	id, err := res.LastInsertId()
+	if err != nil {
+		return domain.Note{}, err
+	}
+	note.ID = id
+	return note, nil
+}
+
+func (r *NoteRepository) DeleteNote(ctx context.Context, id int64) error {
+	query := "DELETE FROM note WHERE id = ?"
+	_, err := conn(ctx, r.DB).ExecContext(ctx, query, id)
+	return err
+}
@@ -0,0 +1,297 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/rimvydascivilis/book-tracker/backend/domain"
+	"github.com/rimvydascivilis/book-tracker/backend/dto"
+)
+
+type ProgressRepository struct {
+	DB *sql.DB
+}
+
+func NewProgressRepository(db *sql.DB) *ProgressRepository {
+	return &ProgressRepository{
+		DB: db,
+	}
+}
+
+func (r *ProgressRepository) GetTotalProgressByReadingID(ctx context.Context, readingID int64) (int64, error) {
+	query := `SELECT COALESCE(SUM(pages), 0) FROM progress WHERE reading_id = ?`
+
+	var totalProgress int64
+	err := conn(ctx, r.DB).QueryRowContext(ctx, query, readingID).Scan(&totalProgress)
+	if err != nil {
+		return 0, err
+	}
+
+	return totalProgress, nil
+}
+
+func (r *ProgressRepository) getGrouped(ctx context.Context, query string, args ...interface{}) ([]dto.Progress, error) {
+	rows, err := conn(ctx, r.DB).QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var progress []dto.Progress
+	for rows.Next() {
+		var p dto.Progress
+		err = rows.Scan(&p.Date, &p.Pages, &p.Minutes)
+		if err != nil {
+			return nil, err
+		}
+		progress = append(progress, p)
+	}
+
+	return progress, rows.Err()
+}
+
+// normalizedSums adds up the progress of each format in pages and minutes.
+// Ebook percent is converted with the page count of the book.
+const normalizedSums = `
+	COALESCE(SUM(CASE WHEN r.format IN ('ebook', 'audiobook') THEN 0 ELSE p.pages END), 0) +
+		COALESCE(SUM(CASE WHEN r.format = 'ebook' THEN p.pages * b.page_count ELSE 0 END), 0) / 100 AS pages,
+	COALESCE(SUM(CASE WHEN r.format = 'audiobook' THEN p.pages ELSE 0 END), 0) AS minutes
+FROM progress p
+JOIN reading r ON r.id = p.reading_id
+JOIN book b ON b.id = r.book_id`
+
+func (r *ProgressRepository) GetPeriodProgress(ctx context.Context, userID int64, from, to time.Time) (dto.PeriodProgress, error) {
+	query := `
+SELECT
+	(SELECT COUNT(id) FROM reading
+		WHERE user_id = ? AND status = 'completed' AND date(finished_at) BETWEEN ? AND ?) AS books,` + normalizedSums + `
+WHERE p.user_id = ?
+	AND r.status <> 'abandoned'
+	AND date(p.reading_date) BETWEEN ? AND ?`
+	fromDay, toDay := from.Format(dateFormat), to.Format(dateFormat)
+
+	var progress dto.PeriodProgress
+	err := conn(ctx, r.DB).QueryRowContext(ctx, query, userID, fromDay, toDay, userID, fromDay, toDay).
+		Scan(&progress.Books, &progress.Pages, &progress.Minutes)
+	if err != nil {
+		return dto.PeriodProgress{}, err
+	}
+
+	return progress, nil
+}
+
+// progressByDay adds up the progress of each day in hundredths of a page,
+// minutes and completed readings.
+const progressByDay = `
+SELECT day, SUM(page_hundredths), SUM(minutes), SUM(books)
+FROM (
+	SELECT
+		date(p.reading_date) AS day,
+		SUM(CASE
+			WHEN r.format = 'audiobook' THEN 0
+			WHEN r.format = 'ebook' THEN p.pages * b.page_count
+			ELSE p.pages * 100 END) AS page_hundredths,
+		SUM(CASE WHEN r.format = 'audiobook' THEN p.pages ELSE 0 END) AS minutes,
+		0 AS books
+	FROM progress p
+	JOIN reading r ON r.id = p.reading_id
+	JOIN book b ON b.id = r.book_id
+	WHERE p.user_id = ?
+		AND r.status <> 'abandoned'
+		AND date(p.reading_date) BETWEEN ? AND ?
+	GROUP BY day
+	UNION ALL
+	SELECT date(finished_at) AS day, 0, 0, COUNT(id)
+	FROM reading
+	WHERE user_id = ? AND status = 'completed' AND date(finished_at) BETWEEN ? AND ?
+	GROUP BY day
+)
+GROUP BY day
+ORDER BY day`
+
+func (r *ProgressRepository) GetProgressByDay(ctx context.Context, userID int64, from, to time.Time) ([]dto.DayProgress, error) {
+	fromDay, toDay := from.Format(dateFormat), to.Format(dateFormat)
+	rows, err := conn(ctx, r.DB).QueryContext(ctx, progressByDay, userID, fromDay, toDay, userID, fromDay, toDay)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	days := []dto.DayProgress{}
+	for rows.Next() {
+		var date string
+		var p dto.DayProgress
+		if err := rows.Scan(&date, &p.PageHundredths, &p.Minutes, &p.Books); err != nil {
+			return nil, err
+		}
+		if p.Day, err = time.Parse(dateFormat, date); err != nil {
+			return nil, err
+		}
+		days = append(days, p)
+	}
+
+	return days, rows.Err()
+}
+
+func (r *ProgressRepository) GetMonthlyProgress(ctx context.Context, userID, year int64) ([]dto.Progress, error) {
+	query := `
+SELECT
+	CAST(strftime('%m', p.reading_date) AS INTEGER) AS date,` + normalizedSums + `
+WHERE p.user_id = ?
+	AND CAST(strftime('%Y', p.reading_date) AS INTEGER) = ?
+GROUP BY date
+`
+	return r.getGrouped(ctx, query, userID, year)
+}
+
+func (r *ProgressRepository) GetDailyProgress(ctx context.Context, userID, year, month int64) ([]dto.Progress, error) {
+	query := `
+SELECT
+	CAST(strftime('%d', p.reading_date) AS INTEGER) AS date,` + normalizedSums + `
+WHERE p.user_id = ?
+	AND CAST(strftime('%Y', p.reading_date) AS INTEGER) = ?
+	AND CAST(strftime('%m', p.reading_date) AS INTEGER) = ?
+GROUP BY date
+`
+	return r.getGrouped(ctx, query, userID, year, month)
+}
+
+const progressColumns = `id, reading_id, user_id, pages, position, reading_date`
+
+func scanProgress(row scanner) (domain.Progress, error) {
+	var p domain.Progress
+	var date string
+	if err := row.Scan(&p.ID, &p.ReadingID, &p.UserID, &p.Pages, &p.Position, &date); err != nil {
+		return domain.Progress{}, err
+	}
+	if len(date) > len(dateFormat) {
+		date = date[:len(dateFormat)]
+	}
+
+	var err error
+	if p.ReadingDate, err = time.Parse(dateFormat, date); err != nil {
+		return domain.Progress{}, err
+	}
+	return p, nil
+}
+
+func (r *ProgressRepository) queryProgress(ctx context.Context, query string, args ...interface{}) ([]domain.Progress, error) {
+	rows, err := conn(ctx, r.DB).QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	progress := []domain.Progress{}
+	for rows.Next() {
+		p, err := scanProgress(rows)
+		if err != nil {
+			return nil, err
+		}
+		progress = append(progress, p)
+	}
+
+	return progress, rows.Err()
+}
+
+func (r *ProgressRepository) GetProgressByUserID(ctx context.Context, userID, offset, limit int64) ([]domain.Progress, error) {
+	query := `SELECT ` + progressColumns + ` FROM progress WHERE user_id = ? ORDER BY id LIMIT ? OFFSET ?`
+	return r.queryProgress(ctx, query, userID, limit, offset)
+}
+
+func (r *ProgressRepository) GetProgressByID(ctx context.Context, id int64) (domain.Progress, error) {
+	query := `SELECT ` + progressColumns + ` FROM progress WHERE id = ?`
+	p, err := scanProgress(conn(ctx, r.DB).QueryRowContext(ctx, query, id))
+	if err == sql.ErrNoRows {
+		return domain.Progress{}, fmt.Errorf("%w: %s", domain.ErrRecordNotFound, "progress")
+	}
+	return p, err
+}
+
+func (r *ProgressRepository) GetProgressByReadingID(ctx context.Context, readingID int64) ([]domain.Progress, error) {
+	query := `SELECT ` + progressColumns + ` FROM progress WHERE reading_id = ? ORDER BY reading_date, id`
+	return r.queryProgress(ctx, query, readingID)
+}
+
+func (r *ProgressRepository) GetProgressByReadingIDs(ctx context.Context, readingIDs []int64) (map[int64][]domain.Progress, error) {
+	res := map[int64][]domain.Progress{}
+	if len(readingIDs) == 0 {
+		return res, nil
+	}
+
+	query := `SELECT ` + progressColumns + ` FROM progress
+WHERE reading_id IN (` + placeholders(len(readingIDs)) + `) ORDER BY reading_date, id`
+	args := make([]interface{}, 0, len(readingIDs))
+	for _, id := range readingIDs {
+		args = append(args, id)
+	}
+
+	progress, err := r.queryProgress(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	for _, p := range progress {
+		res[p.ReadingID] = append(res[p.ReadingID], p)
+	}
+	return res, nil
+}
+
+func (r *ProgressRepository) CreateProgress(ctx context.Context, progress domain.Progress) (domain.Progress, error) {
+	query := `INSERT INTO progress (reading_id, user_id, pages, position, reading_date) VALUES (?, ?, ?, ?, ?)`
+	res, err := conn(ctx, r.DB).ExecContext(ctx, query, progress.ReadingID, progress.UserID, progress.Pages,
+		progress.Position, progress.ReadingDate.Format(dateFormat))
+	if err != nil {
+		return domain.Progress{}, err
+	}
+
+	id, err := res.LastInsertId()
+	if err != nil {
+		return domain.Progress{}, err
+	}
+
+	progress.ID = id
+	return progress, nil
+}
+
+func (r *ProgressRepository) UpdateProgress(ctx context.Context, progress domain.Progress) (domain.Progress, error) {
+	query := `UPDATE progress SET pages = ?, position = ?, reading_date = ? WHERE id = ?`
+	_, err := conn(ctx, r.DB).ExecContext(ctx, query, progress.Pages, progress.Position,
+		progress.ReadingDate.Format(dateFormat), progress.ID)
+	if err != nil {
+		return domain.Progress{}, err
+	}
+
+	return progress, nil
+}
+
+func (r *ProgressRepository) DeleteProgress(ctx context.Context, id int64) error {
+	query := `DELETE FROM progress WHERE id = ?`
+	_, err := conn(ctx, r.DB).ExecContext(ctx, query, id)
+	return err
+}
+
+func (r *ProgressRepository) GetReadingDays(ctx context.Context, userID int64) ([]time.Time, error) {
+	query := `SELECT DISTINCT date(reading_date) FROM progress WHERE user_id = ? AND pages > 0 ORDER BY 1`
+	rows, err := conn(ctx, r.DB).QueryContext(ctx, query, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	days := []time.Time{}
+	for rows.Next() {
+		var date string
+		if err := rows.Scan(&date); err != nil {
+			return nil, err
+		}
+		day, err := time.Parse(dateFormat, date)
+		if err != nil {
+			return nil, err
+		}
+		days = append(days, day)
+	}
+
+	return days, rows.Err()
+}
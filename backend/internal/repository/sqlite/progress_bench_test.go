@@ -0,0 +1,180 @@
+package sqlite_test
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/rimvydascivilis/book-tracker/backend/domain"
+	"github.com/rimvydascivilis/book-tracker/backend/internal/repository/sqlite"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	benchReadings = 100
+	benchDays     = 30
+)
+
+// statements counts the queries and execs sent through the "sqlite-counting"
+// driver.
+var (
+	statements         atomic.Int64
+	registerCountingDB sync.Once
+)
+
+// countingDriver wraps the SQLite driver to count the statements it runs.
+type countingDriver struct {
+	driver.Driver
+}
+
+func (d countingDriver) Open(name string) (driver.Conn, error) {
+	c, err := d.Driver.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	return countingConn{c}, nil
+}
+
+// countingConn passes every call on to the SQLite connection, counting
+// queries and execs.
+type countingConn struct {
+	driver.Conn
+}
+
+func (c countingConn) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	statements.Add(1)
+	return c.Conn.(driver.QueryerContext).QueryContext(ctx, query, args)
+}
+
+func (c countingConn) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	statements.Add(1)
+	return c.Conn.(driver.ExecerContext).ExecContext(ctx, query, args)
+}
+
+func (c countingConn) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return c.Conn.(driver.ConnPrepareContext).PrepareContext(ctx, query)
+}
+
+func (c countingConn) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return c.Conn.(driver.ConnBeginTx).BeginTx(ctx, opts)
+}
+
+// setupCountingDB opens a migrated in-memory database like setupDB, on a
+// connection that counts its statements.
+func setupCountingDB(b *testing.B) *sql.DB {
+	registerCountingDB.Do(func() {
+		db, err := sqlite.Open(":memory:")
+		require.NoError(b, err)
+		sql.Register("sqlite-counting", countingDriver{db.Driver()})
+		require.NoError(b, db.Close())
+	})
+
+	db, err := sql.Open("sqlite-counting", "file::memory:?_pragma=foreign_keys(1)&_pragma=busy_timeout(5000)")
+	require.NoError(b, err)
+	db.SetMaxOpenConns(1)
+	migrateDB(b, db)
+	return db
+}
+
+// setupBenchProgress gives a user benchReadings readings of every format with
+// progress on each of the last benchDays days, 3000 progress rows in all.
+func setupBenchProgress(b *testing.B) (*sql.DB, domain.User, time.Time, time.Time) {
+	db := setupCountingDB(b)
+	ctx := context.Background()
+	user := createUser(b, db, "bench@example.com")
+	to := time.Date(2024, 3, 31, 0, 0, 0, 0, time.UTC)
+	from := to.AddDate(0, 0, 1-benchDays)
+
+	readingRepo := sqlite.NewReadingRepository(db)
+	progressRepo := sqlite.NewProgressRepository(db)
+	formats := []string{domain.ReadingFormatPrint, domain.ReadingFormatEbook, domain.ReadingFormatAudiobook}
+	for i := 0; i < benchReadings; i++ {
+		book := createBook(b, db, user.ID, fmt.Sprintf("Book %d", i))
+		reading, err := readingRepo.CreateReading(ctx, domain.Reading{
+			UserID: user.ID, BookID: book.ID, Format: formats[i%len(formats)], TotalPages: 1000,
+			Status: domain.ReadingStatusReading, CreatedAt: from, UpdatedAt: from,
+		})
+		require.NoError(b, err)
+
+		for day := from; !day.After(to); day = day.AddDate(0, 0, 1) {
+			_, err := progressRepo.CreateProgress(ctx, domain.Progress{
+				UserID: user.ID, ReadingID: reading.ID, Pages: 3, ReadingDate: day,
+			})
+			require.NoError(b, err)
+		}
+	}
+
+	return db, user, from, to
+}
+
+// reportStatements reports the statements run since the timer was reset, per
+// operation.
+func reportStatements(b *testing.B, before int64) {
+	b.ReportMetric(float64(statements.Load()-before)/float64(b.N), "queries/op")
+}
+
+// BenchmarkGoalProgress_PerReading measures goal progress computed one
+// reading at a time, as it used to be: the readings of the user, then the
+// progress of each and, for ebooks, its book. The repository methods that
+// narrowed these to the period are gone, so the period is picked out here.
+func BenchmarkGoalProgress_PerReading(b *testing.B) {
+	db, user, from, to := setupBenchProgress(b)
+	ctx := context.Background()
+	readingRepo := sqlite.NewReadingRepository(db)
+	progressRepo := sqlite.NewProgressRepository(db)
+	bookRepo := sqlite.NewBookRepository(db)
+
+	b.ResetTimer()
+	before := statements.Load()
+	for i := 0; i < b.N; i++ {
+		readings, err := readingRepo.GetReadingsByUserID(ctx, user.ID, "", 0, benchReadings)
+		require.NoError(b, err)
+
+		var pages, minutes int64
+		for _, reading := range readings {
+			if reading.Status == domain.ReadingStatusAbandoned {
+				continue
+			}
+			progress, err := progressRepo.GetProgressByReadingID(ctx, reading.ID)
+			require.NoError(b, err)
+			var periodProgress int64
+			for _, p := range progress {
+				if !p.ReadingDate.Before(from) && !p.ReadingDate.After(to) {
+					periodProgress += p.Pages
+				}
+			}
+
+			var pageCount int64
+			switch reading.Format {
+			case domain.ReadingFormatAudiobook:
+				minutes += reading.MinutesOf(periodProgress)
+				continue
+			case domain.ReadingFormatEbook:
+				book, err := bookRepo.GetBookByUserID(ctx, user.ID, reading.BookID)
+				require.NoError(b, err)
+				pageCount = book.PageCount
+			}
+			pages += reading.PagesOf(periodProgress, pageCount)
+		}
+	}
+	reportStatements(b, before)
+}
+
+func BenchmarkGoalProgress_Aggregated(b *testing.B) {
+	db, user, from, to := setupBenchProgress(b)
+	ctx := context.Background()
+	repo := sqlite.NewProgressRepository(db)
+
+	b.ResetTimer()
+	before := statements.Load()
+	for i := 0; i < b.N; i++ {
+		_, err := repo.GetPeriodProgress(ctx, user.ID, from, to)
+		require.NoError(b, err)
+	}
+	reportStatements(b, before)
+}
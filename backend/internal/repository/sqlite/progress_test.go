@@ -0,0 +1,323 @@
+package sqlite_test
+
+import (
+	"context"
+	"database/sql"
+	"io/fs"
+	"testing"
+	"time"
+
+	"github.com/rimvydascivilis/book-tracker/backend/domain"
+	"github.com/rimvydascivilis/book-tracker/backend/dto"
+	"github.com/rimvydascivilis/book-tracker/backend/internal/migration"
+	"github.com/rimvydascivilis/book-tracker/backend/internal/repository/sqlite"
+	"github.com/rimvydascivilis/book-tracker/backend/migrations"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func setupProgress(t *testing.T) (*sql.DB, *sqlite.ProgressRepository, domain.Reading) {
+	db := setupDB(t)
+	ctx := context.Background()
+	user := createUser(t, db, "test@example.com")
+	book := createBook(t, db, user.ID, "Dune")
+
+	reading, err := sqlite.NewReadingRepository(db).CreateReading(ctx, domain.Reading{
+		UserID: user.ID, BookID: book.ID, TotalPages: 500, CreatedAt: time.Now(), UpdatedAt: time.Now(),
+	})
+	require.NoError(t, err)
+
+	repo := sqlite.NewProgressRepository(db)
+	for _, p := range []struct {
+		date  time.Time
+		pages int64
+	}{
+		{time.Date(2024, 1, 31, 23, 30, 0, 0, time.FixedZone("EET", 2*3600)), 10},
+		{time.Date(2024, 2, 1, 0, 15, 0, 0, time.FixedZone("EET", 2*3600)), 20},
+		{time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC), 5},
+		{time.Date(2024, 2, 14, 0, 0, 0, 0, time.UTC), 7},
+	} {
+		_, err := repo.CreateProgress(ctx, domain.Progress{UserID: user.ID, ReadingID: reading.ID, Pages: p.pages, ReadingDate: p.date})
+		require.NoError(t, err)
+	}
+
+	return db, repo, reading
+}
+
+func TestProgressRepository_GetTotalProgressByReadingID(t *testing.T) {
+	_, repo, reading := setupProgress(t)
+
+	total, err := repo.GetTotalProgressByReadingID(context.Background(), reading.ID)
+
+	assert.NoError(t, err)
+	assert.Equal(t, int64(42), total)
+}
+
+func TestProgressRepository_GetPeriodProgress(t *testing.T) {
+	db, repo, reading := setupProgress(t)
+	ctx := context.Background()
+	feb1 := time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)
+	feb29 := time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC)
+
+	day, err := repo.GetPeriodProgress(ctx, reading.UserID, feb1, feb1)
+	assert.NoError(t, err)
+	assert.Equal(t, dto.PeriodProgress{Pages: 25}, day)
+
+	// 10 percent of a 474 page ebook and half an hour of an audiobook, while
+	// progress on abandoned readings does not count.
+	book, err := sqlite.NewBookRepository(db).CreateBook(ctx, domain.Book{UserID: reading.UserID, Title: "Emma", PageCount: 474})
+	require.NoError(t, err)
+	for _, r := range []struct {
+		format string
+		status string
+		pages  int64
+	}{
+		{domain.ReadingFormatEbook, domain.ReadingStatusReading, 10},
+		{domain.ReadingFormatAudiobook, domain.ReadingStatusReading, 30},
+		{domain.ReadingFormatPrint, domain.ReadingStatusAbandoned, 100},
+	} {
+		other, err := sqlite.NewReadingRepository(db).CreateReading(ctx, domain.Reading{
+			UserID: reading.UserID, BookID: book.ID, Format: r.format, TotalPages: 474, Status: r.status,
+			CreatedAt: time.Now(), UpdatedAt: time.Now(),
+		})
+		require.NoError(t, err)
+		_, err = repo.CreateProgress(ctx, domain.Progress{UserID: reading.UserID, ReadingID: other.ID, Pages: r.pages, ReadingDate: feb29})
+		require.NoError(t, err)
+	}
+
+	month, err := repo.GetPeriodProgress(ctx, reading.UserID, feb1, feb29)
+	assert.NoError(t, err)
+	assert.Equal(t, dto.PeriodProgress{Pages: 32 + 47, Minutes: 30}, month)
+}
+
+func TestProgressRepository_GetProgressByDay(t *testing.T) {
+	db, repo, reading := setupProgress(t)
+	ctx := context.Background()
+	feb := func(day int) time.Time { return time.Date(2024, 2, day, 0, 0, 0, 0, time.UTC) }
+
+	// 5 percent of a 474 page ebook on two days, an abandoned reading and a
+	// reading completed on Valentine's day.
+	book, err := sqlite.NewBookRepository(db).CreateBook(ctx, domain.Book{UserID: reading.UserID, Title: "Emma", PageCount: 474})
+	require.NoError(t, err)
+	finished := feb(14).Add(20 * time.Hour)
+	readings := sqlite.NewReadingRepository(db)
+	for _, r := range []domain.Reading{
+		{Format: domain.ReadingFormatEbook, Status: domain.ReadingStatusReading},
+		{Format: domain.ReadingFormatPrint, Status: domain.ReadingStatusAbandoned},
+		{Format: domain.ReadingFormatAudiobook, Status: domain.ReadingStatusCompleted, FinishedAt: &finished},
+	} {
+		r.UserID, r.BookID, r.TotalPages, r.CreatedAt, r.UpdatedAt = reading.UserID, book.ID, 474, time.Now(), time.Now()
+		other, err := readings.CreateReading(ctx, r)
+		require.NoError(t, err)
+		for _, date := range []time.Time{feb(28), feb(29)} {
+			_, err = repo.CreateProgress(ctx, domain.Progress{UserID: reading.UserID, ReadingID: other.ID, Pages: 5, ReadingDate: date})
+			require.NoError(t, err)
+		}
+	}
+
+	days, err := repo.GetProgressByDay(ctx, reading.UserID, feb(1), feb(29))
+	assert.NoError(t, err)
+	assert.Equal(t, []dto.DayProgress{
+		{Day: feb(1), PageHundredths: 2500},
+		{Day: feb(14), PageHundredths: 700, Books: 1},
+		{Day: feb(28), PageHundredths: 2370, Minutes: 5},
+		{Day: feb(29), PageHundredths: 2370, Minutes: 5},
+	}, days)
+
+	// The days add up to the period without rounding each of them.
+	period, err := repo.GetPeriodProgress(ctx, reading.UserID, feb(1), feb(29))
+	assert.NoError(t, err)
+	assert.Equal(t, dto.PeriodProgress{Pages: 32 + 47, Minutes: 10, Books: 1}, period)
+
+	days, err = repo.GetProgressByDay(ctx, reading.UserID, feb(2), feb(13))
+	assert.NoError(t, err)
+	assert.Empty(t, days)
+}
+
+func TestProgressRepository_GetMonthlyAndDailyProgress(t *testing.T) {
+	_, repo, reading := setupProgress(t)
+	ctx := context.Background()
+
+	monthly, err := repo.GetMonthlyProgress(ctx, reading.UserID, 2024)
+	assert.NoError(t, err)
+	assert.Equal(t, []dto.Progress{{Date: "1", Pages: 10}, {Date: "2", Pages: 32}}, monthly)
+
+	daily, err := repo.GetDailyProgress(ctx, reading.UserID, 2024, 2)
+	assert.NoError(t, err)
+	assert.Equal(t, []dto.Progress{{Date: "1", Pages: 25}, {Date: "14", Pages: 7}}, daily)
+}
+
+func TestProgressRepository_GetProgressByUserID(t *testing.T) {
+	db, repo, reading := setupProgress(t)
+	ctx := context.Background()
+	other := createUser(t, db, "other@example.com")
+
+	progress, err := repo.GetProgressByUserID(ctx, reading.UserID, 0, 10)
+	assert.NoError(t, err)
+	require.Len(t, progress, 4)
+	assert.Equal(t, reading.ID, progress[0].ReadingID)
+	assert.Equal(t, "2024-01-31", progress[0].ReadingDate.Format("2006-01-02"))
+
+	page, err := repo.GetProgressByUserID(ctx, reading.UserID, 3, 10)
+	assert.NoError(t, err)
+	require.Len(t, page, 1)
+	assert.Equal(t, progress[3].ID, page[0].ID)
+
+	none, err := repo.GetProgressByUserID(ctx, other.ID, 0, 10)
+	assert.NoError(t, err)
+	assert.Empty(t, none)
+}
+
+func TestProgressRepository_GetProgressByReadingID(t *testing.T) {
+	_, repo, reading := setupProgress(t)
+
+	progress, err := repo.GetProgressByReadingID(context.Background(), reading.ID)
+
+	assert.NoError(t, err)
+	require.Len(t, progress, 4)
+	assert.Equal(t, "2024-01-31", progress[0].ReadingDate.Format("2006-01-02"))
+	assert.Equal(t, "2024-02-14", progress[3].ReadingDate.Format("2006-01-02"))
+}
+
+func TestProgressRepository_UpdateAndDeleteProgress(t *testing.T) {
+	_, repo, reading := setupProgress(t)
+	ctx := context.Background()
+
+	progress, err := repo.GetProgressByReadingID(ctx, reading.ID)
+	require.NoError(t, err)
+
+	p := progress[3]
+	p.Pages = 17
+	p.ReadingDate = time.Date(2024, 2, 15, 0, 0, 0, 0, time.UTC)
+	_, err = repo.UpdateProgress(ctx, p)
+	require.NoError(t, err)
+
+	got, err := repo.GetProgressByID(ctx, p.ID)
+	require.NoError(t, err)
+	assert.Equal(t, int64(17), got.Pages)
+	assert.Equal(t, p.ReadingDate, got.ReadingDate)
+
+	require.NoError(t, repo.DeleteProgress(ctx, p.ID))
+	_, err = repo.GetProgressByID(ctx, p.ID)
+	assert.ErrorIs(t, err, domain.ErrRecordNotFound)
+
+	total, err := repo.GetTotalProgressByReadingID(ctx, reading.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(35), total)
+}
+
+func TestProgressPositionMigration(t *testing.T) {
+	db := setupDB(t)
+	ctx := context.Background()
+	user := createUser(t, db, "test@example.com")
+	book := createBook(t, db, user.ID, "Dune")
+	reading, err := sqlite.NewReadingRepository(db).CreateReading(ctx, domain.Reading{
+		UserID: user.ID, BookID: book.ID, TotalPages: 500, CreatedAt: time.Now(), UpdatedAt: time.Now(),
+	})
+	require.NoError(t, err)
+
+	migrationsFS, err := fs.Sub(migrations.SQLite, "sqlite")
+	require.NoError(t, err)
+	migrator, err := migration.NewMigrator(db, migrationsFS)
+	require.NoError(t, err)
+	for {
+		version, err := migrator.Version(ctx)
+		require.NoError(t, err)
+		if version <= 5 {
+			break
+		}
+		_, _, err = migrator.Down(ctx)
+		require.NoError(t, err)
+	}
+
+	// Logged out of order: the backfill follows the reading dates.
+	for _, row := range []struct {
+		pages int64
+		date  string
+	}{{20, "2024-02-03"}, {10, "2024-02-01"}, {5, "2024-02-03"}} {
+		_, err := db.Exec(`INSERT INTO progress (reading_id, user_id, pages, reading_date) VALUES (?, ?, ?, ?)`,
+			reading.ID, user.ID, row.pages, row.date)
+		require.NoError(t, err)
+	}
+	_, err = migrator.Up(ctx)
+	require.NoError(t, err)
+
+	progress, err := sqlite.NewProgressRepository(db).GetProgressByReadingID(ctx, reading.ID)
+	require.NoError(t, err)
+	require.Len(t, progress, 3)
+	assert.Equal(t, []int64{10, 30, 35}, []int64{progress[0].Position, progress[1].Position, progress[2].Position})
+}
+
+func TestProgressRepository_GetMonthlyProgress_NormalizesFormats(t *testing.T) {
+	db := setupDB(t)
+	ctx := context.Background()
+	user := createUser(t, db, "test@example.com")
+	book, err := sqlite.NewBookRepository(db).CreateBook(ctx, domain.Book{UserID: user.ID, Title: "Emma", PageCount: 474})
+	require.NoError(t, err)
+	readingRepo := sqlite.NewReadingRepository(db)
+	repo := sqlite.NewProgressRepository(db)
+	date := time.Date(2024, 3, 5, 0, 0, 0, 0, time.UTC)
+
+	for _, r := range []struct {
+		format string
+		total  int64
+		pages  []int64
+	}{
+		{domain.ReadingFormatPrint, 474, []int64{30}},
+		// 10 and 15 percent of 474 pages add up to 118 pages.
+		{domain.ReadingFormatEbook, 100, []int64{10, 15}},
+		{domain.ReadingFormatAudiobook, 720, []int64{45}},
+	} {
+		reading, err := readingRepo.CreateReading(ctx, domain.Reading{
+			UserID: user.ID, BookID: book.ID, Format: r.format, TotalPages: r.total, CreatedAt: time.Now(), UpdatedAt: time.Now(),
+		})
+		require.NoError(t, err)
+		for _, pages := range r.pages {
+			_, err := repo.CreateProgress(ctx, domain.Progress{UserID: user.ID, ReadingID: reading.ID, Pages: pages, ReadingDate: date})
+			require.NoError(t, err)
+		}
+	}
+
+	monthly, err := repo.GetMonthlyProgress(ctx, user.ID, 2024)
+	assert.NoError(t, err)
+	assert.Equal(t, []dto.Progress{{Date: "3", Pages: 148, Minutes: 45}}, monthly)
+
+	daily, err := repo.GetDailyProgress(ctx, user.ID, 2024, 3)
+	assert.NoError(t, err)
+	assert.Equal(t, []dto.Progress{{Date: "5", Pages: 148, Minutes: 45}}, daily)
+}
+
+func TestProgressRepository_GetReadingDays(t *testing.T) {
+	db := setupDB(t)
+	ctx := context.Background()
+	user := createUser(t, db, "test@example.com")
+	other := createUser(t, db, "other@example.com")
+	book := createBook(t, db, user.ID, "Emma")
+	reading, err := sqlite.NewReadingRepository(db).CreateReading(ctx, domain.Reading{
+		UserID: user.ID, BookID: book.ID, TotalPages: 474, CreatedAt: time.Now(), UpdatedAt: time.Now(),
+	})
+	require.NoError(t, err)
+	repo := sqlite.NewProgressRepository(db)
+
+	for _, p := range []domain.Progress{
+		{UserID: user.ID, Pages: 20, ReadingDate: time.Date(2024, 3, 5, 0, 0, 0, 0, time.UTC)},
+		{UserID: user.ID, Pages: 10, ReadingDate: time.Date(2024, 3, 5, 0, 0, 0, 0, time.UTC)},
+		// Backdated after the later day was logged.
+		{UserID: user.ID, Pages: 15, ReadingDate: time.Date(2024, 3, 4, 0, 0, 0, 0, time.UTC)},
+		// Going back a few pages is not reading.
+		{UserID: user.ID, Pages: -5, ReadingDate: time.Date(2024, 3, 6, 0, 0, 0, 0, time.UTC)},
+		{UserID: other.ID, Pages: 5, ReadingDate: time.Date(2024, 3, 7, 0, 0, 0, 0, time.UTC)},
+	} {
+		p.ReadingID = reading.ID
+		_, err := repo.CreateProgress(ctx, p)
+		require.NoError(t, err)
+	}
+
+	days, err := repo.GetReadingDays(ctx, user.ID)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []time.Time{
+		time.Date(2024, 3, 4, 0, 0, 0, 0, time.UTC),
+		time.Date(2024, 3, 5, 0, 0, 0, 0, time.UTC),
+	}, days)
+}
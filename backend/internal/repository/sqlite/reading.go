@@ -0,0 +1,236 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/rimvydascivilis/book-tracker/backend/domain"
+	"github.com/rimvydascivilis/book-tracker/backend/dto"
+)
+
+type ReadingRepository struct {
+	DB *sql.DB
+}
+
+func NewReadingRepository(db *sql.DB) *ReadingRepository {
+	return &ReadingRepository{
+		DB: db,
+	}
+}
+
+const readingColumns = `id, user_id, book_id, format, total_pages, COALESCE(link, ''), status, started_at, finished_at, target_date, created_at, updated_at`
+
+func scanReading(s scanner) (domain.Reading, error) {
+	var b domain.Reading
+	var startedAt, finishedAt, targetDate sql.NullString
+	err := s.Scan(&b.ID, &b.UserID, &b.BookID, &b.Format, &b.TotalPages, &b.Link, &b.Status, &startedAt, &finishedAt,
+		&targetDate, &b.CreatedAt, &b.UpdatedAt)
+	if err != nil {
+		return domain.Reading{}, err
+	}
+
+	if b.StartedAt, err = parseNullDate(startedAt); err != nil {
+		return domain.Reading{}, err
+	}
+	if b.FinishedAt, err = parseNullDate(finishedAt); err != nil {
+		return domain.Reading{}, err
+	}
+	if b.TargetDate, err = parseNullDate(targetDate); err != nil {
+		return domain.Reading{}, err
+	}
+	return b, nil
+}
+
+func (r *ReadingRepository) getAll(ctx context.Context, query string, args ...interface{}) ([]domain.Reading, error) {
+	rows, err := conn(ctx, r.DB).QueryContext(ctx, query, args...)
+	if err != nil {
+		return []domain.Reading{}, err
+	}
+	defer rows.Close()
+
+	res := []domain.Reading{}
+	for rows.Next() {
+		b, err := scanReading(rows)
+		if err != nil {
+			return []domain.Reading{}, err
+		}
+		res = append(res, b)
+	}
+
+	return res, rows.Err()
+}
+
+func (r *ReadingRepository) getOne(ctx context.Context, query string, args ...interface{}) (domain.Reading, error) {
+	b, err := scanReading(conn(ctx, r.DB).QueryRowContext(ctx, query, args...))
+	if err == sql.ErrNoRows {
+		return domain.Reading{}, fmt.Errorf("%w: %s", domain.ErrRecordNotFound, "reading")
+	}
+	if err != nil {
+		return domain.Reading{}, err
+	}
+
+	return b, nil
+}
+
+func (r *ReadingRepository) GetReadingsByUserID(ctx context.Context, userID int64, status string, offset, limit int64) ([]domain.Reading, error) {
+	query := `SELECT ` + readingColumns + `
+FROM reading WHERE user_id = ? AND (? = '' OR status = ?) ORDER BY created_at DESC, id DESC LIMIT ? OFFSET ?`
+	return r.getAll(ctx, query, userID, status, status, limit, offset)
+}
+
+// summaryColumns follow readingColumns in reading summaries: the title of
+// the book and the pages read so far.
+const summaryColumns = `(SELECT title FROM book WHERE book.id = reading.book_id),
+(SELECT COALESCE(SUM(pages), 0) FROM progress WHERE progress.reading_id = reading.id)`
+
+// withExtra scans the columns after the ones read by the wrapped scan into
+// extra.
+type withExtra struct {
+	scanner
+	extra []interface{}
+}
+
+func (s withExtra) Scan(dest ...interface{}) error {
+	return s.scanner.Scan(append(dest, s.extra...)...)
+}
+
+func (r *ReadingRepository) GetReadingSummariesByUserID(ctx context.Context, userID int64, status string, offset, limit int64) ([]domain.ReadingSummary, error) {
+	query := `SELECT ` + readingColumns + `, ` + summaryColumns + `
+FROM reading WHERE user_id = ? AND (? = '' OR status = ?) ORDER BY created_at DESC, id DESC LIMIT ? OFFSET ?`
+	rows, err := conn(ctx, r.DB).QueryContext(ctx, query, userID, status, status, limit, offset)
+	if err != nil {
+		return []domain.ReadingSummary{}, err
+	}
+	defer rows.Close()
+
+	res := []domain.ReadingSummary{}
+	for rows.Next() {
+		var summary domain.ReadingSummary
+		var title sql.NullString
+		summary.Reading, err = scanReading(withExtra{rows, []interface{}{&title, &summary.Progress}})
+		if err != nil {
+			return []domain.ReadingSummary{}, err
+		}
+		summary.BookTitle = title.String
+		res = append(res, summary)
+	}
+
+	return res, rows.Err()
+}
+
+func (r *ReadingRepository) GetReadingByID(ctx context.Context, id int64) (domain.Reading, error) {
+	query := `SELECT ` + readingColumns + ` FROM reading WHERE id = ?`
+	return r.getOne(ctx, query, id)
+}
+
+// GetReadingByIDForUpdate needs no row lock: transactions hold the only
+// connection, so they cannot interleave.
+func (r *ReadingRepository) GetReadingByIDForUpdate(ctx context.Context, id int64) (domain.Reading, error) {
+	return r.GetReadingByID(ctx, id)
+}
+
+func (r *ReadingRepository) GetReadingsByUserIDAndBookID(ctx context.Context, userID, bookID int64) ([]domain.Reading, error) {
+	query := `SELECT ` + readingColumns + `
+FROM reading WHERE user_id = ? AND book_id = ? ORDER BY created_at DESC, id DESC`
+	return r.getAll(ctx, query, userID, bookID)
+}
+
+func (r *ReadingRepository) CountReadingsByUserID(ctx context.Context, userID int64, status string) (int64, error) {
+	query := `SELECT COUNT(id) FROM reading WHERE user_id = ? AND (? = '' OR status = ?)`
+
+	var count int64
+	err := conn(ctx, r.DB).QueryRowContext(ctx, query, userID, status, status).Scan(&count)
+	if err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+func (r *ReadingRepository) getGrouped(ctx context.Context, query string, args ...interface{}) ([]dto.Progress, error) {
+	rows, err := conn(ctx, r.DB).QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var res []dto.Progress
+	for rows.Next() {
+		var p dto.Progress
+		if err := rows.Scan(&p.Date, &p.Books); err != nil {
+			return nil, err
+		}
+		res = append(res, p)
+	}
+
+	return res, rows.Err()
+}
+
+func (r *ReadingRepository) GetMonthlyFinishedReadings(ctx context.Context, userID, year int64) ([]dto.Progress, error) {
+	query := `
+SELECT
+	CAST(strftime('%m', finished_at) AS INTEGER) AS date,
+	COUNT(id) AS books
+FROM reading
+WHERE user_id = ?
+	AND status = 'completed'
+	AND CAST(strftime('%Y', finished_at) AS INTEGER) = ?
+GROUP BY date
+`
+	return r.getGrouped(ctx, query, userID, year)
+}
+
+func (r *ReadingRepository) GetDailyFinishedReadings(ctx context.Context, userID, year, month int64) ([]dto.Progress, error) {
+	query := `
+SELECT
+	CAST(strftime('%d', finished_at) AS INTEGER) AS date,
+	COUNT(id) AS books
+FROM reading
+WHERE user_id = ?
+	AND status = 'completed'
+	AND CAST(strftime('%Y', finished_at) AS INTEGER) = ?
+	AND CAST(strftime('%m', finished_at) AS INTEGER) = ?
+GROUP BY date
+`
+	return r.getGrouped(ctx, query, userID, year, month)
+}
+
+func (r *ReadingRepository) CreateReading(ctx context.Context, reading domain.Reading) (domain.Reading, error) {
+	query := `
+INSERT INTO reading (user_id, book_id, format, total_pages, link, status, started_at, finished_at, target_date, created_at, updated_at)
+VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`
+	res, err := conn(ctx, r.DB).ExecContext(ctx, query, reading.UserID, reading.BookID, reading.Format, reading.TotalPages, reading.Link, reading.Status,
+		nullDate(reading.StartedAt), nullDate(reading.FinishedAt), nullDate(reading.TargetDate), reading.CreatedAt, reading.UpdatedAt)
+	if err != nil {
+		return domain.Reading{}, err
+	}
+
+	id, err := res.LastInsertId()
+	if err != nil {
+		return domain.Reading{}, err
+	}
+
+	reading.ID = id
+	return reading, nil
+}
+
+func (r *ReadingRepository) UpdateReading(ctx context.Context, reading domain.Reading) (domain.Reading, error) {
+	query := `
+UPDATE reading SET total_pages = ?, link = ?, status = ?, started_at = ?, finished_at = ?, target_date = ?, updated_at = ?
+WHERE id = ?`
+	_, err := conn(ctx, r.DB).ExecContext(ctx, query, reading.TotalPages, reading.Link, reading.Status,
+		nullDate(reading.StartedAt), nullDate(reading.FinishedAt), nullDate(reading.TargetDate), reading.UpdatedAt, reading.ID)
+	if err != nil {
+		return domain.Reading{}, err
+	}
+
+	return reading, nil
+}
+
+// DeleteReading relies on the foreign key to delete the progress.
+func (r *ReadingRepository) DeleteReading(ctx context.Context, id int64) error {
+	query := `DELETE FROM reading WHERE id = ?`
+	_, err := conn(ctx, r.DB).ExecContext(ctx, query, id)
+	return err
+}
@@ -0,0 +1,145 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/rimvydascivilis/book-tracker/backend/domain"
+	sqlite "modernc.org/sqlite"
+	sqlite3 "modernc.org/sqlite/lib"
+)
+
+type ReadingSessionRepository struct {
+	DB *sql.DB
+}
+
+func NewReadingSessionRepository(db *sql.DB) *ReadingSessionRepository {
+	return &ReadingSessionRepository{
+		DB: db,
+	}
+}
+
+const readingSessionColumns = `id, user_id, reading_id, start_position, end_position, started_at, ended_at,
+duration, progress_id, auto_closed`
+
+func scanReadingSession(row scanner) (domain.ReadingSession, error) {
+	var (
+		session     domain.ReadingSession
+		endPosition sql.NullInt64
+		endedAt     sql.NullTime
+		progressID  sql.NullInt64
+	)
+	err := row.Scan(&session.ID, &session.UserID, &session.ReadingID, &session.StartPosition, &endPosition,
+		&session.StartedAt, &endedAt, &session.Duration, &progressID, &session.AutoClosed)
+	if err != nil {
+		return domain.ReadingSession{}, err
+	}
+
+	if endPosition.Valid {
+		session.EndPosition = &endPosition.Int64
+	}
+	if endedAt.Valid {
+		t := endedAt.Time.UTC()
+		session.EndedAt = &t
+	}
+	if progressID.Valid {
+		session.ProgressID = &progressID.Int64
+	}
+	session.StartedAt = session.StartedAt.UTC()
+	return session, nil
+}
+
+func (r *ReadingSessionRepository) getAll(ctx context.Context, query string, args ...interface{}) ([]domain.ReadingSession, error) {
+	rows, err := conn(ctx, r.DB).QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	sessions := []domain.ReadingSession{}
+	for rows.Next() {
+		session, err := scanReadingSession(rows)
+		if err != nil {
+			return nil, err
+		}
+		sessions = append(sessions, session)
+	}
+	return sessions, rows.Err()
+}
+
+func (r *ReadingSessionRepository) GetReadingSessionsByReadingID(ctx context.Context, readingID int64) ([]domain.ReadingSession, error) {
+	query := `SELECT ` + readingSessionColumns + `
+FROM reading_session WHERE reading_id = ? ORDER BY started_at DESC, id DESC`
+	return r.getAll(ctx, query, readingID)
+}
+
+// GetOpenReadingSessionForUpdate needs no row lock: transactions hold the
+// only connection, so they cannot interleave.
+func (r *ReadingSessionRepository) GetOpenReadingSessionForUpdate(ctx context.Context, userID int64) (domain.ReadingSession, error) {
+	query := `SELECT ` + readingSessionColumns + ` FROM reading_session WHERE user_id = ? AND ended_at IS NULL`
+
+	session, err := scanReadingSession(conn(ctx, r.DB).QueryRowContext(ctx, query, userID))
+	if err == sql.ErrNoRows {
+		return domain.ReadingSession{}, fmt.Errorf("%w: %s", domain.ErrRecordNotFound, "open reading session")
+	}
+	if err != nil {
+		return domain.ReadingSession{}, err
+	}
+	return session, nil
+}
+
+func (r *ReadingSessionRepository) GetOpenReadingSessionsStartedBefore(ctx context.Context, t time.Time) ([]domain.ReadingSession, error) {
+	query := `SELECT ` + readingSessionColumns + `
+FROM reading_session WHERE ended_at IS NULL AND started_at < ? ORDER BY id`
+	return r.getAll(ctx, query, t.UTC())
+}
+
+func (r *ReadingSessionRepository) CreateReadingSession(ctx context.Context, session domain.ReadingSession) (domain.ReadingSession, error) {
+	query := `
+INSERT INTO reading_session (user_id, reading_id, start_position, started_at)
+VALUES (?, ?, ?, ?)`
+	res, err := conn(ctx, r.DB).ExecContext(ctx, query, session.UserID, session.ReadingID, session.StartPosition,
+		session.StartedAt.UTC())
+	var sqliteErr *sqlite.Error
+	if errors.As(err, &sqliteErr) && sqliteErr.Code() == sqlite3.SQLITE_CONSTRAINT_UNIQUE {
+		return domain.ReadingSession{}, fmt.Errorf("%w: open reading session of user %d", domain.ErrAlreadyExists, session.UserID)
+	}
+	if err != nil {
+		return domain.ReadingSession{}, err
+	}
+
+	session.ID, err = res.LastInsertId()
+	if err != nil {
+		return domain.ReadingSession{}, err
+	}
+	return session, nil
+}
+
+func (r *ReadingSessionRepository) UpdateReadingSession(ctx context.Context, session domain.ReadingSession) (domain.ReadingSession, error) {
+	var endedAt interface{}
+	if session.EndedAt != nil {
+		endedAt = session.EndedAt.UTC()
+	}
+
+	query := `
+UPDATE reading_session
+SET end_position = ?, ended_at = ?, duration = ?, progress_id = ?, auto_closed = ?
+WHERE id = ?`
+	res, err := conn(ctx, r.DB).ExecContext(ctx, query, session.EndPosition, endedAt, session.Duration,
+		session.ProgressID, session.AutoClosed, session.ID)
+	if err != nil {
+		return domain.ReadingSession{}, err
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return domain.ReadingSession{}, err
+	}
+	if affected == 0 {
+		return domain.ReadingSession{}, fmt.Errorf("%w: %s", domain.ErrRecordNotFound, "reading session")
+	}
+	return session, nil
+}
@@ -0,0 +1,74 @@
+package sqlite_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/rimvydascivilis/book-tracker/backend/domain"
+	"github.com/rimvydascivilis/book-tracker/backend/internal/repository/sqlite"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadingSessionRepository(t *testing.T) {
+	db := setupDB(t)
+	ctx := context.Background()
+	user := createUser(t, db, "test@example.com")
+	book := createBook(t, db, user.ID, "Dune")
+	reading, err := sqlite.NewReadingRepository(db).CreateReading(ctx, domain.Reading{
+		UserID: user.ID, BookID: book.ID, TotalPages: 500, CreatedAt: time.Now(), UpdatedAt: time.Now(),
+	})
+	require.NoError(t, err)
+	repo := sqlite.NewReadingSessionRepository(db)
+	startedAt := time.Date(2024, 3, 1, 20, 0, 0, 0, time.UTC)
+
+	first, err := repo.CreateReadingSession(ctx, domain.ReadingSession{
+		UserID: user.ID, ReadingID: reading.ID, StartPosition: 10, StartedAt: startedAt,
+	})
+	require.NoError(t, err)
+
+	_, err = repo.CreateReadingSession(ctx, domain.ReadingSession{
+		UserID: user.ID, ReadingID: reading.ID, StartedAt: startedAt.Add(time.Hour),
+	})
+	assert.ErrorIs(t, err, domain.ErrAlreadyExists, "a user has one open session at a time")
+
+	stale, err := repo.GetOpenReadingSessionsStartedBefore(ctx, startedAt.Add(time.Minute))
+	require.NoError(t, err)
+	require.Len(t, stale, 1)
+	assert.Equal(t, first.ID, stale[0].ID)
+
+	progress, err := sqlite.NewProgressRepository(db).CreateProgress(ctx, domain.Progress{
+		UserID: user.ID, ReadingID: reading.ID, Pages: 30, Position: 40, ReadingDate: startedAt,
+	})
+	require.NoError(t, err)
+	endedAt := startedAt.Add(45 * time.Minute)
+	endPosition := int64(40)
+	first.EndedAt = &endedAt
+	first.EndPosition = &endPosition
+	first.Duration = 2700
+	first.ProgressID = &progress.ID
+	_, err = repo.UpdateReadingSession(ctx, first)
+	require.NoError(t, err)
+
+	_, err = repo.GetOpenReadingSessionForUpdate(ctx, user.ID)
+	assert.ErrorIs(t, err, domain.ErrRecordNotFound)
+	second, err := repo.CreateReadingSession(ctx, domain.ReadingSession{
+		UserID: user.ID, ReadingID: reading.ID, StartPosition: 40, StartedAt: startedAt.Add(time.Hour),
+	})
+	require.NoError(t, err)
+	open, err := repo.GetOpenReadingSessionForUpdate(ctx, user.ID)
+	require.NoError(t, err)
+	assert.Equal(t, second.ID, open.ID)
+
+	require.NoError(t, sqlite.NewProgressRepository(db).DeleteProgress(ctx, progress.ID))
+	sessions, err := repo.GetReadingSessionsByReadingID(ctx, reading.ID)
+	require.NoError(t, err)
+	require.Len(t, sessions, 2)
+	assert.Equal(t, second.ID, sessions[0].ID)
+	assert.Equal(t, startedAt, sessions[1].StartedAt)
+	require.NotNil(t, sessions[1].EndedAt)
+	assert.Equal(t, endedAt, *sessions[1].EndedAt)
+	assert.Equal(t, int64(2700), sessions[1].Duration)
+	assert.Nil(t, sessions[1].ProgressID, "deleting the progress keeps the session")
+}
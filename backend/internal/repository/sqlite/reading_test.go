@@ -0,0 +1,208 @@
+package sqlite_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/rimvydascivilis/book-tracker/backend/domain"
+	"github.com/rimvydascivilis/book-tracker/backend/dto"
+	"github.com/rimvydascivilis/book-tracker/backend/internal/repository/sqlite"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadingRepository_CreateAndList(t *testing.T) {
+	db := setupDB(t)
+	repo := sqlite.NewReadingRepository(db)
+	ctx := context.Background()
+	user := createUser(t, db, "test@example.com")
+	book := createBook(t, db, user.ID, "Dune")
+
+	created, err := repo.CreateReading(ctx, domain.Reading{
+		UserID: user.ID, BookID: book.ID, TotalPages: 300, CreatedAt: time.Now(), UpdatedAt: time.Now(),
+	})
+	assert.NoError(t, err)
+
+	got, err := repo.GetReadingByID(ctx, created.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(300), got.TotalPages)
+	assert.Equal(t, "", got.Link)
+
+	readings, err := repo.GetReadingsByUserID(ctx, user.ID, "", 0, 10)
+	assert.NoError(t, err)
+	assert.Len(t, readings, 1)
+
+	byBook, err := repo.GetReadingsByUserIDAndBookID(ctx, user.ID, book.ID)
+	assert.NoError(t, err)
+	assert.Len(t, byBook, 1)
+}
+
+func TestReadingRepository_GetReadingSummariesByUserID(t *testing.T) {
+	db := setupDB(t)
+	repo := sqlite.NewReadingRepository(db)
+	progressRepo := sqlite.NewProgressRepository(db)
+	ctx := context.Background()
+	user := createUser(t, db, "test@example.com")
+	dune := createBook(t, db, user.ID, "Dune")
+	emma := createBook(t, db, user.ID, "Emma")
+
+	first, err := repo.CreateReading(ctx, domain.Reading{
+		UserID: user.ID, BookID: dune.ID, TotalPages: 300, CreatedAt: time.Now().Add(-time.Hour), UpdatedAt: time.Now(),
+	})
+	require.NoError(t, err)
+	second, err := repo.CreateReading(ctx, domain.Reading{
+		UserID: user.ID, BookID: emma.ID, TotalPages: 200, CreatedAt: time.Now(), UpdatedAt: time.Now(),
+	})
+	require.NoError(t, err)
+	day := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	for _, pages := range []int64{20, 15} {
+		_, err := progressRepo.CreateProgress(ctx, domain.Progress{UserID: user.ID, ReadingID: first.ID, Pages: pages, ReadingDate: day})
+		require.NoError(t, err)
+		day = day.AddDate(0, 0, 1)
+	}
+
+	summaries, err := repo.GetReadingSummariesByUserID(ctx, user.ID, "", 0, 10)
+	require.NoError(t, err)
+	require.Len(t, summaries, 2)
+	assert.Equal(t, second.ID, summaries[0].ID)
+	assert.Equal(t, "Emma", summaries[0].BookTitle)
+	assert.Zero(t, summaries[0].Progress)
+	assert.Equal(t, "Dune", summaries[1].BookTitle)
+	assert.Equal(t, int64(35), summaries[1].Progress)
+
+	entries, err := progressRepo.GetProgressByReadingIDs(ctx, []int64{first.ID, second.ID})
+	require.NoError(t, err)
+	require.Len(t, entries[first.ID], 2)
+	assert.Equal(t, int64(20), entries[first.ID][0].Pages)
+	assert.Empty(t, entries[second.ID])
+}
+
+func TestReadingRepository_FinishedReadings(t *testing.T) {
+	db := setupDB(t)
+	repo := sqlite.NewReadingRepository(db)
+	ctx := context.Background()
+	user := createUser(t, db, "test@example.com")
+	book := createBook(t, db, user.ID, "Dune")
+
+	started := time.Date(2024, 1, 20, 0, 0, 0, 0, time.UTC)
+	for _, finished := range []time.Time{
+		time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(2024, 2, 14, 0, 0, 0, 0, time.UTC),
+		time.Date(2024, 3, 3, 0, 0, 0, 0, time.UTC),
+	} {
+		_, err := repo.CreateReading(ctx, domain.Reading{
+			UserID: user.ID, BookID: book.ID, TotalPages: 300, Status: domain.ReadingStatusCompleted,
+			StartedAt: &started, FinishedAt: &finished, CreatedAt: time.Now(), UpdatedAt: time.Now(),
+		})
+		require.NoError(t, err)
+	}
+	abandoned := time.Date(2024, 2, 20, 0, 0, 0, 0, time.UTC)
+	_, err := repo.CreateReading(ctx, domain.Reading{
+		UserID: user.ID, BookID: book.ID, TotalPages: 300, Status: domain.ReadingStatusAbandoned,
+		StartedAt: &started, FinishedAt: &abandoned, CreatedAt: time.Now(), UpdatedAt: time.Now(),
+	})
+	require.NoError(t, err)
+	_, err = repo.CreateReading(ctx, domain.Reading{
+		UserID: user.ID, BookID: book.ID, TotalPages: 300, Status: domain.ReadingStatusReading,
+		CreatedAt: time.Now(), UpdatedAt: time.Now(),
+	})
+	require.NoError(t, err)
+
+	readings, err := repo.GetReadingsByUserIDAndBookID(ctx, user.ID, book.ID)
+	require.NoError(t, err)
+	require.Len(t, readings, 5)
+	assert.Nil(t, readings[0].FinishedAt)
+	assert.Equal(t, domain.ReadingStatusReading, readings[0].Status)
+	require.NotNil(t, readings[2].FinishedAt)
+	assert.Equal(t, "2024-03-03", readings[2].FinishedAt.Format("2006-01-02"))
+	assert.Equal(t, started, *readings[2].StartedAt)
+
+	paged, err := repo.GetReadingsByUserID(ctx, user.ID, domain.ReadingStatusCompleted, 0, 10)
+	assert.NoError(t, err)
+	assert.Len(t, paged, 3)
+
+	total, err := repo.CountReadingsByUserID(ctx, user.ID, domain.ReadingStatusAbandoned)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), total)
+
+	progressRepo := sqlite.NewProgressRepository(db)
+	period, err := progressRepo.GetPeriodProgress(ctx, user.ID,
+		time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC), time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC))
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), period.Books)
+
+	feb14 := time.Date(2024, 2, 14, 0, 0, 0, 0, time.UTC)
+	period, err = progressRepo.GetPeriodProgress(ctx, user.ID, feb14, feb14)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), period.Books)
+
+	monthly, err := repo.GetMonthlyFinishedReadings(ctx, user.ID, 2024)
+	assert.NoError(t, err)
+	assert.Equal(t, []dto.Progress{{Date: "2", Books: 2}, {Date: "3", Books: 1}}, monthly)
+
+	daily, err := repo.GetDailyFinishedReadings(ctx, user.ID, 2024, 2)
+	assert.NoError(t, err)
+	assert.Equal(t, []dto.Progress{{Date: "1", Books: 1}, {Date: "14", Books: 1}}, daily)
+}
+
+func TestReadingRepository_UpdateReading(t *testing.T) {
+	db := setupDB(t)
+	repo := sqlite.NewReadingRepository(db)
+	ctx := context.Background()
+	user := createUser(t, db, "test@example.com")
+	book := createBook(t, db, user.ID, "Dune")
+
+	reading, err := repo.CreateReading(ctx, domain.Reading{
+		UserID: user.ID, BookID: book.ID, TotalPages: 300, CreatedAt: time.Now(), UpdatedAt: time.Now(),
+	})
+	require.NoError(t, err)
+
+	finished := time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)
+	target := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	reading.FinishedAt = &finished
+	reading.StartedAt = &finished
+	reading.TargetDate = &target
+	_, err = repo.UpdateReading(ctx, reading)
+	require.NoError(t, err)
+
+	got, err := repo.GetReadingByID(ctx, reading.ID)
+	require.NoError(t, err)
+	require.NotNil(t, got.FinishedAt)
+	assert.Equal(t, finished, *got.FinishedAt)
+	require.NotNil(t, got.TargetDate)
+	assert.Equal(t, target, *got.TargetDate)
+}
+
+func TestReadingRepository_GetReadingByID_NotFound(t *testing.T) {
+	db := setupDB(t)
+
+	_, err := sqlite.NewReadingRepository(db).GetReadingByID(context.Background(), 99)
+
+	assert.ErrorIs(t, err, domain.ErrRecordNotFound)
+}
+
+func TestReadingRepository_DeleteReading(t *testing.T) {
+	db := setupDB(t)
+	repo := sqlite.NewReadingRepository(db)
+	progressRepo := sqlite.NewProgressRepository(db)
+	ctx := context.Background()
+	user := createUser(t, db, "test@example.com")
+	book := createBook(t, db, user.ID, "Dune")
+
+	reading, err := repo.CreateReading(ctx, domain.Reading{
+		UserID: user.ID, BookID: book.ID, TotalPages: 300, CreatedAt: time.Now(), UpdatedAt: time.Now(),
+	})
+	require.NoError(t, err)
+	progress, err := progressRepo.CreateProgress(ctx, domain.Progress{
+		UserID: user.ID, ReadingID: reading.ID, Pages: 10, ReadingDate: time.Now(),
+	})
+	require.NoError(t, err)
+
+	require.NoError(t, repo.DeleteReading(ctx, reading.ID))
+
+	_, err = repo.GetReadingByID(ctx, reading.ID)
+	assert.ErrorIs(t, err, domain.ErrRecordNotFound)
+	_, err = progressRepo.GetProgressByID(ctx, progress.ID)
+	assert.ErrorIs(t, err, domain.ErrRecordNotFound)
+}
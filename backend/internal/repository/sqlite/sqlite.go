@@ -0,0 +1,60 @@
+package sqlite
+
+import (
+	"database/sql"
+	"strings"
+	"time"
+
+	_ "modernc.org/sqlite"
+)
+
+// Open opens the SQLite database at path with foreign keys enforced. SQLite
+// allows a single writer, so the pool is limited to one connection to avoid
+// SQLITE_BUSY errors under concurrent requests.
+func Open(path string) (*sql.DB, error) {
+	dsn := path
+	if !strings.HasPrefix(dsn, "file:") {
+		dsn = "file:" + dsn
+	}
+	sep := "?"
+	if strings.Contains(dsn, "?") {
+		sep = "&"
+	}
+	dsn += sep + "_pragma=foreign_keys(1)&_pragma=busy_timeout(5000)"
+
+	db, err := sql.Open("sqlite", dsn)
+	if err != nil {
+		return nil, err
+	}
+	db.SetMaxOpenConns(1)
+
+	return db, nil
+}
+
+const dateFormat = "2006-01-02"
+
+// nullDate stores an optional date as a YYYY-MM-DD string.
+func nullDate(t *time.Time) interface{} {
+	if t == nil {
+		return nil
+	}
+	return t.Format(dateFormat)
+}
+
+// parseNullDate reads back a date stored by nullDate. SQLite returns DATE
+// columns written by other tools with a time part, which is ignored.
+func parseNullDate(s sql.NullString) (*time.Time, error) {
+	if !s.Valid || s.String == "" {
+		return nil, nil
+	}
+
+	value := s.String
+	if len(value) > len(dateFormat) {
+		value = value[:len(dateFormat)]
+	}
+	t, err := time.Parse(dateFormat, value)
+	if err != nil {
+		return nil, err
+	}
+	return &t, nil
+}
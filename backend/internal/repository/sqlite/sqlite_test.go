@@ -0,0 +1,57 @@
+package sqlite_test
+
+import (
+	"context"
+	"database/sql"
+	"io/fs"
+	"testing"
+
+	"github.com/rimvydascivilis/book-tracker/backend/domain"
+	"github.com/rimvydascivilis/book-tracker/backend/internal/migration"
+	"github.com/rimvydascivilis/book-tracker/backend/internal/repository/sqlite"
+	"github.com/rimvydascivilis/book-tracker/backend/migrations"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	_ domain.UserRepository     = (*sqlite.UserRepository)(nil)
+	_ domain.BookRepository     = (*sqlite.BookRepository)(nil)
+	_ domain.GoalRepository     = (*sqlite.GoalRepository)(nil)
+	_ domain.ReadingRepository  = (*sqlite.ReadingRepository)(nil)
+	_ domain.ProgressRepository = (*sqlite.ProgressRepository)(nil)
+	_ domain.ListRepository     = (*sqlite.ListRepository)(nil)
+	_ domain.ListItemRepository = (*sqlite.ListItemRepository)(nil)
+	_ domain.NoteRepository     = (*sqlite.NoteRepository)(nil)
+	_ domain.ImportRepository   = (*sqlite.ImportRepository)(nil)
+)
+
+func setupDB(t testing.TB) *sql.DB {
+	db, err := sqlite.Open(":memory:")
+	require.NoError(t, err)
+	migrateDB(t, db)
+	return db
+}
+
+// migrateDB closes db when the test ends and brings it to the latest schema.
+func migrateDB(t testing.TB, db *sql.DB) {
+	t.Cleanup(func() { db.Close() })
+
+	migrationsFS, err := fs.Sub(migrations.SQLite, "sqlite")
+	require.NoError(t, err)
+	migrator, err := migration.NewMigrator(db, migrationsFS)
+	require.NoError(t, err)
+	_, err = migrator.Up(context.Background())
+	require.NoError(t, err)
+}
+
+func createUser(t testing.TB, db *sql.DB, email string) domain.User {
+	u, err := sqlite.NewUserRepository(db).CreateUser(context.Background(), domain.User{Email: email})
+	require.NoError(t, err)
+	return u
+}
+
+func createBook(t testing.TB, db *sql.DB, userID int64, title string) domain.Book {
+	b, err := sqlite.NewBookRepository(db).CreateBook(context.Background(), domain.Book{UserID: userID, Title: title, Rating: 4})
+	require.NoError(t, err)
+	return b
+}
@@ -0,0 +1,79 @@
+package sqlite_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/rimvydascivilis/book-tracker/backend/domain"
+	"github.com/rimvydascivilis/book-tracker/backend/internal/repository/sqlite"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTxManager_RollsBackOnError(t *testing.T) {
+	db := setupDB(t)
+	user := createUser(t, db, "test@example.com")
+	bookRepo := sqlite.NewBookRepository(db)
+	fnErr := errors.New("boom")
+
+	err := sqlite.NewTxManager(db).WithinTransaction(context.Background(), func(ctx context.Context) error {
+		if _, err := bookRepo.CreateBook(ctx, domain.Book{UserID: user.ID, Title: "Emma"}); err != nil {
+			return err
+		}
+		return fnErr
+	})
+
+	assert.ErrorIs(t, err, fnErr)
+	count, err := bookRepo.CountBooksByUser(context.Background(), user.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(0), count)
+}
+
+func TestTxManager_Commits(t *testing.T) {
+	db := setupDB(t)
+	user := createUser(t, db, "test@example.com")
+	bookRepo := sqlite.NewBookRepository(db)
+
+	err := sqlite.NewTxManager(db).WithinTransaction(context.Background(), func(ctx context.Context) error {
+		_, err := bookRepo.CreateBook(ctx, domain.Book{UserID: user.ID, Title: "Emma"})
+		return err
+	})
+
+	assert.NoError(t, err)
+	count, err := bookRepo.CountBooksByUser(context.Background(), user.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), count)
+}
+
+func TestTxManager_ReadOnlySeesOneSnapshot(t *testing.T) {
+	db := setupDB(t)
+	user := createUser(t, db, "test@example.com")
+	bookRepo := sqlite.NewBookRepository(db)
+	txManager := sqlite.NewTxManager(db)
+	before := int64(0)
+
+	written := make(chan error, 1)
+	err := txManager.WithinReadOnlyTransaction(context.Background(), func(ctx context.Context) error {
+		count, err := bookRepo.CountBooksByUser(ctx, user.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, before, count)
+
+		go func() {
+			_, err := bookRepo.CreateBook(context.Background(), domain.Book{UserID: user.ID, Title: "Emma"})
+			written <- err
+		}()
+		time.Sleep(50 * time.Millisecond)
+
+		count, err = bookRepo.CountBooksByUser(ctx, user.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, before, count, "the book is written once the transaction ends")
+		return nil
+	})
+
+	assert.NoError(t, err)
+	assert.NoError(t, <-written)
+	count, err := bookRepo.CountBooksByUser(context.Background(), user.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, before+1, count)
+}
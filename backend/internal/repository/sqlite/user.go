@@ -0,0 +1,98 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+	"time"
+
+	"github.com/rimvydascivilis/book-tracker/backend/domain"
+)
+
+type UserRepository struct {
+	DB *sql.DB
+}
+
+const userColumns = `id, email, timezone, rest_days, created_at`
+
+// userTimezone is the zone stored for u; users without one count days in UTC.
+func userTimezone(u domain.User) string {
+	if u.Timezone == "" {
+		return "UTC"
+	}
+	return u.Timezone
+}
+
+// splitRestDays reads back the comma separated rest days.
+func splitRestDays(s string) []string {
+	if s == "" {
+		return nil
+	}
+	return strings.Split(s, ",")
+}
+
+func NewUserRepository(db *sql.DB) *UserRepository {
+	return &UserRepository{
+		DB: db,
+	}
+}
+
+func (r *UserRepository) getOne(ctx context.Context, query string, args ...interface{}) (domain.User, error) {
+	u := domain.User{}
+	var restDays string
+	err := conn(ctx, r.DB).QueryRowContext(ctx, query, args...).Scan(&u.ID, &u.Email, &u.Timezone, &restDays, &u.CreatedAt)
+	if err == sql.ErrNoRows {
+		return domain.User{}, domain.ErrRecordNotFound
+	}
+	if err != nil {
+		return domain.User{}, err
+	}
+	u.RestDays = splitRestDays(restDays)
+	return u, nil
+}
+
+func (r *UserRepository) GetByEmail(ctx context.Context, email string) (domain.User, error) {
+	query := `SELECT ` + userColumns + ` FROM user WHERE email = ?`
+	return r.getOne(ctx, query, email)
+}
+
+func (r *UserRepository) GetByID(ctx context.Context, id int64) (domain.User, error) {
+	query := `SELECT ` + userColumns + ` FROM user WHERE id = ?`
+	return r.getOne(ctx, query, id)
+}
+
+func (r *UserRepository) CreateUser(ctx context.Context, u domain.User) (domain.User, error) {
+	query := `INSERT INTO user (email, timezone, rest_days, created_at) VALUES (?, ?, ?, ?)`
+	u.Timezone = userTimezone(u)
+	u.CreatedAt = time.Now()
+	res, err := conn(ctx, r.DB).ExecContext(ctx, query, u.Email, u.Timezone, strings.Join(u.RestDays, ","), u.CreatedAt)
+	if err != nil {
+		return domain.User{}, err
+	}
+
+	id, err := res.LastInsertId()
+	if err != nil {
+		return domain.User{}, err
+	}
+	u.ID = id
+
+	return u, nil
+}
+
+func (r *UserRepository) UpdateUser(ctx context.Context, u domain.User) (domain.User, error) {
+	query := `UPDATE user SET timezone = ?, rest_days = ? WHERE id = ?`
+	res, err := conn(ctx, r.DB).ExecContext(ctx, query, userTimezone(u), strings.Join(u.RestDays, ","), u.ID)
+	if err != nil {
+		return domain.User{}, err
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return domain.User{}, err
+	}
+	if affected == 0 {
+		return domain.User{}, domain.ErrRecordNotFound
+	}
+	u.Timezone = userTimezone(u)
+	return u, nil
+}
@@ -0,0 +1,59 @@
+package sqlite_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/rimvydascivilis/book-tracker/backend/domain"
+	"github.com/rimvydascivilis/book-tracker/backend/internal/repository/sqlite"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUserRepository_CreateAndGet(t *testing.T) {
+	db := setupDB(t)
+	repo := sqlite.NewUserRepository(db)
+	ctx := context.Background()
+
+	created, err := repo.CreateUser(ctx, domain.User{Email: "test@example.com"})
+	assert.NoError(t, err)
+	assert.NotZero(t, created.ID)
+
+	byEmail, err := repo.GetByEmail(ctx, "test@example.com")
+	assert.NoError(t, err)
+	assert.Equal(t, created.ID, byEmail.ID)
+
+	byID, err := repo.GetByID(ctx, created.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, "test@example.com", byID.Email)
+}
+
+func TestUserRepository_GetByEmail_NotFound(t *testing.T) {
+	db := setupDB(t)
+	repo := sqlite.NewUserRepository(db)
+
+	_, err := repo.GetByEmail(context.Background(), "missing@example.com")
+
+	assert.ErrorIs(t, err, domain.ErrRecordNotFound)
+}
+
+func TestUserRepository_UpdateUser(t *testing.T) {
+	db := setupDB(t)
+	repo := sqlite.NewUserRepository(db)
+	ctx := context.Background()
+	created, err := repo.CreateUser(ctx, domain.User{Email: "test@example.com"})
+	assert.NoError(t, err)
+	assert.Equal(t, "UTC", created.Timezone)
+
+	created.Timezone = "Europe/Vilnius"
+	created.RestDays = []string{"saturday", "sunday"}
+	_, err = repo.UpdateUser(ctx, created)
+	assert.NoError(t, err)
+
+	byID, err := repo.GetByID(ctx, created.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, "Europe/Vilnius", byID.Timezone)
+	assert.Equal(t, []string{"saturday", "sunday"}, byID.RestDays)
+
+	_, err = repo.UpdateUser(ctx, domain.User{ID: created.ID + 1})
+	assert.ErrorIs(t, err, domain.ErrRecordNotFound)
+}
@@ -0,0 +1,99 @@
+package rest
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+	"github.com/rimvydascivilis/book-tracker/backend/domain"
+	"github.com/rimvydascivilis/book-tracker/backend/dto"
+	"github.com/rimvydascivilis/book-tracker/backend/utils"
+)
+
+type AccessTokenHandler struct {
+	AccessTokenSvc domain.AccessTokenService
+}
+
+func NewAccessTokenHandler(accessTokenSvc domain.AccessTokenService) *AccessTokenHandler {
+	return &AccessTokenHandler{
+		AccessTokenSvc: accessTokenSvc,
+	}
+}
+
+func (h *AccessTokenHandler) GetAccessTokens(c echo.Context) error {
+	ctx := c.Request().Context()
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	userID, err := getUserIDFromToken(c)
+	if err != nil {
+		utils.Error("failed to get user id from token", err)
+		return c.JSON(http.StatusUnauthorized, ResponseError{Message: "Invalid token"})
+	}
+
+	tokens, err := h.AccessTokenSvc.GetAccessTokens(ctx, userID)
+	if err != nil {
+		return handleServiceError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"tokens": tokens,
+	})
+}
+
+// CreateAccessToken responds with the token itself, which cannot be
+// retrieved later.
+func (h *AccessTokenHandler) CreateAccessToken(c echo.Context) error {
+	ctx := c.Request().Context()
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	var req dto.AccessTokenRequest
+	if err := c.Bind(&req); err != nil {
+		utils.Error("failed to bind request", err)
+		return c.JSON(http.StatusBadRequest, ResponseError{Message: "invalid request format"})
+	}
+
+	userID, err := getUserIDFromToken(c)
+	if err != nil {
+		utils.Error("failed to get user id from token", err)
+		return c.JSON(http.StatusUnauthorized, ResponseError{Message: "Invalid token"})
+	}
+
+	token, secret, err := h.AccessTokenSvc.CreateAccessToken(ctx, userID, req)
+	if err != nil {
+		return handleServiceError(c, err)
+	}
+
+	return c.JSON(http.StatusCreated, map[string]interface{}{
+		"access_token": token,
+		"token":        secret,
+	})
+}
+
+func (h *AccessTokenHandler) DeleteAccessToken(c echo.Context) error {
+	ctx := c.Request().Context()
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	tokenID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, ResponseError{Message: "invalid token id"})
+	}
+
+	userID, err := getUserIDFromToken(c)
+	if err != nil {
+		utils.Error("failed to get user id from token", err)
+		return c.JSON(http.StatusUnauthorized, ResponseError{Message: "Invalid token"})
+	}
+
+	if err := h.AccessTokenSvc.DeleteAccessToken(ctx, userID, tokenID); err != nil {
+		return handleServiceError(c, err)
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
@@ -0,0 +1,62 @@
+package rest_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/rimvydascivilis/book-tracker/backend/domain"
+	"github.com/rimvydascivilis/book-tracker/backend/dto"
+	"github.com/rimvydascivilis/book-tracker/backend/internal/rest"
+	"github.com/rimvydascivilis/book-tracker/backend/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestCreateAccessToken(t *testing.T) {
+	mockSvc := new(mocks.AccessTokenService)
+	handler := rest.NewAccessTokenHandler(mockSvc)
+
+	mockSvc.On("CreateAccessToken", mock.Anything, int64(1),
+		dto.AccessTokenRequest{Name: "Kobo sync", Scope: domain.AccessTokenScopeProgressWrite}).
+		Return(domain.AccessToken{ID: 3, UserID: 1, Name: "Kobo sync", Scope: domain.AccessTokenScopeProgressWrite},
+			"btpat_secret", nil)
+
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodPost, "/auth/tokens",
+		strings.NewReader(`{"name":"Kobo sync","scope":"progress-write"}`))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.Set("user", &mockJWTToken)
+
+	err := handler.CreateAccessToken(c)
+
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusCreated, rec.Code)
+	assert.Contains(t, rec.Body.String(), `"token":"btpat_secret"`)
+	assert.NotContains(t, rec.Body.String(), "token_hash")
+	mockSvc.AssertExpectations(t)
+}
+
+func TestDeleteAccessToken_OtherUsers(t *testing.T) {
+	mockSvc := new(mocks.AccessTokenService)
+	handler := rest.NewAccessTokenHandler(mockSvc)
+
+	mockSvc.On("DeleteAccessToken", mock.Anything, int64(1), int64(3)).Return(domain.ErrForbidden)
+
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodDelete, "/auth/tokens/3", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetParamNames("id")
+	c.SetParamValues("3")
+	c.Set("user", &mockJWTToken)
+
+	err := handler.DeleteAccessToken(c)
+
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusForbidden, rec.Code)
+}
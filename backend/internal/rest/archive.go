@@ -0,0 +1,89 @@
+package rest
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/rimvydascivilis/book-tracker/backend/domain"
+	"github.com/rimvydascivilis/book-tracker/backend/utils"
+)
+
+const maxArchiveFileSize = 100 << 20
+
+type ArchiveHandler struct {
+	ArchiveSvc domain.ArchiveService
+}
+
+func NewArchiveHandler(archiveSvc domain.ArchiveService) *ArchiveHandler {
+	return &ArchiveHandler{
+		ArchiveSvc: archiveSvc,
+	}
+}
+
+// Export sends the archive once it is built. Failures before the first byte
+// is written get an error status; once it is written the status can no
+// longer change, so later failures only show up as a truncated archive and in
+// the logs.
+func (h *ArchiveHandler) Export(c echo.Context) error {
+	ctx := c.Request().Context()
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	userID, err := getUserIDFromToken(c)
+	if err != nil {
+		utils.Error("failed to get user id from token", err)
+		return c.JSON(http.StatusUnauthorized, ResponseError{Message: "Invalid token"})
+	}
+
+	filename := fmt.Sprintf("book-tracker-%s.zip", utils.Now().Format("2006-01-02"))
+	c.Response().Header().Set(echo.HeaderContentType, "application/zip")
+	c.Response().Header().Set(echo.HeaderContentDisposition, fmt.Sprintf("attachment; filename=%q", filename))
+
+	if err := h.ArchiveSvc.Export(ctx, userID, c.Response()); err != nil {
+		if !c.Response().Committed {
+			c.Response().Header().Del(echo.HeaderContentDisposition)
+			return handleServiceError(c, err)
+		}
+		utils.Error("failed to export account", err)
+	}
+	return nil
+}
+
+func (h *ArchiveHandler) Restore(c echo.Context) error {
+	ctx := c.Request().Context()
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	userID, err := getUserIDFromToken(c)
+	if err != nil {
+		utils.Error("failed to get user id from token", err)
+		return c.JSON(http.StatusUnauthorized, ResponseError{Message: "Invalid token"})
+	}
+
+	fileHeader, err := c.FormFile("file")
+	if err != nil {
+		utils.Error("failed to read uploaded file", err)
+		return c.JSON(http.StatusBadRequest, ResponseError{Message: "a zip archive is required in the file field"})
+	}
+	if fileHeader.Size > maxArchiveFileSize {
+		return c.JSON(http.StatusRequestEntityTooLarge, ResponseError{Message: "file is too large"})
+	}
+
+	file, err := fileHeader.Open()
+	if err != nil {
+		utils.Error("failed to open uploaded file", err)
+		return c.JSON(http.StatusBadRequest, ResponseError{Message: "a zip archive is required in the file field"})
+	}
+	defer file.Close()
+
+	manifest, err := h.ArchiveSvc.Restore(ctx, userID, file, fileHeader.Size)
+	if err != nil {
+		return handleServiceError(c, err)
+	}
+
+	return c.JSON(http.StatusCreated, manifest)
+}
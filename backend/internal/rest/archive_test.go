@@ -0,0 +1,130 @@
+package rest_test
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/rimvydascivilis/book-tracker/backend/domain"
+	"github.com/rimvydascivilis/book-tracker/backend/internal/rest"
+	"github.com/rimvydascivilis/book-tracker/backend/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestExport(t *testing.T) {
+	mockSvc := new(mocks.ArchiveService)
+	handler := rest.NewArchiveHandler(mockSvc)
+
+	mockSvc.On("Export", mock.Anything, int64(1), mock.Anything).
+		Run(func(args mock.Arguments) {
+			_, _ = io.WriteString(args.Get(2).(io.Writer), "zip data")
+		}).
+		Return(nil)
+
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, "/export", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.Set("user", &mockJWTToken)
+
+	err := handler.Export(c)
+
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "application/zip", rec.Header().Get(echo.HeaderContentType))
+	assert.Contains(t, rec.Header().Get(echo.HeaderContentDisposition), "attachment")
+	assert.Equal(t, "zip data", rec.Body.String())
+}
+
+func TestExport_FailsBeforeWriting(t *testing.T) {
+	mockSvc := new(mocks.ArchiveService)
+	handler := rest.NewArchiveHandler(mockSvc)
+
+	mockSvc.On("Export", mock.Anything, int64(1), mock.Anything).Return(errors.New("database is gone"))
+
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, "/export", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.Set("user", &mockJWTToken)
+
+	err := handler.Export(c)
+
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusInternalServerError, rec.Code)
+	assert.Empty(t, rec.Header().Get(echo.HeaderContentDisposition))
+}
+
+func newArchiveRequest(t *testing.T, field string, content []byte) *http.Request {
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+	part, err := writer.CreateFormFile(field, "book-tracker.zip")
+	assert.NoError(t, err)
+	_, err = part.Write(content)
+	assert.NoError(t, err)
+	assert.NoError(t, writer.Close())
+
+	req := httptest.NewRequest(http.MethodPost, "/import/archive", body)
+	req.Header.Set(echo.HeaderContentType, writer.FormDataContentType())
+	return req
+}
+
+func TestRestore(t *testing.T) {
+	mockSvc := new(mocks.ArchiveService)
+	handler := rest.NewArchiveHandler(mockSvc)
+
+	mockSvc.On("Restore", mock.Anything, int64(1), mock.Anything, int64(8)).
+		Return(domain.ArchiveManifest{Format: domain.ArchiveFormat, Entities: map[string]int64{"books": 2}}, nil)
+
+	e := echo.New()
+	rec := httptest.NewRecorder()
+	c := e.NewContext(newArchiveRequest(t, "file", []byte("zip data")), rec)
+	c.Set("user", &mockJWTToken)
+
+	err := handler.Restore(c)
+
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusCreated, rec.Code)
+	assert.Contains(t, rec.Body.String(), `"books":2`)
+}
+
+func TestRestore_MissingFile(t *testing.T) {
+	mockSvc := new(mocks.ArchiveService)
+	handler := rest.NewArchiveHandler(mockSvc)
+
+	e := echo.New()
+	rec := httptest.NewRecorder()
+	c := e.NewContext(newArchiveRequest(t, "other", []byte("zip data")), rec)
+	c.Set("user", &mockJWTToken)
+
+	err := handler.Restore(c)
+
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	mockSvc.AssertNotCalled(t, "Restore", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestRestore_AccountNotEmpty(t *testing.T) {
+	mockSvc := new(mocks.ArchiveService)
+	handler := rest.NewArchiveHandler(mockSvc)
+
+	mockSvc.On("Restore", mock.Anything, int64(1), mock.Anything, mock.Anything).
+		Return(domain.ArchiveManifest{}, fmt.Errorf("%w: %s", domain.ErrAlreadyExists, "account is not empty"))
+
+	e := echo.New()
+	rec := httptest.NewRecorder()
+	c := e.NewContext(newArchiveRequest(t, "file", []byte("zip data")), rec)
+	c.Set("user", &mockJWTToken)
+
+	err := handler.Restore(c)
+
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusConflict, rec.Code)
+}
@@ -3,8 +3,10 @@ package rest
 import (
 	"context"
 	"net/http"
+	"strconv"
 
 	"github.com/rimvydascivilis/book-tracker/backend/domain"
+	"github.com/rimvydascivilis/book-tracker/backend/dto"
 	"github.com/rimvydascivilis/book-tracker/backend/utils"
 
 	"github.com/labstack/echo/v4"
@@ -41,10 +43,107 @@ func (a *AuthHandler) Login(c echo.Context) error {
 		return c.JSON(http.StatusBadRequest, ResponseError{Message: "missing token"})
 	}
 
-	jwtToken, err := a.AuthSvc.Login(ctx, req.Token)
+	tokens, err := a.AuthSvc.Login(ctx, req.Token, c.Request().UserAgent())
 	if err != nil {
 		return handleServiceError(c, err)
 	}
 
-	return c.JSON(http.StatusOK, map[string]string{"token": jwtToken})
+	return c.JSON(http.StatusOK, tokens)
+}
+
+func (a *AuthHandler) Refresh(c echo.Context) error {
+	ctx := c.Request().Context()
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	var req dto.RefreshRequest
+	if err := c.Bind(&req); err != nil {
+		utils.Error("failed to bind request", err)
+		return c.JSON(http.StatusBadRequest, ResponseError{Message: "invalid request format"})
+	}
+
+	if req.RefreshToken == "" {
+		return c.JSON(http.StatusBadRequest, ResponseError{Message: "missing refresh token"})
+	}
+
+	tokens, err := a.AuthSvc.Refresh(ctx, req.RefreshToken)
+	if err != nil {
+		return handleServiceError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, tokens)
+}
+
+// Logout revokes the session of the token the request was made with.
+func (a *AuthHandler) Logout(c echo.Context) error {
+	ctx := c.Request().Context()
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	userID, err := getUserIDFromToken(c)
+	if err != nil {
+		utils.Error("failed to get user id from token", err)
+		return c.JSON(http.StatusUnauthorized, ResponseError{Message: "Invalid token"})
+	}
+	sessionID, err := getSessionIDFromToken(c)
+	if err != nil {
+		utils.Error("failed to get session id from token", err)
+		return c.JSON(http.StatusUnauthorized, ResponseError{Message: "Invalid token"})
+	}
+
+	if err := a.AuthSvc.RevokeSession(ctx, userID, sessionID); err != nil {
+		return handleServiceError(c, err)
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+func (a *AuthHandler) GetSessions(c echo.Context) error {
+	ctx := c.Request().Context()
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	userID, err := getUserIDFromToken(c)
+	if err != nil {
+		utils.Error("failed to get user id from token", err)
+		return c.JSON(http.StatusUnauthorized, ResponseError{Message: "Invalid token"})
+	}
+	// Tokens issued before sessions existed have none to mark as current.
+	sessionID, _ := getSessionIDFromToken(c)
+
+	sessions, err := a.AuthSvc.GetSessions(ctx, userID, sessionID)
+	if err != nil {
+		return handleServiceError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"sessions": sessions,
+	})
+}
+
+func (a *AuthHandler) DeleteSession(c echo.Context) error {
+	ctx := c.Request().Context()
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	sessionID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, ResponseError{Message: "invalid session id"})
+	}
+
+	userID, err := getUserIDFromToken(c)
+	if err != nil {
+		utils.Error("failed to get user id from token", err)
+		return c.JSON(http.StatusUnauthorized, ResponseError{Message: "Invalid token"})
+	}
+
+	if err := a.AuthSvc.RevokeSession(ctx, userID, sessionID); err != nil {
+		return handleServiceError(c, err)
+	}
+
+	return c.NoContent(http.StatusNoContent)
 }
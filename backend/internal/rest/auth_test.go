@@ -9,6 +9,7 @@ import (
 	"testing"
 
 	"github.com/rimvydascivilis/book-tracker/backend/domain"
+	"github.com/rimvydascivilis/book-tracker/backend/dto"
 	"github.com/rimvydascivilis/book-tracker/backend/internal/rest"
 	"github.com/rimvydascivilis/book-tracker/backend/mocks"
 
@@ -30,11 +31,13 @@ func TestAuthHandler_Login_Success(t *testing.T) {
 	rec := httptest.NewRecorder()
 	c := e.NewContext(req, rec)
 
-	mockAuthService.On("Login", mock.Anything, "valid_token").Return("jwt_token", nil)
+	req.Header.Set("User-Agent", "Firefox")
+	mockAuthService.On("Login", mock.Anything, "valid_token", "Firefox").
+		Return(dto.TokenResponse{Token: "jwt_token", RefreshToken: "refresh_token", ExpiresIn: 900}, nil)
 
 	if assert.NoError(t, handler.Login(c)) {
 		assert.Equal(t, http.StatusOK, rec.Code)
-		assert.JSONEq(t, `{"token":"jwt_token"}`, rec.Body.String())
+		assert.JSONEq(t, `{"token":"jwt_token","refresh_token":"refresh_token","expires_in":900}`, rec.Body.String())
 	}
 
 	mockAuthService.AssertExpectations(t)
@@ -86,7 +89,7 @@ func TestAuthHandler_Login_Failure(t *testing.T) {
 	rec := httptest.NewRecorder()
 	c := e.NewContext(req, rec)
 
-	mockAuthService.On("Login", mock.Anything, "invalid_token").Return("", fmt.Errorf("%w: %s", domain.ErrAuthentication, "invalid token"))
+	mockAuthService.On("Login", mock.Anything, "invalid_token", mock.Anything).Return(dto.TokenResponse{}, fmt.Errorf("%w: %s", domain.ErrAuthentication, "invalid token"))
 
 	if assert.NoError(t, handler.Login(c)) {
 		assert.Equal(t, http.StatusUnauthorized, rec.Code)
@@ -95,3 +98,123 @@ func TestAuthHandler_Login_Failure(t *testing.T) {
 
 	mockAuthService.AssertExpectations(t)
 }
+
+func TestAuthHandler_Refresh_Reused(t *testing.T) {
+	e := echo.New()
+
+	mockAuthService := new(mocks.AuthService)
+	handler := rest.NewAuthHandler(mockAuthService)
+
+	requestBody, _ := json.Marshal(map[string]string{"refresh_token": "used_token"})
+	req := httptest.NewRequest(http.MethodPost, "/auth/refresh", bytes.NewBuffer(requestBody))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	mockAuthService.On("Refresh", mock.Anything, "used_token").
+		Return(dto.TokenResponse{}, fmt.Errorf("%w: %s", domain.ErrAuthentication, "refresh token already used, session revoked"))
+
+	if assert.NoError(t, handler.Refresh(c)) {
+		assert.Equal(t, http.StatusUnauthorized, rec.Code)
+	}
+
+	mockAuthService.AssertExpectations(t)
+}
+
+func TestAuthHandler_Refresh_MissingToken(t *testing.T) {
+	e := echo.New()
+	handler := rest.NewAuthHandler(new(mocks.AuthService))
+
+	req := httptest.NewRequest(http.MethodPost, "/auth/refresh", bytes.NewBufferString(`{}`))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	if assert.NoError(t, handler.Refresh(c)) {
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+		assert.JSONEq(t, `{"message":"missing refresh token"}`, rec.Body.String())
+	}
+}
+
+func TestAuthHandler_Logout(t *testing.T) {
+	e := echo.New()
+
+	mockAuthService := new(mocks.AuthService)
+	handler := rest.NewAuthHandler(mockAuthService)
+
+	req := httptest.NewRequest(http.MethodPost, "/auth/logout", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.Set("user", &mockSessionJWTToken)
+
+	mockAuthService.On("RevokeSession", mock.Anything, int64(1), int64(4)).Return(nil)
+
+	if assert.NoError(t, handler.Logout(c)) {
+		assert.Equal(t, http.StatusNoContent, rec.Code)
+	}
+
+	mockAuthService.AssertExpectations(t)
+}
+
+func TestAuthHandler_Logout_TokenWithoutSession(t *testing.T) {
+	e := echo.New()
+	mockAuthService := new(mocks.AuthService)
+	handler := rest.NewAuthHandler(mockAuthService)
+
+	req := httptest.NewRequest(http.MethodPost, "/auth/logout", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.Set("user", &mockJWTToken)
+
+	if assert.NoError(t, handler.Logout(c)) {
+		assert.Equal(t, http.StatusUnauthorized, rec.Code)
+	}
+	mockAuthService.AssertNotCalled(t, "RevokeSession", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestAuthHandler_GetSessions(t *testing.T) {
+	e := echo.New()
+
+	mockAuthService := new(mocks.AuthService)
+	handler := rest.NewAuthHandler(mockAuthService)
+
+	req := httptest.NewRequest(http.MethodGet, "/auth/sessions", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.Set("user", &mockSessionJWTToken)
+
+	mockAuthService.On("GetSessions", mock.Anything, int64(1), int64(4)).
+		Return([]dto.SessionResponse{{ID: 4, UserAgent: "Firefox", Current: true}}, nil)
+
+	if assert.NoError(t, handler.GetSessions(c)) {
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Contains(t, rec.Body.String(), `"current":true`)
+	}
+
+	mockAuthService.AssertExpectations(t)
+}
+
+func TestAuthHandler_DeleteSession_OtherUsers(t *testing.T) {
+	e := echo.New()
+
+	mockAuthService := new(mocks.AuthService)
+	handler := rest.NewAuthHandler(mockAuthService)
+
+	req := httptest.NewRequest(http.MethodDelete, "/auth/sessions/9", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetParamNames("id")
+	c.SetParamValues("9")
+	c.Set("user", &mockSessionJWTToken)
+
+	mockAuthService.On("RevokeSession", mock.Anything, int64(1), int64(9)).
+		Return(fmt.Errorf("%w: %s", domain.ErrForbidden, "session does not belong to user"))
+
+	if assert.NoError(t, handler.DeleteSession(c)) {
+		assert.Equal(t, http.StatusForbidden, rec.Code)
+	}
+
+	mockAuthService.AssertExpectations(t)
+}
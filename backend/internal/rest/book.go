@@ -58,10 +58,44 @@ func (a *BookHandler) SearchBooks(c echo.Context) error {
 		return c.JSON(http.StatusUnauthorized, ResponseError{Message: "Invalid token"})
 	}
 
-	title := c.QueryParam("title")
+	// "title" is the parameter older clients send; "q" also matches authors and ISBNs.
+	query := c.QueryParam("q")
+	if query == "" {
+		query = c.QueryParam("title")
+	}
 	limit := getInt64QueryParam(c, "limit", 10)
 
-	books, err := a.BookSvc.SearchBooks(ctx, userID, title, limit)
+	books, err := a.BookSvc.SearchBooks(ctx, userID, query, limit)
+	if err != nil {
+		return handleServiceError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, books)
+}
+
+// LookupBook resolves ?isbn= into a single prefilled book, or ?title= and
+// ?author= into a list of candidates.
+func (a *BookHandler) LookupBook(c echo.Context) error {
+	ctx := c.Request().Context()
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	if _, err := getUserIDFromToken(c); err != nil {
+		utils.Error("failed to get user id from token", err)
+		return c.JSON(http.StatusUnauthorized, ResponseError{Message: "Invalid token"})
+	}
+
+	if isbn := c.QueryParam("isbn"); isbn != "" {
+		book, err := a.BookSvc.LookupBook(ctx, isbn)
+		if err != nil {
+			return handleServiceError(c, err)
+		}
+		return c.JSON(http.StatusOK, book)
+	}
+
+	limit := getInt64QueryParam(c, "limit", 10)
+	books, err := a.BookSvc.SearchMetadata(ctx, c.QueryParam("title"), c.QueryParam("author"), limit)
 	if err != nil {
 		return handleServiceError(c, err)
 	}
@@ -69,13 +103,19 @@ func (a *BookHandler) SearchBooks(c echo.Context) error {
 	return c.JSON(http.StatusOK, books)
 }
 
+// createBookRequest is a book with an optional ISBN to prefill it from.
+type createBookRequest struct {
+	domain.Book
+	ISBN string `json:"isbn"`
+}
+
 func (a *BookHandler) CreateBook(c echo.Context) error {
 	ctx := c.Request().Context()
 	if ctx == nil {
 		ctx = context.Background()
 	}
 
-	var req domain.Book
+	var req createBookRequest
 	if err := c.Bind(&req); err != nil {
 		utils.Error("failed to bind request", err)
 		return c.JSON(http.StatusBadRequest, ResponseError{Message: "invalid request format"})
@@ -87,7 +127,12 @@ func (a *BookHandler) CreateBook(c echo.Context) error {
 		return c.JSON(http.StatusUnauthorized, ResponseError{Message: "Invalid token"})
 	}
 
-	book, err := a.BookSvc.CreateBook(ctx, userID, req)
+	var book domain.Book
+	if req.ISBN != "" {
+		book, err = a.BookSvc.CreateBookFromISBN(ctx, userID, req.ISBN, req.Book)
+	} else {
+		book, err = a.BookSvc.CreateBook(ctx, userID, req.Book)
+	}
 	if err != nil {
 		return handleServiceError(c, err)
 	}
@@ -170,3 +170,128 @@ func TestDeleteBook(t *testing.T) {
 	assert.Equal(t, http.StatusNoContent, rec.Code)
 	assert.Equal(t, "", rec.Body.String())
 }
+
+func TestSearchBooks(t *testing.T) {
+	tests := []struct {
+		name  string
+		url   string
+		query string
+	}{
+		{"query", "/books/search?q=herbert", "herbert"},
+		{"legacy title", "/books/search?title=dune", "dune"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockSvc := new(mocks.BookService)
+			handler := rest.NewBookHandler(mockSvc)
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodGet, tt.url, nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.Set("user", &mockJWTToken)
+
+			books := []domain.Book{{ID: 1, Title: "Dune", Authors: []domain.Author{{ID: 1, Name: "Frank Herbert"}}}}
+			mockSvc.On("SearchBooks", mock.Anything, int64(1), tt.query, int64(10)).Return(books, nil)
+
+			err := handler.SearchBooks(c)
+
+			assert.NoError(t, err)
+			assert.Equal(t, http.StatusOK, rec.Code)
+
+			var response []domain.Book
+			assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+			assert.Equal(t, books, response)
+			mockSvc.AssertExpectations(t)
+		})
+	}
+}
+
+func TestCreateBook_FromISBN(t *testing.T) {
+	mockSvc := new(mocks.BookService)
+	handler := rest.NewBookHandler(mockSvc)
+
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodPost, "/books", bytes.NewBufferString(`{"isbn": "978-0-13-235088-4", "rating": 5}`))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.Set("user", &mockJWTToken)
+
+	createdBook := domain.Book{ID: 1, Title: "Clean Code", Rating: 5, ISBN13: "9780132350884"}
+	mockSvc.On("CreateBookFromISBN", mock.Anything, int64(1), "978-0-13-235088-4", domain.Book{Rating: 5}).
+		Return(createdBook, nil)
+
+	err := handler.CreateBook(c)
+
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusCreated, rec.Code)
+	mockSvc.AssertNotCalled(t, "CreateBook", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestLookupBook(t *testing.T) {
+	tests := []struct {
+		name       string
+		url        string
+		setup      func(*mocks.BookService)
+		wantStatus int
+	}{
+		{
+			name: "by isbn",
+			url:  "/books/lookup?isbn=9780132350884",
+			setup: func(m *mocks.BookService) {
+				m.On("LookupBook", mock.Anything, "9780132350884").
+					Return(domain.Book{Title: "Clean Code", ISBN13: "9780132350884"}, nil)
+			},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name: "unknown isbn",
+			url:  "/books/lookup?isbn=9780000000002",
+			setup: func(m *mocks.BookService) {
+				m.On("LookupBook", mock.Anything, "9780000000002").
+					Return(domain.Book{}, fmt.Errorf("%w: %s", domain.ErrRecordNotFound, "no book with this ISBN"))
+			},
+			wantStatus: http.StatusNotFound,
+		},
+		{
+			name: "upstream failure",
+			url:  "/books/lookup?isbn=9780132350884",
+			setup: func(m *mocks.BookService) {
+				m.On("LookupBook", mock.Anything, "9780132350884").
+					Return(domain.Book{}, fmt.Errorf("%w: %s", domain.ErrUpstream, "timeout"))
+			},
+			wantStatus: http.StatusBadGateway,
+		},
+		{
+			name: "by title and author",
+			url:  "/books/lookup?title=Clean%20Code&author=Martin&limit=5",
+			setup: func(m *mocks.BookService) {
+				m.On("SearchMetadata", mock.Anything, "Clean Code", "Martin", int64(5)).
+					Return([]domain.Book{{Title: "Clean Code"}}, nil)
+			},
+			wantStatus: http.StatusOK,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockSvc := new(mocks.BookService)
+			handler := rest.NewBookHandler(mockSvc)
+			tt.setup(mockSvc)
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodGet, tt.url, nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.Set("user", &mockJWTToken)
+
+			err := handler.LookupBook(c)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantStatus, rec.Code)
+			mockSvc.AssertExpectations(t)
+		})
+	}
+}
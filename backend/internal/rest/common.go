@@ -46,6 +46,27 @@ func getUserIDFromToken(c echo.Context) (int64, error) {
 	return int64(userID), nil
 }
 
+// getSessionIDFromToken returns the auth session the access token was
+// issued for.
+func getSessionIDFromToken(c echo.Context) (int64, error) {
+	token, ok := c.Get("user").(*jwt.Token)
+	if !ok {
+		return 0, errors.New("user token not found")
+	}
+
+	claims, ok := token.Claims.(jwt.MapClaims)
+	if !ok {
+		return 0, errors.New("invalid token claims")
+	}
+
+	sessionID, ok := claims["sid"].(float64)
+	if !ok {
+		return 0, errors.New("session ID not found in token")
+	}
+
+	return int64(sessionID), nil
+}
+
 func getPaginationParams(c echo.Context) (int64, int64) {
 	page := getInt64QueryParam(c, "page", 1)
 	limit := getInt64QueryParam(c, "limit", 10)
@@ -68,6 +89,13 @@ func handleServiceError(c echo.Context, err error) error {
 	if errors.Is(err, domain.ErrForbidden) {
 		return c.JSON(http.StatusForbidden, ResponseError{Message: err.Error()})
 	}
+	if errors.Is(err, domain.ErrTooManyAttempts) {
+		return c.JSON(http.StatusTooManyRequests, ResponseError{Message: err.Error()})
+	}
+	if errors.Is(err, domain.ErrUpstream) {
+		utils.Error("upstream service failed", err)
+		return c.JSON(http.StatusBadGateway, ResponseError{Message: "upstream service error"})
+	}
 
 	utils.Error("failed to handle request", err)
 	return c.JSON(http.StatusInternalServerError, ResponseError{Message: "server error"})
@@ -9,3 +9,10 @@ var mockJWTToken = jwt.Token{
 		"id": float64(1),
 	},
 }
+
+var mockSessionJWTToken = jwt.Token{
+	Claims: jwt.MapClaims{
+		"id":  float64(1),
+		"sid": float64(4),
+	},
+}
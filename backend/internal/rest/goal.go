@@ -2,10 +2,14 @@ package rest
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"net/http"
+	"strconv"
 
 	"github.com/labstack/echo/v4"
 	"github.com/rimvydascivilis/book-tracker/backend/domain"
+	"github.com/rimvydascivilis/book-tracker/backend/dto"
 	"github.com/rimvydascivilis/book-tracker/backend/utils"
 )
 
@@ -19,7 +23,7 @@ func NewGoalHandler(goalSvc domain.GoalService) *GoalHandler {
 	}
 }
 
-func (h *GoalHandler) GetGoal(c echo.Context) error {
+func (h *GoalHandler) GetGoals(c echo.Context) error {
 	ctx := c.Request().Context()
 	if ctx == nil {
 		ctx = context.Background()
@@ -31,12 +35,14 @@ func (h *GoalHandler) GetGoal(c echo.Context) error {
 		return c.JSON(http.StatusUnauthorized, ResponseError{Message: "Invalid token"})
 	}
 
-	goal, err := h.GoalSvc.GetGoal(ctx, userID)
+	goals, err := h.GoalSvc.GetGoals(ctx, userID)
 	if err != nil {
 		return handleServiceError(c, err)
 	}
 
-	return c.JSON(http.StatusOK, goal)
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"goals": goals,
+	})
 }
 
 func (h *GoalHandler) GetGoalProgress(c echo.Context) error {
@@ -59,7 +65,179 @@ func (h *GoalHandler) GetGoalProgress(c echo.Context) error {
 	return c.JSON(http.StatusOK, progress)
 }
 
-func (h *GoalHandler) SetGoal(c echo.Context) error {
+func (h *GoalHandler) GetGoalHistory(c echo.Context) error {
+	ctx := c.Request().Context()
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	userID, err := getUserIDFromToken(c)
+	if err != nil {
+		utils.Error("failed to get user id from token", err)
+		return c.JSON(http.StatusUnauthorized, ResponseError{Message: "Invalid token"})
+	}
+
+	history, err := h.GoalSvc.GetGoalHistory(ctx, userID)
+	if err != nil {
+		return handleServiceError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, history)
+}
+
+func (h *GoalHandler) CreateGoal(c echo.Context) error {
+	ctx := c.Request().Context()
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	var req domain.Goal
+	if err := c.Bind(&req); err != nil {
+		utils.Error("failed to bind request", err)
+		return c.JSON(http.StatusBadRequest, ResponseError{Message: "invalid request format"})
+	}
+
+	userID, err := getUserIDFromToken(c)
+	if err != nil {
+		utils.Error("failed to get user id from token", err)
+		return c.JSON(http.StatusUnauthorized, ResponseError{Message: "Invalid token"})
+	}
+
+	goal, err := h.GoalSvc.CreateGoal(ctx, userID, req)
+	if err != nil {
+		return handleServiceError(c, err)
+	}
+
+	return c.JSON(http.StatusCreated, goal)
+}
+
+func (h *GoalHandler) UpdateGoal(c echo.Context) error {
+	ctx := c.Request().Context()
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	var req domain.Goal
+	if err := c.Bind(&req); err != nil {
+		utils.Error("failed to bind request", err)
+		return c.JSON(http.StatusBadRequest, ResponseError{Message: "invalid request format"})
+	}
+
+	userID, err := getUserIDFromToken(c)
+	if err != nil {
+		utils.Error("failed to get user id from token", err)
+		return c.JSON(http.StatusUnauthorized, ResponseError{Message: "Invalid token"})
+	}
+
+	goalID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		utils.Error("failed to parse goal id", err)
+		return c.JSON(http.StatusBadRequest, ResponseError{Message: "invalid goal id"})
+	}
+
+	goal, err := h.GoalSvc.UpdateGoal(ctx, userID, goalID, req)
+	if err != nil {
+		return handleServiceError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, goal)
+}
+
+func (h *GoalHandler) DeleteGoal(c echo.Context) error {
+	ctx := c.Request().Context()
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	userID, err := getUserIDFromToken(c)
+	if err != nil {
+		utils.Error("failed to get user id from token", err)
+		return c.JSON(http.StatusUnauthorized, ResponseError{Message: "Invalid token"})
+	}
+
+	goalID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		utils.Error("failed to parse goal id", err)
+		return c.JSON(http.StatusBadRequest, ResponseError{Message: "invalid goal id"})
+	}
+
+	if err := h.GoalSvc.DeleteGoal(ctx, userID, goalID); err != nil {
+		return handleServiceError(c, err)
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+// The handlers below keep the single goal API that came before /goals
+// working for clients that have not moved yet. They act on the oldest goal of
+// the user, which is the one that API created.
+
+// firstGoal returns the oldest goal of the user, or ErrRecordNotFound.
+func (h *GoalHandler) firstGoal(ctx context.Context, userID int64) (domain.Goal, error) {
+	goals, err := h.GoalSvc.GetGoals(ctx, userID)
+	if err != nil {
+		return domain.Goal{}, err
+	}
+	if len(goals) == 0 {
+		return domain.Goal{}, fmt.Errorf("%w: %s", domain.ErrRecordNotFound, "goal")
+	}
+	return goals[0], nil
+}
+
+// GetLegacyGoal is the deprecated GET /goal.
+func (h *GoalHandler) GetLegacyGoal(c echo.Context) error {
+	ctx := c.Request().Context()
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	userID, err := getUserIDFromToken(c)
+	if err != nil {
+		utils.Error("failed to get user id from token", err)
+		return c.JSON(http.StatusUnauthorized, ResponseError{Message: "Invalid token"})
+	}
+
+	goal, err := h.firstGoal(ctx, userID)
+	if err != nil {
+		return handleServiceError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, goal)
+}
+
+// GetLegacyGoalProgress is the deprecated GET /goal/progress.
+func (h *GoalHandler) GetLegacyGoalProgress(c echo.Context) error {
+	ctx := c.Request().Context()
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	userID, err := getUserIDFromToken(c)
+	if err != nil {
+		utils.Error("failed to get user id from token", err)
+		return c.JSON(http.StatusUnauthorized, ResponseError{Message: "Invalid token"})
+	}
+
+	goal, err := h.firstGoal(ctx, userID)
+	if err != nil {
+		return handleServiceError(c, err)
+	}
+	progress, err := h.GoalSvc.GetGoalProgress(ctx, userID)
+	if err != nil {
+		return handleServiceError(c, err)
+	}
+
+	for _, p := range progress.Goals {
+		if p.GoalID == goal.ID {
+			return c.JSON(http.StatusOK, dto.LegacyGoalProgressResponse{Percentage: p.Percentage, Left: p.Left})
+		}
+	}
+	return handleServiceError(c, fmt.Errorf("%w: %s", domain.ErrRecordNotFound, "goal progress"))
+}
+
+// SetLegacyGoal is the deprecated PUT /goal: it updates the oldest goal of the
+// user, or creates it.
+func (h *GoalHandler) SetLegacyGoal(c echo.Context) error {
 	ctx := c.Request().Context()
 	if ctx == nil {
 		ctx = context.Background()
@@ -77,7 +255,13 @@ func (h *GoalHandler) SetGoal(c echo.Context) error {
 		return c.JSON(http.StatusUnauthorized, ResponseError{Message: "Invalid token"})
 	}
 
-	goal, err := h.GoalSvc.SetGoal(ctx, userID, req)
+	goal, err := h.firstGoal(ctx, userID)
+	switch {
+	case errors.Is(err, domain.ErrRecordNotFound):
+		goal, err = h.GoalSvc.CreateGoal(ctx, userID, req)
+	case err == nil:
+		goal, err = h.GoalSvc.UpdateGoal(ctx, userID, goal.ID, req)
+	}
 	if err != nil {
 		return handleServiceError(c, err)
 	}
@@ -10,87 +10,89 @@ import (
 
 	"github.com/labstack/echo/v4"
 	"github.com/rimvydascivilis/book-tracker/backend/domain"
+	"github.com/rimvydascivilis/book-tracker/backend/dto"
 	"github.com/rimvydascivilis/book-tracker/backend/internal/rest"
 	"github.com/rimvydascivilis/book-tracker/backend/mocks"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 )
 
-func TestGetGoal_Success(t *testing.T) {
+func TestGetGoals_Success(t *testing.T) {
 	mockSvc := new(mocks.GoalService)
 	handler := rest.NewGoalHandler(mockSvc)
 
 	e := echo.New()
-	req := httptest.NewRequest(http.MethodGet, "/goal", nil)
+	req := httptest.NewRequest(http.MethodGet, "/goals", nil)
 	rec := httptest.NewRecorder()
 	c := e.NewContext(req, rec)
 	c.Set("user", &mockJWTToken)
 
-	expectedGoal := domain.Goal{
-		UserID:    1,
-		Type:      "books",
-		Frequency: "daily",
-		Value:     10,
+	expectedGoals := []domain.Goal{
+		{ID: 1, UserID: 1, Type: "pages", Frequency: "daily", Value: 20},
+		{ID: 2, UserID: 1, Type: "books", Frequency: "yearly", Value: 24},
 	}
-	mockSvc.On("GetGoal", mock.Anything, int64(1)).Return(expectedGoal, nil)
+	mockSvc.On("GetGoals", mock.Anything, int64(1)).Return(expectedGoals, nil)
 
-	err := handler.GetGoal(c)
+	err := handler.GetGoals(c)
 
 	assert.NoError(t, err)
 	assert.Equal(t, http.StatusOK, rec.Code)
 
-	var responseGoal domain.Goal
-	err = json.Unmarshal(rec.Body.Bytes(), &responseGoal)
+	var response struct {
+		Goals []domain.Goal `json:"goals"`
+	}
+	err = json.Unmarshal(rec.Body.Bytes(), &response)
 	assert.NoError(t, err)
-	assert.Equal(t, expectedGoal, responseGoal)
+	assert.Equal(t, expectedGoals, response.Goals)
 }
 
-func TestGetGoal_InvalidToken(t *testing.T) {
+func TestGetGoals_InvalidToken(t *testing.T) {
 	mockSvc := new(mocks.GoalService)
 	handler := rest.NewGoalHandler(mockSvc)
 
 	e := echo.New()
-	req := httptest.NewRequest(http.MethodGet, "/goal", nil)
+	req := httptest.NewRequest(http.MethodGet, "/goals", nil)
 	rec := httptest.NewRecorder()
 	c := e.NewContext(req, rec)
 
-	err := handler.GetGoal(c)
+	err := handler.GetGoals(c)
 
 	assert.NoError(t, err)
 	assert.Equal(t, http.StatusUnauthorized, rec.Code)
 	assert.Contains(t, rec.Body.String(), "Invalid token")
 }
 
-func TestSetGoal_CreateGoal_Success(t *testing.T) {
+func TestCreateGoal_Success(t *testing.T) {
 	mockSvc := new(mocks.GoalService)
 	handler := rest.NewGoalHandler(mockSvc)
 
 	e := echo.New()
 	reqGoal := domain.Goal{
 		Type:      "books",
-		Frequency: "daily",
-		Value:     10,
+		Frequency: "yearly",
+		Value:     24,
 	}
 	body, _ := json.Marshal(reqGoal)
 
-	req := httptest.NewRequest(http.MethodPost, "/goal", bytes.NewReader(body))
+	req := httptest.NewRequest(http.MethodPost, "/goals", bytes.NewReader(body))
 	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
 	rec := httptest.NewRecorder()
 	c := e.NewContext(req, rec)
 	c.Set("user", &mockJWTToken)
 
 	expectedGoal := domain.Goal{
+		ID:        3,
 		UserID:    1,
 		Type:      "books",
-		Frequency: "daily",
-		Value:     10,
+		Frequency: "yearly",
+		Value:     24,
 	}
-	mockSvc.On("SetGoal", mock.Anything, int64(1), reqGoal).Return(expectedGoal, nil)
+	mockSvc.On("CreateGoal", mock.Anything, int64(1), reqGoal).Return(expectedGoal, nil)
 
-	err := handler.SetGoal(c)
+	err := handler.CreateGoal(c)
 
 	assert.NoError(t, err)
-	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, http.StatusCreated, rec.Code)
 
 	var responseGoal domain.Goal
 	err = json.Unmarshal(rec.Body.Bytes(), &responseGoal)
@@ -98,53 +100,194 @@ func TestSetGoal_CreateGoal_Success(t *testing.T) {
 	assert.Equal(t, expectedGoal, responseGoal)
 }
 
-func TestSetGoal_InvalidToken(t *testing.T) {
+func TestCreateGoal_AlreadyExists(t *testing.T) {
 	mockSvc := new(mocks.GoalService)
 	handler := rest.NewGoalHandler(mockSvc)
 
 	e := echo.New()
 	reqGoal := domain.Goal{
-		Type:      "books",
+		Type:      "pages",
 		Frequency: "daily",
 		Value:     10,
 	}
 	body, _ := json.Marshal(reqGoal)
 
-	req := httptest.NewRequest(http.MethodPost, "/goal", bytes.NewReader(body))
+	req := httptest.NewRequest(http.MethodPost, "/goals", bytes.NewReader(body))
 	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
 	rec := httptest.NewRecorder()
 	c := e.NewContext(req, rec)
+	c.Set("user", &mockJWTToken)
 
-	err := handler.SetGoal(c)
+	mockSvc.On("CreateGoal", mock.Anything, int64(1), reqGoal).
+		Return(domain.Goal{}, fmt.Errorf("%w: daily pages goal", domain.ErrAlreadyExists))
+
+	err := handler.CreateGoal(c)
 
 	assert.NoError(t, err)
-	assert.Equal(t, http.StatusUnauthorized, rec.Code)
-	assert.Contains(t, rec.Body.String(), "Invalid token")
+	assert.Equal(t, http.StatusConflict, rec.Code)
 }
 
-func TestSetGoal_ServiceError(t *testing.T) {
+func TestUpdateGoal_ServiceError(t *testing.T) {
 	mockSvc := new(mocks.GoalService)
 	handler := rest.NewGoalHandler(mockSvc)
 
 	e := echo.New()
 	reqGoal := domain.Goal{
-		Type:      "books",
-		Frequency: "daily",
-		Value:     10,
+		Value: 10,
 	}
 	body, _ := json.Marshal(reqGoal)
 
-	req := httptest.NewRequest(http.MethodPost, "/goal", bytes.NewReader(body))
+	req := httptest.NewRequest(http.MethodPut, "/goals/4", bytes.NewReader(body))
 	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
 	rec := httptest.NewRecorder()
 	c := e.NewContext(req, rec)
+	c.SetParamNames("id")
+	c.SetParamValues("4")
 	c.Set("user", &mockJWTToken)
 
-	mockSvc.On("SetGoal", mock.Anything, int64(1), reqGoal).Return(domain.Goal{}, fmt.Errorf("service error"))
+	mockSvc.On("UpdateGoal", mock.Anything, int64(1), int64(4), reqGoal).Return(domain.Goal{}, fmt.Errorf("service error"))
 
-	err := handler.SetGoal(c)
+	err := handler.UpdateGoal(c)
 
 	assert.NoError(t, err)
 	assert.Equal(t, http.StatusInternalServerError, rec.Code)
 	assert.Contains(t, rec.Body.String(), "server error")
 }
+
+func TestDeleteGoal_InvalidID(t *testing.T) {
+	mockSvc := new(mocks.GoalService)
+	handler := rest.NewGoalHandler(mockSvc)
+
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodDelete, "/goals/abc", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetParamNames("id")
+	c.SetParamValues("abc")
+	c.Set("user", &mockJWTToken)
+
+	err := handler.DeleteGoal(c)
+
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	mockSvc.AssertNotCalled(t, "DeleteGoal", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestGetGoalHistory_Success(t *testing.T) {
+	mockSvc := new(mocks.GoalService)
+	handler := rest.NewGoalHandler(mockSvc)
+
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, "/goals/history", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.Set("user", &mockJWTToken)
+
+	mockSvc.On("GetGoalHistory", mock.Anything, int64(1)).Return(dto.GoalHistoryResponse{Periods: []dto.GoalPeriod{
+		{GoalID: 1, Type: "pages", Frequency: "monthly", Value: 300, Achieved: 320, Hit: true, PeriodStart: "2024-02-01", PeriodEnd: "2024-02-29"},
+	}}, nil)
+
+	err := handler.GetGoalHistory(c)
+
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Contains(t, rec.Body.String(), `"hit":true`)
+	assert.Contains(t, rec.Body.String(), `"period_end":"2024-02-29"`)
+}
+
+func TestGetLegacyGoal_NoGoal(t *testing.T) {
+	mockSvc := new(mocks.GoalService)
+	handler := rest.NewGoalHandler(mockSvc)
+
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, "/goal", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.Set("user", &mockJWTToken)
+
+	mockSvc.On("GetGoals", mock.Anything, int64(1)).Return([]domain.Goal{}, nil)
+
+	err := handler.GetLegacyGoal(c)
+
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
+
+func TestGetLegacyGoalProgress_OldestGoal(t *testing.T) {
+	mockSvc := new(mocks.GoalService)
+	handler := rest.NewGoalHandler(mockSvc)
+
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, "/goal/progress", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.Set("user", &mockJWTToken)
+
+	mockSvc.On("GetGoals", mock.Anything, int64(1)).Return([]domain.Goal{
+		{ID: 1, UserID: 1, Type: "pages", Frequency: "daily", Value: 20},
+		{ID: 2, UserID: 1, Type: "books", Frequency: "yearly", Value: 24},
+	}, nil)
+	mockSvc.On("GetGoalProgress", mock.Anything, int64(1)).Return(dto.GoalProgressResponse{
+		Goals: []dto.GoalProgress{
+			{GoalID: 2, Percentage: 25, Left: 18},
+			{GoalID: 1, Percentage: 50, Left: 10},
+		},
+	}, nil)
+
+	err := handler.GetLegacyGoalProgress(c)
+
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.JSONEq(t, `{"percentage": 50, "left": 10}`, rec.Body.String())
+}
+
+func TestSetLegacyGoal_UpdatesOldestGoal(t *testing.T) {
+	mockSvc := new(mocks.GoalService)
+	handler := rest.NewGoalHandler(mockSvc)
+
+	e := echo.New()
+	reqGoal := domain.Goal{Value: 30}
+	body, _ := json.Marshal(reqGoal)
+
+	req := httptest.NewRequest(http.MethodPut, "/goal", bytes.NewReader(body))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.Set("user", &mockJWTToken)
+
+	expectedGoal := domain.Goal{ID: 1, UserID: 1, Type: "pages", Frequency: "daily", Value: 30}
+	mockSvc.On("GetGoals", mock.Anything, int64(1)).Return([]domain.Goal{
+		{ID: 1, UserID: 1, Type: "pages", Frequency: "daily", Value: 20},
+	}, nil)
+	mockSvc.On("UpdateGoal", mock.Anything, int64(1), int64(1), reqGoal).Return(expectedGoal, nil)
+
+	err := handler.SetLegacyGoal(c)
+
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	mockSvc.AssertNotCalled(t, "CreateGoal", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestSetLegacyGoal_CreatesGoal(t *testing.T) {
+	mockSvc := new(mocks.GoalService)
+	handler := rest.NewGoalHandler(mockSvc)
+
+	e := echo.New()
+	reqGoal := domain.Goal{Type: "pages", Frequency: "daily", Value: 20}
+	body, _ := json.Marshal(reqGoal)
+
+	req := httptest.NewRequest(http.MethodPut, "/goal", bytes.NewReader(body))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.Set("user", &mockJWTToken)
+
+	mockSvc.On("GetGoals", mock.Anything, int64(1)).Return([]domain.Goal{}, nil)
+	mockSvc.On("CreateGoal", mock.Anything, int64(1), reqGoal).
+		Return(domain.Goal{ID: 1, UserID: 1, Type: "pages", Frequency: "daily", Value: 20}, nil)
+
+	err := handler.SetLegacyGoal(c)
+
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, rec.Code)
+}
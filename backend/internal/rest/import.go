@@ -0,0 +1,105 @@
+package rest
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+	"github.com/rimvydascivilis/book-tracker/backend/domain"
+	"github.com/rimvydascivilis/book-tracker/backend/utils"
+)
+
+// maxImportFileSize is comfortably above the size of a Goodreads export of a
+// few thousand books.
+const maxImportFileSize = 10 << 20
+
+type ImportHandler struct {
+	ImportSvc domain.ImportService
+}
+
+func NewImportHandler(importSvc domain.ImportService) *ImportHandler {
+	return &ImportHandler{
+		ImportSvc: importSvc,
+	}
+}
+
+// readUploadedFile reads the multipart file field named "file".
+func readUploadedFile(c echo.Context) ([]byte, error) {
+	fileHeader, err := c.FormFile("file")
+	if err != nil {
+		return nil, err
+	}
+
+	file, err := fileHeader.Open()
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	data, err := io.ReadAll(io.LimitReader(file, maxImportFileSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(data) > maxImportFileSize {
+		return nil, echo.ErrStatusRequestEntityTooLarge
+	}
+
+	return data, nil
+}
+
+func (h *ImportHandler) ImportGoodreads(c echo.Context) error {
+	ctx := c.Request().Context()
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	userID, err := getUserIDFromToken(c)
+	if err != nil {
+		utils.Error("failed to get user id from token", err)
+		return c.JSON(http.StatusUnauthorized, ResponseError{Message: "Invalid token"})
+	}
+
+	data, err := readUploadedFile(c)
+	if err == echo.ErrStatusRequestEntityTooLarge {
+		return c.JSON(http.StatusRequestEntityTooLarge, ResponseError{Message: "file is too large"})
+	}
+	if err != nil {
+		utils.Error("failed to read uploaded file", err)
+		return c.JSON(http.StatusBadRequest, ResponseError{Message: "a CSV file is required in the file field"})
+	}
+
+	job, err := h.ImportSvc.ImportGoodreads(ctx, userID, data)
+	if err != nil {
+		return handleServiceError(c, err)
+	}
+
+	return c.JSON(http.StatusAccepted, job)
+}
+
+func (h *ImportHandler) GetImportJob(c echo.Context) error {
+	ctx := c.Request().Context()
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	userID, err := getUserIDFromToken(c)
+	if err != nil {
+		utils.Error("failed to get user id from token", err)
+		return c.JSON(http.StatusUnauthorized, ResponseError{Message: "Invalid token"})
+	}
+
+	jobID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		utils.Error("failed to parse import job id", err)
+		return c.JSON(http.StatusBadRequest, ResponseError{Message: "invalid import job id"})
+	}
+
+	job, err := h.ImportSvc.GetImportJob(ctx, userID, jobID)
+	if err != nil {
+		return handleServiceError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, job)
+}
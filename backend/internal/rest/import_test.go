@@ -0,0 +1,111 @@
+package rest_test
+
+import (
+	"bytes"
+	"fmt"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/rimvydascivilis/book-tracker/backend/domain"
+	"github.com/rimvydascivilis/book-tracker/backend/internal/rest"
+	"github.com/rimvydascivilis/book-tracker/backend/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func newUploadRequest(t *testing.T, field string, content []byte) *http.Request {
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+	part, err := writer.CreateFormFile(field, "goodreads_library_export.csv")
+	assert.NoError(t, err)
+	_, err = part.Write(content)
+	assert.NoError(t, err)
+	assert.NoError(t, writer.Close())
+
+	req := httptest.NewRequest(http.MethodPost, "/import/goodreads", body)
+	req.Header.Set(echo.HeaderContentType, writer.FormDataContentType())
+	return req
+}
+
+func TestImportGoodreads(t *testing.T) {
+	mockSvc := new(mocks.ImportService)
+	handler := rest.NewImportHandler(mockSvc)
+
+	csv := []byte("Book Id,Title\n1,Dune\n")
+	mockSvc.On("ImportGoodreads", mock.Anything, int64(1), csv).
+		Return(domain.ImportJob{ID: 3, Status: domain.ImportStatusPending, TotalRows: 1}, nil)
+
+	e := echo.New()
+	rec := httptest.NewRecorder()
+	c := e.NewContext(newUploadRequest(t, "file", csv), rec)
+	c.Set("user", &mockJWTToken)
+
+	err := handler.ImportGoodreads(c)
+
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusAccepted, rec.Code)
+	assert.Contains(t, rec.Body.String(), `"status":"pending"`)
+}
+
+func TestImportGoodreads_MissingFile(t *testing.T) {
+	mockSvc := new(mocks.ImportService)
+	handler := rest.NewImportHandler(mockSvc)
+
+	e := echo.New()
+	rec := httptest.NewRecorder()
+	c := e.NewContext(newUploadRequest(t, "other", []byte("data")), rec)
+	c.Set("user", &mockJWTToken)
+
+	err := handler.ImportGoodreads(c)
+
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	mockSvc.AssertNotCalled(t, "ImportGoodreads", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestImportGoodreads_InvalidFile(t *testing.T) {
+	mockSvc := new(mocks.ImportService)
+	handler := rest.NewImportHandler(mockSvc)
+
+	mockSvc.On("ImportGoodreads", mock.Anything, int64(1), mock.Anything).
+		Return(domain.ImportJob{}, fmt.Errorf("%w: %s", domain.ErrValidation, "file is empty"))
+
+	e := echo.New()
+	rec := httptest.NewRecorder()
+	c := e.NewContext(newUploadRequest(t, "file", []byte{}), rec)
+	c.Set("user", &mockJWTToken)
+
+	err := handler.ImportGoodreads(c)
+
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+}
+
+func TestGetImportJob(t *testing.T) {
+	mockSvc := new(mocks.ImportService)
+	handler := rest.NewImportHandler(mockSvc)
+
+	mockSvc.On("GetImportJob", mock.Anything, int64(1), int64(3)).Return(domain.ImportJob{
+		ID:         3,
+		Status:     domain.ImportStatusCompleted,
+		FailedRows: 1,
+		Rows:       []domain.ImportRow{{RowNumber: 1, Status: domain.ImportRowStatusFailed, Error: "missing Book Id"}},
+	}, nil)
+
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, "/import/jobs/3", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetParamNames("id")
+	c.SetParamValues("3")
+	c.Set("user", &mockJWTToken)
+
+	err := handler.GetImportJob(c)
+
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Contains(t, rec.Body.String(), `"error":"missing Book Id"`)
+}
@@ -0,0 +1,138 @@
+package rest
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/rimvydascivilis/book-tracker/backend/domain"
+	"github.com/rimvydascivilis/book-tracker/backend/dto"
+	"github.com/rimvydascivilis/book-tracker/backend/utils"
+
+	"github.com/labstack/echo/v4"
+)
+
+// LocalAuthHandler serves registration and sign-in with an email and
+// password. Registering and asking for a reset answer 202 whether or not the
+// email has an account.
+type LocalAuthHandler struct {
+	LocalAuthSvc domain.LocalAuthService
+}
+
+func NewLocalAuthHandler(las domain.LocalAuthService) *LocalAuthHandler {
+	handler := &LocalAuthHandler{
+		LocalAuthSvc: las,
+	}
+	return handler
+}
+
+func (a *LocalAuthHandler) Register(c echo.Context) error {
+	ctx := c.Request().Context()
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	var req dto.PasswordRequest
+	if err := c.Bind(&req); err != nil {
+		utils.Error("failed to bind request", err)
+		return c.JSON(http.StatusBadRequest, ResponseError{Message: "invalid request format"})
+	}
+
+	if err := a.LocalAuthSvc.Register(ctx, req); err != nil {
+		return handleServiceError(c, err)
+	}
+
+	return c.NoContent(http.StatusAccepted)
+}
+
+func (a *LocalAuthHandler) Login(c echo.Context) error {
+	ctx := c.Request().Context()
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	var req dto.PasswordRequest
+	if err := c.Bind(&req); err != nil {
+		utils.Error("failed to bind request", err)
+		return c.JSON(http.StatusBadRequest, ResponseError{Message: "invalid request format"})
+	}
+
+	if req.Email == "" || req.Password == "" {
+		return c.JSON(http.StatusBadRequest, ResponseError{Message: "missing email or password"})
+	}
+
+	tokens, err := a.LocalAuthSvc.Login(ctx, req, c.Request().UserAgent())
+	if err != nil {
+		return handleServiceError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, tokens)
+}
+
+func (a *LocalAuthHandler) VerifyEmail(c echo.Context) error {
+	ctx := c.Request().Context()
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	var req dto.VerifyEmailRequest
+	if err := c.Bind(&req); err != nil {
+		utils.Error("failed to bind request", err)
+		return c.JSON(http.StatusBadRequest, ResponseError{Message: "invalid request format"})
+	}
+
+	if req.Token == "" {
+		return c.JSON(http.StatusBadRequest, ResponseError{Message: "missing token"})
+	}
+
+	if err := a.LocalAuthSvc.VerifyEmail(ctx, req.Token); err != nil {
+		return handleServiceError(c, err)
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+func (a *LocalAuthHandler) ForgotPassword(c echo.Context) error {
+	ctx := c.Request().Context()
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	var req dto.ForgotPasswordRequest
+	if err := c.Bind(&req); err != nil {
+		utils.Error("failed to bind request", err)
+		return c.JSON(http.StatusBadRequest, ResponseError{Message: "invalid request format"})
+	}
+
+	if req.Email == "" {
+		return c.JSON(http.StatusBadRequest, ResponseError{Message: "missing email"})
+	}
+
+	if err := a.LocalAuthSvc.RequestPasswordReset(ctx, req.Email); err != nil {
+		return handleServiceError(c, err)
+	}
+
+	return c.NoContent(http.StatusAccepted)
+}
+
+func (a *LocalAuthHandler) ResetPassword(c echo.Context) error {
+	ctx := c.Request().Context()
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	var req dto.PasswordResetRequest
+	if err := c.Bind(&req); err != nil {
+		utils.Error("failed to bind request", err)
+		return c.JSON(http.StatusBadRequest, ResponseError{Message: "invalid request format"})
+	}
+
+	if req.Token == "" {
+		return c.JSON(http.StatusBadRequest, ResponseError{Message: "missing token"})
+	}
+
+	if err := a.LocalAuthSvc.ResetPassword(ctx, req); err != nil {
+		return handleServiceError(c, err)
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
@@ -0,0 +1,156 @@
+package rest_test
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/rimvydascivilis/book-tracker/backend/domain"
+	"github.com/rimvydascivilis/book-tracker/backend/dto"
+	"github.com/rimvydascivilis/book-tracker/backend/internal/rest"
+	"github.com/rimvydascivilis/book-tracker/backend/mocks"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func newLocalAuthContext(body string) (echo.Context, *httptest.ResponseRecorder) {
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodPost, "/", bytes.NewBufferString(body))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	req.Header.Set("User-Agent", "Firefox")
+	rec := httptest.NewRecorder()
+	return e.NewContext(req, rec), rec
+}
+
+func TestLocalAuthHandler_Register(t *testing.T) {
+	mockSvc := new(mocks.LocalAuthService)
+	handler := rest.NewLocalAuthHandler(mockSvc)
+	c, rec := newLocalAuthContext(`{"email":"reader@example.com","password":"correct horse"}`)
+
+	mockSvc.On("Register", mock.Anything, dto.PasswordRequest{Email: "reader@example.com", Password: "correct horse"}).
+		Return(nil)
+
+	if assert.NoError(t, handler.Register(c)) {
+		assert.Equal(t, http.StatusAccepted, rec.Code)
+	}
+	mockSvc.AssertExpectations(t)
+}
+
+func TestLocalAuthHandler_Register_Invalid(t *testing.T) {
+	mockSvc := new(mocks.LocalAuthService)
+	handler := rest.NewLocalAuthHandler(mockSvc)
+	c, rec := newLocalAuthContext(`{"email":"reader@example.com","password":"short"}`)
+
+	mockSvc.On("Register", mock.Anything, mock.Anything).
+		Return(fmt.Errorf("%w: %s", domain.ErrValidation, "password is too short"))
+
+	if assert.NoError(t, handler.Register(c)) {
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	}
+}
+
+func TestLocalAuthHandler_Login(t *testing.T) {
+	mockSvc := new(mocks.LocalAuthService)
+	handler := rest.NewLocalAuthHandler(mockSvc)
+	c, rec := newLocalAuthContext(`{"email":"reader@example.com","password":"correct horse"}`)
+
+	mockSvc.On("Login", mock.Anything, dto.PasswordRequest{Email: "reader@example.com", Password: "correct horse"}, "Firefox").
+		Return(dto.TokenResponse{Token: "jwt_token", RefreshToken: "refresh_token", ExpiresIn: 900}, nil)
+
+	if assert.NoError(t, handler.Login(c)) {
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.JSONEq(t, `{"token":"jwt_token","refresh_token":"refresh_token","expires_in":900}`, rec.Body.String())
+	}
+	mockSvc.AssertExpectations(t)
+}
+
+func TestLocalAuthHandler_Login_Errors(t *testing.T) {
+	tests := []struct {
+		name       string
+		body       string
+		err        error
+		wantStatus int
+	}{
+		{name: "missing password", body: `{"email":"reader@example.com"}`, wantStatus: http.StatusBadRequest},
+		{name: "bad json", body: `{invalid_json}`, wantStatus: http.StatusBadRequest},
+		{
+			name:       "wrong password",
+			body:       `{"email":"reader@example.com","password":"wrong"}`,
+			err:        fmt.Errorf("%w: %s", domain.ErrAuthentication, "invalid email or password"),
+			wantStatus: http.StatusUnauthorized,
+		},
+		{
+			name:       "locked",
+			body:       `{"email":"reader@example.com","password":"wrong"}`,
+			err:        fmt.Errorf("%w: %s", domain.ErrTooManyAttempts, "too many failed logins, try again later"),
+			wantStatus: http.StatusTooManyRequests,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockSvc := new(mocks.LocalAuthService)
+			handler := rest.NewLocalAuthHandler(mockSvc)
+			c, rec := newLocalAuthContext(tt.body)
+			if tt.err != nil {
+				mockSvc.On("Login", mock.Anything, mock.Anything, mock.Anything).Return(dto.TokenResponse{}, tt.err)
+			}
+
+			if assert.NoError(t, handler.Login(c)) {
+				assert.Equal(t, tt.wantStatus, rec.Code)
+			}
+			mockSvc.AssertExpectations(t)
+		})
+	}
+}
+
+func TestLocalAuthHandler_VerifyEmail(t *testing.T) {
+	mockSvc := new(mocks.LocalAuthService)
+	handler := rest.NewLocalAuthHandler(mockSvc)
+	c, rec := newLocalAuthContext(`{"token":"abc"}`)
+
+	mockSvc.On("VerifyEmail", mock.Anything, "abc").Return(nil)
+
+	if assert.NoError(t, handler.VerifyEmail(c)) {
+		assert.Equal(t, http.StatusNoContent, rec.Code)
+	}
+	mockSvc.AssertExpectations(t)
+
+	c, rec = newLocalAuthContext(`{}`)
+	if assert.NoError(t, handler.VerifyEmail(c)) {
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+		assert.JSONEq(t, `{"message":"missing token"}`, rec.Body.String())
+	}
+}
+
+func TestLocalAuthHandler_ForgotPassword(t *testing.T) {
+	mockSvc := new(mocks.LocalAuthService)
+	handler := rest.NewLocalAuthHandler(mockSvc)
+	c, rec := newLocalAuthContext(`{"email":"reader@example.com"}`)
+
+	mockSvc.On("RequestPasswordReset", mock.Anything, "reader@example.com").Return(nil)
+
+	if assert.NoError(t, handler.ForgotPassword(c)) {
+		assert.Equal(t, http.StatusAccepted, rec.Code)
+	}
+	mockSvc.AssertExpectations(t)
+}
+
+func TestLocalAuthHandler_ResetPassword(t *testing.T) {
+	mockSvc := new(mocks.LocalAuthService)
+	handler := rest.NewLocalAuthHandler(mockSvc)
+	c, rec := newLocalAuthContext(`{"token":"abc","password":"battery staple"}`)
+
+	mockSvc.On("ResetPassword", mock.Anything, dto.PasswordResetRequest{Token: "abc", Password: "battery staple"}).
+		Return(fmt.Errorf("%w: %s", domain.ErrAuthentication, "invalid or expired link"))
+
+	if assert.NoError(t, handler.ResetPassword(c)) {
+		assert.Equal(t, http.StatusUnauthorized, rec.Code)
+		assert.JSONEq(t, `{"message":"authentication error: invalid or expired link"}`, rec.Body.String())
+	}
+	mockSvc.AssertExpectations(t)
+}
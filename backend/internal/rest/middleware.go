@@ -0,0 +1,110 @@
+package rest
+
+import (
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/labstack/echo/v4"
+	"github.com/labstack/echo/v4/middleware"
+	"github.com/rimvydascivilis/book-tracker/backend/domain"
+	"golang.org/x/time/rate"
+)
+
+// ParseToken returns the echojwt ParseTokenFunc of the authenticated routes.
+// Personal access tokens are checked by accessTokenSvc and anything else is
+// parsed as a JWT signed with secret. Both come back as a *jwt.Token with
+// the user in "id", so handlers need not tell them apart; access tokens add
+// their "scope".
+func ParseToken(secret []byte, accessTokenSvc domain.AccessTokenService) func(c echo.Context, auth string) (interface{}, error) {
+	return func(c echo.Context, auth string) (interface{}, error) {
+		if strings.HasPrefix(auth, domain.AccessTokenPrefix) {
+			token, err := accessTokenSvc.Authenticate(c.Request().Context(), auth)
+			if err != nil {
+				return nil, err
+			}
+			return &jwt.Token{
+				Claims: jwt.MapClaims{"id": float64(token.UserID), "scope": token.Scope},
+				Valid:  true,
+			}, nil
+		}
+
+		return jwt.Parse(auth, func(*jwt.Token) (interface{}, error) {
+			return secret, nil
+		}, jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}))
+	}
+}
+
+// progressWriteRoutes are the routes that change data which progress-write
+// tokens may call.
+var progressWriteRoutes = map[string]bool{
+	"POST /api/progress/:readingId":         true,
+	"PUT /api/progress/:id":                 true,
+	"DELETE /api/progress/:id":              true,
+	"POST /api/readings/:id/sessions/start": true,
+	"POST /api/readings/:id/sessions/stop":  true,
+}
+
+// CheckScope refuses the requests that the scope of a personal access token
+// does not cover. Tokens of every scope may read, but none may manage
+// sessions or access tokens. Requests made with a session are let through.
+func CheckScope(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		scope := getScopeFromToken(c)
+		if scope == "" {
+			return next(c)
+		}
+
+		method := c.Request().Method
+		allowed := false
+		switch {
+		case strings.HasPrefix(c.Path(), "/api/auth/"):
+			allowed = false
+		case method == http.MethodGet || method == http.MethodHead:
+			allowed = true
+		case scope == domain.AccessTokenScopeFull:
+			allowed = true
+		case scope == domain.AccessTokenScopeProgressWrite:
+			allowed = progressWriteRoutes[method+" "+c.Path()]
+		}
+		if !allowed {
+			return c.JSON(http.StatusForbidden, ResponseError{Message: "access token scope does not allow this request"})
+		}
+		return next(c)
+	}
+}
+
+// getScopeFromToken returns the scope of a personal access token, or "" for
+// session tokens.
+func getScopeFromToken(c echo.Context) string {
+	token, ok := c.Get("user").(*jwt.Token)
+	if !ok {
+		return ""
+	}
+	claims, ok := token.Claims.(jwt.MapClaims)
+	if !ok {
+		return ""
+	}
+	scope, _ := claims["scope"].(string)
+	return scope
+}
+
+// RateLimit lets each client, told apart by its IP address, make perMinute
+// requests a minute, in bursts of up to perMinute. Further requests are
+// refused with 429 Too Many Requests.
+func RateLimit(perMinute int) echo.MiddlewareFunc {
+	return middleware.RateLimiterWithConfig(middleware.RateLimiterConfig{
+		Store: middleware.NewRateLimiterMemoryStoreWithConfig(middleware.RateLimiterMemoryStoreConfig{
+			Rate:      rate.Limit(float64(perMinute) / 60),
+			Burst:     perMinute,
+			ExpiresIn: 10 * time.Minute,
+		}),
+		ErrorHandler: func(c echo.Context, err error) error {
+			return c.JSON(http.StatusForbidden, ResponseError{Message: "could not identify the client"})
+		},
+		DenyHandler: func(c echo.Context, identifier string, err error) error {
+			return c.JSON(http.StatusTooManyRequests, ResponseError{Message: "too many requests, try again later"})
+		},
+	})
+}
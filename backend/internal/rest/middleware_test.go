@@ -0,0 +1,131 @@
+package rest_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	echojwt "github.com/labstack/echo-jwt/v4"
+	"github.com/labstack/echo/v4"
+	"github.com/rimvydascivilis/book-tracker/backend/domain"
+	"github.com/rimvydascivilis/book-tracker/backend/internal/rest"
+	"github.com/rimvydascivilis/book-tracker/backend/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+var testSecret = []byte("my_secret")
+
+// setupAuthenticatedApi serves a few routes the way main.go does, each
+// answering with the user of the request.
+func setupAuthenticatedApi(accessTokenSvc domain.AccessTokenService) *echo.Echo {
+	e := echo.New()
+	api := e.Group("/api", echojwt.WithConfig(echojwt.Config{
+		ParseTokenFunc: rest.ParseToken(testSecret, accessTokenSvc),
+		ContextKey:     "user",
+	}), rest.CheckScope)
+
+	handler := func(c echo.Context) error {
+		claims := c.Get("user").(*jwt.Token).Claims.(jwt.MapClaims)
+		return c.String(http.StatusOK, fmt.Sprint(claims["id"]))
+	}
+	api.GET("/readings", handler)
+	api.POST("/progress/:readingId", handler)
+	api.POST("/books", handler)
+	api.GET("/auth/tokens", handler)
+	return e
+}
+
+func serve(e *echo.Echo, method, path, token string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(method, path, nil)
+	req.Header.Set(echo.HeaderAuthorization, "Bearer "+token)
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+	return rec
+}
+
+func TestParseToken_JWT(t *testing.T) {
+	e := setupAuthenticatedApi(new(mocks.AccessTokenService))
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+		"id": 1, "sid": 4, "exp": time.Now().Add(time.Minute).Unix(),
+	}).SignedString(testSecret)
+	require.NoError(t, err)
+
+	rec := serve(e, http.MethodPost, "/api/books", token)
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "1", rec.Body.String())
+
+	forged, err := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{"id": 1}).SignedString([]byte("other"))
+	require.NoError(t, err)
+	rec = serve(e, http.MethodGet, "/api/readings", forged)
+	assert.Equal(t, http.StatusUnauthorized, rec.Code)
+}
+
+func TestParseToken_AccessToken(t *testing.T) {
+	accessTokenSvc := new(mocks.AccessTokenService)
+	e := setupAuthenticatedApi(accessTokenSvc)
+	accessTokenSvc.On("Authenticate", mock.Anything, "btpat_valid").
+		Return(domain.AccessToken{ID: 3, UserID: 7, Scope: domain.AccessTokenScopeReadOnly}, nil)
+	accessTokenSvc.On("Authenticate", mock.Anything, "btpat_revoked").
+		Return(domain.AccessToken{}, fmt.Errorf("%w: %s", domain.ErrAuthentication, "invalid access token"))
+
+	rec := serve(e, http.MethodGet, "/api/readings", "btpat_valid")
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "7", rec.Body.String())
+
+	rec = serve(e, http.MethodGet, "/api/readings", "btpat_revoked")
+	assert.Equal(t, http.StatusUnauthorized, rec.Code)
+}
+
+func TestCheckScope(t *testing.T) {
+	tests := []struct {
+		scope  string
+		method string
+		path   string
+		want   int
+	}{
+		{domain.AccessTokenScopeReadOnly, http.MethodGet, "/api/readings", http.StatusOK},
+		{domain.AccessTokenScopeReadOnly, http.MethodPost, "/api/progress/2", http.StatusForbidden},
+		{domain.AccessTokenScopeProgressWrite, http.MethodPost, "/api/progress/2", http.StatusOK},
+		{domain.AccessTokenScopeProgressWrite, http.MethodPost, "/api/books", http.StatusForbidden},
+		{domain.AccessTokenScopeFull, http.MethodPost, "/api/books", http.StatusOK},
+		{domain.AccessTokenScopeFull, http.MethodGet, "/api/auth/tokens", http.StatusForbidden},
+	}
+
+	for _, tt := range tests {
+		accessTokenSvc := new(mocks.AccessTokenService)
+		accessTokenSvc.On("Authenticate", mock.Anything, "btpat_token").
+			Return(domain.AccessToken{ID: 3, UserID: 7, Scope: tt.scope}, nil)
+		e := setupAuthenticatedApi(accessTokenSvc)
+
+		rec := serve(e, tt.method, tt.path, "btpat_token")
+
+		assert.Equal(t, tt.want, rec.Code, "%s %s with %s", tt.method, tt.path, tt.scope)
+	}
+}
+
+func TestRateLimit(t *testing.T) {
+	e := echo.New()
+	e.POST("/api/auth/login/password", func(c echo.Context) error {
+		return c.NoContent(http.StatusOK)
+	}, rest.RateLimit(3))
+
+	login := func(ip string) int {
+		req := httptest.NewRequest(http.MethodPost, "/api/auth/login/password", nil)
+		req.RemoteAddr = ip + ":1234"
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, req)
+		return rec.Code
+	}
+
+	for i := 0; i < 3; i++ {
+		assert.Equal(t, http.StatusOK, login("192.0.2.1"))
+	}
+	assert.Equal(t, http.StatusTooManyRequests, login("192.0.2.1"))
+	assert.Equal(t, http.StatusOK, login("192.0.2.2"), "other clients have their own limit")
+}
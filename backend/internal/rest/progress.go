@@ -52,3 +52,90 @@ func (h *ProgressHandler) CreateProgress(c echo.Context) error {
 
 	return c.JSON(http.StatusCreated, progress)
 }
+
+// GetReadingProgress lists the progress entries of a reading, so a wrongly
+// logged one can be found and corrected.
+func (h *ProgressHandler) GetReadingProgress(c echo.Context) error {
+	ctx := c.Request().Context()
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	userID, err := getUserIDFromToken(c)
+	if err != nil {
+		utils.Error("failed to get user id from token", err)
+		return c.JSON(http.StatusUnauthorized, ResponseError{Message: "Invalid token"})
+	}
+
+	readingID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		utils.Error("failed to parse reading ID", err)
+		return c.JSON(http.StatusBadRequest, ResponseError{Message: "invalid reading ID"})
+	}
+
+	progress, err := h.ProgressSvc.GetReadingProgress(ctx, userID, readingID)
+	if err != nil {
+		return handleServiceError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"progress": progress,
+	})
+}
+
+func (h *ProgressHandler) UpdateProgress(c echo.Context) error {
+	ctx := c.Request().Context()
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	userID, err := getUserIDFromToken(c)
+	if err != nil {
+		utils.Error("failed to get user id from token", err)
+		return c.JSON(http.StatusUnauthorized, ResponseError{Message: "Invalid token"})
+	}
+
+	var req dto.ProgressRequest
+	if err := c.Bind(&req); err != nil {
+		utils.Error("failed to bind request", err)
+		return c.JSON(http.StatusBadRequest, ResponseError{Message: "invalid request format"})
+	}
+
+	progressID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		utils.Error("failed to parse progress ID", err)
+		return c.JSON(http.StatusBadRequest, ResponseError{Message: "invalid progress ID"})
+	}
+
+	progress, err := h.ProgressSvc.UpdateProgress(ctx, userID, progressID, req)
+	if err != nil {
+		return handleServiceError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, progress)
+}
+
+func (h *ProgressHandler) DeleteProgress(c echo.Context) error {
+	ctx := c.Request().Context()
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	userID, err := getUserIDFromToken(c)
+	if err != nil {
+		utils.Error("failed to get user id from token", err)
+		return c.JSON(http.StatusUnauthorized, ResponseError{Message: "Invalid token"})
+	}
+
+	progressID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		utils.Error("failed to parse progress ID", err)
+		return c.JSON(http.StatusBadRequest, ResponseError{Message: "invalid progress ID"})
+	}
+
+	if err := h.ProgressSvc.DeleteProgress(ctx, userID, progressID); err != nil {
+		return handleServiceError(c, err)
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
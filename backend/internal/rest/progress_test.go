@@ -0,0 +1,62 @@
+package rest_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/rimvydascivilis/book-tracker/backend/domain"
+	"github.com/rimvydascivilis/book-tracker/backend/dto"
+	"github.com/rimvydascivilis/book-tracker/backend/internal/rest"
+	"github.com/rimvydascivilis/book-tracker/backend/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestUpdateProgress(t *testing.T) {
+	mockSvc := new(mocks.ProgressService)
+	handler := rest.NewProgressHandler(mockSvc)
+
+	mockSvc.On("UpdateProgress", mock.Anything, int64(1), int64(5), mock.AnythingOfType("dto.ProgressRequest")).
+		Return(domain.Progress{ID: 5, Pages: 20}, nil)
+
+	e := echo.New()
+	body := `{"pages":20,"date":"2024-03-01T00:00:00Z"}`
+	req := httptest.NewRequest(http.MethodPut, "/progress/5", strings.NewReader(body))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetParamNames("id")
+	c.SetParamValues("5")
+	c.Set("user", &mockJWTToken)
+
+	err := handler.UpdateProgress(c)
+
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	mockSvc.AssertCalled(t, "UpdateProgress", mock.Anything, int64(1), int64(5), mock.MatchedBy(func(r dto.ProgressRequest) bool {
+		return r.Pages == 20
+	}))
+}
+
+func TestDeleteProgress_OtherUser(t *testing.T) {
+	mockSvc := new(mocks.ProgressService)
+	handler := rest.NewProgressHandler(mockSvc)
+
+	mockSvc.On("DeleteProgress", mock.Anything, int64(1), int64(5)).Return(domain.ErrForbidden)
+
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodDelete, "/progress/5", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetParamNames("id")
+	c.SetParamValues("5")
+	c.Set("user", &mockJWTToken)
+
+	err := handler.DeleteProgress(c)
+
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusForbidden, rec.Code)
+}
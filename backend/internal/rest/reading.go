@@ -3,9 +3,12 @@ package rest
 import (
 	"context"
 	"net/http"
+	"strconv"
+	"strings"
 
 	"github.com/labstack/echo/v4"
 	"github.com/rimvydascivilis/book-tracker/backend/domain"
+	"github.com/rimvydascivilis/book-tracker/backend/dto"
 	"github.com/rimvydascivilis/book-tracker/backend/utils"
 )
 
@@ -32,7 +35,7 @@ func (h *ReadingHandler) GetReadings(c echo.Context) error {
 	}
 
 	page, limit := getPaginationParams(c)
-	readings, hasMore, err := h.ReadingSvc.GetReadings(ctx, userID, page, limit)
+	readings, hasMore, err := h.ReadingSvc.GetReadings(ctx, userID, c.QueryParam("status"), page, limit)
 	if err != nil {
 		return handleServiceError(c, err)
 	}
@@ -68,3 +71,160 @@ func (h *ReadingHandler) CreateReading(c echo.Context) error {
 
 	return c.JSON(http.StatusCreated, reading)
 }
+
+// GetBookReadings returns the reading history of a book, newest first.
+func (h *ReadingHandler) GetBookReadings(c echo.Context) error {
+	ctx := c.Request().Context()
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	userID, err := getUserIDFromToken(c)
+	if err != nil {
+		utils.Error("failed to get user id from token", err)
+		return c.JSON(http.StatusUnauthorized, ResponseError{Message: "Invalid token"})
+	}
+
+	bookID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		utils.Error("failed to parse book id", err)
+		return c.JSON(http.StatusBadRequest, ResponseError{Message: "invalid book id"})
+	}
+
+	readings, err := h.ReadingSvc.GetBookReadings(ctx, userID, bookID)
+	if err != nil {
+		return handleServiceError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"readings": readings,
+	})
+}
+
+func (h *ReadingHandler) UpdateReadingStatus(c echo.Context) error {
+	ctx := c.Request().Context()
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	var req dto.ReadingStatusRequest
+	if err := c.Bind(&req); err != nil {
+		utils.Error("failed to bind request", err)
+		return c.JSON(http.StatusBadRequest, ResponseError{Message: "invalid request format"})
+	}
+
+	userID, err := getUserIDFromToken(c)
+	if err != nil {
+		utils.Error("failed to get user id from token", err)
+		return c.JSON(http.StatusUnauthorized, ResponseError{Message: "Invalid token"})
+	}
+
+	readingID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		utils.Error("failed to parse reading id", err)
+		return c.JSON(http.StatusBadRequest, ResponseError{Message: "invalid reading id"})
+	}
+
+	reading, err := h.ReadingSvc.UpdateReadingStatus(ctx, userID, readingID, req.Status)
+	if err != nil {
+		return handleServiceError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, reading)
+}
+
+func (h *ReadingHandler) UpdateReading(c echo.Context) error {
+	ctx := c.Request().Context()
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	var req dto.ReadingRequest
+	if err := c.Bind(&req); err != nil {
+		utils.Error("failed to bind request", err)
+		return c.JSON(http.StatusBadRequest, ResponseError{Message: "invalid request format"})
+	}
+
+	userID, err := getUserIDFromToken(c)
+	if err != nil {
+		utils.Error("failed to get user id from token", err)
+		return c.JSON(http.StatusUnauthorized, ResponseError{Message: "Invalid token"})
+	}
+
+	readingID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		utils.Error("failed to parse reading id", err)
+		return c.JSON(http.StatusBadRequest, ResponseError{Message: "invalid reading id"})
+	}
+
+	reading, err := h.ReadingSvc.UpdateReading(ctx, userID, readingID, req)
+	if err != nil {
+		return handleServiceError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, reading)
+}
+
+func (h *ReadingHandler) DeleteReading(c echo.Context) error {
+	ctx := c.Request().Context()
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	userID, err := getUserIDFromToken(c)
+	if err != nil {
+		utils.Error("failed to get user id from token", err)
+		return c.JSON(http.StatusUnauthorized, ResponseError{Message: "Invalid token"})
+	}
+
+	readingID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		utils.Error("failed to parse reading id", err)
+		return c.JSON(http.StatusBadRequest, ResponseError{Message: "invalid reading id"})
+	}
+
+	if err := h.ReadingSvc.DeleteReading(ctx, userID, readingID); err != nil {
+		return handleServiceError(c, err)
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+// GetReadingPace returns the pace of a reading with its daily progress. The
+// windows query parameter lists the days to average over, e.g. 7,30.
+func (h *ReadingHandler) GetReadingPace(c echo.Context) error {
+	ctx := c.Request().Context()
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	userID, err := getUserIDFromToken(c)
+	if err != nil {
+		utils.Error("failed to get user id from token", err)
+		return c.JSON(http.StatusUnauthorized, ResponseError{Message: "Invalid token"})
+	}
+
+	readingID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		utils.Error("failed to parse reading id", err)
+		return c.JSON(http.StatusBadRequest, ResponseError{Message: "invalid reading id"})
+	}
+
+	var windows []int64
+	if param := c.QueryParam("windows"); param != "" {
+		for _, part := range strings.Split(param, ",") {
+			days, err := strconv.ParseInt(strings.TrimSpace(part), 10, 64)
+			if err != nil {
+				return c.JSON(http.StatusBadRequest, ResponseError{Message: "invalid windows"})
+			}
+			windows = append(windows, days)
+		}
+	}
+
+	pace, err := h.ReadingSvc.GetReadingPace(ctx, userID, readingID, windows)
+	if err != nil {
+		return handleServiceError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, pace)
+}
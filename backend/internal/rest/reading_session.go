@@ -0,0 +1,111 @@
+package rest
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+	"github.com/rimvydascivilis/book-tracker/backend/domain"
+	"github.com/rimvydascivilis/book-tracker/backend/dto"
+	"github.com/rimvydascivilis/book-tracker/backend/utils"
+)
+
+type ReadingSessionHandler struct {
+	SessionSvc domain.ReadingSessionService
+}
+
+func NewReadingSessionHandler(sessionSvc domain.ReadingSessionService) *ReadingSessionHandler {
+	return &ReadingSessionHandler{
+		SessionSvc: sessionSvc,
+	}
+}
+
+func (h *ReadingSessionHandler) GetReadingSessions(c echo.Context) error {
+	ctx := c.Request().Context()
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	userID, err := getUserIDFromToken(c)
+	if err != nil {
+		utils.Error("failed to get user id from token", err)
+		return c.JSON(http.StatusUnauthorized, ResponseError{Message: "Invalid token"})
+	}
+
+	readingID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		utils.Error("failed to parse reading id", err)
+		return c.JSON(http.StatusBadRequest, ResponseError{Message: "invalid reading id"})
+	}
+
+	sessions, err := h.SessionSvc.GetReadingSessions(ctx, userID, readingID)
+	if err != nil {
+		return handleServiceError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"sessions": sessions,
+	})
+}
+
+func (h *ReadingSessionHandler) StartReadingSession(c echo.Context) error {
+	ctx := c.Request().Context()
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	userID, err := getUserIDFromToken(c)
+	if err != nil {
+		utils.Error("failed to get user id from token", err)
+		return c.JSON(http.StatusUnauthorized, ResponseError{Message: "Invalid token"})
+	}
+
+	readingID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		utils.Error("failed to parse reading id", err)
+		return c.JSON(http.StatusBadRequest, ResponseError{Message: "invalid reading id"})
+	}
+
+	session, err := h.SessionSvc.StartReadingSession(ctx, userID, readingID)
+	if err != nil {
+		return handleServiceError(c, err)
+	}
+
+	return c.JSON(http.StatusCreated, session)
+}
+
+func (h *ReadingSessionHandler) StopReadingSession(c echo.Context) error {
+	ctx := c.Request().Context()
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	var req dto.ReadingSessionStopRequest
+	if err := c.Bind(&req); err != nil {
+		utils.Error("failed to bind request", err)
+		return c.JSON(http.StatusBadRequest, ResponseError{Message: "invalid request format"})
+	}
+	if req.Page == nil {
+		return c.JSON(http.StatusBadRequest, ResponseError{Message: "page is required"})
+	}
+
+	userID, err := getUserIDFromToken(c)
+	if err != nil {
+		utils.Error("failed to get user id from token", err)
+		return c.JSON(http.StatusUnauthorized, ResponseError{Message: "Invalid token"})
+	}
+
+	readingID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		utils.Error("failed to parse reading id", err)
+		return c.JSON(http.StatusBadRequest, ResponseError{Message: "invalid reading id"})
+	}
+
+	session, err := h.SessionSvc.StopReadingSession(ctx, userID, readingID, *req.Page)
+	if err != nil {
+		return handleServiceError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, session)
+}
@@ -0,0 +1,81 @@
+package rest_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/rimvydascivilis/book-tracker/backend/domain"
+	"github.com/rimvydascivilis/book-tracker/backend/internal/rest"
+	"github.com/rimvydascivilis/book-tracker/backend/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestStartReadingSession_AlreadyRunning(t *testing.T) {
+	mockSvc := new(mocks.ReadingSessionService)
+	handler := rest.NewReadingSessionHandler(mockSvc)
+
+	mockSvc.On("StartReadingSession", mock.Anything, int64(1), int64(3)).
+		Return(domain.ReadingSession{}, fmt.Errorf("%w: session 1 of reading 2 is still running", domain.ErrAlreadyExists))
+
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodPost, "/readings/3/sessions/start", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetParamNames("id")
+	c.SetParamValues("3")
+	c.Set("user", &mockJWTToken)
+
+	err := handler.StartReadingSession(c)
+
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusConflict, rec.Code)
+}
+
+func TestStopReadingSession(t *testing.T) {
+	mockSvc := new(mocks.ReadingSessionService)
+	handler := rest.NewReadingSessionHandler(mockSvc)
+
+	page := int64(213)
+	mockSvc.On("StopReadingSession", mock.Anything, int64(1), int64(3), page).
+		Return(domain.ReadingSession{ID: 7, ReadingID: 3, EndPosition: &page, Duration: 1800}, nil)
+
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodPost, "/readings/3/sessions/stop", strings.NewReader(`{"page":213}`))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetParamNames("id")
+	c.SetParamValues("3")
+	c.Set("user", &mockJWTToken)
+
+	err := handler.StopReadingSession(c)
+
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Contains(t, rec.Body.String(), `"duration":1800`)
+}
+
+func TestStopReadingSession_MissingPage(t *testing.T) {
+	mockSvc := new(mocks.ReadingSessionService)
+	handler := rest.NewReadingSessionHandler(mockSvc)
+
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodPost, "/readings/3/sessions/stop", strings.NewReader(`{}`))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetParamNames("id")
+	c.SetParamValues("3")
+	c.Set("user", &mockJWTToken)
+
+	err := handler.StopReadingSession(c)
+
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	mockSvc.AssertNotCalled(t, "StopReadingSession", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
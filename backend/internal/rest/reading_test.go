@@ -0,0 +1,166 @@
+package rest_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/rimvydascivilis/book-tracker/backend/domain"
+	"github.com/rimvydascivilis/book-tracker/backend/dto"
+	"github.com/rimvydascivilis/book-tracker/backend/internal/rest"
+	"github.com/rimvydascivilis/book-tracker/backend/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestGetBookReadings(t *testing.T) {
+	mockSvc := new(mocks.ReadingService)
+	handler := rest.NewReadingHandler(mockSvc)
+
+	mockSvc.On("GetBookReadings", mock.Anything, int64(1), int64(7)).Return([]dto.ReadingResponse{
+		{BookTitle: "Dune", Status: domain.ReadingStatusReading, Reading: dto.Reading{ID: 2, BookID: 7}},
+		{BookTitle: "Dune", Status: domain.ReadingStatusCompleted, Reading: dto.Reading{ID: 1, BookID: 7}},
+	}, nil)
+
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, "/books/7/readings", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetParamNames("id")
+	c.SetParamValues("7")
+	c.Set("user", &mockJWTToken)
+
+	err := handler.GetBookReadings(c)
+
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Contains(t, rec.Body.String(), `"status":"completed"`)
+}
+
+func TestGetBookReadings_InvalidID(t *testing.T) {
+	mockSvc := new(mocks.ReadingService)
+	handler := rest.NewReadingHandler(mockSvc)
+
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, "/books/abc/readings", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetParamNames("id")
+	c.SetParamValues("abc")
+	c.Set("user", &mockJWTToken)
+
+	err := handler.GetBookReadings(c)
+
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+}
+
+func TestUpdateReadingStatus(t *testing.T) {
+	mockSvc := new(mocks.ReadingService)
+	handler := rest.NewReadingHandler(mockSvc)
+
+	mockSvc.On("UpdateReadingStatus", mock.Anything, int64(1), int64(3), domain.ReadingStatusPaused).
+		Return(domain.Reading{ID: 3, Status: domain.ReadingStatusPaused}, nil)
+
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodPatch, "/readings/3/status", strings.NewReader(`{"status":"paused"}`))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetParamNames("id")
+	c.SetParamValues("3")
+	c.Set("user", &mockJWTToken)
+
+	err := handler.UpdateReadingStatus(c)
+
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Contains(t, rec.Body.String(), `"status":"paused"`)
+}
+
+func TestUpdateReadingStatus_InvalidTransition(t *testing.T) {
+	mockSvc := new(mocks.ReadingService)
+	handler := rest.NewReadingHandler(mockSvc)
+
+	mockSvc.On("UpdateReadingStatus", mock.Anything, int64(1), int64(3), domain.ReadingStatusPaused).
+		Return(domain.Reading{}, domain.ErrValidation)
+
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodPatch, "/readings/3/status", strings.NewReader(`{"status":"paused"}`))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetParamNames("id")
+	c.SetParamValues("3")
+	c.Set("user", &mockJWTToken)
+
+	err := handler.UpdateReadingStatus(c)
+
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+}
+
+func TestDeleteReading(t *testing.T) {
+	mockSvc := new(mocks.ReadingService)
+	handler := rest.NewReadingHandler(mockSvc)
+
+	mockSvc.On("DeleteReading", mock.Anything, int64(1), int64(3)).Return(nil)
+
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodDelete, "/readings/3", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetParamNames("id")
+	c.SetParamValues("3")
+	c.Set("user", &mockJWTToken)
+
+	err := handler.DeleteReading(c)
+
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusNoContent, rec.Code)
+	mockSvc.AssertExpectations(t)
+}
+
+func TestGetReadingPace(t *testing.T) {
+	mockSvc := new(mocks.ReadingService)
+	handler := rest.NewReadingHandler(mockSvc)
+
+	mockSvc.On("GetReadingPace", mock.Anything, int64(1), int64(4), []int64{7, 30}).Return(dto.PaceResponse{
+		Pace:  dto.Pace{Unit: domain.UnitPages, Remaining: 120, Windows: []dto.PaceWindow{{Days: 7, PerDay: 12}, {Days: 30, PerDay: 9.5}}},
+		Daily: []dto.PaceDay{{Date: "2024-03-01", Pages: 12, Position: 12}},
+	}, nil)
+
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, "/readings/4/pace?windows=7,30", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetParamNames("id")
+	c.SetParamValues("4")
+	c.Set("user", &mockJWTToken)
+
+	err := handler.GetReadingPace(c)
+
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Contains(t, rec.Body.String(), `"daily":[{"date":"2024-03-01","pages":12,"position":12}]`)
+}
+
+func TestGetReadingPace_InvalidWindows(t *testing.T) {
+	mockSvc := new(mocks.ReadingService)
+	handler := rest.NewReadingHandler(mockSvc)
+
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, "/readings/4/pace?windows=week", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetParamNames("id")
+	c.SetParamValues("4")
+	c.Set("user", &mockJWTToken)
+
+	err := handler.GetReadingPace(c)
+
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+}
@@ -0,0 +1,40 @@
+package rest
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/rimvydascivilis/book-tracker/backend/domain"
+	"github.com/rimvydascivilis/book-tracker/backend/utils"
+)
+
+type StreakHandler struct {
+	StreakSvc domain.StreakService
+}
+
+func NewStreakHandler(streakSvc domain.StreakService) *StreakHandler {
+	return &StreakHandler{
+		StreakSvc: streakSvc,
+	}
+}
+
+func (h *StreakHandler) GetStreaks(c echo.Context) error {
+	ctx := c.Request().Context()
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	userID, err := getUserIDFromToken(c)
+	if err != nil {
+		utils.Error("failed to get user id from token", err)
+		return c.JSON(http.StatusUnauthorized, ResponseError{Message: "Invalid token"})
+	}
+
+	streaks, err := h.StreakSvc.GetStreaks(ctx, userID)
+	if err != nil {
+		return handleServiceError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, streaks)
+}
@@ -0,0 +1,34 @@
+package rest_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/rimvydascivilis/book-tracker/backend/dto"
+	"github.com/rimvydascivilis/book-tracker/backend/internal/rest"
+	"github.com/rimvydascivilis/book-tracker/backend/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestGetStreaks(t *testing.T) {
+	mockSvc := new(mocks.StreakService)
+	handler := rest.NewStreakHandler(mockSvc)
+
+	mockSvc.On("GetStreaks", mock.Anything, int64(1)).
+		Return(dto.StreakResponse{Current: 4, Longest: 12, ReadToday: true, LastReadOn: "2024-03-07"}, nil)
+
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, "/stats/streaks", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.Set("user", &mockJWTToken)
+
+	err := handler.GetStreaks(c)
+
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.JSONEq(t, `{"current":4,"longest":12,"read_today":true,"last_read_on":"2024-03-07"}`, rec.Body.String())
+}
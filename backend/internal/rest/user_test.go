@@ -0,0 +1,59 @@
+package rest_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/rimvydascivilis/book-tracker/backend/domain"
+	"github.com/rimvydascivilis/book-tracker/backend/dto"
+	"github.com/rimvydascivilis/book-tracker/backend/internal/rest"
+	"github.com/rimvydascivilis/book-tracker/backend/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestUpdateUserSettings(t *testing.T) {
+	mockSvc := new(mocks.UserService)
+	handler := rest.NewUserHandler(mockSvc)
+
+	settings := dto.UserSettingsRequest{Timezone: "Europe/Vilnius", RestDays: []string{"sunday"}}
+	mockSvc.On("UpdateUserSettings", mock.Anything, int64(1), settings).
+		Return(domain.User{ID: 1, Email: "user@example.com", Timezone: "Europe/Vilnius", RestDays: []string{"sunday"}}, nil)
+
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodPut, "/user/settings", strings.NewReader(`{"timezone":"Europe/Vilnius","rest_days":["sunday"]}`))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.Set("user", &mockJWTToken)
+
+	err := handler.UpdateUserSettings(c)
+
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Contains(t, rec.Body.String(), `"timezone":"Europe/Vilnius"`)
+}
+
+func TestUpdateUserSettings_InvalidTimezone(t *testing.T) {
+	mockSvc := new(mocks.UserService)
+	handler := rest.NewUserHandler(mockSvc)
+
+	mockSvc.On("UpdateUserSettings", mock.Anything, int64(1), mock.Anything).
+		Return(domain.User{}, fmt.Errorf("%w: %s", domain.ErrValidation, "The Timezone field is invalid."))
+
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodPut, "/user/settings", strings.NewReader(`{"timezone":"Mars/Olympus"}`))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.Set("user", &mockJWTToken)
+
+	err := handler.UpdateUserSettings(c)
+
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+}
@@ -1,15 +1,8 @@
--- Drop tables if they already exist
-DROP TABLE IF EXISTS note;
-DROP TABLE IF EXISTS list_item;
-DROP TABLE IF EXISTS list;
-DROP TABLE IF EXISTS progress;
-Drop TABLE IF EXISTS reading;
-DROP TABLE IF EXISTS goal;
-DROP TABLE IF EXISTS book;
-DROP TABLE IF EXISTS user;
+-- Tables use IF NOT EXISTS so databases created by the old schema.sql can be
+-- brought under migration control without losing data.
 
 -- user table
-CREATE TABLE user (
+CREATE TABLE IF NOT EXISTS user (
     id INT NOT NULL AUTO_INCREMENT,
     email VARCHAR(255) NOT NULL,
     created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
@@ -17,7 +10,7 @@ CREATE TABLE user (
 );
 
 -- goal table
-CREATE TABLE goal (
+CREATE TABLE IF NOT EXISTS goal (
     user_id INT NOT NULL,
     type ENUM('books', 'pages') NOT NULL,
     frequency ENUM('daily', 'monthly') NOT NULL,
@@ -27,7 +20,7 @@ CREATE TABLE goal (
 );
 
 -- book table
-CREATE TABLE book (
+CREATE TABLE IF NOT EXISTS book (
     id INT NOT NULL AUTO_INCREMENT,
     user_id INT NOT NULL,
     title VARCHAR(50) NOT NULL,
@@ -38,7 +31,7 @@ CREATE TABLE book (
 );
 
 -- reading table
-CREATE TABLE reading (
+CREATE TABLE IF NOT EXISTS reading (
     id INT NOT NULL AUTO_INCREMENT,
     book_id INT NOT NULL,
     user_id INT NOT NULL,
@@ -52,7 +45,7 @@ CREATE TABLE reading (
 );
 
 -- progress table
-CREATE TABLE progress (
+CREATE TABLE IF NOT EXISTS progress (
     id INT NOT NULL AUTO_INCREMENT,
     reading_id INT NOT NULL,
     user_id INT NOT NULL,
@@ -64,7 +57,7 @@ CREATE TABLE progress (
 );
 
 -- list table
-CREATE TABLE list (
+CREATE TABLE IF NOT EXISTS list (
     id INT NOT NULL AUTO_INCREMENT,
     user_id INT NOT NULL,
     title VARCHAR(50) NOT NULL,
@@ -74,7 +67,7 @@ CREATE TABLE list (
 );
 
 -- list_item table
-CREATE TABLE list_item (
+CREATE TABLE IF NOT EXISTS list_item (
     id INT NOT NULL AUTO_INCREMENT,
     list_id INT NOT NULL,
     book_id INT NOT NULL,
@@ -85,7 +78,7 @@ CREATE TABLE list_item (
 );
 
 -- note table
-CREATE TABLE note (
+CREATE TABLE IF NOT EXISTS note (
     id INT NOT NULL AUTO_INCREMENT,
     user_id INT NOT NULL,
     book_id INT NOT NULL,
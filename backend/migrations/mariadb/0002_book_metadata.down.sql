@@ -0,0 +1,15 @@
+DROP TABLE book_author;
+DROP TABLE author;
+
+DROP INDEX book_isbn13_idx ON book;
+
+-- Titles longer than 50 characters have to be shortened before this runs.
+ALTER TABLE book
+    DROP COLUMN isbn10,
+    DROP COLUMN isbn13,
+    DROP COLUMN publisher,
+    DROP COLUMN publication_year,
+    DROP COLUMN language,
+    DROP COLUMN description,
+    DROP COLUMN page_count,
+    MODIFY title VARCHAR(50) NOT NULL;
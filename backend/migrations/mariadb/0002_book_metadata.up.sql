@@ -0,0 +1,30 @@
+ALTER TABLE book
+    MODIFY title VARCHAR(255) NOT NULL,
+    ADD COLUMN isbn10 CHAR(10) NOT NULL DEFAULT '' AFTER title,
+    ADD COLUMN isbn13 CHAR(13) NOT NULL DEFAULT '' AFTER isbn10,
+    ADD COLUMN publisher VARCHAR(255) NOT NULL DEFAULT '' AFTER isbn13,
+    ADD COLUMN publication_year SMALLINT NOT NULL DEFAULT 0 AFTER publisher,
+    ADD COLUMN language VARCHAR(35) NOT NULL DEFAULT '' AFTER publication_year,
+    ADD COLUMN description TEXT NOT NULL DEFAULT '' AFTER language,
+    ADD COLUMN page_count INT NOT NULL DEFAULT 0 CHECK (page_count >= 0) AFTER description;
+
+CREATE INDEX book_isbn13_idx ON book (user_id, isbn13);
+
+-- author table
+-- Authors are shared by every user; books link to them through book_author.
+CREATE TABLE author (
+    id INT NOT NULL AUTO_INCREMENT,
+    name VARCHAR(255) NOT NULL,
+    PRIMARY KEY (id),
+    UNIQUE (name)
+);
+
+-- book_author table
+CREATE TABLE book_author (
+    book_id INT NOT NULL,
+    author_id INT NOT NULL,
+    position INT NOT NULL,
+    PRIMARY KEY (book_id, author_id),
+    FOREIGN KEY (book_id) REFERENCES book(id) ON DELETE CASCADE,
+    FOREIGN KEY (author_id) REFERENCES author(id) ON DELETE CASCADE
+);
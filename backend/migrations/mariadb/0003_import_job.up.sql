@@ -0,0 +1,34 @@
+-- import_job table
+-- data keeps the uploaded file so an interrupted job can be resumed.
+CREATE TABLE import_job (
+    id INT NOT NULL AUTO_INCREMENT,
+    user_id INT NOT NULL,
+    source VARCHAR(32) NOT NULL,
+    checksum CHAR(64) NOT NULL,
+    data MEDIUMBLOB NOT NULL,
+    status ENUM('pending', 'running', 'completed', 'failed') NOT NULL DEFAULT 'pending',
+    total_rows INT NOT NULL DEFAULT 0,
+    error TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (id),
+    UNIQUE (user_id, source, checksum),
+    FOREIGN KEY (user_id) REFERENCES user(id) ON DELETE CASCADE
+);
+
+-- import_row table
+CREATE TABLE import_row (
+    id INT NOT NULL AUTO_INCREMENT,
+    job_id INT NOT NULL,
+    row_num INT NOT NULL,
+    external_id VARCHAR(64) NOT NULL DEFAULT '',
+    status ENUM('imported', 'skipped', 'failed') NOT NULL,
+    book_id INT NULL,
+    error VARCHAR(1000) NOT NULL DEFAULT '',
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (id),
+    UNIQUE (job_id, row_num),
+    INDEX import_row_external_id_idx (external_id),
+    FOREIGN KEY (job_id) REFERENCES import_job(id) ON DELETE CASCADE,
+    FOREIGN KEY (book_id) REFERENCES book(id) ON DELETE SET NULL
+);
@@ -0,0 +1,6 @@
+DROP INDEX reading_finished_at_idx ON reading;
+DROP INDEX reading_book_idx ON reading;
+
+ALTER TABLE reading
+    DROP COLUMN finished_at,
+    DROP COLUMN started_at;
@@ -0,0 +1,17 @@
+-- A book can be read several times; each reading records when it was started
+-- and finished. finished_at stays NULL until the reading is completed.
+ALTER TABLE reading
+    ADD COLUMN started_at DATE NULL AFTER link,
+    ADD COLUMN finished_at DATE NULL AFTER started_at;
+
+CREATE INDEX reading_book_idx ON reading (user_id, book_id);
+CREATE INDEX reading_finished_at_idx ON reading (user_id, finished_at);
+
+UPDATE reading r
+JOIN (
+    SELECT reading_id, MIN(reading_date) AS first_date, MAX(reading_date) AS last_date, SUM(pages) AS pages
+    FROM progress
+    GROUP BY reading_id
+) p ON p.reading_id = r.id
+SET r.started_at = p.first_date,
+    r.finished_at = IF(p.pages >= r.total_pages, p.last_date, NULL);
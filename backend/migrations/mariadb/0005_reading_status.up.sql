@@ -0,0 +1,12 @@
+-- The status of a reading is stored instead of being derived from its
+-- progress, so readings can be paused or abandoned.
+ALTER TABLE reading
+    ADD COLUMN status VARCHAR(20) NOT NULL DEFAULT 'not started' AFTER link;
+
+CREATE INDEX reading_status_idx ON reading (user_id, status);
+
+UPDATE reading SET status = CASE
+    WHEN finished_at IS NOT NULL THEN 'completed'
+    WHEN started_at IS NOT NULL THEN 'reading'
+    ELSE 'not started'
+END;
@@ -0,0 +1,6 @@
+-- Corrections cannot be stored without negative pages and are dropped.
+DELETE FROM progress WHERE pages < 0;
+
+ALTER TABLE progress
+    DROP COLUMN position,
+    MODIFY COLUMN pages INT NOT NULL CHECK (pages > 0);
@@ -0,0 +1,13 @@
+-- position is the page the reader was on after an entry and pages the change
+-- since the previous entry. A correction moves the position back, so its
+-- pages are negative.
+ALTER TABLE progress
+    MODIFY COLUMN pages INT NOT NULL CHECK (pages <> 0),
+    ADD COLUMN position INT NOT NULL DEFAULT 0 CHECK (position >= 0) AFTER pages;
+
+UPDATE progress
+JOIN (
+    SELECT id, SUM(pages) OVER (PARTITION BY reading_id ORDER BY reading_date, id) AS position
+    FROM progress
+) running ON running.id = progress.id
+SET progress.position = running.position;
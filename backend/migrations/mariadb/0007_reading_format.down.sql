@@ -0,0 +1,8 @@
+-- Minutes goals cannot be stored and are dropped. Ebook and audiobook
+-- readings become print readings of the same length.
+DELETE FROM goal WHERE type = 'minutes';
+
+ALTER TABLE goal
+    MODIFY COLUMN type ENUM('books', 'pages') NOT NULL;
+
+ALTER TABLE reading DROP COLUMN format;
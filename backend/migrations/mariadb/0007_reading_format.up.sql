@@ -0,0 +1,7 @@
+-- The format of a reading decides the unit of its total and progress: pages
+-- for print, percent for ebooks and minutes for audiobooks.
+ALTER TABLE reading
+    ADD COLUMN format VARCHAR(20) NOT NULL DEFAULT 'print' AFTER link;
+
+ALTER TABLE goal
+    MODIFY COLUMN type ENUM('books', 'pages', 'minutes') NOT NULL;
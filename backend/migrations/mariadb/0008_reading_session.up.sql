@@ -0,0 +1,22 @@
+-- reading_session table
+-- duration is in seconds. open_user_id is only set while the session is
+-- open, so its unique index allows a single open session per user.
+CREATE TABLE reading_session (
+    id INT NOT NULL AUTO_INCREMENT,
+    user_id INT NOT NULL,
+    reading_id INT NOT NULL,
+    start_position INT NOT NULL CHECK (start_position >= 0),
+    end_position INT NULL CHECK (end_position >= 0),
+    started_at DATETIME NOT NULL,
+    ended_at DATETIME NULL,
+    duration INT NOT NULL DEFAULT 0 CHECK (duration >= 0),
+    progress_id INT NULL,
+    auto_closed BOOLEAN NOT NULL DEFAULT FALSE,
+    open_user_id INT AS (IF(ended_at IS NULL, user_id, NULL)) PERSISTENT,
+    PRIMARY KEY (id),
+    UNIQUE reading_session_open_idx (open_user_id),
+    INDEX reading_session_started_at_idx (started_at),
+    FOREIGN KEY (user_id) REFERENCES user(id) ON DELETE CASCADE,
+    FOREIGN KEY (reading_id) REFERENCES reading(id) ON DELETE CASCADE,
+    FOREIGN KEY (progress_id) REFERENCES progress(id) ON DELETE SET NULL
+);
@@ -0,0 +1,3 @@
+-- target_date is the day the reader wants to finish by.
+ALTER TABLE reading
+    ADD COLUMN target_date DATE NULL AFTER finished_at;
@@ -0,0 +1,3 @@
+ALTER TABLE user
+    DROP COLUMN rest_days,
+    DROP COLUMN timezone;
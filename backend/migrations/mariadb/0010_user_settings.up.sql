@@ -0,0 +1,5 @@
+-- timezone is an IANA zone name. rest_days lists the weekdays that do not
+-- break a reading streak, comma separated.
+ALTER TABLE user
+    ADD COLUMN timezone VARCHAR(64) NOT NULL DEFAULT 'UTC' AFTER email,
+    ADD COLUMN rest_days VARCHAR(64) NOT NULL DEFAULT '' AFTER timezone;
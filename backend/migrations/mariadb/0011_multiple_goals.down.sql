@@ -0,0 +1,13 @@
+-- Only the oldest daily or monthly goal of every user is kept.
+DELETE FROM goal WHERE frequency NOT IN ('daily', 'monthly');
+
+DELETE g FROM goal g
+JOIN goal older ON older.user_id = g.user_id AND older.id < g.id;
+
+ALTER TABLE goal
+    MODIFY COLUMN id INT NOT NULL,
+    DROP PRIMARY KEY,
+    ADD PRIMARY KEY (user_id),
+    DROP COLUMN id,
+    DROP INDEX goal_user_type_frequency,
+    MODIFY COLUMN frequency ENUM('daily', 'monthly') NOT NULL;
@@ -0,0 +1,7 @@
+-- Users can have several goals, one per type and frequency. The unique key
+-- starts with user_id, so it also serves the foreign key.
+ALTER TABLE goal
+    DROP PRIMARY KEY,
+    ADD COLUMN id INT AUTO_INCREMENT PRIMARY KEY FIRST,
+    ADD UNIQUE KEY goal_user_type_frequency (user_id, type, frequency),
+    MODIFY COLUMN frequency ENUM('daily', 'weekly', 'monthly', 'yearly') NOT NULL;
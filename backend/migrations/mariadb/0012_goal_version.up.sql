@@ -0,0 +1,22 @@
+-- goal_version table
+-- Every change of a goal starts a new version, so past periods are measured
+-- against the target they had. goal_id is cleared when the goal is deleted
+-- and its history kept.
+CREATE TABLE goal_version (
+    id INT NOT NULL AUTO_INCREMENT,
+    user_id INT NOT NULL,
+    goal_id INT NULL,
+    type ENUM('books', 'pages', 'minutes') NOT NULL,
+    frequency ENUM('daily', 'weekly', 'monthly', 'yearly') NOT NULL,
+    value INT NOT NULL CHECK (value >= 1),
+    effective_from DATE NOT NULL,
+    effective_to DATE NULL,
+    PRIMARY KEY (id),
+    FOREIGN KEY (user_id) REFERENCES user(id) ON DELETE CASCADE,
+    FOREIGN KEY (goal_id) REFERENCES goal(id) ON DELETE SET NULL
+);
+
+-- It is not known since when the existing goals were set, so their history
+-- starts today.
+INSERT INTO goal_version (user_id, goal_id, type, frequency, value, effective_from)
+SELECT user_id, id, type, frequency, value, CURRENT_DATE FROM goal;
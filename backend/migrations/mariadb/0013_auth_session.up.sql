@@ -0,0 +1,28 @@
+-- auth_session table
+-- A session is one signed-in device. Its refresh tokens form a family: each
+-- is used once and replaced by the next, and reusing one revokes the session.
+CREATE TABLE auth_session (
+    id INT NOT NULL AUTO_INCREMENT,
+    user_id INT NOT NULL,
+    user_agent VARCHAR(255) NOT NULL DEFAULT '',
+    created_at DATETIME NOT NULL,
+    last_used_at DATETIME NOT NULL,
+    expires_at DATETIME NOT NULL,
+    revoked_at DATETIME NULL,
+    PRIMARY KEY (id),
+    INDEX auth_session_user_id_idx (user_id),
+    FOREIGN KEY (user_id) REFERENCES user(id) ON DELETE CASCADE
+);
+
+-- refresh_token table
+-- Only the SHA-256 of each token is stored.
+CREATE TABLE refresh_token (
+    id INT NOT NULL AUTO_INCREMENT,
+    session_id INT NOT NULL,
+    token_hash CHAR(64) NOT NULL,
+    expires_at DATETIME NOT NULL,
+    used_at DATETIME NULL,
+    PRIMARY KEY (id),
+    UNIQUE refresh_token_hash_idx (token_hash),
+    FOREIGN KEY (session_id) REFERENCES auth_session(id) ON DELETE CASCADE
+);
@@ -0,0 +1,17 @@
+-- access_token table
+-- Personal access tokens for scripts. Only the SHA-256 of each token is
+-- stored; expires_at is NULL for tokens that do not expire.
+CREATE TABLE access_token (
+    id INT NOT NULL AUTO_INCREMENT,
+    user_id INT NOT NULL,
+    name VARCHAR(100) NOT NULL,
+    scope ENUM('read-only', 'progress-write', 'full') NOT NULL,
+    token_hash CHAR(64) NOT NULL,
+    created_at DATETIME NOT NULL,
+    expires_at DATETIME NULL,
+    last_used_at DATETIME NULL,
+    PRIMARY KEY (id),
+    UNIQUE access_token_hash_idx (token_hash),
+    INDEX access_token_user_id_idx (user_id),
+    FOREIGN KEY (user_id) REFERENCES user(id) ON DELETE CASCADE
+);
@@ -0,0 +1,30 @@
+-- local_account table
+-- Password sign-in of a user. password_hash is an argon2id hash in PHC
+-- format; failed_logins counts the wrong passwords since the last sign-in and
+-- locked_until is set once there are too many of them.
+CREATE TABLE local_account (
+    user_id INT NOT NULL,
+    password_hash VARCHAR(255) NOT NULL,
+    email_verified_at DATETIME NULL,
+    failed_logins INT NOT NULL DEFAULT 0,
+    locked_until DATETIME NULL,
+    created_at DATETIME NOT NULL,
+    PRIMARY KEY (user_id),
+    FOREIGN KEY (user_id) REFERENCES user(id) ON DELETE CASCADE
+);
+
+-- account_token table
+-- Single-use tokens mailed to verify an email or reset a password. Only the
+-- SHA-256 of each token is stored.
+CREATE TABLE account_token (
+    id INT NOT NULL AUTO_INCREMENT,
+    user_id INT NOT NULL,
+    purpose ENUM('verify-email', 'reset-password') NOT NULL,
+    token_hash CHAR(64) NOT NULL,
+    expires_at DATETIME NOT NULL,
+    used_at DATETIME NULL,
+    PRIMARY KEY (id),
+    UNIQUE account_token_hash_idx (token_hash),
+    INDEX account_token_user_id_idx (user_id),
+    FOREIGN KEY (user_id) REFERENCES user(id) ON DELETE CASCADE
+);
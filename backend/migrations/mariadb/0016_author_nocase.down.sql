@@ -0,0 +1,2 @@
+-- Merged authors stay merged, only the collation is reverted.
+ALTER TABLE author MODIFY name VARCHAR(255) NOT NULL;
@@ -0,0 +1,15 @@
+-- Author names are matched ignoring case whatever the server's default
+-- collation. Names that only differ in case are merged into the oldest
+-- author first. Links that would duplicate one the book already has are
+-- left to be removed with the merged author.
+UPDATE IGNORE book_author ba
+JOIN author a ON a.id = ba.author_id
+JOIN (SELECT LOWER(name) AS name, MIN(id) AS id FROM author GROUP BY LOWER(name)) oldest ON oldest.name = LOWER(a.name)
+SET ba.author_id = oldest.id
+WHERE ba.author_id <> oldest.id;
+
+DELETE a FROM author a
+JOIN (SELECT LOWER(name) AS name, MIN(id) AS id FROM author GROUP BY LOWER(name)) oldest ON oldest.name = LOWER(a.name)
+WHERE a.id <> oldest.id;
+
+ALTER TABLE author MODIFY name VARCHAR(255) CHARACTER SET utf8mb4 COLLATE utf8mb4_general_ci NOT NULL;
@@ -0,0 +1,13 @@
+package migrations
+
+import "embed"
+
+// MariaDB holds the numbered up/down migrations for the MariaDB schema.
+//
+//go:embed mariadb/*.sql
+var MariaDB embed.FS
+
+// SQLite holds the same schema history written for SQLite.
+//
+//go:embed sqlite/*.sql
+var SQLite embed.FS
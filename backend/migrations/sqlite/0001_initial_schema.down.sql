@@ -0,0 +1,8 @@
+DROP TABLE IF EXISTS note;
+DROP TABLE IF EXISTS list_item;
+DROP TABLE IF EXISTS list;
+DROP TABLE IF EXISTS progress;
+DROP TABLE IF EXISTS reading;
+DROP TABLE IF EXISTS goal;
+DROP TABLE IF EXISTS book;
+DROP TABLE IF EXISTS user;
@@ -0,0 +1,12 @@
+DROP TABLE book_author;
+DROP TABLE author;
+
+DROP INDEX book_isbn13_idx;
+
+ALTER TABLE book DROP COLUMN page_count;
+ALTER TABLE book DROP COLUMN description;
+ALTER TABLE book DROP COLUMN language;
+ALTER TABLE book DROP COLUMN publication_year;
+ALTER TABLE book DROP COLUMN publisher;
+ALTER TABLE book DROP COLUMN isbn13;
+ALTER TABLE book DROP COLUMN isbn10;
@@ -0,0 +1,27 @@
+-- SQLite does not enforce VARCHAR lengths, so the longer title needs no change.
+ALTER TABLE book ADD COLUMN isbn10 CHAR(10) NOT NULL DEFAULT '';
+ALTER TABLE book ADD COLUMN isbn13 CHAR(13) NOT NULL DEFAULT '';
+ALTER TABLE book ADD COLUMN publisher VARCHAR(255) NOT NULL DEFAULT '';
+ALTER TABLE book ADD COLUMN publication_year INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE book ADD COLUMN language VARCHAR(35) NOT NULL DEFAULT '';
+ALTER TABLE book ADD COLUMN description TEXT NOT NULL DEFAULT '';
+ALTER TABLE book ADD COLUMN page_count INTEGER NOT NULL DEFAULT 0 CHECK (page_count >= 0);
+
+CREATE INDEX book_isbn13_idx ON book (user_id, isbn13);
+
+-- author table
+-- Authors are shared by every user; books link to them through book_author.
+CREATE TABLE author (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    name VARCHAR(255) NOT NULL UNIQUE
+);
+
+-- book_author table
+CREATE TABLE book_author (
+    book_id INTEGER NOT NULL,
+    author_id INTEGER NOT NULL,
+    position INTEGER NOT NULL,
+    PRIMARY KEY (book_id, author_id),
+    FOREIGN KEY (book_id) REFERENCES book(id) ON DELETE CASCADE,
+    FOREIGN KEY (author_id) REFERENCES author(id) ON DELETE CASCADE
+);
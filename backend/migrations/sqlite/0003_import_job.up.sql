@@ -0,0 +1,33 @@
+-- import_job table
+-- data keeps the uploaded file so an interrupted job can be resumed.
+CREATE TABLE import_job (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    user_id INTEGER NOT NULL,
+    source VARCHAR(32) NOT NULL,
+    checksum CHAR(64) NOT NULL,
+    data BLOB NOT NULL,
+    status TEXT NOT NULL DEFAULT 'pending' CHECK (status IN ('pending', 'running', 'completed', 'failed')),
+    total_rows INTEGER NOT NULL DEFAULT 0,
+    error TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (user_id, source, checksum),
+    FOREIGN KEY (user_id) REFERENCES user(id) ON DELETE CASCADE
+);
+
+-- import_row table
+CREATE TABLE import_row (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    job_id INTEGER NOT NULL,
+    row_num INTEGER NOT NULL,
+    external_id VARCHAR(64) NOT NULL DEFAULT '',
+    status TEXT NOT NULL CHECK (status IN ('imported', 'skipped', 'failed')),
+    book_id INTEGER NULL,
+    error VARCHAR(1000) NOT NULL DEFAULT '',
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (job_id, row_num),
+    FOREIGN KEY (job_id) REFERENCES import_job(id) ON DELETE CASCADE,
+    FOREIGN KEY (book_id) REFERENCES book(id) ON DELETE SET NULL
+);
+
+CREATE INDEX import_row_external_id_idx ON import_row (external_id);
@@ -0,0 +1,5 @@
+DROP INDEX reading_finished_at_idx;
+DROP INDEX reading_book_idx;
+
+ALTER TABLE reading DROP COLUMN finished_at;
+ALTER TABLE reading DROP COLUMN started_at;
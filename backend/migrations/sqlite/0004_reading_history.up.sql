@@ -0,0 +1,15 @@
+-- A book can be read several times; each reading records when it was started
+-- and finished. finished_at stays NULL until the reading is completed. Both
+-- are plain YYYY-MM-DD strings, like progress.reading_date.
+ALTER TABLE reading ADD COLUMN started_at DATE NULL;
+ALTER TABLE reading ADD COLUMN finished_at DATE NULL;
+
+CREATE INDEX reading_book_idx ON reading (user_id, book_id);
+CREATE INDEX reading_finished_at_idx ON reading (user_id, finished_at);
+
+UPDATE reading SET
+    started_at = (SELECT MIN(date(reading_date)) FROM progress WHERE progress.reading_id = reading.id),
+    finished_at = CASE
+        WHEN (SELECT COALESCE(SUM(pages), 0) FROM progress WHERE progress.reading_id = reading.id) >= total_pages
+        THEN (SELECT MAX(date(reading_date)) FROM progress WHERE progress.reading_id = reading.id)
+    END;
@@ -0,0 +1,6 @@
+DROP INDEX reading_status_idx;
+
+-- Abandoned readings were never finished.
+UPDATE reading SET finished_at = NULL WHERE status = 'abandoned';
+
+ALTER TABLE reading DROP COLUMN status;
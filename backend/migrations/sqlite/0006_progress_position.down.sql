@@ -0,0 +1,16 @@
+-- Corrections cannot be stored without negative pages and are dropped.
+CREATE TABLE progress_old (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    reading_id INTEGER NOT NULL,
+    user_id INTEGER NOT NULL,
+    pages INTEGER NOT NULL CHECK (pages > 0),
+    reading_date DATE NOT NULL DEFAULT CURRENT_DATE,
+    FOREIGN KEY (user_id) REFERENCES user(id) ON DELETE CASCADE,
+    FOREIGN KEY (reading_id) REFERENCES reading(id) ON DELETE CASCADE
+);
+
+INSERT INTO progress_old (id, reading_id, user_id, pages, reading_date)
+SELECT id, reading_id, user_id, pages, reading_date FROM progress WHERE pages > 0;
+
+DROP TABLE progress;
+ALTER TABLE progress_old RENAME TO progress;
@@ -0,0 +1,25 @@
+-- position is the page the reader was on after an entry and pages the change
+-- since the previous entry. A correction moves the position back, so its
+-- pages are negative. SQLite cannot alter a CHECK constraint, so the table is
+-- rebuilt.
+CREATE TABLE progress_new (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    reading_id INTEGER NOT NULL,
+    user_id INTEGER NOT NULL,
+    pages INTEGER NOT NULL CHECK (pages <> 0),
+    position INTEGER NOT NULL DEFAULT 0 CHECK (position >= 0),
+    reading_date DATE NOT NULL DEFAULT CURRENT_DATE,
+    FOREIGN KEY (user_id) REFERENCES user(id) ON DELETE CASCADE,
+    FOREIGN KEY (reading_id) REFERENCES reading(id) ON DELETE CASCADE
+);
+
+INSERT INTO progress_new (id, reading_id, user_id, pages, position, reading_date)
+SELECT id, reading_id, user_id, pages, (
+    SELECT SUM(p.pages) FROM progress p
+    WHERE p.reading_id = progress.reading_id
+        AND (p.reading_date < progress.reading_date OR (p.reading_date = progress.reading_date AND p.id <= progress.id))
+), reading_date
+FROM progress;
+
+DROP TABLE progress;
+ALTER TABLE progress_new RENAME TO progress;
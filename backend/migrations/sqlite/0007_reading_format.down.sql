@@ -0,0 +1,17 @@
+-- Minutes goals cannot be stored and are dropped. Ebook and audiobook
+-- readings become print readings of the same length.
+CREATE TABLE goal_old (
+    user_id INTEGER NOT NULL PRIMARY KEY,
+    type TEXT NOT NULL CHECK (type IN ('books', 'pages')),
+    frequency TEXT NOT NULL CHECK (frequency IN ('daily', 'monthly')),
+    value INTEGER NOT NULL CHECK (value >= 1),
+    FOREIGN KEY (user_id) REFERENCES user(id) ON DELETE CASCADE
+);
+
+INSERT INTO goal_old (user_id, type, frequency, value)
+SELECT user_id, type, frequency, value FROM goal WHERE type <> 'minutes';
+
+DROP TABLE goal;
+ALTER TABLE goal_old RENAME TO goal;
+
+ALTER TABLE reading DROP COLUMN format;
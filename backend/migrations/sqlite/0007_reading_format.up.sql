@@ -0,0 +1,19 @@
+-- The format of a reading decides the unit of its total and progress: pages
+-- for print, percent for ebooks and minutes for audiobooks.
+ALTER TABLE reading ADD COLUMN format VARCHAR(20) NOT NULL DEFAULT 'print';
+
+-- SQLite cannot alter a CHECK constraint, so the goal table is rebuilt to
+-- allow minutes goals.
+CREATE TABLE goal_new (
+    user_id INTEGER NOT NULL PRIMARY KEY,
+    type TEXT NOT NULL CHECK (type IN ('books', 'pages', 'minutes')),
+    frequency TEXT NOT NULL CHECK (frequency IN ('daily', 'monthly')),
+    value INTEGER NOT NULL CHECK (value >= 1),
+    FOREIGN KEY (user_id) REFERENCES user(id) ON DELETE CASCADE
+);
+
+INSERT INTO goal_new (user_id, type, frequency, value)
+SELECT user_id, type, frequency, value FROM goal;
+
+DROP TABLE goal;
+ALTER TABLE goal_new RENAME TO goal;
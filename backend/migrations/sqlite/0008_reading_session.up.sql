@@ -0,0 +1,21 @@
+-- reading_session table
+-- duration is in seconds. The partial unique index allows a single open
+-- session per user.
+CREATE TABLE reading_session (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    user_id INTEGER NOT NULL,
+    reading_id INTEGER NOT NULL,
+    start_position INTEGER NOT NULL CHECK (start_position >= 0),
+    end_position INTEGER NULL CHECK (end_position >= 0),
+    started_at TIMESTAMP NOT NULL,
+    ended_at TIMESTAMP NULL,
+    duration INTEGER NOT NULL DEFAULT 0 CHECK (duration >= 0),
+    progress_id INTEGER NULL,
+    auto_closed BOOLEAN NOT NULL DEFAULT FALSE,
+    FOREIGN KEY (user_id) REFERENCES user(id) ON DELETE CASCADE,
+    FOREIGN KEY (reading_id) REFERENCES reading(id) ON DELETE CASCADE,
+    FOREIGN KEY (progress_id) REFERENCES progress(id) ON DELETE SET NULL
+);
+
+CREATE INDEX reading_session_reading_id_idx ON reading_session (reading_id);
+CREATE UNIQUE INDEX reading_session_open_idx ON reading_session (user_id) WHERE ended_at IS NULL;
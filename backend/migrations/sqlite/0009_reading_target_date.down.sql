@@ -0,0 +1 @@
+ALTER TABLE reading DROP COLUMN target_date;
@@ -0,0 +1,3 @@
+-- target_date is the day the reader wants to finish by, stored as a
+-- YYYY-MM-DD string like the other reading dates.
+ALTER TABLE reading ADD COLUMN target_date DATE NULL;
@@ -0,0 +1,2 @@
+ALTER TABLE user DROP COLUMN rest_days;
+ALTER TABLE user DROP COLUMN timezone;
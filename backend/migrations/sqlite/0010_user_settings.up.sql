@@ -0,0 +1,4 @@
+-- timezone is an IANA zone name. rest_days lists the weekdays that do not
+-- break a reading streak, comma separated.
+ALTER TABLE user ADD COLUMN timezone VARCHAR(64) NOT NULL DEFAULT 'UTC';
+ALTER TABLE user ADD COLUMN rest_days VARCHAR(64) NOT NULL DEFAULT '';
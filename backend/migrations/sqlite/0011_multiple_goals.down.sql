@@ -0,0 +1,17 @@
+-- Only the oldest daily or monthly goal of every user is kept.
+CREATE TABLE goal_old (
+    user_id INTEGER NOT NULL PRIMARY KEY,
+    type TEXT NOT NULL CHECK (type IN ('books', 'pages', 'minutes')),
+    frequency TEXT NOT NULL CHECK (frequency IN ('daily', 'monthly')),
+    value INTEGER NOT NULL CHECK (value >= 1),
+    FOREIGN KEY (user_id) REFERENCES user(id) ON DELETE CASCADE
+);
+
+INSERT INTO goal_old (user_id, type, frequency, value)
+SELECT user_id, type, frequency, value FROM goal
+WHERE id IN (
+    SELECT MIN(id) FROM goal WHERE frequency IN ('daily', 'monthly') GROUP BY user_id
+);
+
+DROP TABLE goal;
+ALTER TABLE goal_old RENAME TO goal;
@@ -0,0 +1,17 @@
+-- Users can have several goals, one per type and frequency. SQLite cannot
+-- change the primary key or a CHECK constraint, so the goal table is rebuilt.
+CREATE TABLE goal_new (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    user_id INTEGER NOT NULL,
+    type TEXT NOT NULL CHECK (type IN ('books', 'pages', 'minutes')),
+    frequency TEXT NOT NULL CHECK (frequency IN ('daily', 'weekly', 'monthly', 'yearly')),
+    value INTEGER NOT NULL CHECK (value >= 1),
+    UNIQUE (user_id, type, frequency),
+    FOREIGN KEY (user_id) REFERENCES user(id) ON DELETE CASCADE
+);
+
+INSERT INTO goal_new (user_id, type, frequency, value)
+SELECT user_id, type, frequency, value FROM goal ORDER BY user_id;
+
+DROP TABLE goal;
+ALTER TABLE goal_new RENAME TO goal;
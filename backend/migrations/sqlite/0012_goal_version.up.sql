@@ -0,0 +1,24 @@
+-- goal_version table
+-- Every change of a goal starts a new version, so past periods are measured
+-- against the target they had. goal_id is cleared when the goal is deleted
+-- and its history kept. Dates are YYYY-MM-DD strings like the reading dates.
+CREATE TABLE goal_version (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    user_id INTEGER NOT NULL,
+    goal_id INTEGER NULL,
+    type TEXT NOT NULL CHECK (type IN ('books', 'pages', 'minutes')),
+    frequency TEXT NOT NULL CHECK (frequency IN ('daily', 'weekly', 'monthly', 'yearly')),
+    value INTEGER NOT NULL CHECK (value >= 1),
+    effective_from DATE NOT NULL,
+    effective_to DATE NULL,
+    FOREIGN KEY (user_id) REFERENCES user(id) ON DELETE CASCADE,
+    FOREIGN KEY (goal_id) REFERENCES goal(id) ON DELETE SET NULL
+);
+
+CREATE INDEX goal_version_user_id_idx ON goal_version (user_id);
+CREATE INDEX goal_version_goal_id_idx ON goal_version (goal_id);
+
+-- It is not known since when the existing goals were set, so their history
+-- starts today.
+INSERT INTO goal_version (user_id, goal_id, type, frequency, value, effective_from)
+SELECT user_id, id, type, frequency, value, date('now') FROM goal;
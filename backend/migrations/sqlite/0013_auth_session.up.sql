@@ -0,0 +1,28 @@
+-- auth_session table
+-- A session is one signed-in device. Its refresh tokens form a family: each
+-- is used once and replaced by the next, and reusing one revokes the session.
+CREATE TABLE auth_session (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    user_id INTEGER NOT NULL,
+    user_agent VARCHAR(255) NOT NULL DEFAULT '',
+    created_at TIMESTAMP NOT NULL,
+    last_used_at TIMESTAMP NOT NULL,
+    expires_at TIMESTAMP NOT NULL,
+    revoked_at TIMESTAMP NULL,
+    FOREIGN KEY (user_id) REFERENCES user(id) ON DELETE CASCADE
+);
+
+CREATE INDEX auth_session_user_id_idx ON auth_session (user_id);
+
+-- refresh_token table
+-- Only the SHA-256 of each token is stored.
+CREATE TABLE refresh_token (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    session_id INTEGER NOT NULL,
+    token_hash CHAR(64) NOT NULL UNIQUE,
+    expires_at TIMESTAMP NOT NULL,
+    used_at TIMESTAMP NULL,
+    FOREIGN KEY (session_id) REFERENCES auth_session(id) ON DELETE CASCADE
+);
+
+CREATE INDEX refresh_token_session_id_idx ON refresh_token (session_id);
@@ -0,0 +1,16 @@
+-- access_token table
+-- Personal access tokens for scripts. Only the SHA-256 of each token is
+-- stored; expires_at is NULL for tokens that do not expire.
+CREATE TABLE access_token (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    user_id INTEGER NOT NULL,
+    name VARCHAR(100) NOT NULL,
+    scope TEXT NOT NULL CHECK (scope IN ('read-only', 'progress-write', 'full')),
+    token_hash CHAR(64) NOT NULL UNIQUE,
+    created_at TIMESTAMP NOT NULL,
+    expires_at TIMESTAMP NULL,
+    last_used_at TIMESTAMP NULL,
+    FOREIGN KEY (user_id) REFERENCES user(id) ON DELETE CASCADE
+);
+
+CREATE INDEX access_token_user_id_idx ON access_token (user_id);
@@ -0,0 +1,28 @@
+-- local_account table
+-- Password sign-in of a user. password_hash is an argon2id hash in PHC
+-- format; failed_logins counts the wrong passwords since the last sign-in and
+-- locked_until is set once there are too many of them.
+CREATE TABLE local_account (
+    user_id INTEGER PRIMARY KEY,
+    password_hash VARCHAR(255) NOT NULL,
+    email_verified_at TIMESTAMP NULL,
+    failed_logins INTEGER NOT NULL DEFAULT 0,
+    locked_until TIMESTAMP NULL,
+    created_at TIMESTAMP NOT NULL,
+    FOREIGN KEY (user_id) REFERENCES user(id) ON DELETE CASCADE
+);
+
+-- account_token table
+-- Single-use tokens mailed to verify an email or reset a password. Only the
+-- SHA-256 of each token is stored.
+CREATE TABLE account_token (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    user_id INTEGER NOT NULL,
+    purpose TEXT NOT NULL CHECK (purpose IN ('verify-email', 'reset-password')),
+    token_hash CHAR(64) NOT NULL UNIQUE,
+    expires_at TIMESTAMP NOT NULL,
+    used_at TIMESTAMP NULL,
+    FOREIGN KEY (user_id) REFERENCES user(id) ON DELETE CASCADE
+);
+
+CREATE INDEX account_token_user_id_idx ON account_token (user_id);
@@ -0,0 +1,25 @@
+-- Merged authors stay merged, only the collation is reverted.
+CREATE TABLE author_old (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    name VARCHAR(255) NOT NULL UNIQUE
+);
+
+INSERT INTO author_old (id, name)
+SELECT id, name FROM author;
+
+CREATE TABLE book_author_old (
+    book_id INTEGER NOT NULL,
+    author_id INTEGER NOT NULL,
+    position INTEGER NOT NULL,
+    PRIMARY KEY (book_id, author_id),
+    FOREIGN KEY (book_id) REFERENCES book(id) ON DELETE CASCADE,
+    FOREIGN KEY (author_id) REFERENCES author_old(id) ON DELETE CASCADE
+);
+
+INSERT INTO book_author_old (book_id, author_id, position)
+SELECT book_id, author_id, position FROM book_author;
+
+DROP TABLE book_author;
+DROP TABLE author;
+ALTER TABLE author_old RENAME TO author;
+ALTER TABLE book_author_old RENAME TO book_author;
@@ -0,0 +1,33 @@
+-- Author names are matched ignoring case. Names that only differ in case are
+-- merged into the oldest author, and SQLite cannot change the collation of a
+-- column, so author and book_author are rebuilt. book_author is rebuilt
+-- first, as dropping author would otherwise delete the links to it.
+CREATE TABLE author_new (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    name VARCHAR(255) NOT NULL COLLATE NOCASE UNIQUE
+);
+
+INSERT INTO author_new (id, name)
+SELECT id, name FROM author
+WHERE id IN (SELECT MIN(id) FROM author GROUP BY name COLLATE NOCASE);
+
+CREATE TABLE book_author_new (
+    book_id INTEGER NOT NULL,
+    author_id INTEGER NOT NULL,
+    position INTEGER NOT NULL,
+    PRIMARY KEY (book_id, author_id),
+    FOREIGN KEY (book_id) REFERENCES book(id) ON DELETE CASCADE,
+    FOREIGN KEY (author_id) REFERENCES author_new(id) ON DELETE CASCADE
+);
+
+INSERT OR IGNORE INTO book_author_new (book_id, author_id, position)
+SELECT ba.book_id, an.id, ba.position
+FROM book_author ba
+JOIN author a ON a.id = ba.author_id
+JOIN author_new an ON an.name = a.name COLLATE NOCASE
+ORDER BY ba.book_id, ba.position;
+
+DROP TABLE book_author;
+DROP TABLE author;
+ALTER TABLE author_new RENAME TO author;
+ALTER TABLE book_author_new RENAME TO book_author;
@@ -0,0 +1,364 @@
+// Code generated by mockery v2.46.3. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	domain "github.com/rimvydascivilis/book-tracker/backend/domain"
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// AccessTokenRepository is an autogenerated mock type for the AccessTokenRepository type
+type AccessTokenRepository struct {
+	mock.Mock
+}
+
+type AccessTokenRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *AccessTokenRepository) EXPECT() *AccessTokenRepository_Expecter {
+	return &AccessTokenRepository_Expecter{mock: &_m.Mock}
+}
+
+// CreateAccessToken provides a mock function with given fields: ctx, token
+func (_m *AccessTokenRepository) CreateAccessToken(ctx context.Context, token domain.AccessToken) (domain.AccessToken, error) {
+	ret := _m.Called(ctx, token)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateAccessToken")
+	}
+
+	var r0 domain.AccessToken
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.AccessToken) (domain.AccessToken, error)); ok {
+		return rf(ctx, token)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.AccessToken) domain.AccessToken); ok {
+		r0 = rf(ctx, token)
+	} else {
+		r0 = ret.Get(0).(domain.AccessToken)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.AccessToken) error); ok {
+		r1 = rf(ctx, token)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AccessTokenRepository_CreateAccessToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateAccessToken'
+type AccessTokenRepository_CreateAccessToken_Call struct {
+	*mock.Call
+}
+
+// CreateAccessToken is a helper method to define mock.On call
+//   - ctx context.Context
+//   - token domain.AccessToken
+func (_e *AccessTokenRepository_Expecter) CreateAccessToken(ctx interface{}, token interface{}) *AccessTokenRepository_CreateAccessToken_Call {
+	return &AccessTokenRepository_CreateAccessToken_Call{Call: _e.mock.On("CreateAccessToken", ctx, token)}
+}
+
+func (_c *AccessTokenRepository_CreateAccessToken_Call) Run(run func(ctx context.Context, token domain.AccessToken)) *AccessTokenRepository_CreateAccessToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.AccessToken))
+	})
+	return _c
+}
+
+func (_c *AccessTokenRepository_CreateAccessToken_Call) Return(_a0 domain.AccessToken, _a1 error) *AccessTokenRepository_CreateAccessToken_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AccessTokenRepository_CreateAccessToken_Call) RunAndReturn(run func(context.Context, domain.AccessToken) (domain.AccessToken, error)) *AccessTokenRepository_CreateAccessToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteAccessToken provides a mock function with given fields: ctx, id
+func (_m *AccessTokenRepository) DeleteAccessToken(ctx context.Context, id int64) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteAccessToken")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// AccessTokenRepository_DeleteAccessToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteAccessToken'
+type AccessTokenRepository_DeleteAccessToken_Call struct {
+	*mock.Call
+}
+
+// DeleteAccessToken is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+func (_e *AccessTokenRepository_Expecter) DeleteAccessToken(ctx interface{}, id interface{}) *AccessTokenRepository_DeleteAccessToken_Call {
+	return &AccessTokenRepository_DeleteAccessToken_Call{Call: _e.mock.On("DeleteAccessToken", ctx, id)}
+}
+
+func (_c *AccessTokenRepository_DeleteAccessToken_Call) Run(run func(ctx context.Context, id int64)) *AccessTokenRepository_DeleteAccessToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *AccessTokenRepository_DeleteAccessToken_Call) Return(_a0 error) *AccessTokenRepository_DeleteAccessToken_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *AccessTokenRepository_DeleteAccessToken_Call) RunAndReturn(run func(context.Context, int64) error) *AccessTokenRepository_DeleteAccessToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAccessTokenByHash provides a mock function with given fields: ctx, hash
+func (_m *AccessTokenRepository) GetAccessTokenByHash(ctx context.Context, hash string) (domain.AccessToken, error) {
+	ret := _m.Called(ctx, hash)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAccessTokenByHash")
+	}
+
+	var r0 domain.AccessToken
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (domain.AccessToken, error)); ok {
+		return rf(ctx, hash)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) domain.AccessToken); ok {
+		r0 = rf(ctx, hash)
+	} else {
+		r0 = ret.Get(0).(domain.AccessToken)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, hash)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AccessTokenRepository_GetAccessTokenByHash_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAccessTokenByHash'
+type AccessTokenRepository_GetAccessTokenByHash_Call struct {
+	*mock.Call
+}
+
+// GetAccessTokenByHash is a helper method to define mock.On call
+//   - ctx context.Context
+//   - hash string
+func (_e *AccessTokenRepository_Expecter) GetAccessTokenByHash(ctx interface{}, hash interface{}) *AccessTokenRepository_GetAccessTokenByHash_Call {
+	return &AccessTokenRepository_GetAccessTokenByHash_Call{Call: _e.mock.On("GetAccessTokenByHash", ctx, hash)}
+}
+
+func (_c *AccessTokenRepository_GetAccessTokenByHash_Call) Run(run func(ctx context.Context, hash string)) *AccessTokenRepository_GetAccessTokenByHash_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *AccessTokenRepository_GetAccessTokenByHash_Call) Return(_a0 domain.AccessToken, _a1 error) *AccessTokenRepository_GetAccessTokenByHash_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AccessTokenRepository_GetAccessTokenByHash_Call) RunAndReturn(run func(context.Context, string) (domain.AccessToken, error)) *AccessTokenRepository_GetAccessTokenByHash_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAccessTokenByID provides a mock function with given fields: ctx, id
+func (_m *AccessTokenRepository) GetAccessTokenByID(ctx context.Context, id int64) (domain.AccessToken, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAccessTokenByID")
+	}
+
+	var r0 domain.AccessToken
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) (domain.AccessToken, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) domain.AccessToken); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(domain.AccessToken)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AccessTokenRepository_GetAccessTokenByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAccessTokenByID'
+type AccessTokenRepository_GetAccessTokenByID_Call struct {
+	*mock.Call
+}
+
+// GetAccessTokenByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+func (_e *AccessTokenRepository_Expecter) GetAccessTokenByID(ctx interface{}, id interface{}) *AccessTokenRepository_GetAccessTokenByID_Call {
+	return &AccessTokenRepository_GetAccessTokenByID_Call{Call: _e.mock.On("GetAccessTokenByID", ctx, id)}
+}
+
+func (_c *AccessTokenRepository_GetAccessTokenByID_Call) Run(run func(ctx context.Context, id int64)) *AccessTokenRepository_GetAccessTokenByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *AccessTokenRepository_GetAccessTokenByID_Call) Return(_a0 domain.AccessToken, _a1 error) *AccessTokenRepository_GetAccessTokenByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AccessTokenRepository_GetAccessTokenByID_Call) RunAndReturn(run func(context.Context, int64) (domain.AccessToken, error)) *AccessTokenRepository_GetAccessTokenByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAccessTokensByUserID provides a mock function with given fields: ctx, userID
+func (_m *AccessTokenRepository) GetAccessTokensByUserID(ctx context.Context, userID int64) ([]domain.AccessToken, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAccessTokensByUserID")
+	}
+
+	var r0 []domain.AccessToken
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) ([]domain.AccessToken, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) []domain.AccessToken); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.AccessToken)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AccessTokenRepository_GetAccessTokensByUserID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAccessTokensByUserID'
+type AccessTokenRepository_GetAccessTokensByUserID_Call struct {
+	*mock.Call
+}
+
+// GetAccessTokensByUserID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID int64
+func (_e *AccessTokenRepository_Expecter) GetAccessTokensByUserID(ctx interface{}, userID interface{}) *AccessTokenRepository_GetAccessTokensByUserID_Call {
+	return &AccessTokenRepository_GetAccessTokensByUserID_Call{Call: _e.mock.On("GetAccessTokensByUserID", ctx, userID)}
+}
+
+func (_c *AccessTokenRepository_GetAccessTokensByUserID_Call) Run(run func(ctx context.Context, userID int64)) *AccessTokenRepository_GetAccessTokensByUserID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *AccessTokenRepository_GetAccessTokensByUserID_Call) Return(_a0 []domain.AccessToken, _a1 error) *AccessTokenRepository_GetAccessTokensByUserID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AccessTokenRepository_GetAccessTokensByUserID_Call) RunAndReturn(run func(context.Context, int64) ([]domain.AccessToken, error)) *AccessTokenRepository_GetAccessTokensByUserID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateAccessTokenLastUsed provides a mock function with given fields: ctx, id, t
+func (_m *AccessTokenRepository) UpdateAccessTokenLastUsed(ctx context.Context, id int64, t time.Time) error {
+	ret := _m.Called(ctx, id, t)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateAccessTokenLastUsed")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, time.Time) error); ok {
+		r0 = rf(ctx, id, t)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// AccessTokenRepository_UpdateAccessTokenLastUsed_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateAccessTokenLastUsed'
+type AccessTokenRepository_UpdateAccessTokenLastUsed_Call struct {
+	*mock.Call
+}
+
+// UpdateAccessTokenLastUsed is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+//   - t time.Time
+func (_e *AccessTokenRepository_Expecter) UpdateAccessTokenLastUsed(ctx interface{}, id interface{}, t interface{}) *AccessTokenRepository_UpdateAccessTokenLastUsed_Call {
+	return &AccessTokenRepository_UpdateAccessTokenLastUsed_Call{Call: _e.mock.On("UpdateAccessTokenLastUsed", ctx, id, t)}
+}
+
+func (_c *AccessTokenRepository_UpdateAccessTokenLastUsed_Call) Run(run func(ctx context.Context, id int64, t time.Time)) *AccessTokenRepository_UpdateAccessTokenLastUsed_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(time.Time))
+	})
+	return _c
+}
+
+func (_c *AccessTokenRepository_UpdateAccessTokenLastUsed_Call) Return(_a0 error) *AccessTokenRepository_UpdateAccessTokenLastUsed_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *AccessTokenRepository_UpdateAccessTokenLastUsed_Call) RunAndReturn(run func(context.Context, int64, time.Time) error) *AccessTokenRepository_UpdateAccessTokenLastUsed_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewAccessTokenRepository creates a new instance of AccessTokenRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewAccessTokenRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *AccessTokenRepository {
+	mock := &AccessTokenRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,268 @@
+// Code generated by mockery v2.46.3. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	domain "github.com/rimvydascivilis/book-tracker/backend/domain"
+	dto "github.com/rimvydascivilis/book-tracker/backend/dto"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// AccessTokenService is an autogenerated mock type for the AccessTokenService type
+type AccessTokenService struct {
+	mock.Mock
+}
+
+type AccessTokenService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *AccessTokenService) EXPECT() *AccessTokenService_Expecter {
+	return &AccessTokenService_Expecter{mock: &_m.Mock}
+}
+
+// Authenticate provides a mock function with given fields: ctx, token
+func (_m *AccessTokenService) Authenticate(ctx context.Context, token string) (domain.AccessToken, error) {
+	ret := _m.Called(ctx, token)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Authenticate")
+	}
+
+	var r0 domain.AccessToken
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (domain.AccessToken, error)); ok {
+		return rf(ctx, token)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) domain.AccessToken); ok {
+		r0 = rf(ctx, token)
+	} else {
+		r0 = ret.Get(0).(domain.AccessToken)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, token)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AccessTokenService_Authenticate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Authenticate'
+type AccessTokenService_Authenticate_Call struct {
+	*mock.Call
+}
+
+// Authenticate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - token string
+func (_e *AccessTokenService_Expecter) Authenticate(ctx interface{}, token interface{}) *AccessTokenService_Authenticate_Call {
+	return &AccessTokenService_Authenticate_Call{Call: _e.mock.On("Authenticate", ctx, token)}
+}
+
+func (_c *AccessTokenService_Authenticate_Call) Run(run func(ctx context.Context, token string)) *AccessTokenService_Authenticate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *AccessTokenService_Authenticate_Call) Return(_a0 domain.AccessToken, _a1 error) *AccessTokenService_Authenticate_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AccessTokenService_Authenticate_Call) RunAndReturn(run func(context.Context, string) (domain.AccessToken, error)) *AccessTokenService_Authenticate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateAccessToken provides a mock function with given fields: ctx, userID, req
+func (_m *AccessTokenService) CreateAccessToken(ctx context.Context, userID int64, req dto.AccessTokenRequest) (domain.AccessToken, string, error) {
+	ret := _m.Called(ctx, userID, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateAccessToken")
+	}
+
+	var r0 domain.AccessToken
+	var r1 string
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, dto.AccessTokenRequest) (domain.AccessToken, string, error)); ok {
+		return rf(ctx, userID, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, dto.AccessTokenRequest) domain.AccessToken); ok {
+		r0 = rf(ctx, userID, req)
+	} else {
+		r0 = ret.Get(0).(domain.AccessToken)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, dto.AccessTokenRequest) string); ok {
+		r1 = rf(ctx, userID, req)
+	} else {
+		r1 = ret.Get(1).(string)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, int64, dto.AccessTokenRequest) error); ok {
+		r2 = rf(ctx, userID, req)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// AccessTokenService_CreateAccessToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateAccessToken'
+type AccessTokenService_CreateAccessToken_Call struct {
+	*mock.Call
+}
+
+// CreateAccessToken is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID int64
+//   - req dto.AccessTokenRequest
+func (_e *AccessTokenService_Expecter) CreateAccessToken(ctx interface{}, userID interface{}, req interface{}) *AccessTokenService_CreateAccessToken_Call {
+	return &AccessTokenService_CreateAccessToken_Call{Call: _e.mock.On("CreateAccessToken", ctx, userID, req)}
+}
+
+func (_c *AccessTokenService_CreateAccessToken_Call) Run(run func(ctx context.Context, userID int64, req dto.AccessTokenRequest)) *AccessTokenService_CreateAccessToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(dto.AccessTokenRequest))
+	})
+	return _c
+}
+
+func (_c *AccessTokenService_CreateAccessToken_Call) Return(_a0 domain.AccessToken, _a1 string, _a2 error) *AccessTokenService_CreateAccessToken_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *AccessTokenService_CreateAccessToken_Call) RunAndReturn(run func(context.Context, int64, dto.AccessTokenRequest) (domain.AccessToken, string, error)) *AccessTokenService_CreateAccessToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteAccessToken provides a mock function with given fields: ctx, userID, tokenID
+func (_m *AccessTokenService) DeleteAccessToken(ctx context.Context, userID int64, tokenID int64) error {
+	ret := _m.Called(ctx, userID, tokenID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteAccessToken")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64) error); ok {
+		r0 = rf(ctx, userID, tokenID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// AccessTokenService_DeleteAccessToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteAccessToken'
+type AccessTokenService_DeleteAccessToken_Call struct {
+	*mock.Call
+}
+
+// DeleteAccessToken is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID int64
+//   - tokenID int64
+func (_e *AccessTokenService_Expecter) DeleteAccessToken(ctx interface{}, userID interface{}, tokenID interface{}) *AccessTokenService_DeleteAccessToken_Call {
+	return &AccessTokenService_DeleteAccessToken_Call{Call: _e.mock.On("DeleteAccessToken", ctx, userID, tokenID)}
+}
+
+func (_c *AccessTokenService_DeleteAccessToken_Call) Run(run func(ctx context.Context, userID int64, tokenID int64)) *AccessTokenService_DeleteAccessToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(int64))
+	})
+	return _c
+}
+
+func (_c *AccessTokenService_DeleteAccessToken_Call) Return(_a0 error) *AccessTokenService_DeleteAccessToken_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *AccessTokenService_DeleteAccessToken_Call) RunAndReturn(run func(context.Context, int64, int64) error) *AccessTokenService_DeleteAccessToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAccessTokens provides a mock function with given fields: ctx, userID
+func (_m *AccessTokenService) GetAccessTokens(ctx context.Context, userID int64) ([]domain.AccessToken, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAccessTokens")
+	}
+
+	var r0 []domain.AccessToken
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) ([]domain.AccessToken, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) []domain.AccessToken); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.AccessToken)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AccessTokenService_GetAccessTokens_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAccessTokens'
+type AccessTokenService_GetAccessTokens_Call struct {
+	*mock.Call
+}
+
+// GetAccessTokens is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID int64
+func (_e *AccessTokenService_Expecter) GetAccessTokens(ctx interface{}, userID interface{}) *AccessTokenService_GetAccessTokens_Call {
+	return &AccessTokenService_GetAccessTokens_Call{Call: _e.mock.On("GetAccessTokens", ctx, userID)}
+}
+
+func (_c *AccessTokenService_GetAccessTokens_Call) Run(run func(ctx context.Context, userID int64)) *AccessTokenService_GetAccessTokens_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *AccessTokenService_GetAccessTokens_Call) Return(_a0 []domain.AccessToken, _a1 error) *AccessTokenService_GetAccessTokens_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AccessTokenService_GetAccessTokens_Call) RunAndReturn(run func(context.Context, int64) ([]domain.AccessToken, error)) *AccessTokenService_GetAccessTokens_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewAccessTokenService creates a new instance of AccessTokenService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewAccessTokenService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *AccessTokenService {
+	mock := &AccessTokenService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
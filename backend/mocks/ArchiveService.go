@@ -0,0 +1,146 @@
+// Code generated by mockery v2.46.3. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	io "io"
+
+	domain "github.com/rimvydascivilis/book-tracker/backend/domain"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// ArchiveService is an autogenerated mock type for the ArchiveService type
+type ArchiveService struct {
+	mock.Mock
+}
+
+type ArchiveService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *ArchiveService) EXPECT() *ArchiveService_Expecter {
+	return &ArchiveService_Expecter{mock: &_m.Mock}
+}
+
+// Export provides a mock function with given fields: ctx, userID, w
+func (_m *ArchiveService) Export(ctx context.Context, userID int64, w io.Writer) error {
+	ret := _m.Called(ctx, userID, w)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Export")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, io.Writer) error); ok {
+		r0 = rf(ctx, userID, w)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ArchiveService_Export_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Export'
+type ArchiveService_Export_Call struct {
+	*mock.Call
+}
+
+// Export is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID int64
+//   - w io.Writer
+func (_e *ArchiveService_Expecter) Export(ctx interface{}, userID interface{}, w interface{}) *ArchiveService_Export_Call {
+	return &ArchiveService_Export_Call{Call: _e.mock.On("Export", ctx, userID, w)}
+}
+
+func (_c *ArchiveService_Export_Call) Run(run func(ctx context.Context, userID int64, w io.Writer)) *ArchiveService_Export_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(io.Writer))
+	})
+	return _c
+}
+
+func (_c *ArchiveService_Export_Call) Return(_a0 error) *ArchiveService_Export_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ArchiveService_Export_Call) RunAndReturn(run func(context.Context, int64, io.Writer) error) *ArchiveService_Export_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Restore provides a mock function with given fields: ctx, userID, r, size
+func (_m *ArchiveService) Restore(ctx context.Context, userID int64, r io.ReaderAt, size int64) (domain.ArchiveManifest, error) {
+	ret := _m.Called(ctx, userID, r, size)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Restore")
+	}
+
+	var r0 domain.ArchiveManifest
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, io.ReaderAt, int64) (domain.ArchiveManifest, error)); ok {
+		return rf(ctx, userID, r, size)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, io.ReaderAt, int64) domain.ArchiveManifest); ok {
+		r0 = rf(ctx, userID, r, size)
+	} else {
+		r0 = ret.Get(0).(domain.ArchiveManifest)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, io.ReaderAt, int64) error); ok {
+		r1 = rf(ctx, userID, r, size)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ArchiveService_Restore_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Restore'
+type ArchiveService_Restore_Call struct {
+	*mock.Call
+}
+
+// Restore is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID int64
+//   - r io.ReaderAt
+//   - size int64
+func (_e *ArchiveService_Expecter) Restore(ctx interface{}, userID interface{}, r interface{}, size interface{}) *ArchiveService_Restore_Call {
+	return &ArchiveService_Restore_Call{Call: _e.mock.On("Restore", ctx, userID, r, size)}
+}
+
+func (_c *ArchiveService_Restore_Call) Run(run func(ctx context.Context, userID int64, r io.ReaderAt, size int64)) *ArchiveService_Restore_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(io.ReaderAt), args[3].(int64))
+	})
+	return _c
+}
+
+func (_c *ArchiveService_Restore_Call) Return(_a0 domain.ArchiveManifest, _a1 error) *ArchiveService_Restore_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ArchiveService_Restore_Call) RunAndReturn(run func(context.Context, int64, io.ReaderAt, int64) (domain.ArchiveManifest, error)) *ArchiveService_Restore_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewArchiveService creates a new instance of ArchiveService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewArchiveService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ArchiveService {
+	mock := &ArchiveService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -5,6 +5,8 @@ package mocks
 import (
 	context "context"
 
+	dto "github.com/rimvydascivilis/book-tracker/backend/dto"
+
 	mock "github.com/stretchr/testify/mock"
 )
 
@@ -21,27 +23,87 @@ func (_m *AuthService) EXPECT() *AuthService_Expecter {
 	return &AuthService_Expecter{mock: &_m.Mock}
 }
 
-// Login provides a mock function with given fields: ctx, googleOauthToken
-func (_m *AuthService) Login(ctx context.Context, googleOauthToken string) (string, error) {
-	ret := _m.Called(ctx, googleOauthToken)
+// GetSessions provides a mock function with given fields: ctx, userID, currentSessionID
+func (_m *AuthService) GetSessions(ctx context.Context, userID int64, currentSessionID int64) ([]dto.SessionResponse, error) {
+	ret := _m.Called(ctx, userID, currentSessionID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSessions")
+	}
+
+	var r0 []dto.SessionResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64) ([]dto.SessionResponse, error)); ok {
+		return rf(ctx, userID, currentSessionID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64) []dto.SessionResponse); ok {
+		r0 = rf(ctx, userID, currentSessionID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]dto.SessionResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, int64) error); ok {
+		r1 = rf(ctx, userID, currentSessionID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AuthService_GetSessions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSessions'
+type AuthService_GetSessions_Call struct {
+	*mock.Call
+}
+
+// GetSessions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID int64
+//   - currentSessionID int64
+func (_e *AuthService_Expecter) GetSessions(ctx interface{}, userID interface{}, currentSessionID interface{}) *AuthService_GetSessions_Call {
+	return &AuthService_GetSessions_Call{Call: _e.mock.On("GetSessions", ctx, userID, currentSessionID)}
+}
+
+func (_c *AuthService_GetSessions_Call) Run(run func(ctx context.Context, userID int64, currentSessionID int64)) *AuthService_GetSessions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(int64))
+	})
+	return _c
+}
+
+func (_c *AuthService_GetSessions_Call) Return(_a0 []dto.SessionResponse, _a1 error) *AuthService_GetSessions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AuthService_GetSessions_Call) RunAndReturn(run func(context.Context, int64, int64) ([]dto.SessionResponse, error)) *AuthService_GetSessions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Login provides a mock function with given fields: ctx, googleOauthToken, userAgent
+func (_m *AuthService) Login(ctx context.Context, googleOauthToken string, userAgent string) (dto.TokenResponse, error) {
+	ret := _m.Called(ctx, googleOauthToken, userAgent)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Login")
 	}
 
-	var r0 string
+	var r0 dto.TokenResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string) (string, error)); ok {
-		return rf(ctx, googleOauthToken)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (dto.TokenResponse, error)); ok {
+		return rf(ctx, googleOauthToken, userAgent)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string) string); ok {
-		r0 = rf(ctx, googleOauthToken)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) dto.TokenResponse); ok {
+		r0 = rf(ctx, googleOauthToken, userAgent)
 	} else {
-		r0 = ret.Get(0).(string)
+		r0 = ret.Get(0).(dto.TokenResponse)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = rf(ctx, googleOauthToken)
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, googleOauthToken, userAgent)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -57,23 +119,234 @@ type AuthService_Login_Call struct {
 // Login is a helper method to define mock.On call
 //   - ctx context.Context
 //   - googleOauthToken string
-func (_e *AuthService_Expecter) Login(ctx interface{}, googleOauthToken interface{}) *AuthService_Login_Call {
-	return &AuthService_Login_Call{Call: _e.mock.On("Login", ctx, googleOauthToken)}
+//   - userAgent string
+func (_e *AuthService_Expecter) Login(ctx interface{}, googleOauthToken interface{}, userAgent interface{}) *AuthService_Login_Call {
+	return &AuthService_Login_Call{Call: _e.mock.On("Login", ctx, googleOauthToken, userAgent)}
 }
 
-func (_c *AuthService_Login_Call) Run(run func(ctx context.Context, googleOauthToken string)) *AuthService_Login_Call {
+func (_c *AuthService_Login_Call) Run(run func(ctx context.Context, googleOauthToken string, userAgent string)) *AuthService_Login_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *AuthService_Login_Call) Return(_a0 dto.TokenResponse, _a1 error) *AuthService_Login_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AuthService_Login_Call) RunAndReturn(run func(context.Context, string, string) (dto.TokenResponse, error)) *AuthService_Login_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Refresh provides a mock function with given fields: ctx, refreshToken
+func (_m *AuthService) Refresh(ctx context.Context, refreshToken string) (dto.TokenResponse, error) {
+	ret := _m.Called(ctx, refreshToken)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Refresh")
+	}
+
+	var r0 dto.TokenResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (dto.TokenResponse, error)); ok {
+		return rf(ctx, refreshToken)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) dto.TokenResponse); ok {
+		r0 = rf(ctx, refreshToken)
+	} else {
+		r0 = ret.Get(0).(dto.TokenResponse)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, refreshToken)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AuthService_Refresh_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Refresh'
+type AuthService_Refresh_Call struct {
+	*mock.Call
+}
+
+// Refresh is a helper method to define mock.On call
+//   - ctx context.Context
+//   - refreshToken string
+func (_e *AuthService_Expecter) Refresh(ctx interface{}, refreshToken interface{}) *AuthService_Refresh_Call {
+	return &AuthService_Refresh_Call{Call: _e.mock.On("Refresh", ctx, refreshToken)}
+}
+
+func (_c *AuthService_Refresh_Call) Run(run func(ctx context.Context, refreshToken string)) *AuthService_Refresh_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run(args[0].(context.Context), args[1].(string))
 	})
 	return _c
 }
 
-func (_c *AuthService_Login_Call) Return(_a0 string, _a1 error) *AuthService_Login_Call {
+func (_c *AuthService_Refresh_Call) Return(_a0 dto.TokenResponse, _a1 error) *AuthService_Refresh_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AuthService_Refresh_Call) RunAndReturn(run func(context.Context, string) (dto.TokenResponse, error)) *AuthService_Refresh_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RevokeAllSessions provides a mock function with given fields: ctx, userID
+func (_m *AuthService) RevokeAllSessions(ctx context.Context, userID int64) error {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevokeAllSessions")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) error); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// AuthService_RevokeAllSessions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokeAllSessions'
+type AuthService_RevokeAllSessions_Call struct {
+	*mock.Call
+}
+
+// RevokeAllSessions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID int64
+func (_e *AuthService_Expecter) RevokeAllSessions(ctx interface{}, userID interface{}) *AuthService_RevokeAllSessions_Call {
+	return &AuthService_RevokeAllSessions_Call{Call: _e.mock.On("RevokeAllSessions", ctx, userID)}
+}
+
+func (_c *AuthService_RevokeAllSessions_Call) Run(run func(ctx context.Context, userID int64)) *AuthService_RevokeAllSessions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *AuthService_RevokeAllSessions_Call) Return(_a0 error) *AuthService_RevokeAllSessions_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *AuthService_RevokeAllSessions_Call) RunAndReturn(run func(context.Context, int64) error) *AuthService_RevokeAllSessions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RevokeSession provides a mock function with given fields: ctx, userID, sessionID
+func (_m *AuthService) RevokeSession(ctx context.Context, userID int64, sessionID int64) error {
+	ret := _m.Called(ctx, userID, sessionID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevokeSession")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64) error); ok {
+		r0 = rf(ctx, userID, sessionID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// AuthService_RevokeSession_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokeSession'
+type AuthService_RevokeSession_Call struct {
+	*mock.Call
+}
+
+// RevokeSession is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID int64
+//   - sessionID int64
+func (_e *AuthService_Expecter) RevokeSession(ctx interface{}, userID interface{}, sessionID interface{}) *AuthService_RevokeSession_Call {
+	return &AuthService_RevokeSession_Call{Call: _e.mock.On("RevokeSession", ctx, userID, sessionID)}
+}
+
+func (_c *AuthService_RevokeSession_Call) Run(run func(ctx context.Context, userID int64, sessionID int64)) *AuthService_RevokeSession_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(int64))
+	})
+	return _c
+}
+
+func (_c *AuthService_RevokeSession_Call) Return(_a0 error) *AuthService_RevokeSession_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *AuthService_RevokeSession_Call) RunAndReturn(run func(context.Context, int64, int64) error) *AuthService_RevokeSession_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// StartSession provides a mock function with given fields: ctx, userID, userAgent
+func (_m *AuthService) StartSession(ctx context.Context, userID int64, userAgent string) (dto.TokenResponse, error) {
+	ret := _m.Called(ctx, userID, userAgent)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StartSession")
+	}
+
+	var r0 dto.TokenResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, string) (dto.TokenResponse, error)); ok {
+		return rf(ctx, userID, userAgent)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, string) dto.TokenResponse); ok {
+		r0 = rf(ctx, userID, userAgent)
+	} else {
+		r0 = ret.Get(0).(dto.TokenResponse)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, string) error); ok {
+		r1 = rf(ctx, userID, userAgent)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AuthService_StartSession_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StartSession'
+type AuthService_StartSession_Call struct {
+	*mock.Call
+}
+
+// StartSession is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID int64
+//   - userAgent string
+func (_e *AuthService_Expecter) StartSession(ctx interface{}, userID interface{}, userAgent interface{}) *AuthService_StartSession_Call {
+	return &AuthService_StartSession_Call{Call: _e.mock.On("StartSession", ctx, userID, userAgent)}
+}
+
+func (_c *AuthService_StartSession_Call) Run(run func(ctx context.Context, userID int64, userAgent string)) *AuthService_StartSession_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *AuthService_StartSession_Call) Return(_a0 dto.TokenResponse, _a1 error) *AuthService_StartSession_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *AuthService_Login_Call) RunAndReturn(run func(context.Context, string) (string, error)) *AuthService_Login_Call {
+func (_c *AuthService_StartSession_Call) RunAndReturn(run func(context.Context, int64, string) (dto.TokenResponse, error)) *AuthService_StartSession_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -0,0 +1,432 @@
+// Code generated by mockery v2.46.3. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	domain "github.com/rimvydascivilis/book-tracker/backend/domain"
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// AuthSessionRepository is an autogenerated mock type for the AuthSessionRepository type
+type AuthSessionRepository struct {
+	mock.Mock
+}
+
+type AuthSessionRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *AuthSessionRepository) EXPECT() *AuthSessionRepository_Expecter {
+	return &AuthSessionRepository_Expecter{mock: &_m.Mock}
+}
+
+// CreateRefreshToken provides a mock function with given fields: ctx, token
+func (_m *AuthSessionRepository) CreateRefreshToken(ctx context.Context, token domain.RefreshToken) (domain.RefreshToken, error) {
+	ret := _m.Called(ctx, token)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateRefreshToken")
+	}
+
+	var r0 domain.RefreshToken
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.RefreshToken) (domain.RefreshToken, error)); ok {
+		return rf(ctx, token)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.RefreshToken) domain.RefreshToken); ok {
+		r0 = rf(ctx, token)
+	} else {
+		r0 = ret.Get(0).(domain.RefreshToken)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.RefreshToken) error); ok {
+		r1 = rf(ctx, token)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AuthSessionRepository_CreateRefreshToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateRefreshToken'
+type AuthSessionRepository_CreateRefreshToken_Call struct {
+	*mock.Call
+}
+
+// CreateRefreshToken is a helper method to define mock.On call
+//   - ctx context.Context
+//   - token domain.RefreshToken
+func (_e *AuthSessionRepository_Expecter) CreateRefreshToken(ctx interface{}, token interface{}) *AuthSessionRepository_CreateRefreshToken_Call {
+	return &AuthSessionRepository_CreateRefreshToken_Call{Call: _e.mock.On("CreateRefreshToken", ctx, token)}
+}
+
+func (_c *AuthSessionRepository_CreateRefreshToken_Call) Run(run func(ctx context.Context, token domain.RefreshToken)) *AuthSessionRepository_CreateRefreshToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.RefreshToken))
+	})
+	return _c
+}
+
+func (_c *AuthSessionRepository_CreateRefreshToken_Call) Return(_a0 domain.RefreshToken, _a1 error) *AuthSessionRepository_CreateRefreshToken_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AuthSessionRepository_CreateRefreshToken_Call) RunAndReturn(run func(context.Context, domain.RefreshToken) (domain.RefreshToken, error)) *AuthSessionRepository_CreateRefreshToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateSession provides a mock function with given fields: ctx, session
+func (_m *AuthSessionRepository) CreateSession(ctx context.Context, session domain.AuthSession) (domain.AuthSession, error) {
+	ret := _m.Called(ctx, session)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateSession")
+	}
+
+	var r0 domain.AuthSession
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.AuthSession) (domain.AuthSession, error)); ok {
+		return rf(ctx, session)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.AuthSession) domain.AuthSession); ok {
+		r0 = rf(ctx, session)
+	} else {
+		r0 = ret.Get(0).(domain.AuthSession)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.AuthSession) error); ok {
+		r1 = rf(ctx, session)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AuthSessionRepository_CreateSession_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateSession'
+type AuthSessionRepository_CreateSession_Call struct {
+	*mock.Call
+}
+
+// CreateSession is a helper method to define mock.On call
+//   - ctx context.Context
+//   - session domain.AuthSession
+func (_e *AuthSessionRepository_Expecter) CreateSession(ctx interface{}, session interface{}) *AuthSessionRepository_CreateSession_Call {
+	return &AuthSessionRepository_CreateSession_Call{Call: _e.mock.On("CreateSession", ctx, session)}
+}
+
+func (_c *AuthSessionRepository_CreateSession_Call) Run(run func(ctx context.Context, session domain.AuthSession)) *AuthSessionRepository_CreateSession_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.AuthSession))
+	})
+	return _c
+}
+
+func (_c *AuthSessionRepository_CreateSession_Call) Return(_a0 domain.AuthSession, _a1 error) *AuthSessionRepository_CreateSession_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AuthSessionRepository_CreateSession_Call) RunAndReturn(run func(context.Context, domain.AuthSession) (domain.AuthSession, error)) *AuthSessionRepository_CreateSession_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetActiveSessionsByUserID provides a mock function with given fields: ctx, userID, t
+func (_m *AuthSessionRepository) GetActiveSessionsByUserID(ctx context.Context, userID int64, t time.Time) ([]domain.AuthSession, error) {
+	ret := _m.Called(ctx, userID, t)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetActiveSessionsByUserID")
+	}
+
+	var r0 []domain.AuthSession
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, time.Time) ([]domain.AuthSession, error)); ok {
+		return rf(ctx, userID, t)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, time.Time) []domain.AuthSession); ok {
+		r0 = rf(ctx, userID, t)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.AuthSession)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, time.Time) error); ok {
+		r1 = rf(ctx, userID, t)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AuthSessionRepository_GetActiveSessionsByUserID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetActiveSessionsByUserID'
+type AuthSessionRepository_GetActiveSessionsByUserID_Call struct {
+	*mock.Call
+}
+
+// GetActiveSessionsByUserID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID int64
+//   - t time.Time
+func (_e *AuthSessionRepository_Expecter) GetActiveSessionsByUserID(ctx interface{}, userID interface{}, t interface{}) *AuthSessionRepository_GetActiveSessionsByUserID_Call {
+	return &AuthSessionRepository_GetActiveSessionsByUserID_Call{Call: _e.mock.On("GetActiveSessionsByUserID", ctx, userID, t)}
+}
+
+func (_c *AuthSessionRepository_GetActiveSessionsByUserID_Call) Run(run func(ctx context.Context, userID int64, t time.Time)) *AuthSessionRepository_GetActiveSessionsByUserID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(time.Time))
+	})
+	return _c
+}
+
+func (_c *AuthSessionRepository_GetActiveSessionsByUserID_Call) Return(_a0 []domain.AuthSession, _a1 error) *AuthSessionRepository_GetActiveSessionsByUserID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AuthSessionRepository_GetActiveSessionsByUserID_Call) RunAndReturn(run func(context.Context, int64, time.Time) ([]domain.AuthSession, error)) *AuthSessionRepository_GetActiveSessionsByUserID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetRefreshTokenByHashForUpdate provides a mock function with given fields: ctx, hash
+func (_m *AuthSessionRepository) GetRefreshTokenByHashForUpdate(ctx context.Context, hash string) (domain.RefreshToken, error) {
+	ret := _m.Called(ctx, hash)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRefreshTokenByHashForUpdate")
+	}
+
+	var r0 domain.RefreshToken
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (domain.RefreshToken, error)); ok {
+		return rf(ctx, hash)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) domain.RefreshToken); ok {
+		r0 = rf(ctx, hash)
+	} else {
+		r0 = ret.Get(0).(domain.RefreshToken)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, hash)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AuthSessionRepository_GetRefreshTokenByHashForUpdate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRefreshTokenByHashForUpdate'
+type AuthSessionRepository_GetRefreshTokenByHashForUpdate_Call struct {
+	*mock.Call
+}
+
+// GetRefreshTokenByHashForUpdate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - hash string
+func (_e *AuthSessionRepository_Expecter) GetRefreshTokenByHashForUpdate(ctx interface{}, hash interface{}) *AuthSessionRepository_GetRefreshTokenByHashForUpdate_Call {
+	return &AuthSessionRepository_GetRefreshTokenByHashForUpdate_Call{Call: _e.mock.On("GetRefreshTokenByHashForUpdate", ctx, hash)}
+}
+
+func (_c *AuthSessionRepository_GetRefreshTokenByHashForUpdate_Call) Run(run func(ctx context.Context, hash string)) *AuthSessionRepository_GetRefreshTokenByHashForUpdate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *AuthSessionRepository_GetRefreshTokenByHashForUpdate_Call) Return(_a0 domain.RefreshToken, _a1 error) *AuthSessionRepository_GetRefreshTokenByHashForUpdate_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AuthSessionRepository_GetRefreshTokenByHashForUpdate_Call) RunAndReturn(run func(context.Context, string) (domain.RefreshToken, error)) *AuthSessionRepository_GetRefreshTokenByHashForUpdate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetSessionByID provides a mock function with given fields: ctx, id
+func (_m *AuthSessionRepository) GetSessionByID(ctx context.Context, id int64) (domain.AuthSession, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSessionByID")
+	}
+
+	var r0 domain.AuthSession
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) (domain.AuthSession, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) domain.AuthSession); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(domain.AuthSession)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AuthSessionRepository_GetSessionByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSessionByID'
+type AuthSessionRepository_GetSessionByID_Call struct {
+	*mock.Call
+}
+
+// GetSessionByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+func (_e *AuthSessionRepository_Expecter) GetSessionByID(ctx interface{}, id interface{}) *AuthSessionRepository_GetSessionByID_Call {
+	return &AuthSessionRepository_GetSessionByID_Call{Call: _e.mock.On("GetSessionByID", ctx, id)}
+}
+
+func (_c *AuthSessionRepository_GetSessionByID_Call) Run(run func(ctx context.Context, id int64)) *AuthSessionRepository_GetSessionByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *AuthSessionRepository_GetSessionByID_Call) Return(_a0 domain.AuthSession, _a1 error) *AuthSessionRepository_GetSessionByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AuthSessionRepository_GetSessionByID_Call) RunAndReturn(run func(context.Context, int64) (domain.AuthSession, error)) *AuthSessionRepository_GetSessionByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MarkRefreshTokenUsed provides a mock function with given fields: ctx, id, t
+func (_m *AuthSessionRepository) MarkRefreshTokenUsed(ctx context.Context, id int64, t time.Time) error {
+	ret := _m.Called(ctx, id, t)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarkRefreshTokenUsed")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, time.Time) error); ok {
+		r0 = rf(ctx, id, t)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// AuthSessionRepository_MarkRefreshTokenUsed_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkRefreshTokenUsed'
+type AuthSessionRepository_MarkRefreshTokenUsed_Call struct {
+	*mock.Call
+}
+
+// MarkRefreshTokenUsed is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+//   - t time.Time
+func (_e *AuthSessionRepository_Expecter) MarkRefreshTokenUsed(ctx interface{}, id interface{}, t interface{}) *AuthSessionRepository_MarkRefreshTokenUsed_Call {
+	return &AuthSessionRepository_MarkRefreshTokenUsed_Call{Call: _e.mock.On("MarkRefreshTokenUsed", ctx, id, t)}
+}
+
+func (_c *AuthSessionRepository_MarkRefreshTokenUsed_Call) Run(run func(ctx context.Context, id int64, t time.Time)) *AuthSessionRepository_MarkRefreshTokenUsed_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(time.Time))
+	})
+	return _c
+}
+
+func (_c *AuthSessionRepository_MarkRefreshTokenUsed_Call) Return(_a0 error) *AuthSessionRepository_MarkRefreshTokenUsed_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *AuthSessionRepository_MarkRefreshTokenUsed_Call) RunAndReturn(run func(context.Context, int64, time.Time) error) *AuthSessionRepository_MarkRefreshTokenUsed_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateSession provides a mock function with given fields: ctx, session
+func (_m *AuthSessionRepository) UpdateSession(ctx context.Context, session domain.AuthSession) (domain.AuthSession, error) {
+	ret := _m.Called(ctx, session)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateSession")
+	}
+
+	var r0 domain.AuthSession
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.AuthSession) (domain.AuthSession, error)); ok {
+		return rf(ctx, session)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.AuthSession) domain.AuthSession); ok {
+		r0 = rf(ctx, session)
+	} else {
+		r0 = ret.Get(0).(domain.AuthSession)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.AuthSession) error); ok {
+		r1 = rf(ctx, session)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AuthSessionRepository_UpdateSession_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateSession'
+type AuthSessionRepository_UpdateSession_Call struct {
+	*mock.Call
+}
+
+// UpdateSession is a helper method to define mock.On call
+//   - ctx context.Context
+//   - session domain.AuthSession
+func (_e *AuthSessionRepository_Expecter) UpdateSession(ctx interface{}, session interface{}) *AuthSessionRepository_UpdateSession_Call {
+	return &AuthSessionRepository_UpdateSession_Call{Call: _e.mock.On("UpdateSession", ctx, session)}
+}
+
+func (_c *AuthSessionRepository_UpdateSession_Call) Run(run func(ctx context.Context, session domain.AuthSession)) *AuthSessionRepository_UpdateSession_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.AuthSession))
+	})
+	return _c
+}
+
+func (_c *AuthSessionRepository_UpdateSession_Call) Return(_a0 domain.AuthSession, _a1 error) *AuthSessionRepository_UpdateSession_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AuthSessionRepository_UpdateSession_Call) RunAndReturn(run func(context.Context, domain.AuthSession) (domain.AuthSession, error)) *AuthSessionRepository_UpdateSession_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewAuthSessionRepository creates a new instance of AuthSessionRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewAuthSessionRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *AuthSessionRepository {
+	mock := &AuthSessionRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,203 @@
+// Code generated by mockery v2.46.3. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	domain "github.com/rimvydascivilis/book-tracker/backend/domain"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// AuthorRepository is an autogenerated mock type for the AuthorRepository type
+type AuthorRepository struct {
+	mock.Mock
+}
+
+type AuthorRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *AuthorRepository) EXPECT() *AuthorRepository_Expecter {
+	return &AuthorRepository_Expecter{mock: &_m.Mock}
+}
+
+// GetAuthorsByBookIDs provides a mock function with given fields: ctx, bookIDs
+func (_m *AuthorRepository) GetAuthorsByBookIDs(ctx context.Context, bookIDs []int64) (map[int64][]domain.Author, error) {
+	ret := _m.Called(ctx, bookIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAuthorsByBookIDs")
+	}
+
+	var r0 map[int64][]domain.Author
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []int64) (map[int64][]domain.Author, error)); ok {
+		return rf(ctx, bookIDs)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []int64) map[int64][]domain.Author); ok {
+		r0 = rf(ctx, bookIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[int64][]domain.Author)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []int64) error); ok {
+		r1 = rf(ctx, bookIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AuthorRepository_GetAuthorsByBookIDs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAuthorsByBookIDs'
+type AuthorRepository_GetAuthorsByBookIDs_Call struct {
+	*mock.Call
+}
+
+// GetAuthorsByBookIDs is a helper method to define mock.On call
+//   - ctx context.Context
+//   - bookIDs []int64
+func (_e *AuthorRepository_Expecter) GetAuthorsByBookIDs(ctx interface{}, bookIDs interface{}) *AuthorRepository_GetAuthorsByBookIDs_Call {
+	return &AuthorRepository_GetAuthorsByBookIDs_Call{Call: _e.mock.On("GetAuthorsByBookIDs", ctx, bookIDs)}
+}
+
+func (_c *AuthorRepository_GetAuthorsByBookIDs_Call) Run(run func(ctx context.Context, bookIDs []int64)) *AuthorRepository_GetAuthorsByBookIDs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]int64))
+	})
+	return _c
+}
+
+func (_c *AuthorRepository_GetAuthorsByBookIDs_Call) Return(_a0 map[int64][]domain.Author, _a1 error) *AuthorRepository_GetAuthorsByBookIDs_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AuthorRepository_GetAuthorsByBookIDs_Call) RunAndReturn(run func(context.Context, []int64) (map[int64][]domain.Author, error)) *AuthorRepository_GetAuthorsByBookIDs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetOrCreateAuthors provides a mock function with given fields: ctx, names
+func (_m *AuthorRepository) GetOrCreateAuthors(ctx context.Context, names []string) ([]domain.Author, error) {
+	ret := _m.Called(ctx, names)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOrCreateAuthors")
+	}
+
+	var r0 []domain.Author
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []string) ([]domain.Author, error)); ok {
+		return rf(ctx, names)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []string) []domain.Author); ok {
+		r0 = rf(ctx, names)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Author)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []string) error); ok {
+		r1 = rf(ctx, names)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AuthorRepository_GetOrCreateAuthors_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetOrCreateAuthors'
+type AuthorRepository_GetOrCreateAuthors_Call struct {
+	*mock.Call
+}
+
+// GetOrCreateAuthors is a helper method to define mock.On call
+//   - ctx context.Context
+//   - names []string
+func (_e *AuthorRepository_Expecter) GetOrCreateAuthors(ctx interface{}, names interface{}) *AuthorRepository_GetOrCreateAuthors_Call {
+	return &AuthorRepository_GetOrCreateAuthors_Call{Call: _e.mock.On("GetOrCreateAuthors", ctx, names)}
+}
+
+func (_c *AuthorRepository_GetOrCreateAuthors_Call) Run(run func(ctx context.Context, names []string)) *AuthorRepository_GetOrCreateAuthors_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]string))
+	})
+	return _c
+}
+
+func (_c *AuthorRepository_GetOrCreateAuthors_Call) Return(_a0 []domain.Author, _a1 error) *AuthorRepository_GetOrCreateAuthors_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AuthorRepository_GetOrCreateAuthors_Call) RunAndReturn(run func(context.Context, []string) ([]domain.Author, error)) *AuthorRepository_GetOrCreateAuthors_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetBookAuthors provides a mock function with given fields: ctx, bookID, authorIDs
+func (_m *AuthorRepository) SetBookAuthors(ctx context.Context, bookID int64, authorIDs []int64) error {
+	ret := _m.Called(ctx, bookID, authorIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetBookAuthors")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, []int64) error); ok {
+		r0 = rf(ctx, bookID, authorIDs)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// AuthorRepository_SetBookAuthors_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetBookAuthors'
+type AuthorRepository_SetBookAuthors_Call struct {
+	*mock.Call
+}
+
+// SetBookAuthors is a helper method to define mock.On call
+//   - ctx context.Context
+//   - bookID int64
+//   - authorIDs []int64
+func (_e *AuthorRepository_Expecter) SetBookAuthors(ctx interface{}, bookID interface{}, authorIDs interface{}) *AuthorRepository_SetBookAuthors_Call {
+	return &AuthorRepository_SetBookAuthors_Call{Call: _e.mock.On("SetBookAuthors", ctx, bookID, authorIDs)}
+}
+
+func (_c *AuthorRepository_SetBookAuthors_Call) Run(run func(ctx context.Context, bookID int64, authorIDs []int64)) *AuthorRepository_SetBookAuthors_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].([]int64))
+	})
+	return _c
+}
+
+func (_c *AuthorRepository_SetBookAuthors_Call) Return(_a0 error) *AuthorRepository_SetBookAuthors_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *AuthorRepository_SetBookAuthors_Call) RunAndReturn(run func(context.Context, int64, []int64) error) *AuthorRepository_SetBookAuthors_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewAuthorRepository creates a new instance of AuthorRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewAuthorRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *AuthorRepository {
+	mock := &AuthorRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
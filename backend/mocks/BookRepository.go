@@ -242,6 +242,64 @@ func (_c *BookRepository_GetBookByUserID_Call) RunAndReturn(run func(context.Con
 	return _c
 }
 
+// GetBookByUserIDForUpdate provides a mock function with given fields: ctx, userID, bookID
+func (_m *BookRepository) GetBookByUserIDForUpdate(ctx context.Context, userID int64, bookID int64) (domain.Book, error) {
+	ret := _m.Called(ctx, userID, bookID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetBookByUserIDForUpdate")
+	}
+
+	var r0 domain.Book
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64) (domain.Book, error)); ok {
+		return rf(ctx, userID, bookID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64) domain.Book); ok {
+		r0 = rf(ctx, userID, bookID)
+	} else {
+		r0 = ret.Get(0).(domain.Book)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, int64) error); ok {
+		r1 = rf(ctx, userID, bookID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// BookRepository_GetBookByUserIDForUpdate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetBookByUserIDForUpdate'
+type BookRepository_GetBookByUserIDForUpdate_Call struct {
+	*mock.Call
+}
+
+// GetBookByUserIDForUpdate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID int64
+//   - bookID int64
+func (_e *BookRepository_Expecter) GetBookByUserIDForUpdate(ctx interface{}, userID interface{}, bookID interface{}) *BookRepository_GetBookByUserIDForUpdate_Call {
+	return &BookRepository_GetBookByUserIDForUpdate_Call{Call: _e.mock.On("GetBookByUserIDForUpdate", ctx, userID, bookID)}
+}
+
+func (_c *BookRepository_GetBookByUserIDForUpdate_Call) Run(run func(ctx context.Context, userID int64, bookID int64)) *BookRepository_GetBookByUserIDForUpdate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(int64))
+	})
+	return _c
+}
+
+func (_c *BookRepository_GetBookByUserIDForUpdate_Call) Return(_a0 domain.Book, _a1 error) *BookRepository_GetBookByUserIDForUpdate_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *BookRepository_GetBookByUserIDForUpdate_Call) RunAndReturn(run func(context.Context, int64, int64) (domain.Book, error)) *BookRepository_GetBookByUserIDForUpdate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetBooksByUser provides a mock function with given fields: ctx, userID, offset, limit
 func (_m *BookRepository) GetBooksByUser(ctx context.Context, userID int64, offset int64, limit int64) ([]domain.Book, error) {
 	ret := _m.Called(ctx, userID, offset, limit)
@@ -303,21 +361,21 @@ func (_c *BookRepository_GetBooksByUser_Call) RunAndReturn(run func(context.Cont
 	return _c
 }
 
-// SearchBooksByTitle provides a mock function with given fields: ctx, userID, title, limit
-func (_m *BookRepository) SearchBooksByTitle(ctx context.Context, userID int64, title string, limit int64) ([]domain.Book, error) {
-	ret := _m.Called(ctx, userID, title, limit)
+// SearchBooks provides a mock function with given fields: ctx, userID, query, limit
+func (_m *BookRepository) SearchBooks(ctx context.Context, userID int64, query string, limit int64) ([]domain.Book, error) {
+	ret := _m.Called(ctx, userID, query, limit)
 
 	if len(ret) == 0 {
-		panic("no return value specified for SearchBooksByTitle")
+		panic("no return value specified for SearchBooks")
 	}
 
 	var r0 []domain.Book
 	var r1 error
 	if rf, ok := ret.Get(0).(func(context.Context, int64, string, int64) ([]domain.Book, error)); ok {
-		return rf(ctx, userID, title, limit)
+		return rf(ctx, userID, query, limit)
 	}
 	if rf, ok := ret.Get(0).(func(context.Context, int64, string, int64) []domain.Book); ok {
-		r0 = rf(ctx, userID, title, limit)
+		r0 = rf(ctx, userID, query, limit)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]domain.Book)
@@ -325,7 +383,7 @@ func (_m *BookRepository) SearchBooksByTitle(ctx context.Context, userID int64,
 	}
 
 	if rf, ok := ret.Get(1).(func(context.Context, int64, string, int64) error); ok {
-		r1 = rf(ctx, userID, title, limit)
+		r1 = rf(ctx, userID, query, limit)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -333,33 +391,33 @@ func (_m *BookRepository) SearchBooksByTitle(ctx context.Context, userID int64,
 	return r0, r1
 }
 
-// BookRepository_SearchBooksByTitle_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SearchBooksByTitle'
-type BookRepository_SearchBooksByTitle_Call struct {
+// BookRepository_SearchBooks_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SearchBooks'
+type BookRepository_SearchBooks_Call struct {
 	*mock.Call
 }
 
-// SearchBooksByTitle is a helper method to define mock.On call
+// SearchBooks is a helper method to define mock.On call
 //   - ctx context.Context
 //   - userID int64
-//   - title string
+//   - query string
 //   - limit int64
-func (_e *BookRepository_Expecter) SearchBooksByTitle(ctx interface{}, userID interface{}, title interface{}, limit interface{}) *BookRepository_SearchBooksByTitle_Call {
-	return &BookRepository_SearchBooksByTitle_Call{Call: _e.mock.On("SearchBooksByTitle", ctx, userID, title, limit)}
+func (_e *BookRepository_Expecter) SearchBooks(ctx interface{}, userID interface{}, query interface{}, limit interface{}) *BookRepository_SearchBooks_Call {
+	return &BookRepository_SearchBooks_Call{Call: _e.mock.On("SearchBooks", ctx, userID, query, limit)}
 }
 
-func (_c *BookRepository_SearchBooksByTitle_Call) Run(run func(ctx context.Context, userID int64, title string, limit int64)) *BookRepository_SearchBooksByTitle_Call {
+func (_c *BookRepository_SearchBooks_Call) Run(run func(ctx context.Context, userID int64, query string, limit int64)) *BookRepository_SearchBooks_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run(args[0].(context.Context), args[1].(int64), args[2].(string), args[3].(int64))
 	})
 	return _c
 }
 
-func (_c *BookRepository_SearchBooksByTitle_Call) Return(_a0 []domain.Book, _a1 error) *BookRepository_SearchBooksByTitle_Call {
+func (_c *BookRepository_SearchBooks_Call) Return(_a0 []domain.Book, _a1 error) *BookRepository_SearchBooks_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *BookRepository_SearchBooksByTitle_Call) RunAndReturn(run func(context.Context, int64, string, int64) ([]domain.Book, error)) *BookRepository_SearchBooksByTitle_Call {
+func (_c *BookRepository_SearchBooks_Call) RunAndReturn(run func(context.Context, int64, string, int64) ([]domain.Book, error)) *BookRepository_SearchBooks_Call {
 	_c.Call.Return(run)
 	return _c
 }
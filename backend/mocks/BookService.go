@@ -80,6 +80,65 @@ func (_c *BookService_CreateBook_Call) RunAndReturn(run func(context.Context, in
 	return _c
 }
 
+// CreateBookFromISBN provides a mock function with given fields: ctx, userID, isbn, book
+func (_m *BookService) CreateBookFromISBN(ctx context.Context, userID int64, isbn string, book domain.Book) (domain.Book, error) {
+	ret := _m.Called(ctx, userID, isbn, book)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateBookFromISBN")
+	}
+
+	var r0 domain.Book
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, string, domain.Book) (domain.Book, error)); ok {
+		return rf(ctx, userID, isbn, book)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, string, domain.Book) domain.Book); ok {
+		r0 = rf(ctx, userID, isbn, book)
+	} else {
+		r0 = ret.Get(0).(domain.Book)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, string, domain.Book) error); ok {
+		r1 = rf(ctx, userID, isbn, book)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// BookService_CreateBookFromISBN_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateBookFromISBN'
+type BookService_CreateBookFromISBN_Call struct {
+	*mock.Call
+}
+
+// CreateBookFromISBN is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID int64
+//   - isbn string
+//   - book domain.Book
+func (_e *BookService_Expecter) CreateBookFromISBN(ctx interface{}, userID interface{}, isbn interface{}, book interface{}) *BookService_CreateBookFromISBN_Call {
+	return &BookService_CreateBookFromISBN_Call{Call: _e.mock.On("CreateBookFromISBN", ctx, userID, isbn, book)}
+}
+
+func (_c *BookService_CreateBookFromISBN_Call) Run(run func(ctx context.Context, userID int64, isbn string, book domain.Book)) *BookService_CreateBookFromISBN_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(string), args[3].(domain.Book))
+	})
+	return _c
+}
+
+func (_c *BookService_CreateBookFromISBN_Call) Return(_a0 domain.Book, _a1 error) *BookService_CreateBookFromISBN_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *BookService_CreateBookFromISBN_Call) RunAndReturn(run func(context.Context, int64, string, domain.Book) (domain.Book, error)) *BookService_CreateBookFromISBN_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // DeleteBook provides a mock function with given fields: ctx, userID, bookID
 func (_m *BookService) DeleteBook(ctx context.Context, userID int64, bookID int64) error {
 	ret := _m.Called(ctx, userID, bookID)
@@ -196,9 +255,66 @@ func (_c *BookService_GetBooks_Call) RunAndReturn(run func(context.Context, int6
 	return _c
 }
 
-// SearchBooks provides a mock function with given fields: ctx, userID, title, limit
-func (_m *BookService) SearchBooks(ctx context.Context, userID int64, title string, limit int64) ([]domain.Book, error) {
-	ret := _m.Called(ctx, userID, title, limit)
+// LookupBook provides a mock function with given fields: ctx, isbn
+func (_m *BookService) LookupBook(ctx context.Context, isbn string) (domain.Book, error) {
+	ret := _m.Called(ctx, isbn)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LookupBook")
+	}
+
+	var r0 domain.Book
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (domain.Book, error)); ok {
+		return rf(ctx, isbn)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) domain.Book); ok {
+		r0 = rf(ctx, isbn)
+	} else {
+		r0 = ret.Get(0).(domain.Book)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, isbn)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// BookService_LookupBook_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LookupBook'
+type BookService_LookupBook_Call struct {
+	*mock.Call
+}
+
+// LookupBook is a helper method to define mock.On call
+//   - ctx context.Context
+//   - isbn string
+func (_e *BookService_Expecter) LookupBook(ctx interface{}, isbn interface{}) *BookService_LookupBook_Call {
+	return &BookService_LookupBook_Call{Call: _e.mock.On("LookupBook", ctx, isbn)}
+}
+
+func (_c *BookService_LookupBook_Call) Run(run func(ctx context.Context, isbn string)) *BookService_LookupBook_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *BookService_LookupBook_Call) Return(_a0 domain.Book, _a1 error) *BookService_LookupBook_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *BookService_LookupBook_Call) RunAndReturn(run func(context.Context, string) (domain.Book, error)) *BookService_LookupBook_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SearchBooks provides a mock function with given fields: ctx, userID, query, limit
+func (_m *BookService) SearchBooks(ctx context.Context, userID int64, query string, limit int64) ([]domain.Book, error) {
+	ret := _m.Called(ctx, userID, query, limit)
 
 	if len(ret) == 0 {
 		panic("no return value specified for SearchBooks")
@@ -207,10 +323,10 @@ func (_m *BookService) SearchBooks(ctx context.Context, userID int64, title stri
 	var r0 []domain.Book
 	var r1 error
 	if rf, ok := ret.Get(0).(func(context.Context, int64, string, int64) ([]domain.Book, error)); ok {
-		return rf(ctx, userID, title, limit)
+		return rf(ctx, userID, query, limit)
 	}
 	if rf, ok := ret.Get(0).(func(context.Context, int64, string, int64) []domain.Book); ok {
-		r0 = rf(ctx, userID, title, limit)
+		r0 = rf(ctx, userID, query, limit)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]domain.Book)
@@ -218,7 +334,7 @@ func (_m *BookService) SearchBooks(ctx context.Context, userID int64, title stri
 	}
 
 	if rf, ok := ret.Get(1).(func(context.Context, int64, string, int64) error); ok {
-		r1 = rf(ctx, userID, title, limit)
+		r1 = rf(ctx, userID, query, limit)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -234,13 +350,13 @@ type BookService_SearchBooks_Call struct {
 // SearchBooks is a helper method to define mock.On call
 //   - ctx context.Context
 //   - userID int64
-//   - title string
+//   - query string
 //   - limit int64
-func (_e *BookService_Expecter) SearchBooks(ctx interface{}, userID interface{}, title interface{}, limit interface{}) *BookService_SearchBooks_Call {
-	return &BookService_SearchBooks_Call{Call: _e.mock.On("SearchBooks", ctx, userID, title, limit)}
+func (_e *BookService_Expecter) SearchBooks(ctx interface{}, userID interface{}, query interface{}, limit interface{}) *BookService_SearchBooks_Call {
+	return &BookService_SearchBooks_Call{Call: _e.mock.On("SearchBooks", ctx, userID, query, limit)}
 }
 
-func (_c *BookService_SearchBooks_Call) Run(run func(ctx context.Context, userID int64, title string, limit int64)) *BookService_SearchBooks_Call {
+func (_c *BookService_SearchBooks_Call) Run(run func(ctx context.Context, userID int64, query string, limit int64)) *BookService_SearchBooks_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run(args[0].(context.Context), args[1].(int64), args[2].(string), args[3].(int64))
 	})
@@ -257,6 +373,67 @@ func (_c *BookService_SearchBooks_Call) RunAndReturn(run func(context.Context, i
 	return _c
 }
 
+// SearchMetadata provides a mock function with given fields: ctx, title, author, limit
+func (_m *BookService) SearchMetadata(ctx context.Context, title string, author string, limit int64) ([]domain.Book, error) {
+	ret := _m.Called(ctx, title, author, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SearchMetadata")
+	}
+
+	var r0 []domain.Book
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, int64) ([]domain.Book, error)); ok {
+		return rf(ctx, title, author, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, int64) []domain.Book); ok {
+		r0 = rf(ctx, title, author, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Book)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, int64) error); ok {
+		r1 = rf(ctx, title, author, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// BookService_SearchMetadata_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SearchMetadata'
+type BookService_SearchMetadata_Call struct {
+	*mock.Call
+}
+
+// SearchMetadata is a helper method to define mock.On call
+//   - ctx context.Context
+//   - title string
+//   - author string
+//   - limit int64
+func (_e *BookService_Expecter) SearchMetadata(ctx interface{}, title interface{}, author interface{}, limit interface{}) *BookService_SearchMetadata_Call {
+	return &BookService_SearchMetadata_Call{Call: _e.mock.On("SearchMetadata", ctx, title, author, limit)}
+}
+
+func (_c *BookService_SearchMetadata_Call) Run(run func(ctx context.Context, title string, author string, limit int64)) *BookService_SearchMetadata_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(int64))
+	})
+	return _c
+}
+
+func (_c *BookService_SearchMetadata_Call) Return(_a0 []domain.Book, _a1 error) *BookService_SearchMetadata_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *BookService_SearchMetadata_Call) RunAndReturn(run func(context.Context, string, string, int64) ([]domain.Book, error)) *BookService_SearchMetadata_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateBook provides a mock function with given fields: ctx, userID, book
 func (_m *BookService) UpdateBook(ctx context.Context, userID int64, book domain.Book) (domain.Book, error) {
 	ret := _m.Called(ctx, userID, book)
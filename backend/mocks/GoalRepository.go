@@ -7,6 +7,8 @@ import (
 
 	domain "github.com/rimvydascivilis/book-tracker/backend/domain"
 	mock "github.com/stretchr/testify/mock"
+
+	time "time"
 )
 
 // GoalRepository is an autogenerated mock type for the GoalRepository type
@@ -79,25 +81,295 @@ func (_c *GoalRepository_CreateGoal_Call) RunAndReturn(run func(context.Context,
 	return _c
 }
 
-// GetGoalByUserID provides a mock function with given fields: ctx, userID
-func (_m *GoalRepository) GetGoalByUserID(ctx context.Context, userID int64) (domain.Goal, error) {
-	ret := _m.Called(ctx, userID)
+// CreateGoalVersion provides a mock function with given fields: ctx, version
+func (_m *GoalRepository) CreateGoalVersion(ctx context.Context, version domain.GoalVersion) (domain.GoalVersion, error) {
+	ret := _m.Called(ctx, version)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateGoalVersion")
+	}
+
+	var r0 domain.GoalVersion
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.GoalVersion) (domain.GoalVersion, error)); ok {
+		return rf(ctx, version)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.GoalVersion) domain.GoalVersion); ok {
+		r0 = rf(ctx, version)
+	} else {
+		r0 = ret.Get(0).(domain.GoalVersion)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.GoalVersion) error); ok {
+		r1 = rf(ctx, version)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GoalRepository_CreateGoalVersion_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateGoalVersion'
+type GoalRepository_CreateGoalVersion_Call struct {
+	*mock.Call
+}
+
+// CreateGoalVersion is a helper method to define mock.On call
+//   - ctx context.Context
+//   - version domain.GoalVersion
+func (_e *GoalRepository_Expecter) CreateGoalVersion(ctx interface{}, version interface{}) *GoalRepository_CreateGoalVersion_Call {
+	return &GoalRepository_CreateGoalVersion_Call{Call: _e.mock.On("CreateGoalVersion", ctx, version)}
+}
+
+func (_c *GoalRepository_CreateGoalVersion_Call) Run(run func(ctx context.Context, version domain.GoalVersion)) *GoalRepository_CreateGoalVersion_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.GoalVersion))
+	})
+	return _c
+}
+
+func (_c *GoalRepository_CreateGoalVersion_Call) Return(_a0 domain.GoalVersion, _a1 error) *GoalRepository_CreateGoalVersion_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *GoalRepository_CreateGoalVersion_Call) RunAndReturn(run func(context.Context, domain.GoalVersion) (domain.GoalVersion, error)) *GoalRepository_CreateGoalVersion_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteGoal provides a mock function with given fields: ctx, id
+func (_m *GoalRepository) DeleteGoal(ctx context.Context, id int64) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteGoal")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GoalRepository_DeleteGoal_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteGoal'
+type GoalRepository_DeleteGoal_Call struct {
+	*mock.Call
+}
+
+// DeleteGoal is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+func (_e *GoalRepository_Expecter) DeleteGoal(ctx interface{}, id interface{}) *GoalRepository_DeleteGoal_Call {
+	return &GoalRepository_DeleteGoal_Call{Call: _e.mock.On("DeleteGoal", ctx, id)}
+}
+
+func (_c *GoalRepository_DeleteGoal_Call) Run(run func(ctx context.Context, id int64)) *GoalRepository_DeleteGoal_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *GoalRepository_DeleteGoal_Call) Return(_a0 error) *GoalRepository_DeleteGoal_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *GoalRepository_DeleteGoal_Call) RunAndReturn(run func(context.Context, int64) error) *GoalRepository_DeleteGoal_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// EndGoalVersion provides a mock function with given fields: ctx, goalID, to
+func (_m *GoalRepository) EndGoalVersion(ctx context.Context, goalID int64, to time.Time) error {
+	ret := _m.Called(ctx, goalID, to)
+
+	if len(ret) == 0 {
+		panic("no return value specified for EndGoalVersion")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, time.Time) error); ok {
+		r0 = rf(ctx, goalID, to)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GoalRepository_EndGoalVersion_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'EndGoalVersion'
+type GoalRepository_EndGoalVersion_Call struct {
+	*mock.Call
+}
+
+// EndGoalVersion is a helper method to define mock.On call
+//   - ctx context.Context
+//   - goalID int64
+//   - to time.Time
+func (_e *GoalRepository_Expecter) EndGoalVersion(ctx interface{}, goalID interface{}, to interface{}) *GoalRepository_EndGoalVersion_Call {
+	return &GoalRepository_EndGoalVersion_Call{Call: _e.mock.On("EndGoalVersion", ctx, goalID, to)}
+}
+
+func (_c *GoalRepository_EndGoalVersion_Call) Run(run func(ctx context.Context, goalID int64, to time.Time)) *GoalRepository_EndGoalVersion_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(time.Time))
+	})
+	return _c
+}
+
+func (_c *GoalRepository_EndGoalVersion_Call) Return(_a0 error) *GoalRepository_EndGoalVersion_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *GoalRepository_EndGoalVersion_Call) RunAndReturn(run func(context.Context, int64, time.Time) error) *GoalRepository_EndGoalVersion_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetGoalByID provides a mock function with given fields: ctx, id
+func (_m *GoalRepository) GetGoalByID(ctx context.Context, id int64) (domain.Goal, error) {
+	ret := _m.Called(ctx, id)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetGoalByUserID")
+		panic("no return value specified for GetGoalByID")
 	}
 
 	var r0 domain.Goal
 	var r1 error
 	if rf, ok := ret.Get(0).(func(context.Context, int64) (domain.Goal, error)); ok {
-		return rf(ctx, userID)
+		return rf(ctx, id)
 	}
 	if rf, ok := ret.Get(0).(func(context.Context, int64) domain.Goal); ok {
-		r0 = rf(ctx, userID)
+		r0 = rf(ctx, id)
 	} else {
 		r0 = ret.Get(0).(domain.Goal)
 	}
 
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GoalRepository_GetGoalByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetGoalByID'
+type GoalRepository_GetGoalByID_Call struct {
+	*mock.Call
+}
+
+// GetGoalByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+func (_e *GoalRepository_Expecter) GetGoalByID(ctx interface{}, id interface{}) *GoalRepository_GetGoalByID_Call {
+	return &GoalRepository_GetGoalByID_Call{Call: _e.mock.On("GetGoalByID", ctx, id)}
+}
+
+func (_c *GoalRepository_GetGoalByID_Call) Run(run func(ctx context.Context, id int64)) *GoalRepository_GetGoalByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *GoalRepository_GetGoalByID_Call) Return(_a0 domain.Goal, _a1 error) *GoalRepository_GetGoalByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *GoalRepository_GetGoalByID_Call) RunAndReturn(run func(context.Context, int64) (domain.Goal, error)) *GoalRepository_GetGoalByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetGoalVersionsByUserID provides a mock function with given fields: ctx, userID
+func (_m *GoalRepository) GetGoalVersionsByUserID(ctx context.Context, userID int64) ([]domain.GoalVersion, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetGoalVersionsByUserID")
+	}
+
+	var r0 []domain.GoalVersion
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) ([]domain.GoalVersion, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) []domain.GoalVersion); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.GoalVersion)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GoalRepository_GetGoalVersionsByUserID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetGoalVersionsByUserID'
+type GoalRepository_GetGoalVersionsByUserID_Call struct {
+	*mock.Call
+}
+
+// GetGoalVersionsByUserID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID int64
+func (_e *GoalRepository_Expecter) GetGoalVersionsByUserID(ctx interface{}, userID interface{}) *GoalRepository_GetGoalVersionsByUserID_Call {
+	return &GoalRepository_GetGoalVersionsByUserID_Call{Call: _e.mock.On("GetGoalVersionsByUserID", ctx, userID)}
+}
+
+func (_c *GoalRepository_GetGoalVersionsByUserID_Call) Run(run func(ctx context.Context, userID int64)) *GoalRepository_GetGoalVersionsByUserID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *GoalRepository_GetGoalVersionsByUserID_Call) Return(_a0 []domain.GoalVersion, _a1 error) *GoalRepository_GetGoalVersionsByUserID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *GoalRepository_GetGoalVersionsByUserID_Call) RunAndReturn(run func(context.Context, int64) ([]domain.GoalVersion, error)) *GoalRepository_GetGoalVersionsByUserID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetGoalsByUserID provides a mock function with given fields: ctx, userID
+func (_m *GoalRepository) GetGoalsByUserID(ctx context.Context, userID int64) ([]domain.Goal, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetGoalsByUserID")
+	}
+
+	var r0 []domain.Goal
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) ([]domain.Goal, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) []domain.Goal); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Goal)
+		}
+	}
+
 	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
 		r1 = rf(ctx, userID)
 	} else {
@@ -107,31 +379,31 @@ func (_m *GoalRepository) GetGoalByUserID(ctx context.Context, userID int64) (do
 	return r0, r1
 }
 
-// GoalRepository_GetGoalByUserID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetGoalByUserID'
-type GoalRepository_GetGoalByUserID_Call struct {
+// GoalRepository_GetGoalsByUserID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetGoalsByUserID'
+type GoalRepository_GetGoalsByUserID_Call struct {
 	*mock.Call
 }
 
-// GetGoalByUserID is a helper method to define mock.On call
+// GetGoalsByUserID is a helper method to define mock.On call
 //   - ctx context.Context
 //   - userID int64
-func (_e *GoalRepository_Expecter) GetGoalByUserID(ctx interface{}, userID interface{}) *GoalRepository_GetGoalByUserID_Call {
-	return &GoalRepository_GetGoalByUserID_Call{Call: _e.mock.On("GetGoalByUserID", ctx, userID)}
+func (_e *GoalRepository_Expecter) GetGoalsByUserID(ctx interface{}, userID interface{}) *GoalRepository_GetGoalsByUserID_Call {
+	return &GoalRepository_GetGoalsByUserID_Call{Call: _e.mock.On("GetGoalsByUserID", ctx, userID)}
 }
 
-func (_c *GoalRepository_GetGoalByUserID_Call) Run(run func(ctx context.Context, userID int64)) *GoalRepository_GetGoalByUserID_Call {
+func (_c *GoalRepository_GetGoalsByUserID_Call) Run(run func(ctx context.Context, userID int64)) *GoalRepository_GetGoalsByUserID_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run(args[0].(context.Context), args[1].(int64))
 	})
 	return _c
 }
 
-func (_c *GoalRepository_GetGoalByUserID_Call) Return(_a0 domain.Goal, _a1 error) *GoalRepository_GetGoalByUserID_Call {
+func (_c *GoalRepository_GetGoalsByUserID_Call) Return(_a0 []domain.Goal, _a1 error) *GoalRepository_GetGoalsByUserID_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *GoalRepository_GetGoalByUserID_Call) RunAndReturn(run func(context.Context, int64) (domain.Goal, error)) *GoalRepository_GetGoalByUserID_Call {
+func (_c *GoalRepository_GetGoalsByUserID_Call) RunAndReturn(run func(context.Context, int64) ([]domain.Goal, error)) *GoalRepository_GetGoalsByUserID_Call {
 	_c.Call.Return(run)
 	return _c
 }
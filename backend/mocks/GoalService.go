@@ -24,23 +24,129 @@ func (_m *GoalService) EXPECT() *GoalService_Expecter {
 	return &GoalService_Expecter{mock: &_m.Mock}
 }
 
-// GetGoal provides a mock function with given fields: ctx, userID
-func (_m *GoalService) GetGoal(ctx context.Context, userID int64) (domain.Goal, error) {
-	ret := _m.Called(ctx, userID)
+// CreateGoal provides a mock function with given fields: ctx, userID, goal
+func (_m *GoalService) CreateGoal(ctx context.Context, userID int64, goal domain.Goal) (domain.Goal, error) {
+	ret := _m.Called(ctx, userID, goal)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetGoal")
+		panic("no return value specified for CreateGoal")
 	}
 
 	var r0 domain.Goal
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, int64) (domain.Goal, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, int64, domain.Goal) (domain.Goal, error)); ok {
+		return rf(ctx, userID, goal)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, domain.Goal) domain.Goal); ok {
+		r0 = rf(ctx, userID, goal)
+	} else {
+		r0 = ret.Get(0).(domain.Goal)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, domain.Goal) error); ok {
+		r1 = rf(ctx, userID, goal)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GoalService_CreateGoal_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateGoal'
+type GoalService_CreateGoal_Call struct {
+	*mock.Call
+}
+
+// CreateGoal is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID int64
+//   - goal domain.Goal
+func (_e *GoalService_Expecter) CreateGoal(ctx interface{}, userID interface{}, goal interface{}) *GoalService_CreateGoal_Call {
+	return &GoalService_CreateGoal_Call{Call: _e.mock.On("CreateGoal", ctx, userID, goal)}
+}
+
+func (_c *GoalService_CreateGoal_Call) Run(run func(ctx context.Context, userID int64, goal domain.Goal)) *GoalService_CreateGoal_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(domain.Goal))
+	})
+	return _c
+}
+
+func (_c *GoalService_CreateGoal_Call) Return(_a0 domain.Goal, _a1 error) *GoalService_CreateGoal_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *GoalService_CreateGoal_Call) RunAndReturn(run func(context.Context, int64, domain.Goal) (domain.Goal, error)) *GoalService_CreateGoal_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteGoal provides a mock function with given fields: ctx, userID, goalID
+func (_m *GoalService) DeleteGoal(ctx context.Context, userID int64, goalID int64) error {
+	ret := _m.Called(ctx, userID, goalID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteGoal")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64) error); ok {
+		r0 = rf(ctx, userID, goalID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GoalService_DeleteGoal_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteGoal'
+type GoalService_DeleteGoal_Call struct {
+	*mock.Call
+}
+
+// DeleteGoal is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID int64
+//   - goalID int64
+func (_e *GoalService_Expecter) DeleteGoal(ctx interface{}, userID interface{}, goalID interface{}) *GoalService_DeleteGoal_Call {
+	return &GoalService_DeleteGoal_Call{Call: _e.mock.On("DeleteGoal", ctx, userID, goalID)}
+}
+
+func (_c *GoalService_DeleteGoal_Call) Run(run func(ctx context.Context, userID int64, goalID int64)) *GoalService_DeleteGoal_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(int64))
+	})
+	return _c
+}
+
+func (_c *GoalService_DeleteGoal_Call) Return(_a0 error) *GoalService_DeleteGoal_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *GoalService_DeleteGoal_Call) RunAndReturn(run func(context.Context, int64, int64) error) *GoalService_DeleteGoal_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetGoalHistory provides a mock function with given fields: ctx, userID
+func (_m *GoalService) GetGoalHistory(ctx context.Context, userID int64) (dto.GoalHistoryResponse, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetGoalHistory")
+	}
+
+	var r0 dto.GoalHistoryResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) (dto.GoalHistoryResponse, error)); ok {
 		return rf(ctx, userID)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, int64) domain.Goal); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, int64) dto.GoalHistoryResponse); ok {
 		r0 = rf(ctx, userID)
 	} else {
-		r0 = ret.Get(0).(domain.Goal)
+		r0 = ret.Get(0).(dto.GoalHistoryResponse)
 	}
 
 	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
@@ -52,31 +158,31 @@ func (_m *GoalService) GetGoal(ctx context.Context, userID int64) (domain.Goal,
 	return r0, r1
 }
 
-// GoalService_GetGoal_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetGoal'
-type GoalService_GetGoal_Call struct {
+// GoalService_GetGoalHistory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetGoalHistory'
+type GoalService_GetGoalHistory_Call struct {
 	*mock.Call
 }
 
-// GetGoal is a helper method to define mock.On call
+// GetGoalHistory is a helper method to define mock.On call
 //   - ctx context.Context
 //   - userID int64
-func (_e *GoalService_Expecter) GetGoal(ctx interface{}, userID interface{}) *GoalService_GetGoal_Call {
-	return &GoalService_GetGoal_Call{Call: _e.mock.On("GetGoal", ctx, userID)}
+func (_e *GoalService_Expecter) GetGoalHistory(ctx interface{}, userID interface{}) *GoalService_GetGoalHistory_Call {
+	return &GoalService_GetGoalHistory_Call{Call: _e.mock.On("GetGoalHistory", ctx, userID)}
 }
 
-func (_c *GoalService_GetGoal_Call) Run(run func(ctx context.Context, userID int64)) *GoalService_GetGoal_Call {
+func (_c *GoalService_GetGoalHistory_Call) Run(run func(ctx context.Context, userID int64)) *GoalService_GetGoalHistory_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run(args[0].(context.Context), args[1].(int64))
 	})
 	return _c
 }
 
-func (_c *GoalService_GetGoal_Call) Return(_a0 domain.Goal, _a1 error) *GoalService_GetGoal_Call {
+func (_c *GoalService_GetGoalHistory_Call) Return(_a0 dto.GoalHistoryResponse, _a1 error) *GoalService_GetGoalHistory_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *GoalService_GetGoal_Call) RunAndReturn(run func(context.Context, int64) (domain.Goal, error)) *GoalService_GetGoal_Call {
+func (_c *GoalService_GetGoalHistory_Call) RunAndReturn(run func(context.Context, int64) (dto.GoalHistoryResponse, error)) *GoalService_GetGoalHistory_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -138,27 +244,86 @@ func (_c *GoalService_GetGoalProgress_Call) RunAndReturn(run func(context.Contex
 	return _c
 }
 
-// SetGoal provides a mock function with given fields: ctx, userID, goal
-func (_m *GoalService) SetGoal(ctx context.Context, userID int64, goal domain.Goal) (domain.Goal, error) {
-	ret := _m.Called(ctx, userID, goal)
+// GetGoals provides a mock function with given fields: ctx, userID
+func (_m *GoalService) GetGoals(ctx context.Context, userID int64) ([]domain.Goal, error) {
+	ret := _m.Called(ctx, userID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for SetGoal")
+		panic("no return value specified for GetGoals")
+	}
+
+	var r0 []domain.Goal
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) ([]domain.Goal, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) []domain.Goal); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Goal)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GoalService_GetGoals_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetGoals'
+type GoalService_GetGoals_Call struct {
+	*mock.Call
+}
+
+// GetGoals is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID int64
+func (_e *GoalService_Expecter) GetGoals(ctx interface{}, userID interface{}) *GoalService_GetGoals_Call {
+	return &GoalService_GetGoals_Call{Call: _e.mock.On("GetGoals", ctx, userID)}
+}
+
+func (_c *GoalService_GetGoals_Call) Run(run func(ctx context.Context, userID int64)) *GoalService_GetGoals_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *GoalService_GetGoals_Call) Return(_a0 []domain.Goal, _a1 error) *GoalService_GetGoals_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *GoalService_GetGoals_Call) RunAndReturn(run func(context.Context, int64) ([]domain.Goal, error)) *GoalService_GetGoals_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateGoal provides a mock function with given fields: ctx, userID, goalID, goal
+func (_m *GoalService) UpdateGoal(ctx context.Context, userID int64, goalID int64, goal domain.Goal) (domain.Goal, error) {
+	ret := _m.Called(ctx, userID, goalID, goal)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateGoal")
 	}
 
 	var r0 domain.Goal
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, int64, domain.Goal) (domain.Goal, error)); ok {
-		return rf(ctx, userID, goal)
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64, domain.Goal) (domain.Goal, error)); ok {
+		return rf(ctx, userID, goalID, goal)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, int64, domain.Goal) domain.Goal); ok {
-		r0 = rf(ctx, userID, goal)
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64, domain.Goal) domain.Goal); ok {
+		r0 = rf(ctx, userID, goalID, goal)
 	} else {
 		r0 = ret.Get(0).(domain.Goal)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, int64, domain.Goal) error); ok {
-		r1 = rf(ctx, userID, goal)
+	if rf, ok := ret.Get(1).(func(context.Context, int64, int64, domain.Goal) error); ok {
+		r1 = rf(ctx, userID, goalID, goal)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -166,32 +331,33 @@ func (_m *GoalService) SetGoal(ctx context.Context, userID int64, goal domain.Go
 	return r0, r1
 }
 
-// GoalService_SetGoal_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetGoal'
-type GoalService_SetGoal_Call struct {
+// GoalService_UpdateGoal_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateGoal'
+type GoalService_UpdateGoal_Call struct {
 	*mock.Call
 }
 
-// SetGoal is a helper method to define mock.On call
+// UpdateGoal is a helper method to define mock.On call
 //   - ctx context.Context
 //   - userID int64
+//   - goalID int64
 //   - goal domain.Goal
-func (_e *GoalService_Expecter) SetGoal(ctx interface{}, userID interface{}, goal interface{}) *GoalService_SetGoal_Call {
-	return &GoalService_SetGoal_Call{Call: _e.mock.On("SetGoal", ctx, userID, goal)}
+func (_e *GoalService_Expecter) UpdateGoal(ctx interface{}, userID interface{}, goalID interface{}, goal interface{}) *GoalService_UpdateGoal_Call {
+	return &GoalService_UpdateGoal_Call{Call: _e.mock.On("UpdateGoal", ctx, userID, goalID, goal)}
 }
 
-func (_c *GoalService_SetGoal_Call) Run(run func(ctx context.Context, userID int64, goal domain.Goal)) *GoalService_SetGoal_Call {
+func (_c *GoalService_UpdateGoal_Call) Run(run func(ctx context.Context, userID int64, goalID int64, goal domain.Goal)) *GoalService_UpdateGoal_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(int64), args[2].(domain.Goal))
+		run(args[0].(context.Context), args[1].(int64), args[2].(int64), args[3].(domain.Goal))
 	})
 	return _c
 }
 
-func (_c *GoalService_SetGoal_Call) Return(_a0 domain.Goal, _a1 error) *GoalService_SetGoal_Call {
+func (_c *GoalService_UpdateGoal_Call) Return(_a0 domain.Goal, _a1 error) *GoalService_UpdateGoal_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *GoalService_SetGoal_Call) RunAndReturn(run func(context.Context, int64, domain.Goal) (domain.Goal, error)) *GoalService_SetGoal_Call {
+func (_c *GoalService_UpdateGoal_Call) RunAndReturn(run func(context.Context, int64, int64, domain.Goal) (domain.Goal, error)) *GoalService_UpdateGoal_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -0,0 +1,500 @@
+// Code generated by mockery v2.46.3. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	domain "github.com/rimvydascivilis/book-tracker/backend/domain"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// ImportRepository is an autogenerated mock type for the ImportRepository type
+type ImportRepository struct {
+	mock.Mock
+}
+
+type ImportRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *ImportRepository) EXPECT() *ImportRepository_Expecter {
+	return &ImportRepository_Expecter{mock: &_m.Mock}
+}
+
+// CreateImportJob provides a mock function with given fields: ctx, job
+func (_m *ImportRepository) CreateImportJob(ctx context.Context, job domain.ImportJob) (domain.ImportJob, error) {
+	ret := _m.Called(ctx, job)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateImportJob")
+	}
+
+	var r0 domain.ImportJob
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ImportJob) (domain.ImportJob, error)); ok {
+		return rf(ctx, job)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ImportJob) domain.ImportJob); ok {
+		r0 = rf(ctx, job)
+	} else {
+		r0 = ret.Get(0).(domain.ImportJob)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.ImportJob) error); ok {
+		r1 = rf(ctx, job)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ImportRepository_CreateImportJob_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateImportJob'
+type ImportRepository_CreateImportJob_Call struct {
+	*mock.Call
+}
+
+// CreateImportJob is a helper method to define mock.On call
+//   - ctx context.Context
+//   - job domain.ImportJob
+func (_e *ImportRepository_Expecter) CreateImportJob(ctx interface{}, job interface{}) *ImportRepository_CreateImportJob_Call {
+	return &ImportRepository_CreateImportJob_Call{Call: _e.mock.On("CreateImportJob", ctx, job)}
+}
+
+func (_c *ImportRepository_CreateImportJob_Call) Run(run func(ctx context.Context, job domain.ImportJob)) *ImportRepository_CreateImportJob_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.ImportJob))
+	})
+	return _c
+}
+
+func (_c *ImportRepository_CreateImportJob_Call) Return(_a0 domain.ImportJob, _a1 error) *ImportRepository_CreateImportJob_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ImportRepository_CreateImportJob_Call) RunAndReturn(run func(context.Context, domain.ImportJob) (domain.ImportJob, error)) *ImportRepository_CreateImportJob_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateImportRow provides a mock function with given fields: ctx, row
+func (_m *ImportRepository) CreateImportRow(ctx context.Context, row domain.ImportRow) (domain.ImportRow, error) {
+	ret := _m.Called(ctx, row)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateImportRow")
+	}
+
+	var r0 domain.ImportRow
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ImportRow) (domain.ImportRow, error)); ok {
+		return rf(ctx, row)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ImportRow) domain.ImportRow); ok {
+		r0 = rf(ctx, row)
+	} else {
+		r0 = ret.Get(0).(domain.ImportRow)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.ImportRow) error); ok {
+		r1 = rf(ctx, row)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ImportRepository_CreateImportRow_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateImportRow'
+type ImportRepository_CreateImportRow_Call struct {
+	*mock.Call
+}
+
+// CreateImportRow is a helper method to define mock.On call
+//   - ctx context.Context
+//   - row domain.ImportRow
+func (_e *ImportRepository_Expecter) CreateImportRow(ctx interface{}, row interface{}) *ImportRepository_CreateImportRow_Call {
+	return &ImportRepository_CreateImportRow_Call{Call: _e.mock.On("CreateImportRow", ctx, row)}
+}
+
+func (_c *ImportRepository_CreateImportRow_Call) Run(run func(ctx context.Context, row domain.ImportRow)) *ImportRepository_CreateImportRow_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.ImportRow))
+	})
+	return _c
+}
+
+func (_c *ImportRepository_CreateImportRow_Call) Return(_a0 domain.ImportRow, _a1 error) *ImportRepository_CreateImportRow_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ImportRepository_CreateImportRow_Call) RunAndReturn(run func(context.Context, domain.ImportRow) (domain.ImportRow, error)) *ImportRepository_CreateImportRow_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetImportJobByChecksum provides a mock function with given fields: ctx, userID, source, checksum
+func (_m *ImportRepository) GetImportJobByChecksum(ctx context.Context, userID int64, source string, checksum string) (domain.ImportJob, error) {
+	ret := _m.Called(ctx, userID, source, checksum)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetImportJobByChecksum")
+	}
+
+	var r0 domain.ImportJob
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, string, string) (domain.ImportJob, error)); ok {
+		return rf(ctx, userID, source, checksum)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, string, string) domain.ImportJob); ok {
+		r0 = rf(ctx, userID, source, checksum)
+	} else {
+		r0 = ret.Get(0).(domain.ImportJob)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, string, string) error); ok {
+		r1 = rf(ctx, userID, source, checksum)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ImportRepository_GetImportJobByChecksum_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetImportJobByChecksum'
+type ImportRepository_GetImportJobByChecksum_Call struct {
+	*mock.Call
+}
+
+// GetImportJobByChecksum is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID int64
+//   - source string
+//   - checksum string
+func (_e *ImportRepository_Expecter) GetImportJobByChecksum(ctx interface{}, userID interface{}, source interface{}, checksum interface{}) *ImportRepository_GetImportJobByChecksum_Call {
+	return &ImportRepository_GetImportJobByChecksum_Call{Call: _e.mock.On("GetImportJobByChecksum", ctx, userID, source, checksum)}
+}
+
+func (_c *ImportRepository_GetImportJobByChecksum_Call) Run(run func(ctx context.Context, userID int64, source string, checksum string)) *ImportRepository_GetImportJobByChecksum_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(string), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *ImportRepository_GetImportJobByChecksum_Call) Return(_a0 domain.ImportJob, _a1 error) *ImportRepository_GetImportJobByChecksum_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ImportRepository_GetImportJobByChecksum_Call) RunAndReturn(run func(context.Context, int64, string, string) (domain.ImportJob, error)) *ImportRepository_GetImportJobByChecksum_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetImportJobByID provides a mock function with given fields: ctx, id
+func (_m *ImportRepository) GetImportJobByID(ctx context.Context, id int64) (domain.ImportJob, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetImportJobByID")
+	}
+
+	var r0 domain.ImportJob
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) (domain.ImportJob, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) domain.ImportJob); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(domain.ImportJob)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ImportRepository_GetImportJobByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetImportJobByID'
+type ImportRepository_GetImportJobByID_Call struct {
+	*mock.Call
+}
+
+// GetImportJobByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+func (_e *ImportRepository_Expecter) GetImportJobByID(ctx interface{}, id interface{}) *ImportRepository_GetImportJobByID_Call {
+	return &ImportRepository_GetImportJobByID_Call{Call: _e.mock.On("GetImportJobByID", ctx, id)}
+}
+
+func (_c *ImportRepository_GetImportJobByID_Call) Run(run func(ctx context.Context, id int64)) *ImportRepository_GetImportJobByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *ImportRepository_GetImportJobByID_Call) Return(_a0 domain.ImportJob, _a1 error) *ImportRepository_GetImportJobByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ImportRepository_GetImportJobByID_Call) RunAndReturn(run func(context.Context, int64) (domain.ImportJob, error)) *ImportRepository_GetImportJobByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetImportRowsByJobID provides a mock function with given fields: ctx, jobID
+func (_m *ImportRepository) GetImportRowsByJobID(ctx context.Context, jobID int64) ([]domain.ImportRow, error) {
+	ret := _m.Called(ctx, jobID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetImportRowsByJobID")
+	}
+
+	var r0 []domain.ImportRow
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) ([]domain.ImportRow, error)); ok {
+		return rf(ctx, jobID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) []domain.ImportRow); ok {
+		r0 = rf(ctx, jobID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.ImportRow)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, jobID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ImportRepository_GetImportRowsByJobID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetImportRowsByJobID'
+type ImportRepository_GetImportRowsByJobID_Call struct {
+	*mock.Call
+}
+
+// GetImportRowsByJobID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - jobID int64
+func (_e *ImportRepository_Expecter) GetImportRowsByJobID(ctx interface{}, jobID interface{}) *ImportRepository_GetImportRowsByJobID_Call {
+	return &ImportRepository_GetImportRowsByJobID_Call{Call: _e.mock.On("GetImportRowsByJobID", ctx, jobID)}
+}
+
+func (_c *ImportRepository_GetImportRowsByJobID_Call) Run(run func(ctx context.Context, jobID int64)) *ImportRepository_GetImportRowsByJobID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *ImportRepository_GetImportRowsByJobID_Call) Return(_a0 []domain.ImportRow, _a1 error) *ImportRepository_GetImportRowsByJobID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ImportRepository_GetImportRowsByJobID_Call) RunAndReturn(run func(context.Context, int64) ([]domain.ImportRow, error)) *ImportRepository_GetImportRowsByJobID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetImportedBookID provides a mock function with given fields: ctx, userID, source, externalID
+func (_m *ImportRepository) GetImportedBookID(ctx context.Context, userID int64, source string, externalID string) (int64, error) {
+	ret := _m.Called(ctx, userID, source, externalID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetImportedBookID")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, string, string) (int64, error)); ok {
+		return rf(ctx, userID, source, externalID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, string, string) int64); ok {
+		r0 = rf(ctx, userID, source, externalID)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, string, string) error); ok {
+		r1 = rf(ctx, userID, source, externalID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ImportRepository_GetImportedBookID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetImportedBookID'
+type ImportRepository_GetImportedBookID_Call struct {
+	*mock.Call
+}
+
+// GetImportedBookID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID int64
+//   - source string
+//   - externalID string
+func (_e *ImportRepository_Expecter) GetImportedBookID(ctx interface{}, userID interface{}, source interface{}, externalID interface{}) *ImportRepository_GetImportedBookID_Call {
+	return &ImportRepository_GetImportedBookID_Call{Call: _e.mock.On("GetImportedBookID", ctx, userID, source, externalID)}
+}
+
+func (_c *ImportRepository_GetImportedBookID_Call) Run(run func(ctx context.Context, userID int64, source string, externalID string)) *ImportRepository_GetImportedBookID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(string), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *ImportRepository_GetImportedBookID_Call) Return(_a0 int64, _a1 error) *ImportRepository_GetImportedBookID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ImportRepository_GetImportedBookID_Call) RunAndReturn(run func(context.Context, int64, string, string) (int64, error)) *ImportRepository_GetImportedBookID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetUnfinishedImportJobs provides a mock function with given fields: ctx
+func (_m *ImportRepository) GetUnfinishedImportJobs(ctx context.Context) ([]domain.ImportJob, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUnfinishedImportJobs")
+	}
+
+	var r0 []domain.ImportJob
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]domain.ImportJob, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []domain.ImportJob); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.ImportJob)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ImportRepository_GetUnfinishedImportJobs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUnfinishedImportJobs'
+type ImportRepository_GetUnfinishedImportJobs_Call struct {
+	*mock.Call
+}
+
+// GetUnfinishedImportJobs is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *ImportRepository_Expecter) GetUnfinishedImportJobs(ctx interface{}) *ImportRepository_GetUnfinishedImportJobs_Call {
+	return &ImportRepository_GetUnfinishedImportJobs_Call{Call: _e.mock.On("GetUnfinishedImportJobs", ctx)}
+}
+
+func (_c *ImportRepository_GetUnfinishedImportJobs_Call) Run(run func(ctx context.Context)) *ImportRepository_GetUnfinishedImportJobs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *ImportRepository_GetUnfinishedImportJobs_Call) Return(_a0 []domain.ImportJob, _a1 error) *ImportRepository_GetUnfinishedImportJobs_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ImportRepository_GetUnfinishedImportJobs_Call) RunAndReturn(run func(context.Context) ([]domain.ImportJob, error)) *ImportRepository_GetUnfinishedImportJobs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateImportJob provides a mock function with given fields: ctx, job
+func (_m *ImportRepository) UpdateImportJob(ctx context.Context, job domain.ImportJob) (domain.ImportJob, error) {
+	ret := _m.Called(ctx, job)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateImportJob")
+	}
+
+	var r0 domain.ImportJob
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ImportJob) (domain.ImportJob, error)); ok {
+		return rf(ctx, job)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ImportJob) domain.ImportJob); ok {
+		r0 = rf(ctx, job)
+	} else {
+		r0 = ret.Get(0).(domain.ImportJob)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.ImportJob) error); ok {
+		r1 = rf(ctx, job)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ImportRepository_UpdateImportJob_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateImportJob'
+type ImportRepository_UpdateImportJob_Call struct {
+	*mock.Call
+}
+
+// UpdateImportJob is a helper method to define mock.On call
+//   - ctx context.Context
+//   - job domain.ImportJob
+func (_e *ImportRepository_Expecter) UpdateImportJob(ctx interface{}, job interface{}) *ImportRepository_UpdateImportJob_Call {
+	return &ImportRepository_UpdateImportJob_Call{Call: _e.mock.On("UpdateImportJob", ctx, job)}
+}
+
+func (_c *ImportRepository_UpdateImportJob_Call) Run(run func(ctx context.Context, job domain.ImportJob)) *ImportRepository_UpdateImportJob_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.ImportJob))
+	})
+	return _c
+}
+
+func (_c *ImportRepository_UpdateImportJob_Call) Return(_a0 domain.ImportJob, _a1 error) *ImportRepository_UpdateImportJob_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ImportRepository_UpdateImportJob_Call) RunAndReturn(run func(context.Context, domain.ImportJob) (domain.ImportJob, error)) *ImportRepository_UpdateImportJob_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewImportRepository creates a new instance of ImportRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewImportRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ImportRepository {
+	mock := &ImportRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
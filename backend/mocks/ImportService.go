@@ -0,0 +1,199 @@
+// Code generated by mockery v2.46.3. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	domain "github.com/rimvydascivilis/book-tracker/backend/domain"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// ImportService is an autogenerated mock type for the ImportService type
+type ImportService struct {
+	mock.Mock
+}
+
+type ImportService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *ImportService) EXPECT() *ImportService_Expecter {
+	return &ImportService_Expecter{mock: &_m.Mock}
+}
+
+// GetImportJob provides a mock function with given fields: ctx, userID, jobID
+func (_m *ImportService) GetImportJob(ctx context.Context, userID int64, jobID int64) (domain.ImportJob, error) {
+	ret := _m.Called(ctx, userID, jobID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetImportJob")
+	}
+
+	var r0 domain.ImportJob
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64) (domain.ImportJob, error)); ok {
+		return rf(ctx, userID, jobID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64) domain.ImportJob); ok {
+		r0 = rf(ctx, userID, jobID)
+	} else {
+		r0 = ret.Get(0).(domain.ImportJob)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, int64) error); ok {
+		r1 = rf(ctx, userID, jobID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ImportService_GetImportJob_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetImportJob'
+type ImportService_GetImportJob_Call struct {
+	*mock.Call
+}
+
+// GetImportJob is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID int64
+//   - jobID int64
+func (_e *ImportService_Expecter) GetImportJob(ctx interface{}, userID interface{}, jobID interface{}) *ImportService_GetImportJob_Call {
+	return &ImportService_GetImportJob_Call{Call: _e.mock.On("GetImportJob", ctx, userID, jobID)}
+}
+
+func (_c *ImportService_GetImportJob_Call) Run(run func(ctx context.Context, userID int64, jobID int64)) *ImportService_GetImportJob_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(int64))
+	})
+	return _c
+}
+
+func (_c *ImportService_GetImportJob_Call) Return(_a0 domain.ImportJob, _a1 error) *ImportService_GetImportJob_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ImportService_GetImportJob_Call) RunAndReturn(run func(context.Context, int64, int64) (domain.ImportJob, error)) *ImportService_GetImportJob_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ImportGoodreads provides a mock function with given fields: ctx, userID, data
+func (_m *ImportService) ImportGoodreads(ctx context.Context, userID int64, data []byte) (domain.ImportJob, error) {
+	ret := _m.Called(ctx, userID, data)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ImportGoodreads")
+	}
+
+	var r0 domain.ImportJob
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, []byte) (domain.ImportJob, error)); ok {
+		return rf(ctx, userID, data)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, []byte) domain.ImportJob); ok {
+		r0 = rf(ctx, userID, data)
+	} else {
+		r0 = ret.Get(0).(domain.ImportJob)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, []byte) error); ok {
+		r1 = rf(ctx, userID, data)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ImportService_ImportGoodreads_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ImportGoodreads'
+type ImportService_ImportGoodreads_Call struct {
+	*mock.Call
+}
+
+// ImportGoodreads is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID int64
+//   - data []byte
+func (_e *ImportService_Expecter) ImportGoodreads(ctx interface{}, userID interface{}, data interface{}) *ImportService_ImportGoodreads_Call {
+	return &ImportService_ImportGoodreads_Call{Call: _e.mock.On("ImportGoodreads", ctx, userID, data)}
+}
+
+func (_c *ImportService_ImportGoodreads_Call) Run(run func(ctx context.Context, userID int64, data []byte)) *ImportService_ImportGoodreads_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].([]byte))
+	})
+	return _c
+}
+
+func (_c *ImportService_ImportGoodreads_Call) Return(_a0 domain.ImportJob, _a1 error) *ImportService_ImportGoodreads_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ImportService_ImportGoodreads_Call) RunAndReturn(run func(context.Context, int64, []byte) (domain.ImportJob, error)) *ImportService_ImportGoodreads_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ResumeImports provides a mock function with given fields: ctx
+func (_m *ImportService) ResumeImports(ctx context.Context) error {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ResumeImports")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ImportService_ResumeImports_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ResumeImports'
+type ImportService_ResumeImports_Call struct {
+	*mock.Call
+}
+
+// ResumeImports is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *ImportService_Expecter) ResumeImports(ctx interface{}) *ImportService_ResumeImports_Call {
+	return &ImportService_ResumeImports_Call{Call: _e.mock.On("ResumeImports", ctx)}
+}
+
+func (_c *ImportService_ResumeImports_Call) Run(run func(ctx context.Context)) *ImportService_ResumeImports_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *ImportService_ResumeImports_Call) Return(_a0 error) *ImportService_ResumeImports_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ImportService_ResumeImports_Call) RunAndReturn(run func(context.Context) error) *ImportService_ResumeImports_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewImportService creates a new instance of ImportService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewImportService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ImportService {
+	mock := &ImportService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
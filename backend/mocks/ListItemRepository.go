@@ -0,0 +1,259 @@
+// Code generated by mockery v2.46.3. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	domain "github.com/rimvydascivilis/book-tracker/backend/domain"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// ListItemRepository is an autogenerated mock type for the ListItemRepository type
+type ListItemRepository struct {
+	mock.Mock
+}
+
+type ListItemRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *ListItemRepository) EXPECT() *ListItemRepository_Expecter {
+	return &ListItemRepository_Expecter{mock: &_m.Mock}
+}
+
+// CreateListItem provides a mock function with given fields: ctx, listItem
+func (_m *ListItemRepository) CreateListItem(ctx context.Context, listItem domain.ListItem) (domain.ListItem, error) {
+	ret := _m.Called(ctx, listItem)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateListItem")
+	}
+
+	var r0 domain.ListItem
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ListItem) (domain.ListItem, error)); ok {
+		return rf(ctx, listItem)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ListItem) domain.ListItem); ok {
+		r0 = rf(ctx, listItem)
+	} else {
+		r0 = ret.Get(0).(domain.ListItem)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.ListItem) error); ok {
+		r1 = rf(ctx, listItem)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListItemRepository_CreateListItem_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateListItem'
+type ListItemRepository_CreateListItem_Call struct {
+	*mock.Call
+}
+
+// CreateListItem is a helper method to define mock.On call
+//   - ctx context.Context
+//   - listItem domain.ListItem
+func (_e *ListItemRepository_Expecter) CreateListItem(ctx interface{}, listItem interface{}) *ListItemRepository_CreateListItem_Call {
+	return &ListItemRepository_CreateListItem_Call{Call: _e.mock.On("CreateListItem", ctx, listItem)}
+}
+
+func (_c *ListItemRepository_CreateListItem_Call) Run(run func(ctx context.Context, listItem domain.ListItem)) *ListItemRepository_CreateListItem_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.ListItem))
+	})
+	return _c
+}
+
+func (_c *ListItemRepository_CreateListItem_Call) Return(_a0 domain.ListItem, _a1 error) *ListItemRepository_CreateListItem_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ListItemRepository_CreateListItem_Call) RunAndReturn(run func(context.Context, domain.ListItem) (domain.ListItem, error)) *ListItemRepository_CreateListItem_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteListItem provides a mock function with given fields: ctx, id
+func (_m *ListItemRepository) DeleteListItem(ctx context.Context, id int64) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteListItem")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ListItemRepository_DeleteListItem_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteListItem'
+type ListItemRepository_DeleteListItem_Call struct {
+	*mock.Call
+}
+
+// DeleteListItem is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+func (_e *ListItemRepository_Expecter) DeleteListItem(ctx interface{}, id interface{}) *ListItemRepository_DeleteListItem_Call {
+	return &ListItemRepository_DeleteListItem_Call{Call: _e.mock.On("DeleteListItem", ctx, id)}
+}
+
+func (_c *ListItemRepository_DeleteListItem_Call) Run(run func(ctx context.Context, id int64)) *ListItemRepository_DeleteListItem_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *ListItemRepository_DeleteListItem_Call) Return(_a0 error) *ListItemRepository_DeleteListItem_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ListItemRepository_DeleteListItem_Call) RunAndReturn(run func(context.Context, int64) error) *ListItemRepository_DeleteListItem_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetListItemBooksByListID provides a mock function with given fields: ctx, listID
+func (_m *ListItemRepository) GetListItemBooksByListID(ctx context.Context, listID int64) ([]domain.ListItemBook, error) {
+	ret := _m.Called(ctx, listID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetListItemBooksByListID")
+	}
+
+	var r0 []domain.ListItemBook
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) ([]domain.ListItemBook, error)); ok {
+		return rf(ctx, listID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) []domain.ListItemBook); ok {
+		r0 = rf(ctx, listID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.ListItemBook)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, listID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListItemRepository_GetListItemBooksByListID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetListItemBooksByListID'
+type ListItemRepository_GetListItemBooksByListID_Call struct {
+	*mock.Call
+}
+
+// GetListItemBooksByListID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - listID int64
+func (_e *ListItemRepository_Expecter) GetListItemBooksByListID(ctx interface{}, listID interface{}) *ListItemRepository_GetListItemBooksByListID_Call {
+	return &ListItemRepository_GetListItemBooksByListID_Call{Call: _e.mock.On("GetListItemBooksByListID", ctx, listID)}
+}
+
+func (_c *ListItemRepository_GetListItemBooksByListID_Call) Run(run func(ctx context.Context, listID int64)) *ListItemRepository_GetListItemBooksByListID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *ListItemRepository_GetListItemBooksByListID_Call) Return(_a0 []domain.ListItemBook, _a1 error) *ListItemRepository_GetListItemBooksByListID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ListItemRepository_GetListItemBooksByListID_Call) RunAndReturn(run func(context.Context, int64) ([]domain.ListItemBook, error)) *ListItemRepository_GetListItemBooksByListID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetListItemsByListID provides a mock function with given fields: ctx, listID
+func (_m *ListItemRepository) GetListItemsByListID(ctx context.Context, listID int64) ([]domain.ListItem, error) {
+	ret := _m.Called(ctx, listID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetListItemsByListID")
+	}
+
+	var r0 []domain.ListItem
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) ([]domain.ListItem, error)); ok {
+		return rf(ctx, listID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) []domain.ListItem); ok {
+		r0 = rf(ctx, listID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.ListItem)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, listID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListItemRepository_GetListItemsByListID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetListItemsByListID'
+type ListItemRepository_GetListItemsByListID_Call struct {
+	*mock.Call
+}
+
+// GetListItemsByListID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - listID int64
+func (_e *ListItemRepository_Expecter) GetListItemsByListID(ctx interface{}, listID interface{}) *ListItemRepository_GetListItemsByListID_Call {
+	return &ListItemRepository_GetListItemsByListID_Call{Call: _e.mock.On("GetListItemsByListID", ctx, listID)}
+}
+
+func (_c *ListItemRepository_GetListItemsByListID_Call) Run(run func(ctx context.Context, listID int64)) *ListItemRepository_GetListItemsByListID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *ListItemRepository_GetListItemsByListID_Call) Return(_a0 []domain.ListItem, _a1 error) *ListItemRepository_GetListItemsByListID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ListItemRepository_GetListItemsByListID_Call) RunAndReturn(run func(context.Context, int64) ([]domain.ListItem, error)) *ListItemRepository_GetListItemsByListID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewListItemRepository creates a new instance of ListItemRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewListItemRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ListItemRepository {
+	mock := &ListItemRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,210 @@
+// Code generated by mockery v2.46.3. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	domain "github.com/rimvydascivilis/book-tracker/backend/domain"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// ListRepository is an autogenerated mock type for the ListRepository type
+type ListRepository struct {
+	mock.Mock
+}
+
+type ListRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *ListRepository) EXPECT() *ListRepository_Expecter {
+	return &ListRepository_Expecter{mock: &_m.Mock}
+}
+
+// CreateList provides a mock function with given fields: ctx, list
+func (_m *ListRepository) CreateList(ctx context.Context, list domain.List) (domain.List, error) {
+	ret := _m.Called(ctx, list)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateList")
+	}
+
+	var r0 domain.List
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.List) (domain.List, error)); ok {
+		return rf(ctx, list)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.List) domain.List); ok {
+		r0 = rf(ctx, list)
+	} else {
+		r0 = ret.Get(0).(domain.List)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.List) error); ok {
+		r1 = rf(ctx, list)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListRepository_CreateList_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateList'
+type ListRepository_CreateList_Call struct {
+	*mock.Call
+}
+
+// CreateList is a helper method to define mock.On call
+//   - ctx context.Context
+//   - list domain.List
+func (_e *ListRepository_Expecter) CreateList(ctx interface{}, list interface{}) *ListRepository_CreateList_Call {
+	return &ListRepository_CreateList_Call{Call: _e.mock.On("CreateList", ctx, list)}
+}
+
+func (_c *ListRepository_CreateList_Call) Run(run func(ctx context.Context, list domain.List)) *ListRepository_CreateList_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.List))
+	})
+	return _c
+}
+
+func (_c *ListRepository_CreateList_Call) Return(_a0 domain.List, _a1 error) *ListRepository_CreateList_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ListRepository_CreateList_Call) RunAndReturn(run func(context.Context, domain.List) (domain.List, error)) *ListRepository_CreateList_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetListByID provides a mock function with given fields: ctx, listID
+func (_m *ListRepository) GetListByID(ctx context.Context, listID int64) (domain.List, error) {
+	ret := _m.Called(ctx, listID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetListByID")
+	}
+
+	var r0 domain.List
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) (domain.List, error)); ok {
+		return rf(ctx, listID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) domain.List); ok {
+		r0 = rf(ctx, listID)
+	} else {
+		r0 = ret.Get(0).(domain.List)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, listID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListRepository_GetListByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetListByID'
+type ListRepository_GetListByID_Call struct {
+	*mock.Call
+}
+
+// GetListByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - listID int64
+func (_e *ListRepository_Expecter) GetListByID(ctx interface{}, listID interface{}) *ListRepository_GetListByID_Call {
+	return &ListRepository_GetListByID_Call{Call: _e.mock.On("GetListByID", ctx, listID)}
+}
+
+func (_c *ListRepository_GetListByID_Call) Run(run func(ctx context.Context, listID int64)) *ListRepository_GetListByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *ListRepository_GetListByID_Call) Return(_a0 domain.List, _a1 error) *ListRepository_GetListByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ListRepository_GetListByID_Call) RunAndReturn(run func(context.Context, int64) (domain.List, error)) *ListRepository_GetListByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetListsByUserID provides a mock function with given fields: ctx, userID
+func (_m *ListRepository) GetListsByUserID(ctx context.Context, userID int64) ([]domain.List, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetListsByUserID")
+	}
+
+	var r0 []domain.List
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) ([]domain.List, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) []domain.List); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.List)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListRepository_GetListsByUserID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetListsByUserID'
+type ListRepository_GetListsByUserID_Call struct {
+	*mock.Call
+}
+
+// GetListsByUserID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID int64
+func (_e *ListRepository_Expecter) GetListsByUserID(ctx interface{}, userID interface{}) *ListRepository_GetListsByUserID_Call {
+	return &ListRepository_GetListsByUserID_Call{Call: _e.mock.On("GetListsByUserID", ctx, userID)}
+}
+
+func (_c *ListRepository_GetListsByUserID_Call) Run(run func(ctx context.Context, userID int64)) *ListRepository_GetListsByUserID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *ListRepository_GetListsByUserID_Call) Return(_a0 []domain.List, _a1 error) *ListRepository_GetListsByUserID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ListRepository_GetListsByUserID_Call) RunAndReturn(run func(context.Context, int64) ([]domain.List, error)) *ListRepository_GetListsByUserID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewListRepository creates a new instance of ListRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewListRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ListRepository {
+	mock := &ListRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,311 @@
+// Code generated by mockery v2.46.3. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	dto "github.com/rimvydascivilis/book-tracker/backend/dto"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// ListService is an autogenerated mock type for the ListService type
+type ListService struct {
+	mock.Mock
+}
+
+type ListService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *ListService) EXPECT() *ListService_Expecter {
+	return &ListService_Expecter{mock: &_m.Mock}
+}
+
+// AddBookToList provides a mock function with given fields: ctx, userID, listID, bookID
+func (_m *ListService) AddBookToList(ctx context.Context, userID int64, listID int64, bookID int64) error {
+	ret := _m.Called(ctx, userID, listID, bookID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddBookToList")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64, int64) error); ok {
+		r0 = rf(ctx, userID, listID, bookID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ListService_AddBookToList_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddBookToList'
+type ListService_AddBookToList_Call struct {
+	*mock.Call
+}
+
+// AddBookToList is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID int64
+//   - listID int64
+//   - bookID int64
+func (_e *ListService_Expecter) AddBookToList(ctx interface{}, userID interface{}, listID interface{}, bookID interface{}) *ListService_AddBookToList_Call {
+	return &ListService_AddBookToList_Call{Call: _e.mock.On("AddBookToList", ctx, userID, listID, bookID)}
+}
+
+func (_c *ListService_AddBookToList_Call) Run(run func(ctx context.Context, userID int64, listID int64, bookID int64)) *ListService_AddBookToList_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(int64), args[3].(int64))
+	})
+	return _c
+}
+
+func (_c *ListService_AddBookToList_Call) Return(_a0 error) *ListService_AddBookToList_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ListService_AddBookToList_Call) RunAndReturn(run func(context.Context, int64, int64, int64) error) *ListService_AddBookToList_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateList provides a mock function with given fields: ctx, userID, list
+func (_m *ListService) CreateList(ctx context.Context, userID int64, list dto.ListRequest) (dto.ListResponse, error) {
+	ret := _m.Called(ctx, userID, list)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateList")
+	}
+
+	var r0 dto.ListResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, dto.ListRequest) (dto.ListResponse, error)); ok {
+		return rf(ctx, userID, list)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, dto.ListRequest) dto.ListResponse); ok {
+		r0 = rf(ctx, userID, list)
+	} else {
+		r0 = ret.Get(0).(dto.ListResponse)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, dto.ListRequest) error); ok {
+		r1 = rf(ctx, userID, list)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListService_CreateList_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateList'
+type ListService_CreateList_Call struct {
+	*mock.Call
+}
+
+// CreateList is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID int64
+//   - list dto.ListRequest
+func (_e *ListService_Expecter) CreateList(ctx interface{}, userID interface{}, list interface{}) *ListService_CreateList_Call {
+	return &ListService_CreateList_Call{Call: _e.mock.On("CreateList", ctx, userID, list)}
+}
+
+func (_c *ListService_CreateList_Call) Run(run func(ctx context.Context, userID int64, list dto.ListRequest)) *ListService_CreateList_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(dto.ListRequest))
+	})
+	return _c
+}
+
+func (_c *ListService_CreateList_Call) Return(_a0 dto.ListResponse, _a1 error) *ListService_CreateList_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ListService_CreateList_Call) RunAndReturn(run func(context.Context, int64, dto.ListRequest) (dto.ListResponse, error)) *ListService_CreateList_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetList provides a mock function with given fields: ctx, userID, listID
+func (_m *ListService) GetList(ctx context.Context, userID int64, listID int64) (dto.ListResponse, error) {
+	ret := _m.Called(ctx, userID, listID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetList")
+	}
+
+	var r0 dto.ListResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64) (dto.ListResponse, error)); ok {
+		return rf(ctx, userID, listID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64) dto.ListResponse); ok {
+		r0 = rf(ctx, userID, listID)
+	} else {
+		r0 = ret.Get(0).(dto.ListResponse)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, int64) error); ok {
+		r1 = rf(ctx, userID, listID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListService_GetList_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetList'
+type ListService_GetList_Call struct {
+	*mock.Call
+}
+
+// GetList is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID int64
+//   - listID int64
+func (_e *ListService_Expecter) GetList(ctx interface{}, userID interface{}, listID interface{}) *ListService_GetList_Call {
+	return &ListService_GetList_Call{Call: _e.mock.On("GetList", ctx, userID, listID)}
+}
+
+func (_c *ListService_GetList_Call) Run(run func(ctx context.Context, userID int64, listID int64)) *ListService_GetList_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(int64))
+	})
+	return _c
+}
+
+func (_c *ListService_GetList_Call) Return(_a0 dto.ListResponse, _a1 error) *ListService_GetList_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ListService_GetList_Call) RunAndReturn(run func(context.Context, int64, int64) (dto.ListResponse, error)) *ListService_GetList_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListLists provides a mock function with given fields: ctx, userID
+func (_m *ListService) ListLists(ctx context.Context, userID int64) ([]dto.ListListsResponse, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListLists")
+	}
+
+	var r0 []dto.ListListsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) ([]dto.ListListsResponse, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) []dto.ListListsResponse); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]dto.ListListsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListService_ListLists_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListLists'
+type ListService_ListLists_Call struct {
+	*mock.Call
+}
+
+// ListLists is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID int64
+func (_e *ListService_Expecter) ListLists(ctx interface{}, userID interface{}) *ListService_ListLists_Call {
+	return &ListService_ListLists_Call{Call: _e.mock.On("ListLists", ctx, userID)}
+}
+
+func (_c *ListService_ListLists_Call) Run(run func(ctx context.Context, userID int64)) *ListService_ListLists_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *ListService_ListLists_Call) Return(_a0 []dto.ListListsResponse, _a1 error) *ListService_ListLists_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ListService_ListLists_Call) RunAndReturn(run func(context.Context, int64) ([]dto.ListListsResponse, error)) *ListService_ListLists_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemoveBookFromList provides a mock function with given fields: ctx, userID, listID, bookID
+func (_m *ListService) RemoveBookFromList(ctx context.Context, userID int64, listID int64, bookID int64) error {
+	ret := _m.Called(ctx, userID, listID, bookID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveBookFromList")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64, int64) error); ok {
+		r0 = rf(ctx, userID, listID, bookID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ListService_RemoveBookFromList_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveBookFromList'
+type ListService_RemoveBookFromList_Call struct {
+	*mock.Call
+}
+
+// RemoveBookFromList is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID int64
+//   - listID int64
+//   - bookID int64
+func (_e *ListService_Expecter) RemoveBookFromList(ctx interface{}, userID interface{}, listID interface{}, bookID interface{}) *ListService_RemoveBookFromList_Call {
+	return &ListService_RemoveBookFromList_Call{Call: _e.mock.On("RemoveBookFromList", ctx, userID, listID, bookID)}
+}
+
+func (_c *ListService_RemoveBookFromList_Call) Run(run func(ctx context.Context, userID int64, listID int64, bookID int64)) *ListService_RemoveBookFromList_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(int64), args[3].(int64))
+	})
+	return _c
+}
+
+func (_c *ListService_RemoveBookFromList_Call) Return(_a0 error) *ListService_RemoveBookFromList_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ListService_RemoveBookFromList_Call) RunAndReturn(run func(context.Context, int64, int64, int64) error) *ListService_RemoveBookFromList_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewListService creates a new instance of ListService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewListService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ListService {
+	mock := &ListService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
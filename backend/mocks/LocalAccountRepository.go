@@ -0,0 +1,477 @@
+// Code generated by mockery v2.46.3. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	domain "github.com/rimvydascivilis/book-tracker/backend/domain"
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// LocalAccountRepository is an autogenerated mock type for the LocalAccountRepository type
+type LocalAccountRepository struct {
+	mock.Mock
+}
+
+type LocalAccountRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *LocalAccountRepository) EXPECT() *LocalAccountRepository_Expecter {
+	return &LocalAccountRepository_Expecter{mock: &_m.Mock}
+}
+
+// CreateAccountToken provides a mock function with given fields: ctx, token
+func (_m *LocalAccountRepository) CreateAccountToken(ctx context.Context, token domain.AccountToken) (domain.AccountToken, error) {
+	ret := _m.Called(ctx, token)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateAccountToken")
+	}
+
+	var r0 domain.AccountToken
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.AccountToken) (domain.AccountToken, error)); ok {
+		return rf(ctx, token)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.AccountToken) domain.AccountToken); ok {
+		r0 = rf(ctx, token)
+	} else {
+		r0 = ret.Get(0).(domain.AccountToken)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.AccountToken) error); ok {
+		r1 = rf(ctx, token)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// LocalAccountRepository_CreateAccountToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateAccountToken'
+type LocalAccountRepository_CreateAccountToken_Call struct {
+	*mock.Call
+}
+
+// CreateAccountToken is a helper method to define mock.On call
+//   - ctx context.Context
+//   - token domain.AccountToken
+func (_e *LocalAccountRepository_Expecter) CreateAccountToken(ctx interface{}, token interface{}) *LocalAccountRepository_CreateAccountToken_Call {
+	return &LocalAccountRepository_CreateAccountToken_Call{Call: _e.mock.On("CreateAccountToken", ctx, token)}
+}
+
+func (_c *LocalAccountRepository_CreateAccountToken_Call) Run(run func(ctx context.Context, token domain.AccountToken)) *LocalAccountRepository_CreateAccountToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.AccountToken))
+	})
+	return _c
+}
+
+func (_c *LocalAccountRepository_CreateAccountToken_Call) Return(_a0 domain.AccountToken, _a1 error) *LocalAccountRepository_CreateAccountToken_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *LocalAccountRepository_CreateAccountToken_Call) RunAndReturn(run func(context.Context, domain.AccountToken) (domain.AccountToken, error)) *LocalAccountRepository_CreateAccountToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateLocalAccount provides a mock function with given fields: ctx, account
+func (_m *LocalAccountRepository) CreateLocalAccount(ctx context.Context, account domain.LocalAccount) (domain.LocalAccount, error) {
+	ret := _m.Called(ctx, account)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateLocalAccount")
+	}
+
+	var r0 domain.LocalAccount
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.LocalAccount) (domain.LocalAccount, error)); ok {
+		return rf(ctx, account)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.LocalAccount) domain.LocalAccount); ok {
+		r0 = rf(ctx, account)
+	} else {
+		r0 = ret.Get(0).(domain.LocalAccount)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.LocalAccount) error); ok {
+		r1 = rf(ctx, account)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// LocalAccountRepository_CreateLocalAccount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateLocalAccount'
+type LocalAccountRepository_CreateLocalAccount_Call struct {
+	*mock.Call
+}
+
+// CreateLocalAccount is a helper method to define mock.On call
+//   - ctx context.Context
+//   - account domain.LocalAccount
+func (_e *LocalAccountRepository_Expecter) CreateLocalAccount(ctx interface{}, account interface{}) *LocalAccountRepository_CreateLocalAccount_Call {
+	return &LocalAccountRepository_CreateLocalAccount_Call{Call: _e.mock.On("CreateLocalAccount", ctx, account)}
+}
+
+func (_c *LocalAccountRepository_CreateLocalAccount_Call) Run(run func(ctx context.Context, account domain.LocalAccount)) *LocalAccountRepository_CreateLocalAccount_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.LocalAccount))
+	})
+	return _c
+}
+
+func (_c *LocalAccountRepository_CreateLocalAccount_Call) Return(_a0 domain.LocalAccount, _a1 error) *LocalAccountRepository_CreateLocalAccount_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *LocalAccountRepository_CreateLocalAccount_Call) RunAndReturn(run func(context.Context, domain.LocalAccount) (domain.LocalAccount, error)) *LocalAccountRepository_CreateLocalAccount_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteAccountTokens provides a mock function with given fields: ctx, userID, purpose
+func (_m *LocalAccountRepository) DeleteAccountTokens(ctx context.Context, userID int64, purpose string) error {
+	ret := _m.Called(ctx, userID, purpose)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteAccountTokens")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, string) error); ok {
+		r0 = rf(ctx, userID, purpose)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// LocalAccountRepository_DeleteAccountTokens_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteAccountTokens'
+type LocalAccountRepository_DeleteAccountTokens_Call struct {
+	*mock.Call
+}
+
+// DeleteAccountTokens is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID int64
+//   - purpose string
+func (_e *LocalAccountRepository_Expecter) DeleteAccountTokens(ctx interface{}, userID interface{}, purpose interface{}) *LocalAccountRepository_DeleteAccountTokens_Call {
+	return &LocalAccountRepository_DeleteAccountTokens_Call{Call: _e.mock.On("DeleteAccountTokens", ctx, userID, purpose)}
+}
+
+func (_c *LocalAccountRepository_DeleteAccountTokens_Call) Run(run func(ctx context.Context, userID int64, purpose string)) *LocalAccountRepository_DeleteAccountTokens_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *LocalAccountRepository_DeleteAccountTokens_Call) Return(_a0 error) *LocalAccountRepository_DeleteAccountTokens_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *LocalAccountRepository_DeleteAccountTokens_Call) RunAndReturn(run func(context.Context, int64, string) error) *LocalAccountRepository_DeleteAccountTokens_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAccountTokenByHashForUpdate provides a mock function with given fields: ctx, hash
+func (_m *LocalAccountRepository) GetAccountTokenByHashForUpdate(ctx context.Context, hash string) (domain.AccountToken, error) {
+	ret := _m.Called(ctx, hash)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAccountTokenByHashForUpdate")
+	}
+
+	var r0 domain.AccountToken
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (domain.AccountToken, error)); ok {
+		return rf(ctx, hash)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) domain.AccountToken); ok {
+		r0 = rf(ctx, hash)
+	} else {
+		r0 = ret.Get(0).(domain.AccountToken)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, hash)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// LocalAccountRepository_GetAccountTokenByHashForUpdate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAccountTokenByHashForUpdate'
+type LocalAccountRepository_GetAccountTokenByHashForUpdate_Call struct {
+	*mock.Call
+}
+
+// GetAccountTokenByHashForUpdate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - hash string
+func (_e *LocalAccountRepository_Expecter) GetAccountTokenByHashForUpdate(ctx interface{}, hash interface{}) *LocalAccountRepository_GetAccountTokenByHashForUpdate_Call {
+	return &LocalAccountRepository_GetAccountTokenByHashForUpdate_Call{Call: _e.mock.On("GetAccountTokenByHashForUpdate", ctx, hash)}
+}
+
+func (_c *LocalAccountRepository_GetAccountTokenByHashForUpdate_Call) Run(run func(ctx context.Context, hash string)) *LocalAccountRepository_GetAccountTokenByHashForUpdate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *LocalAccountRepository_GetAccountTokenByHashForUpdate_Call) Return(_a0 domain.AccountToken, _a1 error) *LocalAccountRepository_GetAccountTokenByHashForUpdate_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *LocalAccountRepository_GetAccountTokenByHashForUpdate_Call) RunAndReturn(run func(context.Context, string) (domain.AccountToken, error)) *LocalAccountRepository_GetAccountTokenByHashForUpdate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetLocalAccount provides a mock function with given fields: ctx, userID
+func (_m *LocalAccountRepository) GetLocalAccount(ctx context.Context, userID int64) (domain.LocalAccount, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLocalAccount")
+	}
+
+	var r0 domain.LocalAccount
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) (domain.LocalAccount, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) domain.LocalAccount); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		r0 = ret.Get(0).(domain.LocalAccount)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// LocalAccountRepository_GetLocalAccount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLocalAccount'
+type LocalAccountRepository_GetLocalAccount_Call struct {
+	*mock.Call
+}
+
+// GetLocalAccount is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID int64
+func (_e *LocalAccountRepository_Expecter) GetLocalAccount(ctx interface{}, userID interface{}) *LocalAccountRepository_GetLocalAccount_Call {
+	return &LocalAccountRepository_GetLocalAccount_Call{Call: _e.mock.On("GetLocalAccount", ctx, userID)}
+}
+
+func (_c *LocalAccountRepository_GetLocalAccount_Call) Run(run func(ctx context.Context, userID int64)) *LocalAccountRepository_GetLocalAccount_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *LocalAccountRepository_GetLocalAccount_Call) Return(_a0 domain.LocalAccount, _a1 error) *LocalAccountRepository_GetLocalAccount_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *LocalAccountRepository_GetLocalAccount_Call) RunAndReturn(run func(context.Context, int64) (domain.LocalAccount, error)) *LocalAccountRepository_GetLocalAccount_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetLocalAccountForUpdate provides a mock function with given fields: ctx, userID
+func (_m *LocalAccountRepository) GetLocalAccountForUpdate(ctx context.Context, userID int64) (domain.LocalAccount, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLocalAccountForUpdate")
+	}
+
+	var r0 domain.LocalAccount
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) (domain.LocalAccount, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) domain.LocalAccount); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		r0 = ret.Get(0).(domain.LocalAccount)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// LocalAccountRepository_GetLocalAccountForUpdate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLocalAccountForUpdate'
+type LocalAccountRepository_GetLocalAccountForUpdate_Call struct {
+	*mock.Call
+}
+
+// GetLocalAccountForUpdate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID int64
+func (_e *LocalAccountRepository_Expecter) GetLocalAccountForUpdate(ctx interface{}, userID interface{}) *LocalAccountRepository_GetLocalAccountForUpdate_Call {
+	return &LocalAccountRepository_GetLocalAccountForUpdate_Call{Call: _e.mock.On("GetLocalAccountForUpdate", ctx, userID)}
+}
+
+func (_c *LocalAccountRepository_GetLocalAccountForUpdate_Call) Run(run func(ctx context.Context, userID int64)) *LocalAccountRepository_GetLocalAccountForUpdate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *LocalAccountRepository_GetLocalAccountForUpdate_Call) Return(_a0 domain.LocalAccount, _a1 error) *LocalAccountRepository_GetLocalAccountForUpdate_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *LocalAccountRepository_GetLocalAccountForUpdate_Call) RunAndReturn(run func(context.Context, int64) (domain.LocalAccount, error)) *LocalAccountRepository_GetLocalAccountForUpdate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MarkAccountTokenUsed provides a mock function with given fields: ctx, id, t
+func (_m *LocalAccountRepository) MarkAccountTokenUsed(ctx context.Context, id int64, t time.Time) error {
+	ret := _m.Called(ctx, id, t)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarkAccountTokenUsed")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, time.Time) error); ok {
+		r0 = rf(ctx, id, t)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// LocalAccountRepository_MarkAccountTokenUsed_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkAccountTokenUsed'
+type LocalAccountRepository_MarkAccountTokenUsed_Call struct {
+	*mock.Call
+}
+
+// MarkAccountTokenUsed is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+//   - t time.Time
+func (_e *LocalAccountRepository_Expecter) MarkAccountTokenUsed(ctx interface{}, id interface{}, t interface{}) *LocalAccountRepository_MarkAccountTokenUsed_Call {
+	return &LocalAccountRepository_MarkAccountTokenUsed_Call{Call: _e.mock.On("MarkAccountTokenUsed", ctx, id, t)}
+}
+
+func (_c *LocalAccountRepository_MarkAccountTokenUsed_Call) Run(run func(ctx context.Context, id int64, t time.Time)) *LocalAccountRepository_MarkAccountTokenUsed_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(time.Time))
+	})
+	return _c
+}
+
+func (_c *LocalAccountRepository_MarkAccountTokenUsed_Call) Return(_a0 error) *LocalAccountRepository_MarkAccountTokenUsed_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *LocalAccountRepository_MarkAccountTokenUsed_Call) RunAndReturn(run func(context.Context, int64, time.Time) error) *LocalAccountRepository_MarkAccountTokenUsed_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateLocalAccount provides a mock function with given fields: ctx, account
+func (_m *LocalAccountRepository) UpdateLocalAccount(ctx context.Context, account domain.LocalAccount) (domain.LocalAccount, error) {
+	ret := _m.Called(ctx, account)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateLocalAccount")
+	}
+
+	var r0 domain.LocalAccount
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.LocalAccount) (domain.LocalAccount, error)); ok {
+		return rf(ctx, account)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.LocalAccount) domain.LocalAccount); ok {
+		r0 = rf(ctx, account)
+	} else {
+		r0 = ret.Get(0).(domain.LocalAccount)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.LocalAccount) error); ok {
+		r1 = rf(ctx, account)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// LocalAccountRepository_UpdateLocalAccount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateLocalAccount'
+type LocalAccountRepository_UpdateLocalAccount_Call struct {
+	*mock.Call
+}
+
+// UpdateLocalAccount is a helper method to define mock.On call
+//   - ctx context.Context
+//   - account domain.LocalAccount
+func (_e *LocalAccountRepository_Expecter) UpdateLocalAccount(ctx interface{}, account interface{}) *LocalAccountRepository_UpdateLocalAccount_Call {
+	return &LocalAccountRepository_UpdateLocalAccount_Call{Call: _e.mock.On("UpdateLocalAccount", ctx, account)}
+}
+
+func (_c *LocalAccountRepository_UpdateLocalAccount_Call) Run(run func(ctx context.Context, account domain.LocalAccount)) *LocalAccountRepository_UpdateLocalAccount_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.LocalAccount))
+	})
+	return _c
+}
+
+func (_c *LocalAccountRepository_UpdateLocalAccount_Call) Return(_a0 domain.LocalAccount, _a1 error) *LocalAccountRepository_UpdateLocalAccount_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *LocalAccountRepository_UpdateLocalAccount_Call) RunAndReturn(run func(context.Context, domain.LocalAccount) (domain.LocalAccount, error)) *LocalAccountRepository_UpdateLocalAccount_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewLocalAccountRepository creates a new instance of LocalAccountRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewLocalAccountRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *LocalAccountRepository {
+	mock := &LocalAccountRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,284 @@
+// Code generated by mockery v2.46.3. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	dto "github.com/rimvydascivilis/book-tracker/backend/dto"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// LocalAuthService is an autogenerated mock type for the LocalAuthService type
+type LocalAuthService struct {
+	mock.Mock
+}
+
+type LocalAuthService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *LocalAuthService) EXPECT() *LocalAuthService_Expecter {
+	return &LocalAuthService_Expecter{mock: &_m.Mock}
+}
+
+// Login provides a mock function with given fields: ctx, req, userAgent
+func (_m *LocalAuthService) Login(ctx context.Context, req dto.PasswordRequest, userAgent string) (dto.TokenResponse, error) {
+	ret := _m.Called(ctx, req, userAgent)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Login")
+	}
+
+	var r0 dto.TokenResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, dto.PasswordRequest, string) (dto.TokenResponse, error)); ok {
+		return rf(ctx, req, userAgent)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, dto.PasswordRequest, string) dto.TokenResponse); ok {
+		r0 = rf(ctx, req, userAgent)
+	} else {
+		r0 = ret.Get(0).(dto.TokenResponse)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, dto.PasswordRequest, string) error); ok {
+		r1 = rf(ctx, req, userAgent)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// LocalAuthService_Login_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Login'
+type LocalAuthService_Login_Call struct {
+	*mock.Call
+}
+
+// Login is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req dto.PasswordRequest
+//   - userAgent string
+func (_e *LocalAuthService_Expecter) Login(ctx interface{}, req interface{}, userAgent interface{}) *LocalAuthService_Login_Call {
+	return &LocalAuthService_Login_Call{Call: _e.mock.On("Login", ctx, req, userAgent)}
+}
+
+func (_c *LocalAuthService_Login_Call) Run(run func(ctx context.Context, req dto.PasswordRequest, userAgent string)) *LocalAuthService_Login_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(dto.PasswordRequest), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *LocalAuthService_Login_Call) Return(_a0 dto.TokenResponse, _a1 error) *LocalAuthService_Login_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *LocalAuthService_Login_Call) RunAndReturn(run func(context.Context, dto.PasswordRequest, string) (dto.TokenResponse, error)) *LocalAuthService_Login_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Register provides a mock function with given fields: ctx, req
+func (_m *LocalAuthService) Register(ctx context.Context, req dto.PasswordRequest) error {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Register")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, dto.PasswordRequest) error); ok {
+		r0 = rf(ctx, req)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// LocalAuthService_Register_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Register'
+type LocalAuthService_Register_Call struct {
+	*mock.Call
+}
+
+// Register is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req dto.PasswordRequest
+func (_e *LocalAuthService_Expecter) Register(ctx interface{}, req interface{}) *LocalAuthService_Register_Call {
+	return &LocalAuthService_Register_Call{Call: _e.mock.On("Register", ctx, req)}
+}
+
+func (_c *LocalAuthService_Register_Call) Run(run func(ctx context.Context, req dto.PasswordRequest)) *LocalAuthService_Register_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(dto.PasswordRequest))
+	})
+	return _c
+}
+
+func (_c *LocalAuthService_Register_Call) Return(_a0 error) *LocalAuthService_Register_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *LocalAuthService_Register_Call) RunAndReturn(run func(context.Context, dto.PasswordRequest) error) *LocalAuthService_Register_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RequestPasswordReset provides a mock function with given fields: ctx, email
+func (_m *LocalAuthService) RequestPasswordReset(ctx context.Context, email string) error {
+	ret := _m.Called(ctx, email)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RequestPasswordReset")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, email)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// LocalAuthService_RequestPasswordReset_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RequestPasswordReset'
+type LocalAuthService_RequestPasswordReset_Call struct {
+	*mock.Call
+}
+
+// RequestPasswordReset is a helper method to define mock.On call
+//   - ctx context.Context
+//   - email string
+func (_e *LocalAuthService_Expecter) RequestPasswordReset(ctx interface{}, email interface{}) *LocalAuthService_RequestPasswordReset_Call {
+	return &LocalAuthService_RequestPasswordReset_Call{Call: _e.mock.On("RequestPasswordReset", ctx, email)}
+}
+
+func (_c *LocalAuthService_RequestPasswordReset_Call) Run(run func(ctx context.Context, email string)) *LocalAuthService_RequestPasswordReset_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *LocalAuthService_RequestPasswordReset_Call) Return(_a0 error) *LocalAuthService_RequestPasswordReset_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *LocalAuthService_RequestPasswordReset_Call) RunAndReturn(run func(context.Context, string) error) *LocalAuthService_RequestPasswordReset_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ResetPassword provides a mock function with given fields: ctx, req
+func (_m *LocalAuthService) ResetPassword(ctx context.Context, req dto.PasswordResetRequest) error {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ResetPassword")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, dto.PasswordResetRequest) error); ok {
+		r0 = rf(ctx, req)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// LocalAuthService_ResetPassword_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ResetPassword'
+type LocalAuthService_ResetPassword_Call struct {
+	*mock.Call
+}
+
+// ResetPassword is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req dto.PasswordResetRequest
+func (_e *LocalAuthService_Expecter) ResetPassword(ctx interface{}, req interface{}) *LocalAuthService_ResetPassword_Call {
+	return &LocalAuthService_ResetPassword_Call{Call: _e.mock.On("ResetPassword", ctx, req)}
+}
+
+func (_c *LocalAuthService_ResetPassword_Call) Run(run func(ctx context.Context, req dto.PasswordResetRequest)) *LocalAuthService_ResetPassword_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(dto.PasswordResetRequest))
+	})
+	return _c
+}
+
+func (_c *LocalAuthService_ResetPassword_Call) Return(_a0 error) *LocalAuthService_ResetPassword_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *LocalAuthService_ResetPassword_Call) RunAndReturn(run func(context.Context, dto.PasswordResetRequest) error) *LocalAuthService_ResetPassword_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// VerifyEmail provides a mock function with given fields: ctx, token
+func (_m *LocalAuthService) VerifyEmail(ctx context.Context, token string) error {
+	ret := _m.Called(ctx, token)
+
+	if len(ret) == 0 {
+		panic("no return value specified for VerifyEmail")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, token)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// LocalAuthService_VerifyEmail_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'VerifyEmail'
+type LocalAuthService_VerifyEmail_Call struct {
+	*mock.Call
+}
+
+// VerifyEmail is a helper method to define mock.On call
+//   - ctx context.Context
+//   - token string
+func (_e *LocalAuthService_Expecter) VerifyEmail(ctx interface{}, token interface{}) *LocalAuthService_VerifyEmail_Call {
+	return &LocalAuthService_VerifyEmail_Call{Call: _e.mock.On("VerifyEmail", ctx, token)}
+}
+
+func (_c *LocalAuthService_VerifyEmail_Call) Run(run func(ctx context.Context, token string)) *LocalAuthService_VerifyEmail_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *LocalAuthService_VerifyEmail_Call) Return(_a0 error) *LocalAuthService_VerifyEmail_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *LocalAuthService_VerifyEmail_Call) RunAndReturn(run func(context.Context, string) error) *LocalAuthService_VerifyEmail_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewLocalAuthService creates a new instance of LocalAuthService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewLocalAuthService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *LocalAuthService {
+	mock := &LocalAuthService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,155 @@
+// Code generated by mockery v2.46.3. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	domain "github.com/rimvydascivilis/book-tracker/backend/domain"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MetadataProvider is an autogenerated mock type for the MetadataProvider type
+type MetadataProvider struct {
+	mock.Mock
+}
+
+type MetadataProvider_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MetadataProvider) EXPECT() *MetadataProvider_Expecter {
+	return &MetadataProvider_Expecter{mock: &_m.Mock}
+}
+
+// LookupISBN provides a mock function with given fields: ctx, isbn
+func (_m *MetadataProvider) LookupISBN(ctx context.Context, isbn string) (domain.Book, error) {
+	ret := _m.Called(ctx, isbn)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LookupISBN")
+	}
+
+	var r0 domain.Book
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (domain.Book, error)); ok {
+		return rf(ctx, isbn)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) domain.Book); ok {
+		r0 = rf(ctx, isbn)
+	} else {
+		r0 = ret.Get(0).(domain.Book)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, isbn)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MetadataProvider_LookupISBN_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LookupISBN'
+type MetadataProvider_LookupISBN_Call struct {
+	*mock.Call
+}
+
+// LookupISBN is a helper method to define mock.On call
+//   - ctx context.Context
+//   - isbn string
+func (_e *MetadataProvider_Expecter) LookupISBN(ctx interface{}, isbn interface{}) *MetadataProvider_LookupISBN_Call {
+	return &MetadataProvider_LookupISBN_Call{Call: _e.mock.On("LookupISBN", ctx, isbn)}
+}
+
+func (_c *MetadataProvider_LookupISBN_Call) Run(run func(ctx context.Context, isbn string)) *MetadataProvider_LookupISBN_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MetadataProvider_LookupISBN_Call) Return(_a0 domain.Book, _a1 error) *MetadataProvider_LookupISBN_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MetadataProvider_LookupISBN_Call) RunAndReturn(run func(context.Context, string) (domain.Book, error)) *MetadataProvider_LookupISBN_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Search provides a mock function with given fields: ctx, title, author, limit
+func (_m *MetadataProvider) Search(ctx context.Context, title string, author string, limit int64) ([]domain.Book, error) {
+	ret := _m.Called(ctx, title, author, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Search")
+	}
+
+	var r0 []domain.Book
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, int64) ([]domain.Book, error)); ok {
+		return rf(ctx, title, author, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, int64) []domain.Book); ok {
+		r0 = rf(ctx, title, author, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Book)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, int64) error); ok {
+		r1 = rf(ctx, title, author, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MetadataProvider_Search_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Search'
+type MetadataProvider_Search_Call struct {
+	*mock.Call
+}
+
+// Search is a helper method to define mock.On call
+//   - ctx context.Context
+//   - title string
+//   - author string
+//   - limit int64
+func (_e *MetadataProvider_Expecter) Search(ctx interface{}, title interface{}, author interface{}, limit interface{}) *MetadataProvider_Search_Call {
+	return &MetadataProvider_Search_Call{Call: _e.mock.On("Search", ctx, title, author, limit)}
+}
+
+func (_c *MetadataProvider_Search_Call) Run(run func(ctx context.Context, title string, author string, limit int64)) *MetadataProvider_Search_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(int64))
+	})
+	return _c
+}
+
+func (_c *MetadataProvider_Search_Call) Return(_a0 []domain.Book, _a1 error) *MetadataProvider_Search_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MetadataProvider_Search_Call) RunAndReturn(run func(context.Context, string, string, int64) ([]domain.Book, error)) *MetadataProvider_Search_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMetadataProvider creates a new instance of MetadataProvider. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMetadataProvider(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MetadataProvider {
+	mock := &MetadataProvider{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,318 @@
+// Code generated by mockery v2.46.3. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	domain "github.com/rimvydascivilis/book-tracker/backend/domain"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NoteRepository is an autogenerated mock type for the NoteRepository type
+type NoteRepository struct {
+	mock.Mock
+}
+
+type NoteRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *NoteRepository) EXPECT() *NoteRepository_Expecter {
+	return &NoteRepository_Expecter{mock: &_m.Mock}
+}
+
+// CreateNote provides a mock function with given fields: ctx, note
+func (_m *NoteRepository) CreateNote(ctx context.Context, note domain.Note) (domain.Note, error) {
+	ret := _m.Called(ctx, note)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateNote")
+	}
+
+	var r0 domain.Note
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.Note) (domain.Note, error)); ok {
+		return rf(ctx, note)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.Note) domain.Note); ok {
+		r0 = rf(ctx, note)
+	} else {
+		r0 = ret.Get(0).(domain.Note)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.Note) error); ok {
+		r1 = rf(ctx, note)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NoteRepository_CreateNote_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateNote'
+type NoteRepository_CreateNote_Call struct {
+	*mock.Call
+}
+
+// CreateNote is a helper method to define mock.On call
+//   - ctx context.Context
+//   - note domain.Note
+func (_e *NoteRepository_Expecter) CreateNote(ctx interface{}, note interface{}) *NoteRepository_CreateNote_Call {
+	return &NoteRepository_CreateNote_Call{Call: _e.mock.On("CreateNote", ctx, note)}
+}
+
+func (_c *NoteRepository_CreateNote_Call) Run(run func(ctx context.Context, note domain.Note)) *NoteRepository_CreateNote_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.Note))
+	})
+	return _c
+}
+
+func (_c *NoteRepository_CreateNote_Call) Return(_a0 domain.Note, _a1 error) *NoteRepository_CreateNote_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *NoteRepository_CreateNote_Call) RunAndReturn(run func(context.Context, domain.Note) (domain.Note, error)) *NoteRepository_CreateNote_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteNote provides a mock function with given fields: ctx, id
+func (_m *NoteRepository) DeleteNote(ctx context.Context, id int64) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteNote")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NoteRepository_DeleteNote_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteNote'
+type NoteRepository_DeleteNote_Call struct {
+	*mock.Call
+}
+
+// DeleteNote is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+func (_e *NoteRepository_Expecter) DeleteNote(ctx interface{}, id interface{}) *NoteRepository_DeleteNote_Call {
+	return &NoteRepository_DeleteNote_Call{Call: _e.mock.On("DeleteNote", ctx, id)}
+}
+
+func (_c *NoteRepository_DeleteNote_Call) Run(run func(ctx context.Context, id int64)) *NoteRepository_DeleteNote_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *NoteRepository_DeleteNote_Call) Return(_a0 error) *NoteRepository_DeleteNote_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *NoteRepository_DeleteNote_Call) RunAndReturn(run func(context.Context, int64) error) *NoteRepository_DeleteNote_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetBookIDsByUserID provides a mock function with given fields: ctx, userID
+func (_m *NoteRepository) GetBookIDsByUserID(ctx context.Context, userID int64) ([]int64, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetBookIDsByUserID")
+	}
+
+	var r0 []int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) ([]int64, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) []int64); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]int64)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NoteRepository_GetBookIDsByUserID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetBookIDsByUserID'
+type NoteRepository_GetBookIDsByUserID_Call struct {
+	*mock.Call
+}
+
+// GetBookIDsByUserID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID int64
+func (_e *NoteRepository_Expecter) GetBookIDsByUserID(ctx interface{}, userID interface{}) *NoteRepository_GetBookIDsByUserID_Call {
+	return &NoteRepository_GetBookIDsByUserID_Call{Call: _e.mock.On("GetBookIDsByUserID", ctx, userID)}
+}
+
+func (_c *NoteRepository_GetBookIDsByUserID_Call) Run(run func(ctx context.Context, userID int64)) *NoteRepository_GetBookIDsByUserID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *NoteRepository_GetBookIDsByUserID_Call) Return(_a0 []int64, _a1 error) *NoteRepository_GetBookIDsByUserID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *NoteRepository_GetBookIDsByUserID_Call) RunAndReturn(run func(context.Context, int64) ([]int64, error)) *NoteRepository_GetBookIDsByUserID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetNoteByUserID provides a mock function with given fields: ctx, noteID, userID
+func (_m *NoteRepository) GetNoteByUserID(ctx context.Context, noteID int64, userID int64) (domain.Note, error) {
+	ret := _m.Called(ctx, noteID, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetNoteByUserID")
+	}
+
+	var r0 domain.Note
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64) (domain.Note, error)); ok {
+		return rf(ctx, noteID, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64) domain.Note); ok {
+		r0 = rf(ctx, noteID, userID)
+	} else {
+		r0 = ret.Get(0).(domain.Note)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, int64) error); ok {
+		r1 = rf(ctx, noteID, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NoteRepository_GetNoteByUserID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetNoteByUserID'
+type NoteRepository_GetNoteByUserID_Call struct {
+	*mock.Call
+}
+
+// GetNoteByUserID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - noteID int64
+//   - userID int64
+func (_e *NoteRepository_Expecter) GetNoteByUserID(ctx interface{}, noteID interface{}, userID interface{}) *NoteRepository_GetNoteByUserID_Call {
+	return &NoteRepository_GetNoteByUserID_Call{Call: _e.mock.On("GetNoteByUserID", ctx, noteID, userID)}
+}
+
+func (_c *NoteRepository_GetNoteByUserID_Call) Run(run func(ctx context.Context, noteID int64, userID int64)) *NoteRepository_GetNoteByUserID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(int64))
+	})
+	return _c
+}
+
+func (_c *NoteRepository_GetNoteByUserID_Call) Return(_a0 domain.Note, _a1 error) *NoteRepository_GetNoteByUserID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *NoteRepository_GetNoteByUserID_Call) RunAndReturn(run func(context.Context, int64, int64) (domain.Note, error)) *NoteRepository_GetNoteByUserID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetNotesByUserIDAndBookID provides a mock function with given fields: ctx, userID, bookID
+func (_m *NoteRepository) GetNotesByUserIDAndBookID(ctx context.Context, userID int64, bookID int64) ([]domain.Note, error) {
+	ret := _m.Called(ctx, userID, bookID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetNotesByUserIDAndBookID")
+	}
+
+	var r0 []domain.Note
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64) ([]domain.Note, error)); ok {
+		return rf(ctx, userID, bookID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64) []domain.Note); ok {
+		r0 = rf(ctx, userID, bookID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Note)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, int64) error); ok {
+		r1 = rf(ctx, userID, bookID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NoteRepository_GetNotesByUserIDAndBookID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetNotesByUserIDAndBookID'
+type NoteRepository_GetNotesByUserIDAndBookID_Call struct {
+	*mock.Call
+}
+
+// GetNotesByUserIDAndBookID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID int64
+//   - bookID int64
+func (_e *NoteRepository_Expecter) GetNotesByUserIDAndBookID(ctx interface{}, userID interface{}, bookID interface{}) *NoteRepository_GetNotesByUserIDAndBookID_Call {
+	return &NoteRepository_GetNotesByUserIDAndBookID_Call{Call: _e.mock.On("GetNotesByUserIDAndBookID", ctx, userID, bookID)}
+}
+
+func (_c *NoteRepository_GetNotesByUserIDAndBookID_Call) Run(run func(ctx context.Context, userID int64, bookID int64)) *NoteRepository_GetNotesByUserIDAndBookID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(int64))
+	})
+	return _c
+}
+
+func (_c *NoteRepository_GetNotesByUserIDAndBookID_Call) Return(_a0 []domain.Note, _a1 error) *NoteRepository_GetNotesByUserIDAndBookID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *NoteRepository_GetNotesByUserIDAndBookID_Call) RunAndReturn(run func(context.Context, int64, int64) ([]domain.Note, error)) *NoteRepository_GetNotesByUserIDAndBookID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewNoteRepository creates a new instance of NoteRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewNoteRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *NoteRepository {
+	mock := &NoteRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
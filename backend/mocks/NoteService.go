@@ -0,0 +1,205 @@
+// Code generated by mockery v2.46.3. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	dto "github.com/rimvydascivilis/book-tracker/backend/dto"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NoteService is an autogenerated mock type for the NoteService type
+type NoteService struct {
+	mock.Mock
+}
+
+type NoteService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *NoteService) EXPECT() *NoteService_Expecter {
+	return &NoteService_Expecter{mock: &_m.Mock}
+}
+
+// CreateNote provides a mock function with given fields: ctx, userID, bookID, note
+func (_m *NoteService) CreateNote(ctx context.Context, userID int64, bookID int64, note dto.NoteRequest) (dto.NoteResponse, error) {
+	ret := _m.Called(ctx, userID, bookID, note)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateNote")
+	}
+
+	var r0 dto.NoteResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64, dto.NoteRequest) (dto.NoteResponse, error)); ok {
+		return rf(ctx, userID, bookID, note)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64, dto.NoteRequest) dto.NoteResponse); ok {
+		r0 = rf(ctx, userID, bookID, note)
+	} else {
+		r0 = ret.Get(0).(dto.NoteResponse)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, int64, dto.NoteRequest) error); ok {
+		r1 = rf(ctx, userID, bookID, note)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NoteService_CreateNote_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateNote'
+type NoteService_CreateNote_Call struct {
+	*mock.Call
+}
+
+// CreateNote is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID int64
+//   - bookID int64
+//   - note dto.NoteRequest
+func (_e *NoteService_Expecter) CreateNote(ctx interface{}, userID interface{}, bookID interface{}, note interface{}) *NoteService_CreateNote_Call {
+	return &NoteService_CreateNote_Call{Call: _e.mock.On("CreateNote", ctx, userID, bookID, note)}
+}
+
+func (_c *NoteService_CreateNote_Call) Run(run func(ctx context.Context, userID int64, bookID int64, note dto.NoteRequest)) *NoteService_CreateNote_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(int64), args[3].(dto.NoteRequest))
+	})
+	return _c
+}
+
+func (_c *NoteService_CreateNote_Call) Return(_a0 dto.NoteResponse, _a1 error) *NoteService_CreateNote_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *NoteService_CreateNote_Call) RunAndReturn(run func(context.Context, int64, int64, dto.NoteRequest) (dto.NoteResponse, error)) *NoteService_CreateNote_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteNote provides a mock function with given fields: ctx, userID, noteID
+func (_m *NoteService) DeleteNote(ctx context.Context, userID int64, noteID int64) error {
+	ret := _m.Called(ctx, userID, noteID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteNote")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64) error); ok {
+		r0 = rf(ctx, userID, noteID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NoteService_DeleteNote_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteNote'
+type NoteService_DeleteNote_Call struct {
+	*mock.Call
+}
+
+// DeleteNote is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID int64
+//   - noteID int64
+func (_e *NoteService_Expecter) DeleteNote(ctx interface{}, userID interface{}, noteID interface{}) *NoteService_DeleteNote_Call {
+	return &NoteService_DeleteNote_Call{Call: _e.mock.On("DeleteNote", ctx, userID, noteID)}
+}
+
+func (_c *NoteService_DeleteNote_Call) Run(run func(ctx context.Context, userID int64, noteID int64)) *NoteService_DeleteNote_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(int64))
+	})
+	return _c
+}
+
+func (_c *NoteService_DeleteNote_Call) Return(_a0 error) *NoteService_DeleteNote_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *NoteService_DeleteNote_Call) RunAndReturn(run func(context.Context, int64, int64) error) *NoteService_DeleteNote_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetNotes provides a mock function with given fields: ctx, userID, bookID
+func (_m *NoteService) GetNotes(ctx context.Context, userID int64, bookID int64) ([]dto.NoteResponse, error) {
+	ret := _m.Called(ctx, userID, bookID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetNotes")
+	}
+
+	var r0 []dto.NoteResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64) ([]dto.NoteResponse, error)); ok {
+		return rf(ctx, userID, bookID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64) []dto.NoteResponse); ok {
+		r0 = rf(ctx, userID, bookID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]dto.NoteResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, int64) error); ok {
+		r1 = rf(ctx, userID, bookID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NoteService_GetNotes_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetNotes'
+type NoteService_GetNotes_Call struct {
+	*mock.Call
+}
+
+// GetNotes is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID int64
+//   - bookID int64
+func (_e *NoteService_Expecter) GetNotes(ctx interface{}, userID interface{}, bookID interface{}) *NoteService_GetNotes_Call {
+	return &NoteService_GetNotes_Call{Call: _e.mock.On("GetNotes", ctx, userID, bookID)}
+}
+
+func (_c *NoteService_GetNotes_Call) Run(run func(ctx context.Context, userID int64, bookID int64)) *NoteService_GetNotes_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(int64))
+	})
+	return _c
+}
+
+func (_c *NoteService_GetNotes_Call) Return(_a0 []dto.NoteResponse, _a1 error) *NoteService_GetNotes_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *NoteService_GetNotes_Call) RunAndReturn(run func(context.Context, int64, int64) ([]dto.NoteResponse, error)) *NoteService_GetNotes_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewNoteService creates a new instance of NoteService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewNoteService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *NoteService {
+	mock := &NoteService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -6,7 +6,11 @@ import (
 	context "context"
 
 	domain "github.com/rimvydascivilis/book-tracker/backend/domain"
+	dto "github.com/rimvydascivilis/book-tracker/backend/dto"
+
 	mock "github.com/stretchr/testify/mock"
+
+	time "time"
 )
 
 // ProgressRepository is an autogenerated mock type for the ProgressRepository type
@@ -22,9 +26,9 @@ func (_m *ProgressRepository) EXPECT() *ProgressRepository_Expecter {
 	return &ProgressRepository_Expecter{mock: &_m.Mock}
 }
 
-// CreateProgress provides a mock function with given fields: ctx, progress
-func (_m *ProgressRepository) CreateProgress(ctx context.Context, progress domain.Progress) (domain.Progress, error) {
-	ret := _m.Called(ctx, progress)
+// CreateProgress provides a mock function with given fields: ctx, progressReq
+func (_m *ProgressRepository) CreateProgress(ctx context.Context, progressReq domain.Progress) (domain.Progress, error) {
+	ret := _m.Called(ctx, progressReq)
 
 	if len(ret) == 0 {
 		panic("no return value specified for CreateProgress")
@@ -33,16 +37,16 @@ func (_m *ProgressRepository) CreateProgress(ctx context.Context, progress domai
 	var r0 domain.Progress
 	var r1 error
 	if rf, ok := ret.Get(0).(func(context.Context, domain.Progress) (domain.Progress, error)); ok {
-		return rf(ctx, progress)
+		return rf(ctx, progressReq)
 	}
 	if rf, ok := ret.Get(0).(func(context.Context, domain.Progress) domain.Progress); ok {
-		r0 = rf(ctx, progress)
+		r0 = rf(ctx, progressReq)
 	} else {
 		r0 = ret.Get(0).(domain.Progress)
 	}
 
 	if rf, ok := ret.Get(1).(func(context.Context, domain.Progress) error); ok {
-		r1 = rf(ctx, progress)
+		r1 = rf(ctx, progressReq)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -57,12 +61,12 @@ type ProgressRepository_CreateProgress_Call struct {
 
 // CreateProgress is a helper method to define mock.On call
 //   - ctx context.Context
-//   - progress domain.Progress
-func (_e *ProgressRepository_Expecter) CreateProgress(ctx interface{}, progress interface{}) *ProgressRepository_CreateProgress_Call {
-	return &ProgressRepository_CreateProgress_Call{Call: _e.mock.On("CreateProgress", ctx, progress)}
+//   - progressReq domain.Progress
+func (_e *ProgressRepository_Expecter) CreateProgress(ctx interface{}, progressReq interface{}) *ProgressRepository_CreateProgress_Call {
+	return &ProgressRepository_CreateProgress_Call{Call: _e.mock.On("CreateProgress", ctx, progressReq)}
 }
 
-func (_c *ProgressRepository_CreateProgress_Call) Run(run func(ctx context.Context, progress domain.Progress)) *ProgressRepository_CreateProgress_Call {
+func (_c *ProgressRepository_CreateProgress_Call) Run(run func(ctx context.Context, progressReq domain.Progress)) *ProgressRepository_CreateProgress_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run(args[0].(context.Context), args[1].(domain.Progress))
 	})
@@ -79,6 +83,589 @@ func (_c *ProgressRepository_CreateProgress_Call) RunAndReturn(run func(context.
 	return _c
 }
 
+// DeleteProgress provides a mock function with given fields: ctx, id
+func (_m *ProgressRepository) DeleteProgress(ctx context.Context, id int64) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteProgress")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ProgressRepository_DeleteProgress_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteProgress'
+type ProgressRepository_DeleteProgress_Call struct {
+	*mock.Call
+}
+
+// DeleteProgress is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+func (_e *ProgressRepository_Expecter) DeleteProgress(ctx interface{}, id interface{}) *ProgressRepository_DeleteProgress_Call {
+	return &ProgressRepository_DeleteProgress_Call{Call: _e.mock.On("DeleteProgress", ctx, id)}
+}
+
+func (_c *ProgressRepository_DeleteProgress_Call) Run(run func(ctx context.Context, id int64)) *ProgressRepository_DeleteProgress_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *ProgressRepository_DeleteProgress_Call) Return(_a0 error) *ProgressRepository_DeleteProgress_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ProgressRepository_DeleteProgress_Call) RunAndReturn(run func(context.Context, int64) error) *ProgressRepository_DeleteProgress_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDailyProgress provides a mock function with given fields: ctx, userID, year, month
+func (_m *ProgressRepository) GetDailyProgress(ctx context.Context, userID int64, year int64, month int64) ([]dto.Progress, error) {
+	ret := _m.Called(ctx, userID, year, month)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDailyProgress")
+	}
+
+	var r0 []dto.Progress
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64, int64) ([]dto.Progress, error)); ok {
+		return rf(ctx, userID, year, month)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64, int64) []dto.Progress); ok {
+		r0 = rf(ctx, userID, year, month)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]dto.Progress)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, int64, int64) error); ok {
+		r1 = rf(ctx, userID, year, month)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ProgressRepository_GetDailyProgress_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDailyProgress'
+type ProgressRepository_GetDailyProgress_Call struct {
+	*mock.Call
+}
+
+// GetDailyProgress is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID int64
+//   - year int64
+//   - month int64
+func (_e *ProgressRepository_Expecter) GetDailyProgress(ctx interface{}, userID interface{}, year interface{}, month interface{}) *ProgressRepository_GetDailyProgress_Call {
+	return &ProgressRepository_GetDailyProgress_Call{Call: _e.mock.On("GetDailyProgress", ctx, userID, year, month)}
+}
+
+func (_c *ProgressRepository_GetDailyProgress_Call) Run(run func(ctx context.Context, userID int64, year int64, month int64)) *ProgressRepository_GetDailyProgress_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(int64), args[3].(int64))
+	})
+	return _c
+}
+
+func (_c *ProgressRepository_GetDailyProgress_Call) Return(_a0 []dto.Progress, _a1 error) *ProgressRepository_GetDailyProgress_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ProgressRepository_GetDailyProgress_Call) RunAndReturn(run func(context.Context, int64, int64, int64) ([]dto.Progress, error)) *ProgressRepository_GetDailyProgress_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetMonthlyProgress provides a mock function with given fields: ctx, userID, year
+func (_m *ProgressRepository) GetMonthlyProgress(ctx context.Context, userID int64, year int64) ([]dto.Progress, error) {
+	ret := _m.Called(ctx, userID, year)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetMonthlyProgress")
+	}
+
+	var r0 []dto.Progress
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64) ([]dto.Progress, error)); ok {
+		return rf(ctx, userID, year)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64) []dto.Progress); ok {
+		r0 = rf(ctx, userID, year)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]dto.Progress)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, int64) error); ok {
+		r1 = rf(ctx, userID, year)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ProgressRepository_GetMonthlyProgress_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetMonthlyProgress'
+type ProgressRepository_GetMonthlyProgress_Call struct {
+	*mock.Call
+}
+
+// GetMonthlyProgress is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID int64
+//   - year int64
+func (_e *ProgressRepository_Expecter) GetMonthlyProgress(ctx interface{}, userID interface{}, year interface{}) *ProgressRepository_GetMonthlyProgress_Call {
+	return &ProgressRepository_GetMonthlyProgress_Call{Call: _e.mock.On("GetMonthlyProgress", ctx, userID, year)}
+}
+
+func (_c *ProgressRepository_GetMonthlyProgress_Call) Run(run func(ctx context.Context, userID int64, year int64)) *ProgressRepository_GetMonthlyProgress_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(int64))
+	})
+	return _c
+}
+
+func (_c *ProgressRepository_GetMonthlyProgress_Call) Return(_a0 []dto.Progress, _a1 error) *ProgressRepository_GetMonthlyProgress_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ProgressRepository_GetMonthlyProgress_Call) RunAndReturn(run func(context.Context, int64, int64) ([]dto.Progress, error)) *ProgressRepository_GetMonthlyProgress_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPeriodProgress provides a mock function with given fields: ctx, userID, from, to
+func (_m *ProgressRepository) GetPeriodProgress(ctx context.Context, userID int64, from time.Time, to time.Time) (dto.PeriodProgress, error) {
+	ret := _m.Called(ctx, userID, from, to)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPeriodProgress")
+	}
+
+	var r0 dto.PeriodProgress
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, time.Time, time.Time) (dto.PeriodProgress, error)); ok {
+		return rf(ctx, userID, from, to)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, time.Time, time.Time) dto.PeriodProgress); ok {
+		r0 = rf(ctx, userID, from, to)
+	} else {
+		r0 = ret.Get(0).(dto.PeriodProgress)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, time.Time, time.Time) error); ok {
+		r1 = rf(ctx, userID, from, to)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ProgressRepository_GetPeriodProgress_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPeriodProgress'
+type ProgressRepository_GetPeriodProgress_Call struct {
+	*mock.Call
+}
+
+// GetPeriodProgress is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID int64
+//   - from time.Time
+//   - to time.Time
+func (_e *ProgressRepository_Expecter) GetPeriodProgress(ctx interface{}, userID interface{}, from interface{}, to interface{}) *ProgressRepository_GetPeriodProgress_Call {
+	return &ProgressRepository_GetPeriodProgress_Call{Call: _e.mock.On("GetPeriodProgress", ctx, userID, from, to)}
+}
+
+func (_c *ProgressRepository_GetPeriodProgress_Call) Run(run func(ctx context.Context, userID int64, from time.Time, to time.Time)) *ProgressRepository_GetPeriodProgress_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(time.Time), args[3].(time.Time))
+	})
+	return _c
+}
+
+func (_c *ProgressRepository_GetPeriodProgress_Call) Return(_a0 dto.PeriodProgress, _a1 error) *ProgressRepository_GetPeriodProgress_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ProgressRepository_GetPeriodProgress_Call) RunAndReturn(run func(context.Context, int64, time.Time, time.Time) (dto.PeriodProgress, error)) *ProgressRepository_GetPeriodProgress_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetProgressByDay provides a mock function with given fields: ctx, userID, from, to
+func (_m *ProgressRepository) GetProgressByDay(ctx context.Context, userID int64, from time.Time, to time.Time) ([]dto.DayProgress, error) {
+	ret := _m.Called(ctx, userID, from, to)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetProgressByDay")
+	}
+
+	var r0 []dto.DayProgress
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, time.Time, time.Time) ([]dto.DayProgress, error)); ok {
+		return rf(ctx, userID, from, to)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, time.Time, time.Time) []dto.DayProgress); ok {
+		r0 = rf(ctx, userID, from, to)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]dto.DayProgress)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, time.Time, time.Time) error); ok {
+		r1 = rf(ctx, userID, from, to)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ProgressRepository_GetProgressByDay_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetProgressByDay'
+type ProgressRepository_GetProgressByDay_Call struct {
+	*mock.Call
+}
+
+// GetProgressByDay is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID int64
+//   - from time.Time
+//   - to time.Time
+func (_e *ProgressRepository_Expecter) GetProgressByDay(ctx interface{}, userID interface{}, from interface{}, to interface{}) *ProgressRepository_GetProgressByDay_Call {
+	return &ProgressRepository_GetProgressByDay_Call{Call: _e.mock.On("GetProgressByDay", ctx, userID, from, to)}
+}
+
+func (_c *ProgressRepository_GetProgressByDay_Call) Run(run func(ctx context.Context, userID int64, from time.Time, to time.Time)) *ProgressRepository_GetProgressByDay_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(time.Time), args[3].(time.Time))
+	})
+	return _c
+}
+
+func (_c *ProgressRepository_GetProgressByDay_Call) Return(_a0 []dto.DayProgress, _a1 error) *ProgressRepository_GetProgressByDay_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ProgressRepository_GetProgressByDay_Call) RunAndReturn(run func(context.Context, int64, time.Time, time.Time) ([]dto.DayProgress, error)) *ProgressRepository_GetProgressByDay_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetProgressByID provides a mock function with given fields: ctx, id
+func (_m *ProgressRepository) GetProgressByID(ctx context.Context, id int64) (domain.Progress, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetProgressByID")
+	}
+
+	var r0 domain.Progress
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) (domain.Progress, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) domain.Progress); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(domain.Progress)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ProgressRepository_GetProgressByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetProgressByID'
+type ProgressRepository_GetProgressByID_Call struct {
+	*mock.Call
+}
+
+// GetProgressByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+func (_e *ProgressRepository_Expecter) GetProgressByID(ctx interface{}, id interface{}) *ProgressRepository_GetProgressByID_Call {
+	return &ProgressRepository_GetProgressByID_Call{Call: _e.mock.On("GetProgressByID", ctx, id)}
+}
+
+func (_c *ProgressRepository_GetProgressByID_Call) Run(run func(ctx context.Context, id int64)) *ProgressRepository_GetProgressByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *ProgressRepository_GetProgressByID_Call) Return(_a0 domain.Progress, _a1 error) *ProgressRepository_GetProgressByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ProgressRepository_GetProgressByID_Call) RunAndReturn(run func(context.Context, int64) (domain.Progress, error)) *ProgressRepository_GetProgressByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetProgressByReadingID provides a mock function with given fields: ctx, readingID
+func (_m *ProgressRepository) GetProgressByReadingID(ctx context.Context, readingID int64) ([]domain.Progress, error) {
+	ret := _m.Called(ctx, readingID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetProgressByReadingID")
+	}
+
+	var r0 []domain.Progress
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) ([]domain.Progress, error)); ok {
+		return rf(ctx, readingID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) []domain.Progress); ok {
+		r0 = rf(ctx, readingID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Progress)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, readingID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ProgressRepository_GetProgressByReadingID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetProgressByReadingID'
+type ProgressRepository_GetProgressByReadingID_Call struct {
+	*mock.Call
+}
+
+// GetProgressByReadingID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - readingID int64
+func (_e *ProgressRepository_Expecter) GetProgressByReadingID(ctx interface{}, readingID interface{}) *ProgressRepository_GetProgressByReadingID_Call {
+	return &ProgressRepository_GetProgressByReadingID_Call{Call: _e.mock.On("GetProgressByReadingID", ctx, readingID)}
+}
+
+func (_c *ProgressRepository_GetProgressByReadingID_Call) Run(run func(ctx context.Context, readingID int64)) *ProgressRepository_GetProgressByReadingID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *ProgressRepository_GetProgressByReadingID_Call) Return(_a0 []domain.Progress, _a1 error) *ProgressRepository_GetProgressByReadingID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ProgressRepository_GetProgressByReadingID_Call) RunAndReturn(run func(context.Context, int64) ([]domain.Progress, error)) *ProgressRepository_GetProgressByReadingID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetProgressByReadingIDs provides a mock function with given fields: ctx, readingIDs
+func (_m *ProgressRepository) GetProgressByReadingIDs(ctx context.Context, readingIDs []int64) (map[int64][]domain.Progress, error) {
+	ret := _m.Called(ctx, readingIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetProgressByReadingIDs")
+	}
+
+	var r0 map[int64][]domain.Progress
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []int64) (map[int64][]domain.Progress, error)); ok {
+		return rf(ctx, readingIDs)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []int64) map[int64][]domain.Progress); ok {
+		r0 = rf(ctx, readingIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[int64][]domain.Progress)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []int64) error); ok {
+		r1 = rf(ctx, readingIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ProgressRepository_GetProgressByReadingIDs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetProgressByReadingIDs'
+type ProgressRepository_GetProgressByReadingIDs_Call struct {
+	*mock.Call
+}
+
+// GetProgressByReadingIDs is a helper method to define mock.On call
+//   - ctx context.Context
+//   - readingIDs []int64
+func (_e *ProgressRepository_Expecter) GetProgressByReadingIDs(ctx interface{}, readingIDs interface{}) *ProgressRepository_GetProgressByReadingIDs_Call {
+	return &ProgressRepository_GetProgressByReadingIDs_Call{Call: _e.mock.On("GetProgressByReadingIDs", ctx, readingIDs)}
+}
+
+func (_c *ProgressRepository_GetProgressByReadingIDs_Call) Run(run func(ctx context.Context, readingIDs []int64)) *ProgressRepository_GetProgressByReadingIDs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]int64))
+	})
+	return _c
+}
+
+func (_c *ProgressRepository_GetProgressByReadingIDs_Call) Return(_a0 map[int64][]domain.Progress, _a1 error) *ProgressRepository_GetProgressByReadingIDs_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ProgressRepository_GetProgressByReadingIDs_Call) RunAndReturn(run func(context.Context, []int64) (map[int64][]domain.Progress, error)) *ProgressRepository_GetProgressByReadingIDs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetProgressByUserID provides a mock function with given fields: ctx, userID, offset, limit
+func (_m *ProgressRepository) GetProgressByUserID(ctx context.Context, userID int64, offset int64, limit int64) ([]domain.Progress, error) {
+	ret := _m.Called(ctx, userID, offset, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetProgressByUserID")
+	}
+
+	var r0 []domain.Progress
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64, int64) ([]domain.Progress, error)); ok {
+		return rf(ctx, userID, offset, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64, int64) []domain.Progress); ok {
+		r0 = rf(ctx, userID, offset, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Progress)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, int64, int64) error); ok {
+		r1 = rf(ctx, userID, offset, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ProgressRepository_GetProgressByUserID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetProgressByUserID'
+type ProgressRepository_GetProgressByUserID_Call struct {
+	*mock.Call
+}
+
+// GetProgressByUserID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID int64
+//   - offset int64
+//   - limit int64
+func (_e *ProgressRepository_Expecter) GetProgressByUserID(ctx interface{}, userID interface{}, offset interface{}, limit interface{}) *ProgressRepository_GetProgressByUserID_Call {
+	return &ProgressRepository_GetProgressByUserID_Call{Call: _e.mock.On("GetProgressByUserID", ctx, userID, offset, limit)}
+}
+
+func (_c *ProgressRepository_GetProgressByUserID_Call) Run(run func(ctx context.Context, userID int64, offset int64, limit int64)) *ProgressRepository_GetProgressByUserID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(int64), args[3].(int64))
+	})
+	return _c
+}
+
+func (_c *ProgressRepository_GetProgressByUserID_Call) Return(_a0 []domain.Progress, _a1 error) *ProgressRepository_GetProgressByUserID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ProgressRepository_GetProgressByUserID_Call) RunAndReturn(run func(context.Context, int64, int64, int64) ([]domain.Progress, error)) *ProgressRepository_GetProgressByUserID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetReadingDays provides a mock function with given fields: ctx, userID
+func (_m *ProgressRepository) GetReadingDays(ctx context.Context, userID int64) ([]time.Time, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetReadingDays")
+	}
+
+	var r0 []time.Time
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) ([]time.Time, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) []time.Time); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]time.Time)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ProgressRepository_GetReadingDays_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetReadingDays'
+type ProgressRepository_GetReadingDays_Call struct {
+	*mock.Call
+}
+
+// GetReadingDays is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID int64
+func (_e *ProgressRepository_Expecter) GetReadingDays(ctx interface{}, userID interface{}) *ProgressRepository_GetReadingDays_Call {
+	return &ProgressRepository_GetReadingDays_Call{Call: _e.mock.On("GetReadingDays", ctx, userID)}
+}
+
+func (_c *ProgressRepository_GetReadingDays_Call) Run(run func(ctx context.Context, userID int64)) *ProgressRepository_GetReadingDays_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *ProgressRepository_GetReadingDays_Call) Return(_a0 []time.Time, _a1 error) *ProgressRepository_GetReadingDays_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ProgressRepository_GetReadingDays_Call) RunAndReturn(run func(context.Context, int64) ([]time.Time, error)) *ProgressRepository_GetReadingDays_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetTotalProgressByReadingID provides a mock function with given fields: ctx, readingID
 func (_m *ProgressRepository) GetTotalProgressByReadingID(ctx context.Context, readingID int64) (int64, error) {
 	ret := _m.Called(ctx, readingID)
@@ -136,29 +723,27 @@ func (_c *ProgressRepository_GetTotalProgressByReadingID_Call) RunAndReturn(run
 	return _c
 }
 
-// GetUserReadingIDsByPeriod provides a mock function with given fields: ctx, userID, period
-func (_m *ProgressRepository) GetUserReadingIDsByPeriod(ctx context.Context, userID int64, period string) ([]int64, error) {
-	ret := _m.Called(ctx, userID, period)
+// UpdateProgress provides a mock function with given fields: ctx, progress
+func (_m *ProgressRepository) UpdateProgress(ctx context.Context, progress domain.Progress) (domain.Progress, error) {
+	ret := _m.Called(ctx, progress)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetUserReadingIDsByPeriod")
+		panic("no return value specified for UpdateProgress")
 	}
 
-	var r0 []int64
+	var r0 domain.Progress
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, int64, string) ([]int64, error)); ok {
-		return rf(ctx, userID, period)
+	if rf, ok := ret.Get(0).(func(context.Context, domain.Progress) (domain.Progress, error)); ok {
+		return rf(ctx, progress)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, int64, string) []int64); ok {
-		r0 = rf(ctx, userID, period)
+	if rf, ok := ret.Get(0).(func(context.Context, domain.Progress) domain.Progress); ok {
+		r0 = rf(ctx, progress)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]int64)
-		}
+		r0 = ret.Get(0).(domain.Progress)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, int64, string) error); ok {
-		r1 = rf(ctx, userID, period)
+	if rf, ok := ret.Get(1).(func(context.Context, domain.Progress) error); ok {
+		r1 = rf(ctx, progress)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -166,32 +751,31 @@ func (_m *ProgressRepository) GetUserReadingIDsByPeriod(ctx context.Context, use
 	return r0, r1
 }
 
-// ProgressRepository_GetUserReadingIDsByPeriod_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserReadingIDsByPeriod'
-type ProgressRepository_GetUserReadingIDsByPeriod_Call struct {
+// ProgressRepository_UpdateProgress_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateProgress'
+type ProgressRepository_UpdateProgress_Call struct {
 	*mock.Call
 }
 
-// GetUserReadingIDsByPeriod is a helper method to define mock.On call
+// UpdateProgress is a helper method to define mock.On call
 //   - ctx context.Context
-//   - userID int64
-//   - period string
-func (_e *ProgressRepository_Expecter) GetUserReadingIDsByPeriod(ctx interface{}, userID interface{}, period interface{}) *ProgressRepository_GetUserReadingIDsByPeriod_Call {
-	return &ProgressRepository_GetUserReadingIDsByPeriod_Call{Call: _e.mock.On("GetUserReadingIDsByPeriod", ctx, userID, period)}
+//   - progress domain.Progress
+func (_e *ProgressRepository_Expecter) UpdateProgress(ctx interface{}, progress interface{}) *ProgressRepository_UpdateProgress_Call {
+	return &ProgressRepository_UpdateProgress_Call{Call: _e.mock.On("UpdateProgress", ctx, progress)}
 }
 
-func (_c *ProgressRepository_GetUserReadingIDsByPeriod_Call) Run(run func(ctx context.Context, userID int64, period string)) *ProgressRepository_GetUserReadingIDsByPeriod_Call {
+func (_c *ProgressRepository_UpdateProgress_Call) Run(run func(ctx context.Context, progress domain.Progress)) *ProgressRepository_UpdateProgress_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(int64), args[2].(string))
+		run(args[0].(context.Context), args[1].(domain.Progress))
 	})
 	return _c
 }
 
-func (_c *ProgressRepository_GetUserReadingIDsByPeriod_Call) Return(_a0 []int64, _a1 error) *ProgressRepository_GetUserReadingIDsByPeriod_Call {
+func (_c *ProgressRepository_UpdateProgress_Call) Return(_a0 domain.Progress, _a1 error) *ProgressRepository_UpdateProgress_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *ProgressRepository_GetUserReadingIDsByPeriod_Call) RunAndReturn(run func(context.Context, int64, string) ([]int64, error)) *ProgressRepository_GetUserReadingIDsByPeriod_Call {
+func (_c *ProgressRepository_UpdateProgress_Call) RunAndReturn(run func(context.Context, domain.Progress) (domain.Progress, error)) *ProgressRepository_UpdateProgress_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -24,9 +24,9 @@ func (_m *ProgressService) EXPECT() *ProgressService_Expecter {
 	return &ProgressService_Expecter{mock: &_m.Mock}
 }
 
-// CreateProgress provides a mock function with given fields: ctx, readingID, progressReq
-func (_m *ProgressService) CreateProgress(ctx context.Context, readingID int64, progressReq dto.ProgressRequest) (domain.Progress, error) {
-	ret := _m.Called(ctx, readingID, progressReq)
+// CreateProgress provides a mock function with given fields: ctx, userID, readingID, progressReq
+func (_m *ProgressService) CreateProgress(ctx context.Context, userID int64, readingID int64, progressReq dto.ProgressRequest) (domain.Progress, error) {
+	ret := _m.Called(ctx, userID, readingID, progressReq)
 
 	if len(ret) == 0 {
 		panic("no return value specified for CreateProgress")
@@ -34,17 +34,17 @@ func (_m *ProgressService) CreateProgress(ctx context.Context, readingID int64,
 
 	var r0 domain.Progress
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, int64, dto.ProgressRequest) (domain.Progress, error)); ok {
-		return rf(ctx, readingID, progressReq)
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64, dto.ProgressRequest) (domain.Progress, error)); ok {
+		return rf(ctx, userID, readingID, progressReq)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, int64, dto.ProgressRequest) domain.Progress); ok {
-		r0 = rf(ctx, readingID, progressReq)
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64, dto.ProgressRequest) domain.Progress); ok {
+		r0 = rf(ctx, userID, readingID, progressReq)
 	} else {
 		r0 = ret.Get(0).(domain.Progress)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, int64, dto.ProgressRequest) error); ok {
-		r1 = rf(ctx, readingID, progressReq)
+	if rf, ok := ret.Get(1).(func(context.Context, int64, int64, dto.ProgressRequest) error); ok {
+		r1 = rf(ctx, userID, readingID, progressReq)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -59,15 +59,16 @@ type ProgressService_CreateProgress_Call struct {
 
 // CreateProgress is a helper method to define mock.On call
 //   - ctx context.Context
+//   - userID int64
 //   - readingID int64
 //   - progressReq dto.ProgressRequest
-func (_e *ProgressService_Expecter) CreateProgress(ctx interface{}, readingID interface{}, progressReq interface{}) *ProgressService_CreateProgress_Call {
-	return &ProgressService_CreateProgress_Call{Call: _e.mock.On("CreateProgress", ctx, readingID, progressReq)}
+func (_e *ProgressService_Expecter) CreateProgress(ctx interface{}, userID interface{}, readingID interface{}, progressReq interface{}) *ProgressService_CreateProgress_Call {
+	return &ProgressService_CreateProgress_Call{Call: _e.mock.On("CreateProgress", ctx, userID, readingID, progressReq)}
 }
 
-func (_c *ProgressService_CreateProgress_Call) Run(run func(ctx context.Context, readingID int64, progressReq dto.ProgressRequest)) *ProgressService_CreateProgress_Call {
+func (_c *ProgressService_CreateProgress_Call) Run(run func(ctx context.Context, userID int64, readingID int64, progressReq dto.ProgressRequest)) *ProgressService_CreateProgress_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(int64), args[2].(dto.ProgressRequest))
+		run(args[0].(context.Context), args[1].(int64), args[2].(int64), args[3].(dto.ProgressRequest))
 	})
 	return _c
 }
@@ -77,7 +78,174 @@ func (_c *ProgressService_CreateProgress_Call) Return(_a0 domain.Progress, _a1 e
 	return _c
 }
 
-func (_c *ProgressService_CreateProgress_Call) RunAndReturn(run func(context.Context, int64, dto.ProgressRequest) (domain.Progress, error)) *ProgressService_CreateProgress_Call {
+func (_c *ProgressService_CreateProgress_Call) RunAndReturn(run func(context.Context, int64, int64, dto.ProgressRequest) (domain.Progress, error)) *ProgressService_CreateProgress_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteProgress provides a mock function with given fields: ctx, userID, progressID
+func (_m *ProgressService) DeleteProgress(ctx context.Context, userID int64, progressID int64) error {
+	ret := _m.Called(ctx, userID, progressID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteProgress")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64) error); ok {
+		r0 = rf(ctx, userID, progressID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ProgressService_DeleteProgress_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteProgress'
+type ProgressService_DeleteProgress_Call struct {
+	*mock.Call
+}
+
+// DeleteProgress is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID int64
+//   - progressID int64
+func (_e *ProgressService_Expecter) DeleteProgress(ctx interface{}, userID interface{}, progressID interface{}) *ProgressService_DeleteProgress_Call {
+	return &ProgressService_DeleteProgress_Call{Call: _e.mock.On("DeleteProgress", ctx, userID, progressID)}
+}
+
+func (_c *ProgressService_DeleteProgress_Call) Run(run func(ctx context.Context, userID int64, progressID int64)) *ProgressService_DeleteProgress_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(int64))
+	})
+	return _c
+}
+
+func (_c *ProgressService_DeleteProgress_Call) Return(_a0 error) *ProgressService_DeleteProgress_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ProgressService_DeleteProgress_Call) RunAndReturn(run func(context.Context, int64, int64) error) *ProgressService_DeleteProgress_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetReadingProgress provides a mock function with given fields: ctx, userID, readingID
+func (_m *ProgressService) GetReadingProgress(ctx context.Context, userID int64, readingID int64) ([]domain.Progress, error) {
+	ret := _m.Called(ctx, userID, readingID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetReadingProgress")
+	}
+
+	var r0 []domain.Progress
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64) ([]domain.Progress, error)); ok {
+		return rf(ctx, userID, readingID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64) []domain.Progress); ok {
+		r0 = rf(ctx, userID, readingID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Progress)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, int64) error); ok {
+		r1 = rf(ctx, userID, readingID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ProgressService_GetReadingProgress_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetReadingProgress'
+type ProgressService_GetReadingProgress_Call struct {
+	*mock.Call
+}
+
+// GetReadingProgress is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID int64
+//   - readingID int64
+func (_e *ProgressService_Expecter) GetReadingProgress(ctx interface{}, userID interface{}, readingID interface{}) *ProgressService_GetReadingProgress_Call {
+	return &ProgressService_GetReadingProgress_Call{Call: _e.mock.On("GetReadingProgress", ctx, userID, readingID)}
+}
+
+func (_c *ProgressService_GetReadingProgress_Call) Run(run func(ctx context.Context, userID int64, readingID int64)) *ProgressService_GetReadingProgress_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(int64))
+	})
+	return _c
+}
+
+func (_c *ProgressService_GetReadingProgress_Call) Return(_a0 []domain.Progress, _a1 error) *ProgressService_GetReadingProgress_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ProgressService_GetReadingProgress_Call) RunAndReturn(run func(context.Context, int64, int64) ([]domain.Progress, error)) *ProgressService_GetReadingProgress_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateProgress provides a mock function with given fields: ctx, userID, progressID, progressReq
+func (_m *ProgressService) UpdateProgress(ctx context.Context, userID int64, progressID int64, progressReq dto.ProgressRequest) (domain.Progress, error) {
+	ret := _m.Called(ctx, userID, progressID, progressReq)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateProgress")
+	}
+
+	var r0 domain.Progress
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64, dto.ProgressRequest) (domain.Progress, error)); ok {
+		return rf(ctx, userID, progressID, progressReq)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64, dto.ProgressRequest) domain.Progress); ok {
+		r0 = rf(ctx, userID, progressID, progressReq)
+	} else {
+		r0 = ret.Get(0).(domain.Progress)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, int64, dto.ProgressRequest) error); ok {
+		r1 = rf(ctx, userID, progressID, progressReq)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ProgressService_UpdateProgress_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateProgress'
+type ProgressService_UpdateProgress_Call struct {
+	*mock.Call
+}
+
+// UpdateProgress is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID int64
+//   - progressID int64
+//   - progressReq dto.ProgressRequest
+func (_e *ProgressService_Expecter) UpdateProgress(ctx interface{}, userID interface{}, progressID interface{}, progressReq interface{}) *ProgressService_UpdateProgress_Call {
+	return &ProgressService_UpdateProgress_Call{Call: _e.mock.On("UpdateProgress", ctx, userID, progressID, progressReq)}
+}
+
+func (_c *ProgressService_UpdateProgress_Call) Run(run func(ctx context.Context, userID int64, progressID int64, progressReq dto.ProgressRequest)) *ProgressService_UpdateProgress_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(int64), args[3].(dto.ProgressRequest))
+	})
+	return _c
+}
+
+func (_c *ProgressService_UpdateProgress_Call) Return(_a0 domain.Progress, _a1 error) *ProgressService_UpdateProgress_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ProgressService_UpdateProgress_Call) RunAndReturn(run func(context.Context, int64, int64, dto.ProgressRequest) (domain.Progress, error)) *ProgressService_UpdateProgress_Call {
 	_c.Call.Return(run)
 	return _c
 }
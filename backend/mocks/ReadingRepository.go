@@ -6,6 +6,8 @@ import (
 	context "context"
 
 	domain "github.com/rimvydascivilis/book-tracker/backend/domain"
+	dto "github.com/rimvydascivilis/book-tracker/backend/dto"
+
 	mock "github.com/stretchr/testify/mock"
 )
 
@@ -22,9 +24,9 @@ func (_m *ReadingRepository) EXPECT() *ReadingRepository_Expecter {
 	return &ReadingRepository_Expecter{mock: &_m.Mock}
 }
 
-// CountReadingsByUserID provides a mock function with given fields: ctx, userID
-func (_m *ReadingRepository) CountReadingsByUserID(ctx context.Context, userID int64) (int64, error) {
-	ret := _m.Called(ctx, userID)
+// CountReadingsByUserID provides a mock function with given fields: ctx, userID, status
+func (_m *ReadingRepository) CountReadingsByUserID(ctx context.Context, userID int64, status string) (int64, error) {
+	ret := _m.Called(ctx, userID, status)
 
 	if len(ret) == 0 {
 		panic("no return value specified for CountReadingsByUserID")
@@ -32,17 +34,17 @@ func (_m *ReadingRepository) CountReadingsByUserID(ctx context.Context, userID i
 
 	var r0 int64
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, int64) (int64, error)); ok {
-		return rf(ctx, userID)
+	if rf, ok := ret.Get(0).(func(context.Context, int64, string) (int64, error)); ok {
+		return rf(ctx, userID, status)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, int64) int64); ok {
-		r0 = rf(ctx, userID)
+	if rf, ok := ret.Get(0).(func(context.Context, int64, string) int64); ok {
+		r0 = rf(ctx, userID, status)
 	} else {
 		r0 = ret.Get(0).(int64)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
-		r1 = rf(ctx, userID)
+	if rf, ok := ret.Get(1).(func(context.Context, int64, string) error); ok {
+		r1 = rf(ctx, userID, status)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -58,13 +60,14 @@ type ReadingRepository_CountReadingsByUserID_Call struct {
 // CountReadingsByUserID is a helper method to define mock.On call
 //   - ctx context.Context
 //   - userID int64
-func (_e *ReadingRepository_Expecter) CountReadingsByUserID(ctx interface{}, userID interface{}) *ReadingRepository_CountReadingsByUserID_Call {
-	return &ReadingRepository_CountReadingsByUserID_Call{Call: _e.mock.On("CountReadingsByUserID", ctx, userID)}
+//   - status string
+func (_e *ReadingRepository_Expecter) CountReadingsByUserID(ctx interface{}, userID interface{}, status interface{}) *ReadingRepository_CountReadingsByUserID_Call {
+	return &ReadingRepository_CountReadingsByUserID_Call{Call: _e.mock.On("CountReadingsByUserID", ctx, userID, status)}
 }
 
-func (_c *ReadingRepository_CountReadingsByUserID_Call) Run(run func(ctx context.Context, userID int64)) *ReadingRepository_CountReadingsByUserID_Call {
+func (_c *ReadingRepository_CountReadingsByUserID_Call) Run(run func(ctx context.Context, userID int64, status string)) *ReadingRepository_CountReadingsByUserID_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(int64))
+		run(args[0].(context.Context), args[1].(int64), args[2].(string))
 	})
 	return _c
 }
@@ -74,32 +77,138 @@ func (_c *ReadingRepository_CountReadingsByUserID_Call) Return(_a0 int64, _a1 er
 	return _c
 }
 
-func (_c *ReadingRepository_CountReadingsByUserID_Call) RunAndReturn(run func(context.Context, int64) (int64, error)) *ReadingRepository_CountReadingsByUserID_Call {
+func (_c *ReadingRepository_CountReadingsByUserID_Call) RunAndReturn(run func(context.Context, int64, string) (int64, error)) *ReadingRepository_CountReadingsByUserID_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// CountReadingsByUserIDAndBookID provides a mock function with given fields: ctx, userID, bookID
-func (_m *ReadingRepository) CountReadingsByUserIDAndBookID(ctx context.Context, userID int64, bookID int64) (int64, error) {
-	ret := _m.Called(ctx, userID, bookID)
+// CreateReading provides a mock function with given fields: ctx, reading
+func (_m *ReadingRepository) CreateReading(ctx context.Context, reading domain.Reading) (domain.Reading, error) {
+	ret := _m.Called(ctx, reading)
 
 	if len(ret) == 0 {
-		panic("no return value specified for CountReadingsByUserIDAndBookID")
+		panic("no return value specified for CreateReading")
 	}
 
-	var r0 int64
+	var r0 domain.Reading
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, int64, int64) (int64, error)); ok {
-		return rf(ctx, userID, bookID)
+	if rf, ok := ret.Get(0).(func(context.Context, domain.Reading) (domain.Reading, error)); ok {
+		return rf(ctx, reading)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, int64, int64) int64); ok {
-		r0 = rf(ctx, userID, bookID)
+	if rf, ok := ret.Get(0).(func(context.Context, domain.Reading) domain.Reading); ok {
+		r0 = rf(ctx, reading)
 	} else {
-		r0 = ret.Get(0).(int64)
+		r0 = ret.Get(0).(domain.Reading)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, int64, int64) error); ok {
-		r1 = rf(ctx, userID, bookID)
+	if rf, ok := ret.Get(1).(func(context.Context, domain.Reading) error); ok {
+		r1 = rf(ctx, reading)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ReadingRepository_CreateReading_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateReading'
+type ReadingRepository_CreateReading_Call struct {
+	*mock.Call
+}
+
+// CreateReading is a helper method to define mock.On call
+//   - ctx context.Context
+//   - reading domain.Reading
+func (_e *ReadingRepository_Expecter) CreateReading(ctx interface{}, reading interface{}) *ReadingRepository_CreateReading_Call {
+	return &ReadingRepository_CreateReading_Call{Call: _e.mock.On("CreateReading", ctx, reading)}
+}
+
+func (_c *ReadingRepository_CreateReading_Call) Run(run func(ctx context.Context, reading domain.Reading)) *ReadingRepository_CreateReading_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.Reading))
+	})
+	return _c
+}
+
+func (_c *ReadingRepository_CreateReading_Call) Return(_a0 domain.Reading, _a1 error) *ReadingRepository_CreateReading_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ReadingRepository_CreateReading_Call) RunAndReturn(run func(context.Context, domain.Reading) (domain.Reading, error)) *ReadingRepository_CreateReading_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteReading provides a mock function with given fields: ctx, id
+func (_m *ReadingRepository) DeleteReading(ctx context.Context, id int64) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteReading")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ReadingRepository_DeleteReading_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteReading'
+type ReadingRepository_DeleteReading_Call struct {
+	*mock.Call
+}
+
+// DeleteReading is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+func (_e *ReadingRepository_Expecter) DeleteReading(ctx interface{}, id interface{}) *ReadingRepository_DeleteReading_Call {
+	return &ReadingRepository_DeleteReading_Call{Call: _e.mock.On("DeleteReading", ctx, id)}
+}
+
+func (_c *ReadingRepository_DeleteReading_Call) Run(run func(ctx context.Context, id int64)) *ReadingRepository_DeleteReading_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *ReadingRepository_DeleteReading_Call) Return(_a0 error) *ReadingRepository_DeleteReading_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ReadingRepository_DeleteReading_Call) RunAndReturn(run func(context.Context, int64) error) *ReadingRepository_DeleteReading_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDailyFinishedReadings provides a mock function with given fields: ctx, userID, year, month
+func (_m *ReadingRepository) GetDailyFinishedReadings(ctx context.Context, userID int64, year int64, month int64) ([]dto.Progress, error) {
+	ret := _m.Called(ctx, userID, year, month)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDailyFinishedReadings")
+	}
+
+	var r0 []dto.Progress
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64, int64) ([]dto.Progress, error)); ok {
+		return rf(ctx, userID, year, month)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64, int64) []dto.Progress); ok {
+		r0 = rf(ctx, userID, year, month)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]dto.Progress)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, int64, int64) error); ok {
+		r1 = rf(ctx, userID, year, month)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -107,57 +216,60 @@ func (_m *ReadingRepository) CountReadingsByUserIDAndBookID(ctx context.Context,
 	return r0, r1
 }
 
-// ReadingRepository_CountReadingsByUserIDAndBookID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountReadingsByUserIDAndBookID'
-type ReadingRepository_CountReadingsByUserIDAndBookID_Call struct {
+// ReadingRepository_GetDailyFinishedReadings_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDailyFinishedReadings'
+type ReadingRepository_GetDailyFinishedReadings_Call struct {
 	*mock.Call
 }
 
-// CountReadingsByUserIDAndBookID is a helper method to define mock.On call
+// GetDailyFinishedReadings is a helper method to define mock.On call
 //   - ctx context.Context
 //   - userID int64
-//   - bookID int64
-func (_e *ReadingRepository_Expecter) CountReadingsByUserIDAndBookID(ctx interface{}, userID interface{}, bookID interface{}) *ReadingRepository_CountReadingsByUserIDAndBookID_Call {
-	return &ReadingRepository_CountReadingsByUserIDAndBookID_Call{Call: _e.mock.On("CountReadingsByUserIDAndBookID", ctx, userID, bookID)}
+//   - year int64
+//   - month int64
+func (_e *ReadingRepository_Expecter) GetDailyFinishedReadings(ctx interface{}, userID interface{}, year interface{}, month interface{}) *ReadingRepository_GetDailyFinishedReadings_Call {
+	return &ReadingRepository_GetDailyFinishedReadings_Call{Call: _e.mock.On("GetDailyFinishedReadings", ctx, userID, year, month)}
 }
 
-func (_c *ReadingRepository_CountReadingsByUserIDAndBookID_Call) Run(run func(ctx context.Context, userID int64, bookID int64)) *ReadingRepository_CountReadingsByUserIDAndBookID_Call {
+func (_c *ReadingRepository_GetDailyFinishedReadings_Call) Run(run func(ctx context.Context, userID int64, year int64, month int64)) *ReadingRepository_GetDailyFinishedReadings_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(int64), args[2].(int64))
+		run(args[0].(context.Context), args[1].(int64), args[2].(int64), args[3].(int64))
 	})
 	return _c
 }
 
-func (_c *ReadingRepository_CountReadingsByUserIDAndBookID_Call) Return(_a0 int64, _a1 error) *ReadingRepository_CountReadingsByUserIDAndBookID_Call {
+func (_c *ReadingRepository_GetDailyFinishedReadings_Call) Return(_a0 []dto.Progress, _a1 error) *ReadingRepository_GetDailyFinishedReadings_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *ReadingRepository_CountReadingsByUserIDAndBookID_Call) RunAndReturn(run func(context.Context, int64, int64) (int64, error)) *ReadingRepository_CountReadingsByUserIDAndBookID_Call {
+func (_c *ReadingRepository_GetDailyFinishedReadings_Call) RunAndReturn(run func(context.Context, int64, int64, int64) ([]dto.Progress, error)) *ReadingRepository_GetDailyFinishedReadings_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// CreateReading provides a mock function with given fields: ctx, reading
-func (_m *ReadingRepository) CreateReading(ctx context.Context, reading domain.Reading) (domain.Reading, error) {
-	ret := _m.Called(ctx, reading)
+// GetMonthlyFinishedReadings provides a mock function with given fields: ctx, userID, year
+func (_m *ReadingRepository) GetMonthlyFinishedReadings(ctx context.Context, userID int64, year int64) ([]dto.Progress, error) {
+	ret := _m.Called(ctx, userID, year)
 
 	if len(ret) == 0 {
-		panic("no return value specified for CreateReading")
+		panic("no return value specified for GetMonthlyFinishedReadings")
 	}
 
-	var r0 domain.Reading
+	var r0 []dto.Progress
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, domain.Reading) (domain.Reading, error)); ok {
-		return rf(ctx, reading)
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64) ([]dto.Progress, error)); ok {
+		return rf(ctx, userID, year)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, domain.Reading) domain.Reading); ok {
-		r0 = rf(ctx, reading)
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64) []dto.Progress); ok {
+		r0 = rf(ctx, userID, year)
 	} else {
-		r0 = ret.Get(0).(domain.Reading)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]dto.Progress)
+		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, domain.Reading) error); ok {
-		r1 = rf(ctx, reading)
+	if rf, ok := ret.Get(1).(func(context.Context, int64, int64) error); ok {
+		r1 = rf(ctx, userID, year)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -165,31 +277,32 @@ func (_m *ReadingRepository) CreateReading(ctx context.Context, reading domain.R
 	return r0, r1
 }
 
-// ReadingRepository_CreateReading_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateReading'
-type ReadingRepository_CreateReading_Call struct {
+// ReadingRepository_GetMonthlyFinishedReadings_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetMonthlyFinishedReadings'
+type ReadingRepository_GetMonthlyFinishedReadings_Call struct {
 	*mock.Call
 }
 
-// CreateReading is a helper method to define mock.On call
+// GetMonthlyFinishedReadings is a helper method to define mock.On call
 //   - ctx context.Context
-//   - reading domain.Reading
-func (_e *ReadingRepository_Expecter) CreateReading(ctx interface{}, reading interface{}) *ReadingRepository_CreateReading_Call {
-	return &ReadingRepository_CreateReading_Call{Call: _e.mock.On("CreateReading", ctx, reading)}
+//   - userID int64
+//   - year int64
+func (_e *ReadingRepository_Expecter) GetMonthlyFinishedReadings(ctx interface{}, userID interface{}, year interface{}) *ReadingRepository_GetMonthlyFinishedReadings_Call {
+	return &ReadingRepository_GetMonthlyFinishedReadings_Call{Call: _e.mock.On("GetMonthlyFinishedReadings", ctx, userID, year)}
 }
 
-func (_c *ReadingRepository_CreateReading_Call) Run(run func(ctx context.Context, reading domain.Reading)) *ReadingRepository_CreateReading_Call {
+func (_c *ReadingRepository_GetMonthlyFinishedReadings_Call) Run(run func(ctx context.Context, userID int64, year int64)) *ReadingRepository_GetMonthlyFinishedReadings_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(domain.Reading))
+		run(args[0].(context.Context), args[1].(int64), args[2].(int64))
 	})
 	return _c
 }
 
-func (_c *ReadingRepository_CreateReading_Call) Return(_a0 domain.Reading, _a1 error) *ReadingRepository_CreateReading_Call {
+func (_c *ReadingRepository_GetMonthlyFinishedReadings_Call) Return(_a0 []dto.Progress, _a1 error) *ReadingRepository_GetMonthlyFinishedReadings_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *ReadingRepository_CreateReading_Call) RunAndReturn(run func(context.Context, domain.Reading) (domain.Reading, error)) *ReadingRepository_CreateReading_Call {
+func (_c *ReadingRepository_GetMonthlyFinishedReadings_Call) RunAndReturn(run func(context.Context, int64, int64) ([]dto.Progress, error)) *ReadingRepository_GetMonthlyFinishedReadings_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -251,9 +364,128 @@ func (_c *ReadingRepository_GetReadingByID_Call) RunAndReturn(run func(context.C
 	return _c
 }
 
-// GetReadingsByUserID provides a mock function with given fields: ctx, userID, offset, limit
-func (_m *ReadingRepository) GetReadingsByUserID(ctx context.Context, userID int64, offset int64, limit int64) ([]domain.Reading, error) {
-	ret := _m.Called(ctx, userID, offset, limit)
+// GetReadingByIDForUpdate provides a mock function with given fields: ctx, id
+func (_m *ReadingRepository) GetReadingByIDForUpdate(ctx context.Context, id int64) (domain.Reading, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetReadingByIDForUpdate")
+	}
+
+	var r0 domain.Reading
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) (domain.Reading, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) domain.Reading); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(domain.Reading)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ReadingRepository_GetReadingByIDForUpdate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetReadingByIDForUpdate'
+type ReadingRepository_GetReadingByIDForUpdate_Call struct {
+	*mock.Call
+}
+
+// GetReadingByIDForUpdate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+func (_e *ReadingRepository_Expecter) GetReadingByIDForUpdate(ctx interface{}, id interface{}) *ReadingRepository_GetReadingByIDForUpdate_Call {
+	return &ReadingRepository_GetReadingByIDForUpdate_Call{Call: _e.mock.On("GetReadingByIDForUpdate", ctx, id)}
+}
+
+func (_c *ReadingRepository_GetReadingByIDForUpdate_Call) Run(run func(ctx context.Context, id int64)) *ReadingRepository_GetReadingByIDForUpdate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *ReadingRepository_GetReadingByIDForUpdate_Call) Return(_a0 domain.Reading, _a1 error) *ReadingRepository_GetReadingByIDForUpdate_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ReadingRepository_GetReadingByIDForUpdate_Call) RunAndReturn(run func(context.Context, int64) (domain.Reading, error)) *ReadingRepository_GetReadingByIDForUpdate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetReadingSummariesByUserID provides a mock function with given fields: ctx, userID, status, offset, limit
+func (_m *ReadingRepository) GetReadingSummariesByUserID(ctx context.Context, userID int64, status string, offset int64, limit int64) ([]domain.ReadingSummary, error) {
+	ret := _m.Called(ctx, userID, status, offset, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetReadingSummariesByUserID")
+	}
+
+	var r0 []domain.ReadingSummary
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, string, int64, int64) ([]domain.ReadingSummary, error)); ok {
+		return rf(ctx, userID, status, offset, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, string, int64, int64) []domain.ReadingSummary); ok {
+		r0 = rf(ctx, userID, status, offset, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.ReadingSummary)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, string, int64, int64) error); ok {
+		r1 = rf(ctx, userID, status, offset, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ReadingRepository_GetReadingSummariesByUserID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetReadingSummariesByUserID'
+type ReadingRepository_GetReadingSummariesByUserID_Call struct {
+	*mock.Call
+}
+
+// GetReadingSummariesByUserID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID int64
+//   - status string
+//   - offset int64
+//   - limit int64
+func (_e *ReadingRepository_Expecter) GetReadingSummariesByUserID(ctx interface{}, userID interface{}, status interface{}, offset interface{}, limit interface{}) *ReadingRepository_GetReadingSummariesByUserID_Call {
+	return &ReadingRepository_GetReadingSummariesByUserID_Call{Call: _e.mock.On("GetReadingSummariesByUserID", ctx, userID, status, offset, limit)}
+}
+
+func (_c *ReadingRepository_GetReadingSummariesByUserID_Call) Run(run func(ctx context.Context, userID int64, status string, offset int64, limit int64)) *ReadingRepository_GetReadingSummariesByUserID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(string), args[3].(int64), args[4].(int64))
+	})
+	return _c
+}
+
+func (_c *ReadingRepository_GetReadingSummariesByUserID_Call) Return(_a0 []domain.ReadingSummary, _a1 error) *ReadingRepository_GetReadingSummariesByUserID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ReadingRepository_GetReadingSummariesByUserID_Call) RunAndReturn(run func(context.Context, int64, string, int64, int64) ([]domain.ReadingSummary, error)) *ReadingRepository_GetReadingSummariesByUserID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetReadingsByUserID provides a mock function with given fields: ctx, userID, status, offset, limit
+func (_m *ReadingRepository) GetReadingsByUserID(ctx context.Context, userID int64, status string, offset int64, limit int64) ([]domain.Reading, error) {
+	ret := _m.Called(ctx, userID, status, offset, limit)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetReadingsByUserID")
@@ -261,19 +493,19 @@ func (_m *ReadingRepository) GetReadingsByUserID(ctx context.Context, userID int
 
 	var r0 []domain.Reading
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, int64, int64, int64) ([]domain.Reading, error)); ok {
-		return rf(ctx, userID, offset, limit)
+	if rf, ok := ret.Get(0).(func(context.Context, int64, string, int64, int64) ([]domain.Reading, error)); ok {
+		return rf(ctx, userID, status, offset, limit)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, int64, int64, int64) []domain.Reading); ok {
-		r0 = rf(ctx, userID, offset, limit)
+	if rf, ok := ret.Get(0).(func(context.Context, int64, string, int64, int64) []domain.Reading); ok {
+		r0 = rf(ctx, userID, status, offset, limit)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]domain.Reading)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, int64, int64, int64) error); ok {
-		r1 = rf(ctx, userID, offset, limit)
+	if rf, ok := ret.Get(1).(func(context.Context, int64, string, int64, int64) error); ok {
+		r1 = rf(ctx, userID, status, offset, limit)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -289,15 +521,16 @@ type ReadingRepository_GetReadingsByUserID_Call struct {
 // GetReadingsByUserID is a helper method to define mock.On call
 //   - ctx context.Context
 //   - userID int64
+//   - status string
 //   - offset int64
 //   - limit int64
-func (_e *ReadingRepository_Expecter) GetReadingsByUserID(ctx interface{}, userID interface{}, offset interface{}, limit interface{}) *ReadingRepository_GetReadingsByUserID_Call {
-	return &ReadingRepository_GetReadingsByUserID_Call{Call: _e.mock.On("GetReadingsByUserID", ctx, userID, offset, limit)}
+func (_e *ReadingRepository_Expecter) GetReadingsByUserID(ctx interface{}, userID interface{}, status interface{}, offset interface{}, limit interface{}) *ReadingRepository_GetReadingsByUserID_Call {
+	return &ReadingRepository_GetReadingsByUserID_Call{Call: _e.mock.On("GetReadingsByUserID", ctx, userID, status, offset, limit)}
 }
 
-func (_c *ReadingRepository_GetReadingsByUserID_Call) Run(run func(ctx context.Context, userID int64, offset int64, limit int64)) *ReadingRepository_GetReadingsByUserID_Call {
+func (_c *ReadingRepository_GetReadingsByUserID_Call) Run(run func(ctx context.Context, userID int64, status string, offset int64, limit int64)) *ReadingRepository_GetReadingsByUserID_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(int64), args[2].(int64), args[3].(int64))
+		run(args[0].(context.Context), args[1].(int64), args[2].(string), args[3].(int64), args[4].(int64))
 	})
 	return _c
 }
@@ -307,7 +540,124 @@ func (_c *ReadingRepository_GetReadingsByUserID_Call) Return(_a0 []domain.Readin
 	return _c
 }
 
-func (_c *ReadingRepository_GetReadingsByUserID_Call) RunAndReturn(run func(context.Context, int64, int64, int64) ([]domain.Reading, error)) *ReadingRepository_GetReadingsByUserID_Call {
+func (_c *ReadingRepository_GetReadingsByUserID_Call) RunAndReturn(run func(context.Context, int64, string, int64, int64) ([]domain.Reading, error)) *ReadingRepository_GetReadingsByUserID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetReadingsByUserIDAndBookID provides a mock function with given fields: ctx, userID, bookID
+func (_m *ReadingRepository) GetReadingsByUserIDAndBookID(ctx context.Context, userID int64, bookID int64) ([]domain.Reading, error) {
+	ret := _m.Called(ctx, userID, bookID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetReadingsByUserIDAndBookID")
+	}
+
+	var r0 []domain.Reading
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64) ([]domain.Reading, error)); ok {
+		return rf(ctx, userID, bookID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64) []domain.Reading); ok {
+		r0 = rf(ctx, userID, bookID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Reading)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, int64) error); ok {
+		r1 = rf(ctx, userID, bookID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ReadingRepository_GetReadingsByUserIDAndBookID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetReadingsByUserIDAndBookID'
+type ReadingRepository_GetReadingsByUserIDAndBookID_Call struct {
+	*mock.Call
+}
+
+// GetReadingsByUserIDAndBookID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID int64
+//   - bookID int64
+func (_e *ReadingRepository_Expecter) GetReadingsByUserIDAndBookID(ctx interface{}, userID interface{}, bookID interface{}) *ReadingRepository_GetReadingsByUserIDAndBookID_Call {
+	return &ReadingRepository_GetReadingsByUserIDAndBookID_Call{Call: _e.mock.On("GetReadingsByUserIDAndBookID", ctx, userID, bookID)}
+}
+
+func (_c *ReadingRepository_GetReadingsByUserIDAndBookID_Call) Run(run func(ctx context.Context, userID int64, bookID int64)) *ReadingRepository_GetReadingsByUserIDAndBookID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(int64))
+	})
+	return _c
+}
+
+func (_c *ReadingRepository_GetReadingsByUserIDAndBookID_Call) Return(_a0 []domain.Reading, _a1 error) *ReadingRepository_GetReadingsByUserIDAndBookID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ReadingRepository_GetReadingsByUserIDAndBookID_Call) RunAndReturn(run func(context.Context, int64, int64) ([]domain.Reading, error)) *ReadingRepository_GetReadingsByUserIDAndBookID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateReading provides a mock function with given fields: ctx, reading
+func (_m *ReadingRepository) UpdateReading(ctx context.Context, reading domain.Reading) (domain.Reading, error) {
+	ret := _m.Called(ctx, reading)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateReading")
+	}
+
+	var r0 domain.Reading
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.Reading) (domain.Reading, error)); ok {
+		return rf(ctx, reading)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.Reading) domain.Reading); ok {
+		r0 = rf(ctx, reading)
+	} else {
+		r0 = ret.Get(0).(domain.Reading)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.Reading) error); ok {
+		r1 = rf(ctx, reading)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ReadingRepository_UpdateReading_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateReading'
+type ReadingRepository_UpdateReading_Call struct {
+	*mock.Call
+}
+
+// UpdateReading is a helper method to define mock.On call
+//   - ctx context.Context
+//   - reading domain.Reading
+func (_e *ReadingRepository_Expecter) UpdateReading(ctx interface{}, reading interface{}) *ReadingRepository_UpdateReading_Call {
+	return &ReadingRepository_UpdateReading_Call{Call: _e.mock.On("UpdateReading", ctx, reading)}
+}
+
+func (_c *ReadingRepository_UpdateReading_Call) Run(run func(ctx context.Context, reading domain.Reading)) *ReadingRepository_UpdateReading_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.Reading))
+	})
+	return _c
+}
+
+func (_c *ReadingRepository_UpdateReading_Call) Return(_a0 domain.Reading, _a1 error) *ReadingRepository_UpdateReading_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ReadingRepository_UpdateReading_Call) RunAndReturn(run func(context.Context, domain.Reading) (domain.Reading, error)) *ReadingRepository_UpdateReading_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -82,9 +82,176 @@ func (_c *ReadingService_CreateReading_Call) RunAndReturn(run func(context.Conte
 	return _c
 }
 
-// GetReadings provides a mock function with given fields: ctx, userID, page, limit
-func (_m *ReadingService) GetReadings(ctx context.Context, userID int64, page int64, limit int64) ([]dto.ReadingResponse, bool, error) {
-	ret := _m.Called(ctx, userID, page, limit)
+// DeleteReading provides a mock function with given fields: ctx, userID, readingID
+func (_m *ReadingService) DeleteReading(ctx context.Context, userID int64, readingID int64) error {
+	ret := _m.Called(ctx, userID, readingID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteReading")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64) error); ok {
+		r0 = rf(ctx, userID, readingID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ReadingService_DeleteReading_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteReading'
+type ReadingService_DeleteReading_Call struct {
+	*mock.Call
+}
+
+// DeleteReading is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID int64
+//   - readingID int64
+func (_e *ReadingService_Expecter) DeleteReading(ctx interface{}, userID interface{}, readingID interface{}) *ReadingService_DeleteReading_Call {
+	return &ReadingService_DeleteReading_Call{Call: _e.mock.On("DeleteReading", ctx, userID, readingID)}
+}
+
+func (_c *ReadingService_DeleteReading_Call) Run(run func(ctx context.Context, userID int64, readingID int64)) *ReadingService_DeleteReading_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(int64))
+	})
+	return _c
+}
+
+func (_c *ReadingService_DeleteReading_Call) Return(_a0 error) *ReadingService_DeleteReading_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ReadingService_DeleteReading_Call) RunAndReturn(run func(context.Context, int64, int64) error) *ReadingService_DeleteReading_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetBookReadings provides a mock function with given fields: ctx, userID, bookID
+func (_m *ReadingService) GetBookReadings(ctx context.Context, userID int64, bookID int64) ([]dto.ReadingResponse, error) {
+	ret := _m.Called(ctx, userID, bookID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetBookReadings")
+	}
+
+	var r0 []dto.ReadingResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64) ([]dto.ReadingResponse, error)); ok {
+		return rf(ctx, userID, bookID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64) []dto.ReadingResponse); ok {
+		r0 = rf(ctx, userID, bookID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]dto.ReadingResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, int64) error); ok {
+		r1 = rf(ctx, userID, bookID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ReadingService_GetBookReadings_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetBookReadings'
+type ReadingService_GetBookReadings_Call struct {
+	*mock.Call
+}
+
+// GetBookReadings is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID int64
+//   - bookID int64
+func (_e *ReadingService_Expecter) GetBookReadings(ctx interface{}, userID interface{}, bookID interface{}) *ReadingService_GetBookReadings_Call {
+	return &ReadingService_GetBookReadings_Call{Call: _e.mock.On("GetBookReadings", ctx, userID, bookID)}
+}
+
+func (_c *ReadingService_GetBookReadings_Call) Run(run func(ctx context.Context, userID int64, bookID int64)) *ReadingService_GetBookReadings_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(int64))
+	})
+	return _c
+}
+
+func (_c *ReadingService_GetBookReadings_Call) Return(_a0 []dto.ReadingResponse, _a1 error) *ReadingService_GetBookReadings_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ReadingService_GetBookReadings_Call) RunAndReturn(run func(context.Context, int64, int64) ([]dto.ReadingResponse, error)) *ReadingService_GetBookReadings_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetReadingPace provides a mock function with given fields: ctx, userID, readingID, windows
+func (_m *ReadingService) GetReadingPace(ctx context.Context, userID int64, readingID int64, windows []int64) (dto.PaceResponse, error) {
+	ret := _m.Called(ctx, userID, readingID, windows)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetReadingPace")
+	}
+
+	var r0 dto.PaceResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64, []int64) (dto.PaceResponse, error)); ok {
+		return rf(ctx, userID, readingID, windows)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64, []int64) dto.PaceResponse); ok {
+		r0 = rf(ctx, userID, readingID, windows)
+	} else {
+		r0 = ret.Get(0).(dto.PaceResponse)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, int64, []int64) error); ok {
+		r1 = rf(ctx, userID, readingID, windows)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ReadingService_GetReadingPace_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetReadingPace'
+type ReadingService_GetReadingPace_Call struct {
+	*mock.Call
+}
+
+// GetReadingPace is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID int64
+//   - readingID int64
+//   - windows []int64
+func (_e *ReadingService_Expecter) GetReadingPace(ctx interface{}, userID interface{}, readingID interface{}, windows interface{}) *ReadingService_GetReadingPace_Call {
+	return &ReadingService_GetReadingPace_Call{Call: _e.mock.On("GetReadingPace", ctx, userID, readingID, windows)}
+}
+
+func (_c *ReadingService_GetReadingPace_Call) Run(run func(ctx context.Context, userID int64, readingID int64, windows []int64)) *ReadingService_GetReadingPace_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(int64), args[3].([]int64))
+	})
+	return _c
+}
+
+func (_c *ReadingService_GetReadingPace_Call) Return(_a0 dto.PaceResponse, _a1 error) *ReadingService_GetReadingPace_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ReadingService_GetReadingPace_Call) RunAndReturn(run func(context.Context, int64, int64, []int64) (dto.PaceResponse, error)) *ReadingService_GetReadingPace_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetReadings provides a mock function with given fields: ctx, userID, status, page, limit
+func (_m *ReadingService) GetReadings(ctx context.Context, userID int64, status string, page int64, limit int64) ([]dto.ReadingResponse, bool, error) {
+	ret := _m.Called(ctx, userID, status, page, limit)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetReadings")
@@ -93,25 +260,25 @@ func (_m *ReadingService) GetReadings(ctx context.Context, userID int64, page in
 	var r0 []dto.ReadingResponse
 	var r1 bool
 	var r2 error
-	if rf, ok := ret.Get(0).(func(context.Context, int64, int64, int64) ([]dto.ReadingResponse, bool, error)); ok {
-		return rf(ctx, userID, page, limit)
+	if rf, ok := ret.Get(0).(func(context.Context, int64, string, int64, int64) ([]dto.ReadingResponse, bool, error)); ok {
+		return rf(ctx, userID, status, page, limit)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, int64, int64, int64) []dto.ReadingResponse); ok {
-		r0 = rf(ctx, userID, page, limit)
+	if rf, ok := ret.Get(0).(func(context.Context, int64, string, int64, int64) []dto.ReadingResponse); ok {
+		r0 = rf(ctx, userID, status, page, limit)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]dto.ReadingResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, int64, int64, int64) bool); ok {
-		r1 = rf(ctx, userID, page, limit)
+	if rf, ok := ret.Get(1).(func(context.Context, int64, string, int64, int64) bool); ok {
+		r1 = rf(ctx, userID, status, page, limit)
 	} else {
 		r1 = ret.Get(1).(bool)
 	}
 
-	if rf, ok := ret.Get(2).(func(context.Context, int64, int64, int64) error); ok {
-		r2 = rf(ctx, userID, page, limit)
+	if rf, ok := ret.Get(2).(func(context.Context, int64, string, int64, int64) error); ok {
+		r2 = rf(ctx, userID, status, page, limit)
 	} else {
 		r2 = ret.Error(2)
 	}
@@ -127,15 +294,16 @@ type ReadingService_GetReadings_Call struct {
 // GetReadings is a helper method to define mock.On call
 //   - ctx context.Context
 //   - userID int64
+//   - status string
 //   - page int64
 //   - limit int64
-func (_e *ReadingService_Expecter) GetReadings(ctx interface{}, userID interface{}, page interface{}, limit interface{}) *ReadingService_GetReadings_Call {
-	return &ReadingService_GetReadings_Call{Call: _e.mock.On("GetReadings", ctx, userID, page, limit)}
+func (_e *ReadingService_Expecter) GetReadings(ctx interface{}, userID interface{}, status interface{}, page interface{}, limit interface{}) *ReadingService_GetReadings_Call {
+	return &ReadingService_GetReadings_Call{Call: _e.mock.On("GetReadings", ctx, userID, status, page, limit)}
 }
 
-func (_c *ReadingService_GetReadings_Call) Run(run func(ctx context.Context, userID int64, page int64, limit int64)) *ReadingService_GetReadings_Call {
+func (_c *ReadingService_GetReadings_Call) Run(run func(ctx context.Context, userID int64, status string, page int64, limit int64)) *ReadingService_GetReadings_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(int64), args[2].(int64), args[3].(int64))
+		run(args[0].(context.Context), args[1].(int64), args[2].(string), args[3].(int64), args[4].(int64))
 	})
 	return _c
 }
@@ -145,7 +313,125 @@ func (_c *ReadingService_GetReadings_Call) Return(_a0 []dto.ReadingResponse, _a1
 	return _c
 }
 
-func (_c *ReadingService_GetReadings_Call) RunAndReturn(run func(context.Context, int64, int64, int64) ([]dto.ReadingResponse, bool, error)) *ReadingService_GetReadings_Call {
+func (_c *ReadingService_GetReadings_Call) RunAndReturn(run func(context.Context, int64, string, int64, int64) ([]dto.ReadingResponse, bool, error)) *ReadingService_GetReadings_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateReading provides a mock function with given fields: ctx, userID, readingID, req
+func (_m *ReadingService) UpdateReading(ctx context.Context, userID int64, readingID int64, req dto.ReadingRequest) (domain.Reading, error) {
+	ret := _m.Called(ctx, userID, readingID, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateReading")
+	}
+
+	var r0 domain.Reading
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64, dto.ReadingRequest) (domain.Reading, error)); ok {
+		return rf(ctx, userID, readingID, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64, dto.ReadingRequest) domain.Reading); ok {
+		r0 = rf(ctx, userID, readingID, req)
+	} else {
+		r0 = ret.Get(0).(domain.Reading)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, int64, dto.ReadingRequest) error); ok {
+		r1 = rf(ctx, userID, readingID, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ReadingService_UpdateReading_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateReading'
+type ReadingService_UpdateReading_Call struct {
+	*mock.Call
+}
+
+// UpdateReading is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID int64
+//   - readingID int64
+//   - req dto.ReadingRequest
+func (_e *ReadingService_Expecter) UpdateReading(ctx interface{}, userID interface{}, readingID interface{}, req interface{}) *ReadingService_UpdateReading_Call {
+	return &ReadingService_UpdateReading_Call{Call: _e.mock.On("UpdateReading", ctx, userID, readingID, req)}
+}
+
+func (_c *ReadingService_UpdateReading_Call) Run(run func(ctx context.Context, userID int64, readingID int64, req dto.ReadingRequest)) *ReadingService_UpdateReading_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(int64), args[3].(dto.ReadingRequest))
+	})
+	return _c
+}
+
+func (_c *ReadingService_UpdateReading_Call) Return(_a0 domain.Reading, _a1 error) *ReadingService_UpdateReading_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ReadingService_UpdateReading_Call) RunAndReturn(run func(context.Context, int64, int64, dto.ReadingRequest) (domain.Reading, error)) *ReadingService_UpdateReading_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateReadingStatus provides a mock function with given fields: ctx, userID, readingID, status
+func (_m *ReadingService) UpdateReadingStatus(ctx context.Context, userID int64, readingID int64, status string) (domain.Reading, error) {
+	ret := _m.Called(ctx, userID, readingID, status)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateReadingStatus")
+	}
+
+	var r0 domain.Reading
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64, string) (domain.Reading, error)); ok {
+		return rf(ctx, userID, readingID, status)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64, string) domain.Reading); ok {
+		r0 = rf(ctx, userID, readingID, status)
+	} else {
+		r0 = ret.Get(0).(domain.Reading)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, int64, string) error); ok {
+		r1 = rf(ctx, userID, readingID, status)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ReadingService_UpdateReadingStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateReadingStatus'
+type ReadingService_UpdateReadingStatus_Call struct {
+	*mock.Call
+}
+
+// UpdateReadingStatus is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID int64
+//   - readingID int64
+//   - status string
+func (_e *ReadingService_Expecter) UpdateReadingStatus(ctx interface{}, userID interface{}, readingID interface{}, status interface{}) *ReadingService_UpdateReadingStatus_Call {
+	return &ReadingService_UpdateReadingStatus_Call{Call: _e.mock.On("UpdateReadingStatus", ctx, userID, readingID, status)}
+}
+
+func (_c *ReadingService_UpdateReadingStatus_Call) Run(run func(ctx context.Context, userID int64, readingID int64, status string)) *ReadingService_UpdateReadingStatus_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(int64), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *ReadingService_UpdateReadingStatus_Call) Return(_a0 domain.Reading, _a1 error) *ReadingService_UpdateReadingStatus_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ReadingService_UpdateReadingStatus_Call) RunAndReturn(run func(context.Context, int64, int64, string) (domain.Reading, error)) *ReadingService_UpdateReadingStatus_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -0,0 +1,328 @@
+// Code generated by mockery v2.46.3. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	domain "github.com/rimvydascivilis/book-tracker/backend/domain"
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// ReadingSessionRepository is an autogenerated mock type for the ReadingSessionRepository type
+type ReadingSessionRepository struct {
+	mock.Mock
+}
+
+type ReadingSessionRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *ReadingSessionRepository) EXPECT() *ReadingSessionRepository_Expecter {
+	return &ReadingSessionRepository_Expecter{mock: &_m.Mock}
+}
+
+// CreateReadingSession provides a mock function with given fields: ctx, session
+func (_m *ReadingSessionRepository) CreateReadingSession(ctx context.Context, session domain.ReadingSession) (domain.ReadingSession, error) {
+	ret := _m.Called(ctx, session)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateReadingSession")
+	}
+
+	var r0 domain.ReadingSession
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ReadingSession) (domain.ReadingSession, error)); ok {
+		return rf(ctx, session)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ReadingSession) domain.ReadingSession); ok {
+		r0 = rf(ctx, session)
+	} else {
+		r0 = ret.Get(0).(domain.ReadingSession)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.ReadingSession) error); ok {
+		r1 = rf(ctx, session)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ReadingSessionRepository_CreateReadingSession_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateReadingSession'
+type ReadingSessionRepository_CreateReadingSession_Call struct {
+	*mock.Call
+}
+
+// CreateReadingSession is a helper method to define mock.On call
+//   - ctx context.Context
+//   - session domain.ReadingSession
+func (_e *ReadingSessionRepository_Expecter) CreateReadingSession(ctx interface{}, session interface{}) *ReadingSessionRepository_CreateReadingSession_Call {
+	return &ReadingSessionRepository_CreateReadingSession_Call{Call: _e.mock.On("CreateReadingSession", ctx, session)}
+}
+
+func (_c *ReadingSessionRepository_CreateReadingSession_Call) Run(run func(ctx context.Context, session domain.ReadingSession)) *ReadingSessionRepository_CreateReadingSession_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.ReadingSession))
+	})
+	return _c
+}
+
+func (_c *ReadingSessionRepository_CreateReadingSession_Call) Return(_a0 domain.ReadingSession, _a1 error) *ReadingSessionRepository_CreateReadingSession_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ReadingSessionRepository_CreateReadingSession_Call) RunAndReturn(run func(context.Context, domain.ReadingSession) (domain.ReadingSession, error)) *ReadingSessionRepository_CreateReadingSession_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetOpenReadingSessionForUpdate provides a mock function with given fields: ctx, userID
+func (_m *ReadingSessionRepository) GetOpenReadingSessionForUpdate(ctx context.Context, userID int64) (domain.ReadingSession, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOpenReadingSessionForUpdate")
+	}
+
+	var r0 domain.ReadingSession
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) (domain.ReadingSession, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) domain.ReadingSession); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		r0 = ret.Get(0).(domain.ReadingSession)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ReadingSessionRepository_GetOpenReadingSessionForUpdate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetOpenReadingSessionForUpdate'
+type ReadingSessionRepository_GetOpenReadingSessionForUpdate_Call struct {
+	*mock.Call
+}
+
+// GetOpenReadingSessionForUpdate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID int64
+func (_e *ReadingSessionRepository_Expecter) GetOpenReadingSessionForUpdate(ctx interface{}, userID interface{}) *ReadingSessionRepository_GetOpenReadingSessionForUpdate_Call {
+	return &ReadingSessionRepository_GetOpenReadingSessionForUpdate_Call{Call: _e.mock.On("GetOpenReadingSessionForUpdate", ctx, userID)}
+}
+
+func (_c *ReadingSessionRepository_GetOpenReadingSessionForUpdate_Call) Run(run func(ctx context.Context, userID int64)) *ReadingSessionRepository_GetOpenReadingSessionForUpdate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *ReadingSessionRepository_GetOpenReadingSessionForUpdate_Call) Return(_a0 domain.ReadingSession, _a1 error) *ReadingSessionRepository_GetOpenReadingSessionForUpdate_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ReadingSessionRepository_GetOpenReadingSessionForUpdate_Call) RunAndReturn(run func(context.Context, int64) (domain.ReadingSession, error)) *ReadingSessionRepository_GetOpenReadingSessionForUpdate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetOpenReadingSessionsStartedBefore provides a mock function with given fields: ctx, t
+func (_m *ReadingSessionRepository) GetOpenReadingSessionsStartedBefore(ctx context.Context, t time.Time) ([]domain.ReadingSession, error) {
+	ret := _m.Called(ctx, t)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOpenReadingSessionsStartedBefore")
+	}
+
+	var r0 []domain.ReadingSession
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) ([]domain.ReadingSession, error)); ok {
+		return rf(ctx, t)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) []domain.ReadingSession); ok {
+		r0 = rf(ctx, t)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.ReadingSession)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time) error); ok {
+		r1 = rf(ctx, t)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ReadingSessionRepository_GetOpenReadingSessionsStartedBefore_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetOpenReadingSessionsStartedBefore'
+type ReadingSessionRepository_GetOpenReadingSessionsStartedBefore_Call struct {
+	*mock.Call
+}
+
+// GetOpenReadingSessionsStartedBefore is a helper method to define mock.On call
+//   - ctx context.Context
+//   - t time.Time
+func (_e *ReadingSessionRepository_Expecter) GetOpenReadingSessionsStartedBefore(ctx interface{}, t interface{}) *ReadingSessionRepository_GetOpenReadingSessionsStartedBefore_Call {
+	return &ReadingSessionRepository_GetOpenReadingSessionsStartedBefore_Call{Call: _e.mock.On("GetOpenReadingSessionsStartedBefore", ctx, t)}
+}
+
+func (_c *ReadingSessionRepository_GetOpenReadingSessionsStartedBefore_Call) Run(run func(ctx context.Context, t time.Time)) *ReadingSessionRepository_GetOpenReadingSessionsStartedBefore_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Time))
+	})
+	return _c
+}
+
+func (_c *ReadingSessionRepository_GetOpenReadingSessionsStartedBefore_Call) Return(_a0 []domain.ReadingSession, _a1 error) *ReadingSessionRepository_GetOpenReadingSessionsStartedBefore_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ReadingSessionRepository_GetOpenReadingSessionsStartedBefore_Call) RunAndReturn(run func(context.Context, time.Time) ([]domain.ReadingSession, error)) *ReadingSessionRepository_GetOpenReadingSessionsStartedBefore_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetReadingSessionsByReadingID provides a mock function with given fields: ctx, readingID
+func (_m *ReadingSessionRepository) GetReadingSessionsByReadingID(ctx context.Context, readingID int64) ([]domain.ReadingSession, error) {
+	ret := _m.Called(ctx, readingID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetReadingSessionsByReadingID")
+	}
+
+	var r0 []domain.ReadingSession
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) ([]domain.ReadingSession, error)); ok {
+		return rf(ctx, readingID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) []domain.ReadingSession); ok {
+		r0 = rf(ctx, readingID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.ReadingSession)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, readingID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ReadingSessionRepository_GetReadingSessionsByReadingID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetReadingSessionsByReadingID'
+type ReadingSessionRepository_GetReadingSessionsByReadingID_Call struct {
+	*mock.Call
+}
+
+// GetReadingSessionsByReadingID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - readingID int64
+func (_e *ReadingSessionRepository_Expecter) GetReadingSessionsByReadingID(ctx interface{}, readingID interface{}) *ReadingSessionRepository_GetReadingSessionsByReadingID_Call {
+	return &ReadingSessionRepository_GetReadingSessionsByReadingID_Call{Call: _e.mock.On("GetReadingSessionsByReadingID", ctx, readingID)}
+}
+
+func (_c *ReadingSessionRepository_GetReadingSessionsByReadingID_Call) Run(run func(ctx context.Context, readingID int64)) *ReadingSessionRepository_GetReadingSessionsByReadingID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *ReadingSessionRepository_GetReadingSessionsByReadingID_Call) Return(_a0 []domain.ReadingSession, _a1 error) *ReadingSessionRepository_GetReadingSessionsByReadingID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ReadingSessionRepository_GetReadingSessionsByReadingID_Call) RunAndReturn(run func(context.Context, int64) ([]domain.ReadingSession, error)) *ReadingSessionRepository_GetReadingSessionsByReadingID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateReadingSession provides a mock function with given fields: ctx, session
+func (_m *ReadingSessionRepository) UpdateReadingSession(ctx context.Context, session domain.ReadingSession) (domain.ReadingSession, error) {
+	ret := _m.Called(ctx, session)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateReadingSession")
+	}
+
+	var r0 domain.ReadingSession
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ReadingSession) (domain.ReadingSession, error)); ok {
+		return rf(ctx, session)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ReadingSession) domain.ReadingSession); ok {
+		r0 = rf(ctx, session)
+	} else {
+		r0 = ret.Get(0).(domain.ReadingSession)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.ReadingSession) error); ok {
+		r1 = rf(ctx, session)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ReadingSessionRepository_UpdateReadingSession_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateReadingSession'
+type ReadingSessionRepository_UpdateReadingSession_Call struct {
+	*mock.Call
+}
+
+// UpdateReadingSession is a helper method to define mock.On call
+//   - ctx context.Context
+//   - session domain.ReadingSession
+func (_e *ReadingSessionRepository_Expecter) UpdateReadingSession(ctx interface{}, session interface{}) *ReadingSessionRepository_UpdateReadingSession_Call {
+	return &ReadingSessionRepository_UpdateReadingSession_Call{Call: _e.mock.On("UpdateReadingSession", ctx, session)}
+}
+
+func (_c *ReadingSessionRepository_UpdateReadingSession_Call) Run(run func(ctx context.Context, session domain.ReadingSession)) *ReadingSessionRepository_UpdateReadingSession_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.ReadingSession))
+	})
+	return _c
+}
+
+func (_c *ReadingSessionRepository_UpdateReadingSession_Call) Return(_a0 domain.ReadingSession, _a1 error) *ReadingSessionRepository_UpdateReadingSession_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ReadingSessionRepository_UpdateReadingSession_Call) RunAndReturn(run func(context.Context, domain.ReadingSession) (domain.ReadingSession, error)) *ReadingSessionRepository_UpdateReadingSession_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewReadingSessionRepository creates a new instance of ReadingSessionRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewReadingSessionRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ReadingSessionRepository {
+	mock := &ReadingSessionRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
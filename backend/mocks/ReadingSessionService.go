@@ -0,0 +1,270 @@
+// Code generated by mockery v2.46.3. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	domain "github.com/rimvydascivilis/book-tracker/backend/domain"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// ReadingSessionService is an autogenerated mock type for the ReadingSessionService type
+type ReadingSessionService struct {
+	mock.Mock
+}
+
+type ReadingSessionService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *ReadingSessionService) EXPECT() *ReadingSessionService_Expecter {
+	return &ReadingSessionService_Expecter{mock: &_m.Mock}
+}
+
+// CloseExpiredReadingSessions provides a mock function with given fields: ctx
+func (_m *ReadingSessionService) CloseExpiredReadingSessions(ctx context.Context) (int, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CloseExpiredReadingSessions")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (int, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) int); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ReadingSessionService_CloseExpiredReadingSessions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CloseExpiredReadingSessions'
+type ReadingSessionService_CloseExpiredReadingSessions_Call struct {
+	*mock.Call
+}
+
+// CloseExpiredReadingSessions is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *ReadingSessionService_Expecter) CloseExpiredReadingSessions(ctx interface{}) *ReadingSessionService_CloseExpiredReadingSessions_Call {
+	return &ReadingSessionService_CloseExpiredReadingSessions_Call{Call: _e.mock.On("CloseExpiredReadingSessions", ctx)}
+}
+
+func (_c *ReadingSessionService_CloseExpiredReadingSessions_Call) Run(run func(ctx context.Context)) *ReadingSessionService_CloseExpiredReadingSessions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *ReadingSessionService_CloseExpiredReadingSessions_Call) Return(_a0 int, _a1 error) *ReadingSessionService_CloseExpiredReadingSessions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ReadingSessionService_CloseExpiredReadingSessions_Call) RunAndReturn(run func(context.Context) (int, error)) *ReadingSessionService_CloseExpiredReadingSessions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetReadingSessions provides a mock function with given fields: ctx, userID, readingID
+func (_m *ReadingSessionService) GetReadingSessions(ctx context.Context, userID int64, readingID int64) ([]domain.ReadingSession, error) {
+	ret := _m.Called(ctx, userID, readingID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetReadingSessions")
+	}
+
+	var r0 []domain.ReadingSession
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64) ([]domain.ReadingSession, error)); ok {
+		return rf(ctx, userID, readingID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64) []domain.ReadingSession); ok {
+		r0 = rf(ctx, userID, readingID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.ReadingSession)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, int64) error); ok {
+		r1 = rf(ctx, userID, readingID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ReadingSessionService_GetReadingSessions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetReadingSessions'
+type ReadingSessionService_GetReadingSessions_Call struct {
+	*mock.Call
+}
+
+// GetReadingSessions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID int64
+//   - readingID int64
+func (_e *ReadingSessionService_Expecter) GetReadingSessions(ctx interface{}, userID interface{}, readingID interface{}) *ReadingSessionService_GetReadingSessions_Call {
+	return &ReadingSessionService_GetReadingSessions_Call{Call: _e.mock.On("GetReadingSessions", ctx, userID, readingID)}
+}
+
+func (_c *ReadingSessionService_GetReadingSessions_Call) Run(run func(ctx context.Context, userID int64, readingID int64)) *ReadingSessionService_GetReadingSessions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(int64))
+	})
+	return _c
+}
+
+func (_c *ReadingSessionService_GetReadingSessions_Call) Return(_a0 []domain.ReadingSession, _a1 error) *ReadingSessionService_GetReadingSessions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ReadingSessionService_GetReadingSessions_Call) RunAndReturn(run func(context.Context, int64, int64) ([]domain.ReadingSession, error)) *ReadingSessionService_GetReadingSessions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// StartReadingSession provides a mock function with given fields: ctx, userID, readingID
+func (_m *ReadingSessionService) StartReadingSession(ctx context.Context, userID int64, readingID int64) (domain.ReadingSession, error) {
+	ret := _m.Called(ctx, userID, readingID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StartReadingSession")
+	}
+
+	var r0 domain.ReadingSession
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64) (domain.ReadingSession, error)); ok {
+		return rf(ctx, userID, readingID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64) domain.ReadingSession); ok {
+		r0 = rf(ctx, userID, readingID)
+	} else {
+		r0 = ret.Get(0).(domain.ReadingSession)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, int64) error); ok {
+		r1 = rf(ctx, userID, readingID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ReadingSessionService_StartReadingSession_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StartReadingSession'
+type ReadingSessionService_StartReadingSession_Call struct {
+	*mock.Call
+}
+
+// StartReadingSession is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID int64
+//   - readingID int64
+func (_e *ReadingSessionService_Expecter) StartReadingSession(ctx interface{}, userID interface{}, readingID interface{}) *ReadingSessionService_StartReadingSession_Call {
+	return &ReadingSessionService_StartReadingSession_Call{Call: _e.mock.On("StartReadingSession", ctx, userID, readingID)}
+}
+
+func (_c *ReadingSessionService_StartReadingSession_Call) Run(run func(ctx context.Context, userID int64, readingID int64)) *ReadingSessionService_StartReadingSession_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(int64))
+	})
+	return _c
+}
+
+func (_c *ReadingSessionService_StartReadingSession_Call) Return(_a0 domain.ReadingSession, _a1 error) *ReadingSessionService_StartReadingSession_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ReadingSessionService_StartReadingSession_Call) RunAndReturn(run func(context.Context, int64, int64) (domain.ReadingSession, error)) *ReadingSessionService_StartReadingSession_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// StopReadingSession provides a mock function with given fields: ctx, userID, readingID, page
+func (_m *ReadingSessionService) StopReadingSession(ctx context.Context, userID int64, readingID int64, page int64) (domain.ReadingSession, error) {
+	ret := _m.Called(ctx, userID, readingID, page)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StopReadingSession")
+	}
+
+	var r0 domain.ReadingSession
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64, int64) (domain.ReadingSession, error)); ok {
+		return rf(ctx, userID, readingID, page)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64, int64) domain.ReadingSession); ok {
+		r0 = rf(ctx, userID, readingID, page)
+	} else {
+		r0 = ret.Get(0).(domain.ReadingSession)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, int64, int64) error); ok {
+		r1 = rf(ctx, userID, readingID, page)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ReadingSessionService_StopReadingSession_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StopReadingSession'
+type ReadingSessionService_StopReadingSession_Call struct {
+	*mock.Call
+}
+
+// StopReadingSession is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID int64
+//   - readingID int64
+//   - page int64
+func (_e *ReadingSessionService_Expecter) StopReadingSession(ctx interface{}, userID interface{}, readingID interface{}, page interface{}) *ReadingSessionService_StopReadingSession_Call {
+	return &ReadingSessionService_StopReadingSession_Call{Call: _e.mock.On("StopReadingSession", ctx, userID, readingID, page)}
+}
+
+func (_c *ReadingSessionService_StopReadingSession_Call) Run(run func(ctx context.Context, userID int64, readingID int64, page int64)) *ReadingSessionService_StopReadingSession_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(int64), args[3].(int64))
+	})
+	return _c
+}
+
+func (_c *ReadingSessionService_StopReadingSession_Call) Return(_a0 domain.ReadingSession, _a1 error) *ReadingSessionService_StopReadingSession_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ReadingSessionService_StopReadingSession_Call) RunAndReturn(run func(context.Context, int64, int64, int64) (domain.ReadingSession, error)) *ReadingSessionService_StopReadingSession_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewReadingSessionService creates a new instance of ReadingSessionService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewReadingSessionService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ReadingSessionService {
+	mock := &ReadingSessionService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
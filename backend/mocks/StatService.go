@@ -0,0 +1,98 @@
+// Code generated by mockery v2.46.3. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	dto "github.com/rimvydascivilis/book-tracker/backend/dto"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// StatService is an autogenerated mock type for the StatService type
+type StatService struct {
+	mock.Mock
+}
+
+type StatService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *StatService) EXPECT() *StatService_Expecter {
+	return &StatService_Expecter{mock: &_m.Mock}
+}
+
+// GetProgress provides a mock function with given fields: ctx, userID, year, month, isMonthly
+func (_m *StatService) GetProgress(ctx context.Context, userID int64, year int64, month int64, isMonthly bool) (dto.StatResponse, error) {
+	ret := _m.Called(ctx, userID, year, month, isMonthly)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetProgress")
+	}
+
+	var r0 dto.StatResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64, int64, bool) (dto.StatResponse, error)); ok {
+		return rf(ctx, userID, year, month, isMonthly)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64, int64, bool) dto.StatResponse); ok {
+		r0 = rf(ctx, userID, year, month, isMonthly)
+	} else {
+		r0 = ret.Get(0).(dto.StatResponse)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, int64, int64, bool) error); ok {
+		r1 = rf(ctx, userID, year, month, isMonthly)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StatService_GetProgress_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetProgress'
+type StatService_GetProgress_Call struct {
+	*mock.Call
+}
+
+// GetProgress is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID int64
+//   - year int64
+//   - month int64
+//   - isMonthly bool
+func (_e *StatService_Expecter) GetProgress(ctx interface{}, userID interface{}, year interface{}, month interface{}, isMonthly interface{}) *StatService_GetProgress_Call {
+	return &StatService_GetProgress_Call{Call: _e.mock.On("GetProgress", ctx, userID, year, month, isMonthly)}
+}
+
+func (_c *StatService_GetProgress_Call) Run(run func(ctx context.Context, userID int64, year int64, month int64, isMonthly bool)) *StatService_GetProgress_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(int64), args[3].(int64), args[4].(bool))
+	})
+	return _c
+}
+
+func (_c *StatService_GetProgress_Call) Return(_a0 dto.StatResponse, _a1 error) *StatService_GetProgress_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *StatService_GetProgress_Call) RunAndReturn(run func(context.Context, int64, int64, int64, bool) (dto.StatResponse, error)) *StatService_GetProgress_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewStatService creates a new instance of StatService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewStatService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *StatService {
+	mock := &StatService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,95 @@
+// Code generated by mockery v2.46.3. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	dto "github.com/rimvydascivilis/book-tracker/backend/dto"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// StreakService is an autogenerated mock type for the StreakService type
+type StreakService struct {
+	mock.Mock
+}
+
+type StreakService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *StreakService) EXPECT() *StreakService_Expecter {
+	return &StreakService_Expecter{mock: &_m.Mock}
+}
+
+// GetStreaks provides a mock function with given fields: ctx, userID
+func (_m *StreakService) GetStreaks(ctx context.Context, userID int64) (dto.StreakResponse, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetStreaks")
+	}
+
+	var r0 dto.StreakResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) (dto.StreakResponse, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) dto.StreakResponse); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		r0 = ret.Get(0).(dto.StreakResponse)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StreakService_GetStreaks_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetStreaks'
+type StreakService_GetStreaks_Call struct {
+	*mock.Call
+}
+
+// GetStreaks is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID int64
+func (_e *StreakService_Expecter) GetStreaks(ctx interface{}, userID interface{}) *StreakService_GetStreaks_Call {
+	return &StreakService_GetStreaks_Call{Call: _e.mock.On("GetStreaks", ctx, userID)}
+}
+
+func (_c *StreakService_GetStreaks_Call) Run(run func(ctx context.Context, userID int64)) *StreakService_GetStreaks_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *StreakService_GetStreaks_Call) Return(_a0 dto.StreakResponse, _a1 error) *StreakService_GetStreaks_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *StreakService_GetStreaks_Call) RunAndReturn(run func(context.Context, int64) (dto.StreakResponse, error)) *StreakService_GetStreaks_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewStreakService creates a new instance of StreakService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewStreakService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *StreakService {
+	mock := &StreakService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -6,6 +6,8 @@ import (
 	context "context"
 
 	mock "github.com/stretchr/testify/mock"
+
+	time "time"
 )
 
 // TokenService is an autogenerated mock type for the TokenService type
@@ -21,9 +23,9 @@ func (_m *TokenService) EXPECT() *TokenService_Expecter {
 	return &TokenService_Expecter{mock: &_m.Mock}
 }
 
-// GenerateToken provides a mock function with given fields: ctx, userID
-func (_m *TokenService) GenerateToken(ctx context.Context, userID int64) (string, error) {
-	ret := _m.Called(ctx, userID)
+// GenerateToken provides a mock function with given fields: ctx, userID, sessionID
+func (_m *TokenService) GenerateToken(ctx context.Context, userID int64, sessionID int64) (string, error) {
+	ret := _m.Called(ctx, userID, sessionID)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GenerateToken")
@@ -31,17 +33,17 @@ func (_m *TokenService) GenerateToken(ctx context.Context, userID int64) (string
 
 	var r0 string
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, int64) (string, error)); ok {
-		return rf(ctx, userID)
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64) (string, error)); ok {
+		return rf(ctx, userID, sessionID)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, int64) string); ok {
-		r0 = rf(ctx, userID)
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64) string); ok {
+		r0 = rf(ctx, userID, sessionID)
 	} else {
 		r0 = ret.Get(0).(string)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
-		r1 = rf(ctx, userID)
+	if rf, ok := ret.Get(1).(func(context.Context, int64, int64) error); ok {
+		r1 = rf(ctx, userID, sessionID)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -57,13 +59,14 @@ type TokenService_GenerateToken_Call struct {
 // GenerateToken is a helper method to define mock.On call
 //   - ctx context.Context
 //   - userID int64
-func (_e *TokenService_Expecter) GenerateToken(ctx interface{}, userID interface{}) *TokenService_GenerateToken_Call {
-	return &TokenService_GenerateToken_Call{Call: _e.mock.On("GenerateToken", ctx, userID)}
+//   - sessionID int64
+func (_e *TokenService_Expecter) GenerateToken(ctx interface{}, userID interface{}, sessionID interface{}) *TokenService_GenerateToken_Call {
+	return &TokenService_GenerateToken_Call{Call: _e.mock.On("GenerateToken", ctx, userID, sessionID)}
 }
 
-func (_c *TokenService_GenerateToken_Call) Run(run func(ctx context.Context, userID int64)) *TokenService_GenerateToken_Call {
+func (_c *TokenService_GenerateToken_Call) Run(run func(ctx context.Context, userID int64, sessionID int64)) *TokenService_GenerateToken_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(int64))
+		run(args[0].(context.Context), args[1].(int64), args[2].(int64))
 	})
 	return _c
 }
@@ -73,7 +76,52 @@ func (_c *TokenService_GenerateToken_Call) Return(_a0 string, _a1 error) *TokenS
 	return _c
 }
 
-func (_c *TokenService_GenerateToken_Call) RunAndReturn(run func(context.Context, int64) (string, error)) *TokenService_GenerateToken_Call {
+func (_c *TokenService_GenerateToken_Call) RunAndReturn(run func(context.Context, int64, int64) (string, error)) *TokenService_GenerateToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// TTL provides a mock function with given fields:
+func (_m *TokenService) TTL() time.Duration {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for TTL")
+	}
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	return r0
+}
+
+// TokenService_TTL_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TTL'
+type TokenService_TTL_Call struct {
+	*mock.Call
+}
+
+// TTL is a helper method to define mock.On call
+func (_e *TokenService_Expecter) TTL() *TokenService_TTL_Call {
+	return &TokenService_TTL_Call{Call: _e.mock.On("TTL")}
+}
+
+func (_c *TokenService_TTL_Call) Run(run func()) *TokenService_TTL_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *TokenService_TTL_Call) Return(_a0 time.Duration) *TokenService_TTL_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *TokenService_TTL_Call) RunAndReturn(run func() time.Duration) *TokenService_TTL_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -0,0 +1,130 @@
+// Code generated by mockery v2.46.3. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// TxManager is an autogenerated mock type for the TxManager type
+type TxManager struct {
+	mock.Mock
+}
+
+type TxManager_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *TxManager) EXPECT() *TxManager_Expecter {
+	return &TxManager_Expecter{mock: &_m.Mock}
+}
+
+// WithinReadOnlyTransaction provides a mock function with given fields: ctx, fn
+func (_m *TxManager) WithinReadOnlyTransaction(ctx context.Context, fn func(context.Context) error) error {
+	ret := _m.Called(ctx, fn)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WithinReadOnlyTransaction")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, func(context.Context) error) error); ok {
+		r0 = rf(ctx, fn)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// TxManager_WithinReadOnlyTransaction_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WithinReadOnlyTransaction'
+type TxManager_WithinReadOnlyTransaction_Call struct {
+	*mock.Call
+}
+
+// WithinReadOnlyTransaction is a helper method to define mock.On call
+//   - ctx context.Context
+//   - fn func(context.Context) error
+func (_e *TxManager_Expecter) WithinReadOnlyTransaction(ctx interface{}, fn interface{}) *TxManager_WithinReadOnlyTransaction_Call {
+	return &TxManager_WithinReadOnlyTransaction_Call{Call: _e.mock.On("WithinReadOnlyTransaction", ctx, fn)}
+}
+
+func (_c *TxManager_WithinReadOnlyTransaction_Call) Run(run func(ctx context.Context, fn func(context.Context) error)) *TxManager_WithinReadOnlyTransaction_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(func(context.Context) error))
+	})
+	return _c
+}
+
+func (_c *TxManager_WithinReadOnlyTransaction_Call) Return(_a0 error) *TxManager_WithinReadOnlyTransaction_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *TxManager_WithinReadOnlyTransaction_Call) RunAndReturn(run func(context.Context, func(context.Context) error) error) *TxManager_WithinReadOnlyTransaction_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// WithinTransaction provides a mock function with given fields: ctx, fn
+func (_m *TxManager) WithinTransaction(ctx context.Context, fn func(context.Context) error) error {
+	ret := _m.Called(ctx, fn)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WithinTransaction")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, func(context.Context) error) error); ok {
+		r0 = rf(ctx, fn)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// TxManager_WithinTransaction_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WithinTransaction'
+type TxManager_WithinTransaction_Call struct {
+	*mock.Call
+}
+
+// WithinTransaction is a helper method to define mock.On call
+//   - ctx context.Context
+//   - fn func(context.Context) error
+func (_e *TxManager_Expecter) WithinTransaction(ctx interface{}, fn interface{}) *TxManager_WithinTransaction_Call {
+	return &TxManager_WithinTransaction_Call{Call: _e.mock.On("WithinTransaction", ctx, fn)}
+}
+
+func (_c *TxManager_WithinTransaction_Call) Run(run func(ctx context.Context, fn func(context.Context) error)) *TxManager_WithinTransaction_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(func(context.Context) error))
+	})
+	return _c
+}
+
+func (_c *TxManager_WithinTransaction_Call) Return(_a0 error) *TxManager_WithinTransaction_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *TxManager_WithinTransaction_Call) RunAndReturn(run func(context.Context, func(context.Context) error) error) *TxManager_WithinTransaction_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewTxManager creates a new instance of TxManager. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewTxManager(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *TxManager {
+	mock := &TxManager{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -193,6 +193,63 @@ func (_c *UserRepository_GetByID_Call) RunAndReturn(run func(context.Context, in
 	return _c
 }
 
+// UpdateUser provides a mock function with given fields: ctx, u
+func (_m *UserRepository) UpdateUser(ctx context.Context, u domain.User) (domain.User, error) {
+	ret := _m.Called(ctx, u)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateUser")
+	}
+
+	var r0 domain.User
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.User) (domain.User, error)); ok {
+		return rf(ctx, u)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.User) domain.User); ok {
+		r0 = rf(ctx, u)
+	} else {
+		r0 = ret.Get(0).(domain.User)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.User) error); ok {
+		r1 = rf(ctx, u)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UserRepository_UpdateUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateUser'
+type UserRepository_UpdateUser_Call struct {
+	*mock.Call
+}
+
+// UpdateUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - u domain.User
+func (_e *UserRepository_Expecter) UpdateUser(ctx interface{}, u interface{}) *UserRepository_UpdateUser_Call {
+	return &UserRepository_UpdateUser_Call{Call: _e.mock.On("UpdateUser", ctx, u)}
+}
+
+func (_c *UserRepository_UpdateUser_Call) Run(run func(ctx context.Context, u domain.User)) *UserRepository_UpdateUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.User))
+	})
+	return _c
+}
+
+func (_c *UserRepository_UpdateUser_Call) Return(_a0 domain.User, _a1 error) *UserRepository_UpdateUser_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *UserRepository_UpdateUser_Call) RunAndReturn(run func(context.Context, domain.User) (domain.User, error)) *UserRepository_UpdateUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewUserRepository creates a new instance of UserRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewUserRepository(t interface {
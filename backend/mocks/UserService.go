@@ -6,6 +6,8 @@ import (
 	context "context"
 
 	domain "github.com/rimvydascivilis/book-tracker/backend/domain"
+	dto "github.com/rimvydascivilis/book-tracker/backend/dto"
+
 	mock "github.com/stretchr/testify/mock"
 )
 
@@ -79,6 +81,121 @@ func (_c *UserService_GetOrCreateUser_Call) RunAndReturn(run func(context.Contex
 	return _c
 }
 
+// GetUser provides a mock function with given fields: ctx, userID
+func (_m *UserService) GetUser(ctx context.Context, userID int64) (domain.User, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUser")
+	}
+
+	var r0 domain.User
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) (domain.User, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) domain.User); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		r0 = ret.Get(0).(domain.User)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UserService_GetUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUser'
+type UserService_GetUser_Call struct {
+	*mock.Call
+}
+
+// GetUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID int64
+func (_e *UserService_Expecter) GetUser(ctx interface{}, userID interface{}) *UserService_GetUser_Call {
+	return &UserService_GetUser_Call{Call: _e.mock.On("GetUser", ctx, userID)}
+}
+
+func (_c *UserService_GetUser_Call) Run(run func(ctx context.Context, userID int64)) *UserService_GetUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *UserService_GetUser_Call) Return(_a0 domain.User, _a1 error) *UserService_GetUser_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *UserService_GetUser_Call) RunAndReturn(run func(context.Context, int64) (domain.User, error)) *UserService_GetUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateUserSettings provides a mock function with given fields: ctx, userID, req
+func (_m *UserService) UpdateUserSettings(ctx context.Context, userID int64, req dto.UserSettingsRequest) (domain.User, error) {
+	ret := _m.Called(ctx, userID, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateUserSettings")
+	}
+
+	var r0 domain.User
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, dto.UserSettingsRequest) (domain.User, error)); ok {
+		return rf(ctx, userID, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, dto.UserSettingsRequest) domain.User); ok {
+		r0 = rf(ctx, userID, req)
+	} else {
+		r0 = ret.Get(0).(domain.User)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, dto.UserSettingsRequest) error); ok {
+		r1 = rf(ctx, userID, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UserService_UpdateUserSettings_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateUserSettings'
+type UserService_UpdateUserSettings_Call struct {
+	*mock.Call
+}
+
+// UpdateUserSettings is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID int64
+//   - req dto.UserSettingsRequest
+func (_e *UserService_Expecter) UpdateUserSettings(ctx interface{}, userID interface{}, req interface{}) *UserService_UpdateUserSettings_Call {
+	return &UserService_UpdateUserSettings_Call{Call: _e.mock.On("UpdateUserSettings", ctx, userID, req)}
+}
+
+func (_c *UserService_UpdateUserSettings_Call) Run(run func(ctx context.Context, userID int64, req dto.UserSettingsRequest)) *UserService_UpdateUserSettings_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(dto.UserSettingsRequest))
+	})
+	return _c
+}
+
+func (_c *UserService_UpdateUserSettings_Call) Return(_a0 domain.User, _a1 error) *UserService_UpdateUserSettings_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *UserService_UpdateUserSettings_Call) RunAndReturn(run func(context.Context, int64, dto.UserSettingsRequest) (domain.User, error)) *UserService_UpdateUserSettings_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewUserService creates a new instance of UserService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewUserService(t interface {
@@ -0,0 +1,822 @@
+package archive
+
+import (
+	"archive/zip"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/rimvydascivilis/book-tracker/backend/domain"
+	"github.com/rimvydascivilis/book-tracker/backend/utils"
+)
+
+const (
+	manifestFile = "manifest.json"
+	// exportBatchSize is how many records are loaded per query while exporting.
+	exportBatchSize = 100
+)
+
+type ArchiveService struct {
+	bookRepo      domain.BookRepository
+	authorRepo    domain.AuthorRepository
+	readingRepo   domain.ReadingRepository
+	progressRepo  domain.ProgressRepository
+	goalRepo      domain.GoalRepository
+	listRepo      domain.ListRepository
+	listItemRepo  domain.ListItemRepository
+	noteRepo      domain.NoteRepository
+	txManager     domain.TxManager
+	validationSvc domain.ValidationService
+	schemaVersion int64
+}
+
+// NewArchiveService creates the service. schemaVersion is the newest
+// migration known to the binary; archives from newer schemas are refused.
+func NewArchiveService(bookRepo domain.BookRepository, authorRepo domain.AuthorRepository,
+	readingRepo domain.ReadingRepository, progressRepo domain.ProgressRepository, goalRepo domain.GoalRepository,
+	listRepo domain.ListRepository, listItemRepo domain.ListItemRepository, noteRepo domain.NoteRepository,
+	txManager domain.TxManager, validationSvc domain.ValidationService, schemaVersion int64) *ArchiveService {
+	return &ArchiveService{
+		bookRepo:      bookRepo,
+		authorRepo:    authorRepo,
+		readingRepo:   readingRepo,
+		progressRepo:  progressRepo,
+		goalRepo:      goalRepo,
+		listRepo:      listRepo,
+		listItemRepo:  listItemRepo,
+		noteRepo:      noteRepo,
+		txManager:     txManager,
+		validationSvc: validationSvc,
+		schemaVersion: schemaVersion,
+	}
+}
+
+// entity describes one exported file pair. each calls fn with every record of
+// the user and the record's CSV row, loading them in batches.
+type entity struct {
+	name   string
+	header []string
+	each   func(ctx context.Context, userID int64, fn func(record interface{}, row []string) error) error
+}
+
+func (s *ArchiveService) entities() []entity {
+	return []entity{
+		{
+			name: "books",
+			header: []string{"id", "title", "authors", "isbn10", "isbn13", "publisher", "publication_year",
+				"language", "description", "page_count", "rating", "created_at"},
+			each: s.eachBook,
+		},
+		{
+			name: "readings",
+			header: []string{"id", "book_id", "format", "total_pages", "link", "status", "started_at", "finished_at", "target_date",
+				"created_at", "updated_at"},
+			each: s.eachReading,
+		},
+		{
+			name:   "progress",
+			header: []string{"id", "reading_id", "pages", "position", "reading_date"},
+			each:   s.eachProgress,
+		},
+		{
+			name:   "goals",
+			header: []string{"id", "type", "frequency", "value"},
+			each:   s.eachGoal,
+		},
+		{
+			name:   "goal_versions",
+			header: []string{"goal_id", "type", "frequency", "value", "effective_from", "effective_to"},
+			each:   s.eachGoalVersion,
+		},
+		{
+			name:   "lists",
+			header: []string{"id", "title", "created_at"},
+			each:   s.eachList,
+		},
+		{
+			name:   "list_items",
+			header: []string{"id", "list_id", "book_id", "created_at"},
+			each:   s.eachListItem,
+		},
+		{
+			name:   "notes",
+			header: []string{"id", "book_id", "page_number", "content", "created_at"},
+			each:   s.eachNote,
+		},
+	}
+}
+
+// Export writes each entity as a JSON array and as CSV, followed by the
+// manifest. Every batch is read in one read-only transaction, so the files
+// agree with each other and with the manifest. The archive is built in a
+// temporary file and only sent once the transaction is over, so a slow client
+// does not hold up other requests; memory use does not grow with the size of
+// the library either.
+func (s *ArchiveService) Export(ctx context.Context, userID int64, w io.Writer) error {
+	f, err := os.CreateTemp("", "book-tracker-export-*.zip")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(f.Name())
+	defer f.Close()
+
+	err = s.txManager.WithinReadOnlyTransaction(ctx, func(ctx context.Context) error {
+		return s.writeArchive(ctx, userID, f)
+	})
+	if err != nil {
+		return err
+	}
+
+	if _, err := f.Seek(0, io.SeekStart); err != nil {
+		return err
+	}
+	_, err = io.Copy(w, f)
+	return err
+}
+
+// writeArchive writes the zip archive of the user to w, loading the records
+// in batches.
+func (s *ArchiveService) writeArchive(ctx context.Context, userID int64, w io.Writer) error {
+	zw := zip.NewWriter(w)
+	manifest := domain.ArchiveManifest{
+		Format:        domain.ArchiveFormat,
+		SchemaVersion: s.schemaVersion,
+		ExportedAt:    utils.Now(),
+		Entities:      map[string]int64{},
+	}
+
+	for _, e := range s.entities() {
+		count, err := writeJSON(ctx, zw, e, userID)
+		if err != nil {
+			return err
+		}
+		if err := writeCSV(ctx, zw, e, userID); err != nil {
+			return err
+		}
+		manifest.Entities[e.name] = count
+	}
+
+	f, err := zw.Create(manifestFile)
+	if err != nil {
+		return err
+	}
+	encoder := json.NewEncoder(f)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(manifest); err != nil {
+		return err
+	}
+
+	return zw.Close()
+}
+
+func writeJSON(ctx context.Context, zw *zip.Writer, e entity, userID int64) (int64, error) {
+	f, err := zw.Create(e.name + ".json")
+	if err != nil {
+		return 0, err
+	}
+	if _, err := io.WriteString(f, "["); err != nil {
+		return 0, err
+	}
+
+	var count int64
+	err = e.each(ctx, userID, func(record interface{}, _ []string) error {
+		data, err := json.Marshal(record)
+		if err != nil {
+			return err
+		}
+		sep := ",\n"
+		if count == 0 {
+			sep = "\n"
+		}
+		count++
+		if _, err := io.WriteString(f, sep); err != nil {
+			return err
+		}
+		_, err = f.Write(data)
+		return err
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	_, err = io.WriteString(f, "\n]\n")
+	return count, err
+}
+
+func writeCSV(ctx context.Context, zw *zip.Writer, e entity, userID int64) error {
+	f, err := zw.Create(e.name + ".csv")
+	if err != nil {
+		return err
+	}
+
+	cw := csv.NewWriter(f)
+	if err := cw.Write(e.header); err != nil {
+		return err
+	}
+	err = e.each(ctx, userID, func(_ interface{}, row []string) error {
+		return cw.Write(row)
+	})
+	if err != nil {
+		return err
+	}
+
+	cw.Flush()
+	return cw.Error()
+}
+
+func (s *ArchiveService) eachBook(ctx context.Context, userID int64, fn func(interface{}, []string) error) error {
+	for offset := int64(0); ; offset += exportBatchSize {
+		books, err := s.bookRepo.GetBooksByUser(ctx, userID, offset, exportBatchSize)
+		if err != nil {
+			return err
+		}
+
+		ids := make([]int64, 0, len(books))
+		for _, book := range books {
+			ids = append(ids, book.ID)
+		}
+		authors, err := s.authorRepo.GetAuthorsByBookIDs(ctx, ids)
+		if err != nil {
+			return err
+		}
+
+		for _, book := range books {
+			book.Authors = authors[book.ID]
+			if book.Authors == nil {
+				book.Authors = []domain.Author{}
+			}
+
+			names := make([]string, 0, len(book.Authors))
+			for _, author := range book.Authors {
+				names = append(names, author.Name)
+			}
+			row := []string{itoa(book.ID), book.Title, strings.Join(names, "; "), book.ISBN10, book.ISBN13,
+				book.Publisher, itoa(book.PublicationYear), book.Language, book.Description, itoa(book.PageCount),
+				strconv.FormatFloat(book.Rating, 'f', -1, 64), formatTime(book.CreatedAt)}
+			if err := fn(book, row); err != nil {
+				return err
+			}
+		}
+
+		if len(books) < exportBatchSize {
+			return nil
+		}
+	}
+}
+
+func (s *ArchiveService) eachReading(ctx context.Context, userID int64, fn func(interface{}, []string) error) error {
+	for offset := int64(0); ; offset += exportBatchSize {
+		readings, err := s.readingRepo.GetReadingsByUserID(ctx, userID, "", offset, exportBatchSize)
+		if err != nil {
+			return err
+		}
+
+		for _, reading := range readings {
+			row := []string{itoa(reading.ID), itoa(reading.BookID), reading.Format, itoa(reading.TotalPages), reading.Link, reading.Status,
+				formatDate(reading.StartedAt), formatDate(reading.FinishedAt), formatDate(reading.TargetDate),
+				formatTime(reading.CreatedAt), formatTime(reading.UpdatedAt)}
+			if err := fn(reading, row); err != nil {
+				return err
+			}
+		}
+
+		if len(readings) < exportBatchSize {
+			return nil
+		}
+	}
+}
+
+func (s *ArchiveService) eachProgress(ctx context.Context, userID int64, fn func(interface{}, []string) error) error {
+	for offset := int64(0); ; offset += exportBatchSize {
+		progress, err := s.progressRepo.GetProgressByUserID(ctx, userID, offset, exportBatchSize)
+		if err != nil {
+			return err
+		}
+
+		for _, p := range progress {
+			row := []string{itoa(p.ID), itoa(p.ReadingID), itoa(p.Pages), itoa(p.Position), p.ReadingDate.Format("2006-01-02")}
+			if err := fn(p, row); err != nil {
+				return err
+			}
+		}
+
+		if len(progress) < exportBatchSize {
+			return nil
+		}
+	}
+}
+
+func (s *ArchiveService) eachGoal(ctx context.Context, userID int64, fn func(interface{}, []string) error) error {
+	goals, err := s.goalRepo.GetGoalsByUserID(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	for _, goal := range goals {
+		if err := fn(goal, []string{itoa(goal.ID), goal.Type, goal.Frequency, itoa(goal.Value)}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *ArchiveService) eachGoalVersion(ctx context.Context, userID int64, fn func(interface{}, []string) error) error {
+	versions, err := s.goalRepo.GetGoalVersionsByUserID(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	for _, version := range versions {
+		effectiveTo := ""
+		if version.EffectiveTo != nil {
+			effectiveTo = version.EffectiveTo.Format("2006-01-02")
+		}
+		row := []string{itoa(version.GoalID), version.Type, version.Frequency, itoa(version.Value),
+			version.EffectiveFrom.Format("2006-01-02"), effectiveTo}
+		if err := fn(version, row); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *ArchiveService) eachList(ctx context.Context, userID int64, fn func(interface{}, []string) error) error {
+	lists, err := s.listRepo.GetListsByUserID(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	for _, list := range lists {
+		if err := fn(list, []string{itoa(list.ID), list.Title, formatTime(list.CreatedAt)}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *ArchiveService) eachListItem(ctx context.Context, userID int64, fn func(interface{}, []string) error) error {
+	lists, err := s.listRepo.GetListsByUserID(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	for _, list := range lists {
+		items, err := s.listItemRepo.GetListItemsByListID(ctx, list.ID)
+		if err != nil {
+			return err
+		}
+		for _, item := range items {
+			row := []string{itoa(item.ID), itoa(item.ListID), itoa(item.BookID), formatTime(item.CreatedAt)}
+			if err := fn(item, row); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (s *ArchiveService) eachNote(ctx context.Context, userID int64, fn func(interface{}, []string) error) error {
+	bookIDs, err := s.noteRepo.GetBookIDsByUserID(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	for _, bookID := range bookIDs {
+		notes, err := s.noteRepo.GetNotesByUserIDAndBookID(ctx, userID, bookID)
+		if err != nil {
+			return err
+		}
+		for _, note := range notes {
+			row := []string{itoa(note.ID), itoa(note.BookID), itoa(note.PageNumber), note.Content, formatTime(note.CreatedAt)}
+			if err := fn(note, row); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// Restore recreates every record of the archive for userID in a single
+// transaction. Only the JSON files are read; ids are reassigned and the
+// references between records are remapped to the new ids.
+func (s *ArchiveService) Restore(ctx context.Context, userID int64, r io.ReaderAt, size int64) (domain.ArchiveManifest, error) {
+	zr, err := zip.NewReader(r, size)
+	if err != nil {
+		return domain.ArchiveManifest{}, fmt.Errorf("%w: %s", domain.ErrValidation, "file is not a zip archive")
+	}
+
+	manifest, err := s.readManifest(zr)
+	if err != nil {
+		return domain.ArchiveManifest{}, err
+	}
+
+	restored := domain.ArchiveManifest{
+		Format:        domain.ArchiveFormat,
+		SchemaVersion: manifest.SchemaVersion,
+		ExportedAt:    manifest.ExportedAt,
+		Entities:      map[string]int64{},
+	}
+	err = s.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := s.requireEmptyAccount(ctx, userID); err != nil {
+			return err
+		}
+
+		rs := &restore{ArchiveService: s, userID: userID, zr: zr, counts: restored.Entities,
+			bookIDs: map[int64]int64{}, readingIDs: map[int64]int64{}, listIDs: map[int64]int64{},
+			goalIDs: map[int64]int64{}, positions: map[int64]int64{}}
+		return rs.run(ctx)
+	})
+	if err != nil {
+		return domain.ArchiveManifest{}, err
+	}
+
+	return restored, nil
+}
+
+func (s *ArchiveService) readManifest(zr *zip.Reader) (domain.ArchiveManifest, error) {
+	f, err := zr.Open(manifestFile)
+	if err != nil {
+		return domain.ArchiveManifest{}, fmt.Errorf("%w: %s", domain.ErrValidation, "archive has no manifest.json")
+	}
+	defer f.Close()
+
+	var manifest domain.ArchiveManifest
+	if err := json.NewDecoder(f).Decode(&manifest); err != nil {
+		return domain.ArchiveManifest{}, fmt.Errorf("%w: invalid manifest.json: %s", domain.ErrValidation, err)
+	}
+	if manifest.Format != domain.ArchiveFormat {
+		return domain.ArchiveManifest{}, fmt.Errorf("%w: %s", domain.ErrValidation, "archive was not exported by book tracker")
+	}
+	if manifest.SchemaVersion > s.schemaVersion {
+		return domain.ArchiveManifest{}, fmt.Errorf("%w: archive schema version %d is newer than %d",
+			domain.ErrValidation, manifest.SchemaVersion, s.schemaVersion)
+	}
+
+	return manifest, nil
+}
+
+func (s *ArchiveService) requireEmptyAccount(ctx context.Context, userID int64) error {
+	books, err := s.bookRepo.CountBooksByUser(ctx, userID)
+	if err != nil {
+		return err
+	}
+	readings, err := s.readingRepo.CountReadingsByUserID(ctx, userID, "")
+	if err != nil {
+		return err
+	}
+	lists, err := s.listRepo.GetListsByUserID(ctx, userID)
+	if err != nil {
+		return err
+	}
+	goals, err := s.goalRepo.GetGoalsByUserID(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	if books > 0 || readings > 0 || len(lists) > 0 || len(goals) > 0 {
+		return fmt.Errorf("%w: %s", domain.ErrAlreadyExists, "archives can only be restored into an empty account")
+	}
+	return nil
+}
+
+// restore holds the id mappings of a single Restore call.
+type restore struct {
+	*ArchiveService
+	userID int64
+	zr     *zip.Reader
+	counts map[string]int64
+
+	bookIDs    map[int64]int64
+	readingIDs map[int64]int64
+	listIDs    map[int64]int64
+	goalIDs    map[int64]int64
+	// goals are the restored goals, which start their history on the day of
+	// the restore when the archive has none.
+	goals []domain.Goal
+	// positions holds the running total of every restored reading, for
+	// archives made before progress had a position.
+	positions map[int64]int64
+}
+
+func (r *restore) run(ctx context.Context) error {
+	steps := []struct {
+		name string
+		fn   func(ctx context.Context, dec *json.Decoder) error
+	}{
+		{"books", r.book},
+		{"readings", r.reading},
+		{"progress", r.progress},
+		{"goals", r.goal},
+		{"goal_versions", r.goalVersion},
+		{"lists", r.list},
+		{"list_items", r.listItem},
+		{"notes", r.note},
+	}
+
+	for _, step := range steps {
+		count, err := decodeEach(ctx, r.zr, step.name+".json", step.fn)
+		if err != nil {
+			return err
+		}
+		r.counts[step.name] = count
+	}
+
+	if r.counts["goal_versions"] > 0 {
+		return nil
+	}
+	today := utils.Date(utils.Now())
+	for _, goal := range r.goals {
+		_, err := r.goalRepo.CreateGoalVersion(ctx, domain.GoalVersion{
+			GoalID: goal.ID, UserID: goal.UserID, Type: goal.Type, Frequency: goal.Frequency, Value: goal.Value,
+			EffectiveFrom: today,
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// decodeEach calls fn for every element of the JSON array in name. A missing
+// file counts as an empty array.
+func decodeEach(ctx context.Context, zr *zip.Reader, name string, fn func(context.Context, *json.Decoder) error) (int64, error) {
+	f, err := zr.Open(name)
+	if errors.Is(err, fs.ErrNotExist) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, fmt.Errorf("%w: %s: %s", domain.ErrValidation, name, err)
+	}
+	defer f.Close()
+
+	dec := json.NewDecoder(f)
+	if tok, err := dec.Token(); err != nil || tok != json.Delim('[') {
+		return 0, fmt.Errorf("%w: %s must contain a JSON array", domain.ErrValidation, name)
+	}
+
+	var count int64
+	for dec.More() {
+		if err := fn(ctx, dec); err != nil {
+			return 0, fmt.Errorf("%s record %d: %w", name, count+1, err)
+		}
+		count++
+	}
+
+	if _, err := dec.Token(); err != nil {
+		return 0, fmt.Errorf("%w: %s: %s", domain.ErrValidation, name, err)
+	}
+	return count, nil
+}
+
+func decode(dec *json.Decoder, v interface{}) error {
+	if err := dec.Decode(v); err != nil {
+		return fmt.Errorf("%w: %s", domain.ErrValidation, err)
+	}
+	return nil
+}
+
+func mapID(ids map[int64]int64, id int64, entity string) (int64, error) {
+	newID, ok := ids[id]
+	if !ok {
+		return 0, fmt.Errorf("%w: unknown %s %d", domain.ErrValidation, entity, id)
+	}
+	return newID, nil
+}
+
+func (r *restore) book(ctx context.Context, dec *json.Decoder) error {
+	var book domain.Book
+	if err := decode(dec, &book); err != nil {
+		return err
+	}
+
+	oldID := book.ID
+	book.ID = 0
+	book.UserID = r.userID
+	if err := r.validationSvc.ValidateStruct(book); err != nil {
+		return err
+	}
+
+	created, err := r.bookRepo.CreateBook(ctx, book)
+	if err != nil {
+		return err
+	}
+	r.bookIDs[oldID] = created.ID
+
+	if len(book.Authors) == 0 {
+		return nil
+	}
+	names := make([]string, 0, len(book.Authors))
+	for _, author := range book.Authors {
+		names = append(names, author.Name)
+	}
+	authors, err := r.authorRepo.GetOrCreateAuthors(ctx, names)
+	if err != nil {
+		return err
+	}
+	ids := make([]int64, 0, len(authors))
+	for _, author := range authors {
+		ids = append(ids, author.ID)
+	}
+	return r.authorRepo.SetBookAuthors(ctx, created.ID, ids)
+}
+
+func (r *restore) reading(ctx context.Context, dec *json.Decoder) error {
+	var reading domain.Reading
+	if err := decode(dec, &reading); err != nil {
+		return err
+	}
+
+	oldID := reading.ID
+	reading.ID = 0
+	reading.UserID = r.userID
+	bookID, err := mapID(r.bookIDs, reading.BookID, "book")
+	if err != nil {
+		return err
+	}
+	reading.BookID = bookID
+	if reading.Format == "" {
+		// Archives exported before readings had a format.
+		reading.Format = domain.ReadingFormatPrint
+	}
+	if reading.Status == "" {
+		// Archives exported before reading statuses were stored.
+		switch {
+		case reading.FinishedAt != nil:
+			reading.Status = domain.ReadingStatusCompleted
+		case reading.StartedAt != nil:
+			reading.Status = domain.ReadingStatusReading
+		default:
+			reading.Status = domain.ReadingStatusNotStarted
+		}
+	}
+	if err := r.validationSvc.ValidateStruct(reading); err != nil {
+		return err
+	}
+
+	created, err := r.readingRepo.CreateReading(ctx, reading)
+	if err != nil {
+		return err
+	}
+	r.readingIDs[oldID] = created.ID
+	return nil
+}
+
+func (r *restore) progress(ctx context.Context, dec *json.Decoder) error {
+	var progress domain.Progress
+	if err := decode(dec, &progress); err != nil {
+		return err
+	}
+
+	progress.ID = 0
+	progress.UserID = r.userID
+	readingID, err := mapID(r.readingIDs, progress.ReadingID, "reading")
+	if err != nil {
+		return err
+	}
+	progress.ReadingID = readingID
+	if progress.Position == 0 && progress.Pages > 0 {
+		progress.Position = r.positions[readingID] + progress.Pages
+	}
+	r.positions[readingID] = progress.Position
+	if err := r.validationSvc.ValidateStruct(progress); err != nil {
+		return err
+	}
+
+	_, err = r.progressRepo.CreateProgress(ctx, progress)
+	return err
+}
+
+func (r *restore) goal(ctx context.Context, dec *json.Decoder) error {
+	var goal domain.Goal
+	if err := decode(dec, &goal); err != nil {
+		return err
+	}
+
+	oldID := goal.ID
+	goal.ID = 0
+	goal.UserID = r.userID
+	if err := r.validationSvc.ValidateStruct(goal); err != nil {
+		return err
+	}
+
+	created, err := r.goalRepo.CreateGoal(ctx, goal)
+	if err != nil {
+		return err
+	}
+	r.goalIDs[oldID] = created.ID
+	r.goals = append(r.goals, created)
+	return nil
+}
+
+// goalVersion keeps the versions of deleted goals without a goal.
+func (r *restore) goalVersion(ctx context.Context, dec *json.Decoder) error {
+	var version domain.GoalVersion
+	if err := decode(dec, &version); err != nil {
+		return err
+	}
+
+	version.ID = 0
+	version.UserID = r.userID
+	if version.GoalID != 0 {
+		goalID, err := mapID(r.goalIDs, version.GoalID, "goal")
+		if err != nil {
+			return err
+		}
+		version.GoalID = goalID
+	}
+	if err := r.validationSvc.ValidateStruct(version); err != nil {
+		return err
+	}
+
+	_, err := r.goalRepo.CreateGoalVersion(ctx, version)
+	return err
+}
+
+func (r *restore) list(ctx context.Context, dec *json.Decoder) error {
+	var list domain.List
+	if err := decode(dec, &list); err != nil {
+		return err
+	}
+
+	oldID := list.ID
+	list.ID = 0
+	list.UserID = r.userID
+	if err := r.validationSvc.ValidateStruct(list); err != nil {
+		return err
+	}
+
+	created, err := r.listRepo.CreateList(ctx, list)
+	if err != nil {
+		return err
+	}
+	r.listIDs[oldID] = created.ID
+	return nil
+}
+
+func (r *restore) listItem(ctx context.Context, dec *json.Decoder) error {
+	var item domain.ListItem
+	if err := decode(dec, &item); err != nil {
+		return err
+	}
+
+	var err error
+	item.ID = 0
+	if item.ListID, err = mapID(r.listIDs, item.ListID, "list"); err != nil {
+		return err
+	}
+	if item.BookID, err = mapID(r.bookIDs, item.BookID, "book"); err != nil {
+		return err
+	}
+	if err := r.validationSvc.ValidateStruct(item); err != nil {
+		return err
+	}
+
+	_, err = r.listItemRepo.CreateListItem(ctx, item)
+	return err
+}
+
+func (r *restore) note(ctx context.Context, dec *json.Decoder) error {
+	var note domain.Note
+	if err := decode(dec, &note); err != nil {
+		return err
+	}
+
+	var err error
+	note.ID = 0
+	note.UserID = r.userID
+	if note.BookID, err = mapID(r.bookIDs, note.BookID, "book"); err != nil {
+		return err
+	}
+	if err := r.validationSvc.ValidateStruct(note); err != nil {
+		return err
+	}
+
+	_, err = r.noteRepo.CreateNote(ctx, note)
+	return err
+}
+
+func itoa(n int64) string {
+	return strconv.FormatInt(n, 10)
+}
+
+func formatDate(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return t.Format("2006-01-02")
+}
+
+func formatTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339)
+}
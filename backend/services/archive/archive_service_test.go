@@ -0,0 +1,345 @@
+package archive
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/rimvydascivilis/book-tracker/backend/domain"
+	"github.com/rimvydascivilis/book-tracker/backend/internal/repository/memory"
+	"github.com/rimvydascivilis/book-tracker/backend/services/validation"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testSchemaVersion = 3
+
+type fixture struct {
+	svc   *ArchiveService
+	store *memory.Store
+}
+
+func setupArchiveService() fixture {
+	store := memory.NewStore()
+	return fixture{
+		store: store,
+		svc: NewArchiveService(memory.NewBookRepository(store), memory.NewAuthorRepository(store),
+			memory.NewReadingRepository(store), memory.NewProgressRepository(store), memory.NewGoalRepository(store),
+			memory.NewListRepository(store), memory.NewListItemRepository(store), memory.NewNoteRepository(store),
+			memory.NewTxManager(store), validation.NewValidationService(), testSchemaVersion),
+	}
+}
+
+func (f fixture) createUser(t *testing.T, email string) domain.User {
+	t.Helper()
+
+	user, err := memory.NewUserRepository(f.store).CreateUser(context.Background(), domain.User{Email: email})
+	require.NoError(t, err)
+	return user
+}
+
+// seed gives the user a book with an author, a reading with progress, a goal
+// with its version, a list containing the book and a note.
+func (f fixture) seed(t *testing.T, userID int64) {
+	t.Helper()
+	ctx := context.Background()
+	now := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	target := time.Date(2024, 6, 30, 0, 0, 0, 0, time.UTC)
+
+	book, err := memory.NewBookRepository(f.store).CreateBook(ctx, domain.Book{UserID: userID, Title: "Dune", PageCount: 604})
+	require.NoError(t, err)
+	authorRepo := memory.NewAuthorRepository(f.store)
+	authors, err := authorRepo.GetOrCreateAuthors(ctx, []string{"Frank Herbert"})
+	require.NoError(t, err)
+	require.NoError(t, authorRepo.SetBookAuthors(ctx, book.ID, []int64{authors[0].ID}))
+
+	reading, err := memory.NewReadingRepository(f.store).CreateReading(ctx, domain.Reading{
+		UserID: userID, BookID: book.ID, TotalPages: 604, Status: domain.ReadingStatusPaused, StartedAt: &now,
+		TargetDate: &target, CreatedAt: now, UpdatedAt: now,
+	})
+	require.NoError(t, err)
+	_, err = memory.NewProgressRepository(f.store).CreateProgress(ctx, domain.Progress{
+		UserID: userID, ReadingID: reading.ID, Pages: 50, ReadingDate: now,
+	})
+	require.NoError(t, err)
+
+	goal, err := memory.NewGoalRepository(f.store).CreateGoal(ctx, domain.Goal{
+		UserID: userID, Type: domain.GoalTypeBooks, Frequency: domain.GoalFrequencyMonthly, Value: 2,
+	})
+	require.NoError(t, err)
+	_, err = memory.NewGoalRepository(f.store).CreateGoalVersion(ctx, domain.GoalVersion{
+		GoalID: goal.ID, UserID: userID, Type: goal.Type, Frequency: goal.Frequency, Value: goal.Value, EffectiveFrom: now,
+	})
+	require.NoError(t, err)
+
+	list, err := memory.NewListRepository(f.store).CreateList(ctx, domain.List{UserID: userID, Title: "Favourites"})
+	require.NoError(t, err)
+	_, err = memory.NewListItemRepository(f.store).CreateListItem(ctx, domain.ListItem{ListID: list.ID, BookID: book.ID})
+	require.NoError(t, err)
+
+	_, err = memory.NewNoteRepository(f.store).CreateNote(ctx, domain.Note{
+		UserID: userID, BookID: book.ID, PageNumber: 12, Content: "Fear is the mind-killer.",
+	})
+	require.NoError(t, err)
+}
+
+func (f fixture) export(t *testing.T, userID int64) []byte {
+	t.Helper()
+
+	var buf bytes.Buffer
+	require.NoError(t, f.svc.Export(context.Background(), userID, &buf))
+	return buf.Bytes()
+}
+
+func TestExport(t *testing.T) {
+	f := setupArchiveService()
+	user := f.createUser(t, "user@example.com")
+	f.seed(t, user.ID)
+
+	data := f.export(t, user.ID)
+
+	zr, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	require.NoError(t, err)
+
+	mf, err := zr.Open("manifest.json")
+	require.NoError(t, err)
+	var manifest domain.ArchiveManifest
+	require.NoError(t, json.NewDecoder(mf).Decode(&manifest))
+	assert.Equal(t, domain.ArchiveFormat, manifest.Format)
+	assert.Equal(t, int64(testSchemaVersion), manifest.SchemaVersion)
+	assert.Equal(t, map[string]int64{
+		"books": 1, "readings": 1, "progress": 1, "goals": 1, "goal_versions": 1, "lists": 1, "list_items": 1, "notes": 1,
+	}, manifest.Entities)
+
+	bf, err := zr.Open("books.json")
+	require.NoError(t, err)
+	var books []domain.Book
+	require.NoError(t, json.NewDecoder(bf).Decode(&books))
+	require.Len(t, books, 1)
+	assert.Equal(t, "Dune", books[0].Title)
+	require.Len(t, books[0].Authors, 1)
+	assert.Equal(t, "Frank Herbert", books[0].Authors[0].Name)
+
+	cf, err := zr.Open("notes.csv")
+	require.NoError(t, err)
+	rows, err := csv.NewReader(cf).ReadAll()
+	require.NoError(t, err)
+	require.Len(t, rows, 2)
+	assert.Equal(t, []string{"id", "book_id", "page_number", "content", "created_at"}, rows[0])
+	assert.Equal(t, "Fear is the mind-killer.", rows[1][3])
+
+	rf, err := zr.Open("readings.csv")
+	require.NoError(t, err)
+	rows, err = csv.NewReader(rf).ReadAll()
+	require.NoError(t, err)
+	require.Len(t, rows, 2)
+	require.Equal(t, "target_date", rows[0][8])
+	assert.Equal(t, "2024-06-30", rows[1][8])
+}
+
+// storeWriter writes a book to the store before taking the first bytes of
+// the archive.
+type storeWriter struct {
+	bytes.Buffer
+	write func() error
+}
+
+func (w *storeWriter) Write(p []byte) (int, error) {
+	if w.write != nil {
+		if err := w.write(); err != nil {
+			return 0, err
+		}
+		w.write = nil
+	}
+	return w.Buffer.Write(p)
+}
+
+func TestExport_SendsAfterTransaction(t *testing.T) {
+	f := setupArchiveService()
+	user := f.createUser(t, "user@example.com")
+	f.seed(t, user.ID)
+	// More than the zip writer buffers, even compressed, so that the archive
+	// is written while the records are being read.
+	random := rand.New(rand.NewSource(1))
+	content := make([]byte, 20000)
+	for i := range content {
+		content[i] = byte('a' + random.Intn(26))
+	}
+	_, err := memory.NewNoteRepository(f.store).CreateNote(context.Background(), domain.Note{
+		UserID: user.ID, BookID: 1, PageNumber: 1, Content: string(content),
+	})
+	require.NoError(t, err)
+	w := &storeWriter{write: func() error {
+		_, err := memory.NewBookRepository(f.store).CreateBook(context.Background(), domain.Book{UserID: user.ID, Title: "Emma"})
+		return err
+	}}
+
+	done := make(chan error, 1)
+	go func() { done <- f.svc.Export(context.Background(), user.ID, w) }()
+	select {
+	case err := <-done:
+		require.NoError(t, err)
+	case <-time.After(5 * time.Second):
+		t.Fatal("writes wait for the archive to be sent")
+	}
+
+	data := w.Bytes()
+	zr, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	require.NoError(t, err)
+	mf, err := zr.Open("manifest.json")
+	require.NoError(t, err)
+	var manifest domain.ArchiveManifest
+	require.NoError(t, json.NewDecoder(mf).Decode(&manifest))
+	assert.Equal(t, int64(1), manifest.Entities["books"], "the archive is the snapshot taken before sending")
+}
+
+func TestExport_EmptyAccount(t *testing.T) {
+	f := setupArchiveService()
+	user := f.createUser(t, "user@example.com")
+
+	data := f.export(t, user.ID)
+
+	zr, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	require.NoError(t, err)
+	bf, err := zr.Open("books.json")
+	require.NoError(t, err)
+	var books []domain.Book
+	require.NoError(t, json.NewDecoder(bf).Decode(&books))
+	assert.Empty(t, books)
+}
+
+func TestRestore(t *testing.T) {
+	f := setupArchiveService()
+	ctx := context.Background()
+	source := f.createUser(t, "source@example.com")
+	// Another user's book shifts the ids, so a missed remapping shows up.
+	other := f.createUser(t, "other@example.com")
+	f.seed(t, other.ID)
+	f.seed(t, source.ID)
+	target := f.createUser(t, "target@example.com")
+
+	data := f.export(t, source.ID)
+	manifest, err := f.svc.Restore(ctx, target.ID, bytes.NewReader(data), int64(len(data)))
+
+	require.NoError(t, err)
+	assert.Equal(t, map[string]int64{
+		"books": 1, "readings": 1, "progress": 1, "goals": 1, "goal_versions": 1, "lists": 1, "list_items": 1, "notes": 1,
+	}, manifest.Entities)
+
+	books, err := memory.NewBookRepository(f.store).GetBooksByUser(ctx, target.ID, 0, 10)
+	require.NoError(t, err)
+	require.Len(t, books, 1)
+	authors, err := memory.NewAuthorRepository(f.store).GetAuthorsByBookIDs(ctx, []int64{books[0].ID})
+	require.NoError(t, err)
+	require.Len(t, authors[books[0].ID], 1)
+	assert.Equal(t, "Frank Herbert", authors[books[0].ID][0].Name)
+
+	readings, err := memory.NewReadingRepository(f.store).GetReadingsByUserID(ctx, target.ID, "", 0, 10)
+	require.NoError(t, err)
+	require.Len(t, readings, 1)
+	assert.Equal(t, books[0].ID, readings[0].BookID)
+	assert.Equal(t, domain.ReadingStatusPaused, readings[0].Status)
+
+	progress, err := memory.NewProgressRepository(f.store).GetProgressByUserID(ctx, target.ID, 0, 10)
+	require.NoError(t, err)
+	require.Len(t, progress, 1)
+	assert.Equal(t, readings[0].ID, progress[0].ReadingID)
+	assert.Equal(t, int64(50), progress[0].Pages)
+
+	lists, err := memory.NewListRepository(f.store).GetListsByUserID(ctx, target.ID)
+	require.NoError(t, err)
+	require.Len(t, lists, 1)
+	items, err := memory.NewListItemRepository(f.store).GetListItemsByListID(ctx, lists[0].ID)
+	require.NoError(t, err)
+	require.Len(t, items, 1)
+	assert.Equal(t, books[0].ID, items[0].BookID)
+
+	notes, err := memory.NewNoteRepository(f.store).GetNotesByUserIDAndBookID(ctx, target.ID, books[0].ID)
+	require.NoError(t, err)
+	require.Len(t, notes, 1)
+
+	goals, err := memory.NewGoalRepository(f.store).GetGoalsByUserID(ctx, target.ID)
+	require.NoError(t, err)
+	require.Len(t, goals, 1)
+	assert.Equal(t, int64(2), goals[0].Value)
+	versions, err := memory.NewGoalRepository(f.store).GetGoalVersionsByUserID(ctx, target.ID)
+	require.NoError(t, err)
+	require.Len(t, versions, 1)
+	assert.Equal(t, goals[0].ID, versions[0].GoalID)
+	assert.Equal(t, "2024-05-01", versions[0].EffectiveFrom.Format("2006-01-02"))
+}
+
+func TestRestore_AccountNotEmpty(t *testing.T) {
+	f := setupArchiveService()
+	user := f.createUser(t, "user@example.com")
+	f.seed(t, user.ID)
+
+	data := f.export(t, user.ID)
+	_, err := f.svc.Restore(context.Background(), user.ID, bytes.NewReader(data), int64(len(data)))
+
+	assert.ErrorIs(t, err, domain.ErrAlreadyExists)
+}
+
+func TestRestore_NewerSchema(t *testing.T) {
+	f := setupArchiveService()
+	user := f.createUser(t, "user@example.com")
+
+	data := f.export(t, user.ID)
+	f.svc.schemaVersion = testSchemaVersion - 1
+	_, err := f.svc.Restore(context.Background(), user.ID, bytes.NewReader(data), int64(len(data)))
+
+	assert.ErrorIs(t, err, domain.ErrValidation)
+}
+
+func TestRestore_InvalidArchive(t *testing.T) {
+	f := setupArchiveService()
+	user := f.createUser(t, "user@example.com")
+
+	data := []byte("not a zip")
+	_, err := f.svc.Restore(context.Background(), user.ID, bytes.NewReader(data), int64(len(data)))
+	assert.ErrorIs(t, err, domain.ErrValidation)
+
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	w, err := zw.Create("books.json")
+	require.NoError(t, err)
+	_, err = w.Write([]byte("[]"))
+	require.NoError(t, err)
+	require.NoError(t, zw.Close())
+
+	_, err = f.svc.Restore(context.Background(), user.ID, bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	assert.ErrorIs(t, err, domain.ErrValidation)
+}
+
+func TestRestore_RollsBackOnError(t *testing.T) {
+	f := setupArchiveService()
+	ctx := context.Background()
+	user := f.createUser(t, "user@example.com")
+
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	files := map[string]string{
+		"manifest.json": `{"format":"book-tracker-export","schema_version":1}`,
+		"books.json":    `[{"id":7,"title":"Dune"}]`,
+		"readings.json": `[{"id":1,"book_id":99,"total_pages":10}]`,
+	}
+	for name, content := range files {
+		w, err := zw.Create(name)
+		require.NoError(t, err)
+		_, err = w.Write([]byte(content))
+		require.NoError(t, err)
+	}
+	require.NoError(t, zw.Close())
+
+	_, err := f.svc.Restore(ctx, user.ID, bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+
+	assert.ErrorIs(t, err, domain.ErrValidation)
+	count, err := memory.NewBookRepository(f.store).CountBooksByUser(ctx, user.ID)
+	require.NoError(t, err)
+	assert.Zero(t, count)
+}
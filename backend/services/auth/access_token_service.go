@@ -0,0 +1,98 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/rimvydascivilis/book-tracker/backend/domain"
+	"github.com/rimvydascivilis/book-tracker/backend/dto"
+	"github.com/rimvydascivilis/book-tracker/backend/utils"
+)
+
+// lastUsedPrecision is how stale the last use of a token may get, so that
+// scripts polling the API do not write on every request.
+const lastUsedPrecision = time.Minute
+
+type AccessTokenService struct {
+	repo      domain.AccessTokenRepository
+	validator domain.ValidationService
+}
+
+func NewAccessTokenService(repo domain.AccessTokenRepository, validator domain.ValidationService) *AccessTokenService {
+	return &AccessTokenService{
+		repo:      repo,
+		validator: validator,
+	}
+}
+
+func (s *AccessTokenService) GetAccessTokens(ctx context.Context, userID int64) ([]domain.AccessToken, error) {
+	return s.repo.GetAccessTokensByUserID(ctx, userID)
+}
+
+func (s *AccessTokenService) CreateAccessToken(ctx context.Context, userID int64, req dto.AccessTokenRequest) (domain.AccessToken, string, error) {
+	now := utils.Now()
+	token := domain.AccessToken{
+		UserID:    userID,
+		Name:      strings.TrimSpace(req.Name),
+		Scope:     req.Scope,
+		CreatedAt: now,
+		ExpiresAt: req.ExpiresAt,
+	}
+	if err := s.validator.ValidateStruct(token); err != nil {
+		return domain.AccessToken{}, "", err
+	}
+	if token.IsExpired(now) {
+		return domain.AccessToken{}, "", fmt.Errorf("%w: %s", domain.ErrValidation, "expires_at must be in the future")
+	}
+
+	secret, err := newOpaqueToken()
+	if err != nil {
+		return domain.AccessToken{}, "", err
+	}
+	secret = domain.AccessTokenPrefix + secret
+	token.TokenHash = hashToken(secret)
+
+	token, err = s.repo.CreateAccessToken(ctx, token)
+	if err != nil {
+		return domain.AccessToken{}, "", err
+	}
+	return token, secret, nil
+}
+
+func (s *AccessTokenService) DeleteAccessToken(ctx context.Context, userID, tokenID int64) error {
+	token, err := s.repo.GetAccessTokenByID(ctx, tokenID)
+	if err != nil {
+		return err
+	}
+	if token.UserID != userID {
+		return fmt.Errorf("%w: %s", domain.ErrForbidden, "access token does not belong to user")
+	}
+
+	return s.repo.DeleteAccessToken(ctx, tokenID)
+}
+
+func (s *AccessTokenService) Authenticate(ctx context.Context, secret string) (domain.AccessToken, error) {
+	token, err := s.repo.GetAccessTokenByHash(ctx, hashToken(secret))
+	if errors.Is(err, domain.ErrRecordNotFound) {
+		return domain.AccessToken{}, fmt.Errorf("%w: %s", domain.ErrAuthentication, "invalid access token")
+	}
+	if err != nil {
+		return domain.AccessToken{}, err
+	}
+
+	now := utils.Now()
+	if token.IsExpired(now) {
+		return domain.AccessToken{}, fmt.Errorf("%w: %s", domain.ErrAuthentication, "access token expired")
+	}
+
+	if token.LastUsedAt == nil || now.Sub(*token.LastUsedAt) >= lastUsedPrecision {
+		if err := s.repo.UpdateAccessTokenLastUsed(ctx, token.ID, now); err != nil {
+			return domain.AccessToken{}, err
+		}
+		token.LastUsedAt = &now
+	}
+	return token, nil
+}
@@ -0,0 +1,100 @@
+package auth
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/rimvydascivilis/book-tracker/backend/domain"
+	"github.com/rimvydascivilis/book-tracker/backend/dto"
+	"github.com/rimvydascivilis/book-tracker/backend/internal/repository/memory"
+	"github.com/rimvydascivilis/book-tracker/backend/services/validation"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func setupAccessTokenService(t *testing.T) (*AccessTokenService, *memory.AccessTokenRepository, domain.User) {
+	store := memory.NewStore()
+	user, err := memory.NewUserRepository(store).CreateUser(context.Background(), domain.User{Email: "test@example.com"})
+	require.NoError(t, err)
+
+	repo := memory.NewAccessTokenRepository(store)
+	return NewAccessTokenService(repo, validation.NewValidationService()), repo, user
+}
+
+func TestAccessTokenService_CreateAndAuthenticate(t *testing.T) {
+	svc, repo, user := setupAccessTokenService(t)
+	ctx := context.Background()
+
+	token, secret, err := svc.CreateAccessToken(ctx, user.ID, dto.AccessTokenRequest{
+		Name: " Kobo sync ", Scope: domain.AccessTokenScopeProgressWrite,
+	})
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(secret, domain.AccessTokenPrefix))
+	assert.Equal(t, "Kobo sync", token.Name)
+	assert.Equal(t, hashToken(secret), token.TokenHash, "only the hash is stored")
+	assert.Nil(t, token.LastUsedAt)
+
+	authenticated, err := svc.Authenticate(ctx, secret)
+	require.NoError(t, err)
+	assert.Equal(t, token.ID, authenticated.ID)
+	assert.Equal(t, user.ID, authenticated.UserID)
+	assert.Equal(t, domain.AccessTokenScopeProgressWrite, authenticated.Scope)
+
+	stored, err := repo.GetAccessTokenByID(ctx, token.ID)
+	require.NoError(t, err)
+	assert.NotNil(t, stored.LastUsedAt)
+
+	_, err = svc.Authenticate(ctx, secret+"x")
+	assert.ErrorIs(t, err, domain.ErrAuthentication)
+}
+
+func TestAccessTokenService_CreateAccessToken_Invalid(t *testing.T) {
+	svc, _, user := setupAccessTokenService(t)
+	ctx := context.Background()
+	past := time.Now().Add(-time.Hour)
+
+	for _, req := range []dto.AccessTokenRequest{
+		{Name: "", Scope: domain.AccessTokenScopeFull},
+		{Name: "Kobo sync", Scope: "admin"},
+		{Name: "Kobo sync", Scope: domain.AccessTokenScopeFull, ExpiresAt: &past},
+	} {
+		_, _, err := svc.CreateAccessToken(ctx, user.ID, req)
+		assert.ErrorIs(t, err, domain.ErrValidation, req)
+	}
+}
+
+func TestAccessTokenService_Authenticate_Expired(t *testing.T) {
+	svc, repo, user := setupAccessTokenService(t)
+	ctx := context.Background()
+	expiresAt := time.Now().Add(-time.Minute)
+	_, err := repo.CreateAccessToken(ctx, domain.AccessToken{
+		UserID: user.ID, Name: "old", Scope: domain.AccessTokenScopeReadOnly, TokenHash: hashToken("btpat_old"),
+		CreatedAt: time.Now().Add(-time.Hour), ExpiresAt: &expiresAt,
+	})
+	require.NoError(t, err)
+
+	_, err = svc.Authenticate(ctx, "btpat_old")
+
+	assert.ErrorIs(t, err, domain.ErrAuthentication)
+}
+
+func TestAccessTokenService_DeleteAccessToken(t *testing.T) {
+	svc, _, user := setupAccessTokenService(t)
+	ctx := context.Background()
+	token, secret, err := svc.CreateAccessToken(ctx, user.ID, dto.AccessTokenRequest{
+		Name: "Kobo sync", Scope: domain.AccessTokenScopeFull,
+	})
+	require.NoError(t, err)
+
+	err = svc.DeleteAccessToken(ctx, user.ID+1, token.ID)
+	assert.ErrorIs(t, err, domain.ErrForbidden)
+
+	require.NoError(t, svc.DeleteAccessToken(ctx, user.ID, token.ID))
+	_, err = svc.Authenticate(ctx, secret)
+	assert.ErrorIs(t, err, domain.ErrAuthentication)
+	tokens, err := svc.GetAccessTokens(ctx, user.ID)
+	require.NoError(t, err)
+	assert.Empty(t, tokens)
+}
@@ -2,39 +2,241 @@ package auth
 
 import (
 	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"time"
 
 	"github.com/rimvydascivilis/book-tracker/backend/domain"
+	"github.com/rimvydascivilis/book-tracker/backend/dto"
+	"github.com/rimvydascivilis/book-tracker/backend/utils"
 )
 
+// maxUserAgent is the length of auth_session.user_agent.
+const maxUserAgent = 255
+
 type AuthService struct {
-	userSvc   domain.UserService
-	oauth2Svc domain.OAuth2Service
-	tokenSvc  domain.TokenService
+	userSvc     domain.UserService
+	oauth2Svc   domain.OAuth2Service
+	tokenSvc    domain.TokenService
+	sessionRepo domain.AuthSessionRepository
+	txManager   domain.TxManager
+	// refreshTTL is how long a refresh token can be exchanged. Every refresh
+	// extends the session by as much.
+	refreshTTL time.Duration
 }
 
-func NewAuthService(userSvc domain.UserService, oauthSvc domain.OAuth2Service, tokenSvc domain.TokenService) *AuthService {
+func NewAuthService(userSvc domain.UserService, oauthSvc domain.OAuth2Service, tokenSvc domain.TokenService,
+	sessionRepo domain.AuthSessionRepository, txManager domain.TxManager, refreshTTL time.Duration) *AuthService {
 	return &AuthService{
-		userSvc:   userSvc,
-		oauth2Svc: oauthSvc,
-		tokenSvc:  tokenSvc,
+		userSvc:     userSvc,
+		oauth2Svc:   oauthSvc,
+		tokenSvc:    tokenSvc,
+		sessionRepo: sessionRepo,
+		txManager:   txManager,
+		refreshTTL:  refreshTTL,
 	}
 }
 
-func (a *AuthService) Login(ctx context.Context, token string) (string, error) {
+func (a *AuthService) Login(ctx context.Context, token, userAgent string) (dto.TokenResponse, error) {
 	email, err := a.oauth2Svc.ValidateToken(token)
 	if err != nil {
-		return "", err
+		return dto.TokenResponse{}, err
 	}
 
 	user, err := a.userSvc.GetOrCreateUser(ctx, email)
 	if err != nil {
-		return "", err
+		return dto.TokenResponse{}, err
 	}
 
-	jwtToken, err := a.tokenSvc.GenerateToken(ctx, user.ID)
+	return a.StartSession(ctx, user.ID, userAgent)
+}
+
+func (a *AuthService) StartSession(ctx context.Context, userID int64, userAgent string) (dto.TokenResponse, error) {
+	if len(userAgent) > maxUserAgent {
+		userAgent = userAgent[:maxUserAgent]
+	}
+
+	var tokens dto.TokenResponse
+	err := a.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		now := utils.Now()
+		session, err := a.sessionRepo.CreateSession(ctx, domain.AuthSession{
+			UserID:     userID,
+			UserAgent:  userAgent,
+			CreatedAt:  now,
+			LastUsedAt: now,
+			ExpiresAt:  now.Add(a.refreshTTL),
+		})
+		if err != nil {
+			return err
+		}
+
+		tokens, err = a.issueTokens(ctx, session)
+		return err
+	})
 	if err != nil {
+		return dto.TokenResponse{}, err
+	}
+	return tokens, nil
+}
+
+func (a *AuthService) Refresh(ctx context.Context, refreshToken string) (dto.TokenResponse, error) {
+	var (
+		tokens dto.TokenResponse
+		// reused is the session revoked for reusing a refresh token.
+		reused int64
+	)
+	err := a.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		stored, err := a.sessionRepo.GetRefreshTokenByHashForUpdate(ctx, hashToken(refreshToken))
+		if errors.Is(err, domain.ErrRecordNotFound) {
+			return fmt.Errorf("%w: %s", domain.ErrAuthentication, "invalid refresh token")
+		}
+		if err != nil {
+			return err
+		}
+		session, err := a.sessionRepo.GetSessionByID(ctx, stored.SessionID)
+		if err != nil {
+			return err
+		}
+
+		now := utils.Now()
+		if stored.UsedAt != nil {
+			// A token exchanged twice was copied and either copy may be
+			// the stolen one, so the whole session is signed out. The
+			// revocation is committed and the error returned afterwards.
+			reused = session.ID
+			if session.RevokedAt != nil {
+				return nil
+			}
+			session.RevokedAt = &now
+			_, err := a.sessionRepo.UpdateSession(ctx, session)
+			return err
+		}
+		if session.RevokedAt != nil || !now.Before(stored.ExpiresAt) {
+			return fmt.Errorf("%w: %s", domain.ErrAuthentication, "refresh token expired")
+		}
+
+		if err := a.sessionRepo.MarkRefreshTokenUsed(ctx, stored.ID, now); err != nil {
+			return err
+		}
+		session.LastUsedAt = now
+		session.ExpiresAt = now.Add(a.refreshTTL)
+		session, err = a.sessionRepo.UpdateSession(ctx, session)
+		if err != nil {
+			return err
+		}
+
+		tokens, err = a.issueTokens(ctx, session)
+		return err
+	})
+	if err != nil {
+		return dto.TokenResponse{}, err
+	}
+	if reused != 0 {
+		utils.Info("refresh token reused, session revoked", map[string]interface{}{"session_id": reused})
+		return dto.TokenResponse{}, fmt.Errorf("%w: %s", domain.ErrAuthentication, "refresh token already used, session revoked")
+	}
+	return tokens, nil
+}
+
+// issueTokens creates the next refresh token of the session and an access
+// token to go with it.
+func (a *AuthService) issueTokens(ctx context.Context, session domain.AuthSession) (dto.TokenResponse, error) {
+	refreshToken, err := newOpaqueToken()
+	if err != nil {
+		return dto.TokenResponse{}, err
+	}
+	_, err = a.sessionRepo.CreateRefreshToken(ctx, domain.RefreshToken{
+		SessionID: session.ID,
+		TokenHash: hashToken(refreshToken),
+		ExpiresAt: session.ExpiresAt,
+	})
+	if err != nil {
+		return dto.TokenResponse{}, err
+	}
+
+	accessToken, err := a.tokenSvc.GenerateToken(ctx, session.UserID, session.ID)
+	if err != nil {
+		return dto.TokenResponse{}, err
+	}
+
+	return dto.TokenResponse{
+		Token:        accessToken,
+		RefreshToken: refreshToken,
+		ExpiresIn:    int64(a.tokenSvc.TTL() / time.Second),
+	}, nil
+}
+
+func (a *AuthService) GetSessions(ctx context.Context, userID, currentSessionID int64) ([]dto.SessionResponse, error) {
+	sessions, err := a.sessionRepo.GetActiveSessionsByUserID(ctx, userID, utils.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]dto.SessionResponse, 0, len(sessions))
+	for _, session := range sessions {
+		res = append(res, dto.SessionResponse{
+			ID:         session.ID,
+			UserAgent:  session.UserAgent,
+			CreatedAt:  session.CreatedAt,
+			LastUsedAt: session.LastUsedAt,
+			Current:    session.ID == currentSessionID,
+		})
+	}
+	return res, nil
+}
+
+func (a *AuthService) RevokeSession(ctx context.Context, userID, sessionID int64) error {
+	return a.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		session, err := a.sessionRepo.GetSessionByID(ctx, sessionID)
+		if err != nil {
+			return err
+		}
+		if session.UserID != userID {
+			return fmt.Errorf("%w: %s", domain.ErrForbidden, "session does not belong to user")
+		}
+		if session.RevokedAt != nil {
+			return nil
+		}
+
+		now := utils.Now()
+		session.RevokedAt = &now
+		_, err = a.sessionRepo.UpdateSession(ctx, session)
+		return err
+	})
+}
+
+func (a *AuthService) RevokeAllSessions(ctx context.Context, userID int64) error {
+	return a.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		now := utils.Now()
+		sessions, err := a.sessionRepo.GetActiveSessionsByUserID(ctx, userID, now)
+		if err != nil {
+			return err
+		}
+		for _, session := range sessions {
+			session.RevokedAt = &now
+			if _, err := a.sessionRepo.UpdateSession(ctx, session); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// newOpaqueToken returns 32 random bytes, URL-safe encoded.
+func newOpaqueToken() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
 		return "", err
 	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
 
-	return jwtToken, nil
+// hashToken is the SHA-256 of token as stored in place of it.
+func hashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
 }
@@ -4,59 +4,208 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/rimvydascivilis/book-tracker/backend/domain"
+	"github.com/rimvydascivilis/book-tracker/backend/internal/repository/memory"
 	"github.com/rimvydascivilis/book-tracker/backend/mocks"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 )
 
-func setupAuthService() (*AuthService, *mocks.UserService, *mocks.OAuth2Service, *mocks.TokenService) {
-	userSvc := new(mocks.UserService)
-	oauth2Svc := new(mocks.OAuth2Service)
-	tokenService := new(mocks.TokenService)
+const (
+	testAccessTTL  = 15 * time.Minute
+	testRefreshTTL = 24 * time.Hour
+)
 
-	authService := NewAuthService(userSvc, oauth2Svc, tokenService)
+type fixture struct {
+	svc       *AuthService
+	userSvc   *mocks.UserService
+	oauth2Svc *mocks.OAuth2Service
+	sessions  *memory.AuthSessionRepository
+	user      domain.User
+}
 
-	return authService, userSvc, oauth2Svc, tokenService
+func setupAuthService(t *testing.T) fixture {
+	store := memory.NewStore()
+	user, err := memory.NewUserRepository(store).CreateUser(context.Background(), domain.User{Email: "test@example.com"})
+	require.NoError(t, err)
+
+	f := fixture{
+		userSvc:   new(mocks.UserService),
+		oauth2Svc: new(mocks.OAuth2Service),
+		sessions:  memory.NewAuthSessionRepository(store),
+		user:      user,
+	}
+	jwtSvc := NewJWTService("my_secret", testAccessTTL, memory.NewUserRepository(store))
+	f.svc = NewAuthService(f.userSvc, f.oauth2Svc, jwtSvc, f.sessions, memory.NewTxManager(store), testRefreshTTL)
+	return f
 }
 
-func TestAuthService_Login_Success(t *testing.T) {
-	authService, userSvc, oauth2Svc, tokenService := setupAuthService()
+func (f fixture) login(t *testing.T, userAgent string) (string, int64) {
+	t.Helper()
+	ctx := context.Background()
+
+	f.oauth2Svc.On("ValidateToken", "valid_oauth_token").Return(f.user.Email, nil)
+	f.userSvc.On("GetOrCreateUser", mock.Anything, f.user.Email).Return(f.user, nil)
+	tokens, err := f.svc.Login(ctx, "valid_oauth_token", userAgent)
+	require.NoError(t, err)
 
+	sessions, err := f.sessions.GetActiveSessionsByUserID(ctx, f.user.ID, time.Now())
+	require.NoError(t, err)
+	require.NotEmpty(t, sessions)
+	return tokens.RefreshToken, sessions[0].ID
+}
+
+func TestAuthService_Login_Success(t *testing.T) {
+	f := setupAuthService(t)
 	ctx := context.Background()
-	testToken := "valid_oauth_token"
-	testEmail := "test@example.com"
-	testUserID := int64(123)
-	expectedJWT := "generated_jwt_token"
 
-	oauth2Svc.On("ValidateToken", testToken).Return(testEmail, nil)
-	userSvc.On("GetOrCreateUser", ctx, testEmail).Return(domain.User{ID: testUserID, Email: testEmail}, nil)
-	tokenService.On("GenerateToken", ctx, testUserID).Return(expectedJWT, nil)
+	f.oauth2Svc.On("ValidateToken", "valid_oauth_token").Return(f.user.Email, nil)
+	f.userSvc.On("GetOrCreateUser", ctx, f.user.Email).Return(f.user, nil)
 
-	token, err := authService.Login(ctx, testToken)
+	tokens, err := f.svc.Login(ctx, "valid_oauth_token", "Firefox")
 
-	assert.NoError(t, err)
-	assert.Equal(t, expectedJWT, token)
+	require.NoError(t, err)
+	assert.NotEmpty(t, tokens.Token)
+	assert.NotEmpty(t, tokens.RefreshToken)
+	assert.Equal(t, int64(900), tokens.ExpiresIn)
 
-	oauth2Svc.AssertExpectations(t)
-	userSvc.AssertExpectations(t)
-	tokenService.AssertExpectations(t)
+	sessions, err := f.svc.GetSessions(ctx, f.user.ID, 0)
+	require.NoError(t, err)
+	require.Len(t, sessions, 1)
+	assert.Equal(t, "Firefox", sessions[0].UserAgent)
+
+	f.oauth2Svc.AssertExpectations(t)
+	f.userSvc.AssertExpectations(t)
 }
 
 func TestAuthService_Login_OAuth2ValidationError(t *testing.T) {
-	authService, userSvc, oauth2Svc, tokenService := setupAuthService()
-
+	f := setupAuthService(t)
 	ctx := context.Background()
-	testToken := "invalid_oauth_token"
 
-	oauth2Svc.On("ValidateToken", testToken).Return("", errors.New("invalid token"))
+	f.oauth2Svc.On("ValidateToken", "invalid_oauth_token").Return("", errors.New("invalid token"))
 
-	token, err := authService.Login(ctx, testToken)
+	tokens, err := f.svc.Login(ctx, "invalid_oauth_token", "Firefox")
 
 	assert.Error(t, err)
-	assert.Empty(t, token)
+	assert.Empty(t, tokens.Token)
+	f.userSvc.AssertNotCalled(t, "GetOrCreateUser", mock.Anything, mock.Anything)
+}
+
+func TestAuthService_Refresh_Rotates(t *testing.T) {
+	f := setupAuthService(t)
+	ctx := context.Background()
+	first, _ := f.login(t, "Firefox")
+
+	tokens, err := f.svc.Refresh(ctx, first)
+
+	require.NoError(t, err)
+	assert.NotEmpty(t, tokens.Token)
+	assert.NotEqual(t, first, tokens.RefreshToken)
+
+	again, err := f.svc.Refresh(ctx, tokens.RefreshToken)
+	require.NoError(t, err)
+	assert.NotEqual(t, tokens.RefreshToken, again.RefreshToken)
+}
+
+// TestAuthService_Refresh_ReuseRevokesSession presents a refresh token that
+// was already exchanged: the session is revoked, so the newer token no
+// longer works either.
+func TestAuthService_Refresh_ReuseRevokesSession(t *testing.T) {
+	f := setupAuthService(t)
+	ctx := context.Background()
+	first, sessionID := f.login(t, "Firefox")
+	tokens, err := f.svc.Refresh(ctx, first)
+	require.NoError(t, err)
+
+	_, err = f.svc.Refresh(ctx, first)
+	assert.ErrorIs(t, err, domain.ErrAuthentication)
+
+	session, err := f.sessions.GetSessionByID(ctx, sessionID)
+	require.NoError(t, err)
+	assert.NotNil(t, session.RevokedAt)
+	_, err = f.svc.Refresh(ctx, tokens.RefreshToken)
+	assert.ErrorIs(t, err, domain.ErrAuthentication)
+	sessions, err := f.svc.GetSessions(ctx, f.user.ID, sessionID)
+	require.NoError(t, err)
+	assert.Empty(t, sessions)
+}
 
-	oauth2Svc.AssertExpectations(t)
-	userSvc.AssertExpectations(t)
-	tokenService.AssertExpectations(t)
+func TestAuthService_Refresh_ReuseKeepsOtherSessions(t *testing.T) {
+	f := setupAuthService(t)
+	ctx := context.Background()
+	laptop, _ := f.login(t, "Firefox")
+	phone, phoneID := f.login(t, "Safari")
+	_, err := f.svc.Refresh(ctx, laptop)
+	require.NoError(t, err)
+
+	_, err = f.svc.Refresh(ctx, laptop)
+	assert.ErrorIs(t, err, domain.ErrAuthentication)
+
+	_, err = f.svc.Refresh(ctx, phone)
+	require.NoError(t, err)
+	sessions, err := f.svc.GetSessions(ctx, f.user.ID, phoneID)
+	require.NoError(t, err)
+	require.Len(t, sessions, 1)
+	assert.Equal(t, phoneID, sessions[0].ID)
+	assert.True(t, sessions[0].Current)
+}
+
+func TestAuthService_Refresh_Invalid(t *testing.T) {
+	f := setupAuthService(t)
+	ctx := context.Background()
+
+	_, err := f.svc.Refresh(ctx, "not-a-token")
+
+	assert.ErrorIs(t, err, domain.ErrAuthentication)
+}
+
+func TestAuthService_Refresh_Expired(t *testing.T) {
+	f := setupAuthService(t)
+	ctx := context.Background()
+	_, sessionID := f.login(t, "Firefox")
+	_, err := f.sessions.CreateRefreshToken(ctx, domain.RefreshToken{
+		SessionID: sessionID, TokenHash: hashToken("expired"), ExpiresAt: time.Now().Add(-time.Minute),
+	})
+	require.NoError(t, err)
+
+	_, err = f.svc.Refresh(ctx, "expired")
+
+	assert.ErrorIs(t, err, domain.ErrAuthentication)
+}
+
+func TestAuthService_RevokeSession(t *testing.T) {
+	f := setupAuthService(t)
+	ctx := context.Background()
+	refreshToken, sessionID := f.login(t, "Firefox")
+
+	err := f.svc.RevokeSession(ctx, f.user.ID+1, sessionID)
+	assert.ErrorIs(t, err, domain.ErrForbidden)
+
+	require.NoError(t, f.svc.RevokeSession(ctx, f.user.ID, sessionID))
+	_, err = f.svc.Refresh(ctx, refreshToken)
+	assert.ErrorIs(t, err, domain.ErrAuthentication)
+	assert.NoError(t, f.svc.RevokeSession(ctx, f.user.ID, sessionID), "revoking twice is not an error")
+
+	err = f.svc.RevokeSession(ctx, f.user.ID, sessionID+1)
+	assert.ErrorIs(t, err, domain.ErrRecordNotFound)
+}
+
+func TestAuthService_RevokeAllSessions(t *testing.T) {
+	f := setupAuthService(t)
+	ctx := context.Background()
+	first, _ := f.login(t, "Firefox")
+	second, _ := f.login(t, "Kobo")
+
+	require.NoError(t, f.svc.RevokeAllSessions(ctx, f.user.ID))
+
+	for _, refreshToken := range []string{first, second} {
+		_, err := f.svc.Refresh(ctx, refreshToken)
+		assert.ErrorIs(t, err, domain.ErrAuthentication)
+	}
+	sessions, err := f.svc.GetSessions(ctx, f.user.ID, 0)
+	require.NoError(t, err)
+	assert.Empty(t, sessions)
 }
@@ -11,16 +11,20 @@ import (
 type JWTService struct {
 	userRepo domain.UserRepository
 	secret   string
+	ttl      time.Duration
 }
 
-func NewJWTService(secret string, ur domain.UserRepository) *JWTService {
+func NewJWTService(secret string, ttl time.Duration, ur domain.UserRepository) *JWTService {
 	return &JWTService{
 		userRepo: ur,
 		secret:   secret,
+		ttl:      ttl,
 	}
 }
 
-func (j *JWTService) GenerateToken(ctx context.Context, userID int64) (string, error) {
+// GenerateToken signs an access token carrying the user in "id" and the
+// session in "sid".
+func (j *JWTService) GenerateToken(ctx context.Context, userID, sessionID int64) (string, error) {
 	user, err := j.userRepo.GetByID(ctx, userID)
 	if err != nil {
 		return "", err
@@ -28,7 +32,8 @@ func (j *JWTService) GenerateToken(ctx context.Context, userID int64) (string, e
 
 	claims := &jwt.MapClaims{
 		"id":  user.ID,
-		"exp": time.Now().Add(72 * time.Hour).Unix(), // Token expiry time: 3 days
+		"sid": sessionID,
+		"exp": time.Now().Add(j.ttl).Unix(),
 	}
 
 	jwtToken := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
@@ -39,3 +44,7 @@ func (j *JWTService) GenerateToken(ctx context.Context, userID int64) (string, e
 
 	return signedToken, nil
 }
+
+func (j *JWTService) TTL() time.Duration {
+	return j.ttl
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/golang-jwt/jwt/v5"
 	"github.com/rimvydascivilis/book-tracker/backend/domain"
@@ -14,14 +15,14 @@ import (
 func TestJWTService_GenerateToken_Success(t *testing.T) {
 	userRepo := new(mocks.UserRepository)
 	secret := "my_secret"
-	jwtService := NewJWTService(secret, userRepo)
+	jwtService := NewJWTService(secret, 15*time.Minute, userRepo)
 
 	ctx := context.Background()
 	userID := int64(123)
 
 	userRepo.On("GetByID", ctx, userID).Return(domain.User{ID: userID}, nil)
 
-	token, err := jwtService.GenerateToken(ctx, userID)
+	token, err := jwtService.GenerateToken(ctx, userID, 7)
 
 	assert.NoError(t, err)
 	assert.NotEmpty(t, token)
@@ -33,19 +34,23 @@ func TestJWTService_GenerateToken_Success(t *testing.T) {
 
 	assert.NoError(t, err)
 	assert.Equal(t, userID, int64((*claims)["id"].(float64)))
+	assert.Equal(t, int64(7), int64((*claims)["sid"].(float64)))
+	exp, err := claims.GetExpirationTime()
+	assert.NoError(t, err)
+	assert.WithinDuration(t, time.Now().Add(15*time.Minute), exp.Time, 5*time.Second)
 }
 
 func TestJWTService_GenerateToken_UserNotFound(t *testing.T) {
 	userRepo := new(mocks.UserRepository)
 	secret := "my_secret"
-	jwtService := NewJWTService(secret, userRepo)
+	jwtService := NewJWTService(secret, 15*time.Minute, userRepo)
 
 	ctx := context.Background()
 	userID := int64(456)
 
 	userRepo.On("GetByID", ctx, userID).Return(domain.User{}, errors.New("user not found"))
 
-	token, err := jwtService.GenerateToken(ctx, userID)
+	token, err := jwtService.GenerateToken(ctx, userID, 7)
 
 	assert.Error(t, err)
 	assert.Empty(t, token)
@@ -55,14 +60,14 @@ func TestJWTService_GenerateToken_UserNotFound(t *testing.T) {
 func TestJWTService_GenerateToken_InvalidUserID(t *testing.T) {
 	userRepo := new(mocks.UserRepository)
 	secret := "my_secret"
-	jwtService := NewJWTService(secret, userRepo)
+	jwtService := NewJWTService(secret, 15*time.Minute, userRepo)
 
 	ctx := context.Background()
 	invalidUserID := int64(999) // An ID that does not exist
 
 	userRepo.On("GetByID", ctx, invalidUserID).Return(domain.User{}, domain.ErrRecordNotFound)
 
-	token, err := jwtService.GenerateToken(ctx, invalidUserID)
+	token, err := jwtService.GenerateToken(ctx, invalidUserID, 7)
 
 	assert.Error(t, err)
 	assert.Empty(t, token)
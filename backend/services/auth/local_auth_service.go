@@ -0,0 +1,421 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/rimvydascivilis/book-tracker/backend/domain"
+	"github.com/rimvydascivilis/book-tracker/backend/dto"
+	"github.com/rimvydascivilis/book-tracker/backend/utils"
+)
+
+const (
+	// maxFailedLogins wrong passwords in a row lock an account for
+	// loginLockout.
+	maxFailedLogins = 5
+	loginLockout    = 15 * time.Minute
+
+	verifyEmailTTL   = 48 * time.Hour
+	resetPasswordTTL = time.Hour
+
+	// backgroundTimeout bounds the work done after a request has been
+	// answered, such as sending its email.
+	backgroundTimeout = time.Minute
+)
+
+// LocalAuthService signs users in with an email and password. Sessions are
+// started by the AuthService, so they are refreshed and revoked like any
+// other.
+type LocalAuthService struct {
+	authSvc       domain.AuthService
+	userRepo      domain.UserRepository
+	accountRepo   domain.LocalAccountRepository
+	mailer        domain.Mailer
+	txManager     domain.TxManager
+	validationSvc domain.ValidationService
+	// appURL is where the frontend is served; emails link to its
+	// /verify-email and /reset-password pages.
+	appURL string
+	// unknownEmails counts failed logins of emails without a local account.
+	unknownEmails *failureCounter
+	// pending tracks the work left running in the background.
+	pending sync.WaitGroup
+}
+
+func NewLocalAuthService(authSvc domain.AuthService, userRepo domain.UserRepository, accountRepo domain.LocalAccountRepository,
+	mailer domain.Mailer, txManager domain.TxManager, validator domain.ValidationService, appURL string) *LocalAuthService {
+	return &LocalAuthService{
+		authSvc:       authSvc,
+		userRepo:      userRepo,
+		accountRepo:   accountRepo,
+		mailer:        mailer,
+		txManager:     txManager,
+		validationSvc: validator,
+		appURL:        strings.TrimSuffix(appURL, "/"),
+		unknownEmails: newFailureCounter(),
+	}
+}
+
+func normalizeEmail(email string) string {
+	return strings.ToLower(strings.TrimSpace(email))
+}
+
+func (s *LocalAuthService) Register(ctx context.Context, req dto.PasswordRequest) error {
+	req.Email = normalizeEmail(req.Email)
+	if err := s.validationSvc.ValidateStruct(req); err != nil {
+		return err
+	}
+	hash, err := hashPassword(ctx, req.Password)
+	if err != nil {
+		return err
+	}
+
+	var email domain.Email
+	err = s.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		user, err := s.userRepo.GetByEmail(ctx, req.Email)
+		if errors.Is(err, domain.ErrRecordNotFound) {
+			user, err = s.userRepo.CreateUser(ctx, domain.User{Email: req.Email})
+		}
+		if err != nil {
+			return err
+		}
+
+		account, err := s.accountRepo.GetLocalAccountForUpdate(ctx, user.ID)
+		switch {
+		case errors.Is(err, domain.ErrRecordNotFound):
+			// Users who signed in with an OAuth provider get a password too,
+			// once they verify the email.
+			_, err = s.accountRepo.CreateLocalAccount(ctx, domain.LocalAccount{
+				UserID:       user.ID,
+				PasswordHash: hash,
+				CreatedAt:    utils.Now(),
+			})
+			if err != nil {
+				return err
+			}
+		case err != nil:
+			return err
+		case account.EmailVerifiedAt != nil:
+			// The password is left alone: only the owner of the email may
+			// change it, through a reset.
+			email = s.alreadyRegisteredEmail(user.Email)
+			return nil
+		default:
+			// Whoever registers last sets the password, and only the link
+			// sent to them verifies the account: someone else registering
+			// the email first cannot have their password verified by its
+			// owner.
+			account.PasswordHash = hash
+			if _, err := s.accountRepo.UpdateLocalAccount(ctx, account); err != nil {
+				return err
+			}
+			err = s.accountRepo.DeleteAccountTokens(ctx, user.ID, domain.AccountTokenVerifyEmail)
+			if err != nil {
+				return err
+			}
+		}
+
+		token, err := s.createToken(ctx, user.ID, domain.AccountTokenVerifyEmail, verifyEmailTTL)
+		if err != nil {
+			return err
+		}
+		email = s.verificationEmail(user.Email, token)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	s.background(ctx, "failed to send registration email", func(ctx context.Context) error {
+		return s.mailer.Send(ctx, email)
+	})
+	return nil
+}
+
+func (s *LocalAuthService) Login(ctx context.Context, req dto.PasswordRequest, userAgent string) (dto.TokenResponse, error) {
+	errInvalid := fmt.Errorf("%w: %s", domain.ErrAuthentication, "invalid email or password")
+	errLocked := fmt.Errorf("%w: %s", domain.ErrTooManyAttempts, "too many failed logins, try again later")
+
+	// Emails without a local account fail like a wrong password and are
+	// locked out like an account. Hashing takes as long as checking a
+	// password, so they cannot be told apart by the response time either.
+	email := normalizeEmail(req.Email)
+	failUnknown := func() (dto.TokenResponse, error) {
+		now := utils.Now()
+		if s.unknownEmails.locked(email, now) {
+			return dto.TokenResponse{}, errLocked
+		}
+		if _, err := hashPassword(ctx, req.Password); err != nil {
+			return dto.TokenResponse{}, err
+		}
+		s.unknownEmails.fail(email, now)
+		return dto.TokenResponse{}, errInvalid
+	}
+
+	user, err := s.userRepo.GetByEmail(ctx, email)
+	if errors.Is(err, domain.ErrRecordNotFound) {
+		return failUnknown()
+	}
+	if err != nil {
+		return dto.TokenResponse{}, err
+	}
+
+	account, err := s.accountRepo.GetLocalAccount(ctx, user.ID)
+	if errors.Is(err, domain.ErrRecordNotFound) {
+		return failUnknown()
+	}
+	if err != nil {
+		return dto.TokenResponse{}, err
+	}
+	if account.IsLocked(utils.Now()) {
+		return dto.TokenResponse{}, errLocked
+	}
+
+	// The password is checked outside the transaction, which would otherwise
+	// hold the account (or, in SQLite, the whole database) while hashing.
+	ok, err := checkPassword(ctx, req.Password, account.PasswordHash)
+	if err != nil {
+		return dto.TokenResponse{}, err
+	}
+	checkedHash := account.PasswordHash
+
+	// wrong is set for a wrong password. The failed attempt is committed and
+	// the error returned afterwards.
+	var wrong bool
+	err = s.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		account, err := s.accountRepo.GetLocalAccountForUpdate(ctx, user.ID)
+		if err != nil {
+			return err
+		}
+
+		// Failed logins committed while hashing may have locked the account.
+		now := utils.Now()
+		if account.IsLocked(now) {
+			return errLocked
+		}
+		// A password changed while hashing was not the one checked.
+		if account.PasswordHash != checkedHash {
+			wrong = true
+			return nil
+		}
+
+		if !ok {
+			wrong = true
+			account.FailedLogins++
+			if account.FailedLogins >= maxFailedLogins {
+				lockedUntil := now.Add(loginLockout)
+				account.LockedUntil = &lockedUntil
+				account.FailedLogins = 0
+				utils.Info("local account locked after failed logins", map[string]interface{}{"user_id": user.ID})
+			}
+			_, err := s.accountRepo.UpdateLocalAccount(ctx, account)
+			return err
+		}
+		if account.EmailVerifiedAt == nil {
+			return fmt.Errorf("%w: %s", domain.ErrAuthentication, "email is not verified")
+		}
+
+		if account.FailedLogins > 0 || account.LockedUntil != nil {
+			account.FailedLogins = 0
+			account.LockedUntil = nil
+			_, err = s.accountRepo.UpdateLocalAccount(ctx, account)
+		}
+		return err
+	})
+	if err != nil {
+		return dto.TokenResponse{}, err
+	}
+	if wrong {
+		return dto.TokenResponse{}, errInvalid
+	}
+
+	return s.authSvc.StartSession(ctx, user.ID, userAgent)
+}
+
+func (s *LocalAuthService) VerifyEmail(ctx context.Context, token string) error {
+	return s.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		stored, err := s.useToken(ctx, token, domain.AccountTokenVerifyEmail)
+		if err != nil {
+			return err
+		}
+		account, err := s.accountRepo.GetLocalAccountForUpdate(ctx, stored.UserID)
+		if err != nil {
+			return err
+		}
+		if account.EmailVerifiedAt != nil {
+			return nil
+		}
+
+		now := utils.Now()
+		account.EmailVerifiedAt = &now
+		_, err = s.accountRepo.UpdateLocalAccount(ctx, account)
+		return err
+	})
+}
+
+// RequestPasswordReset answers at once and mails the link in the
+// background, so that neither the answer nor its timing tell whether the
+// email has an account.
+func (s *LocalAuthService) RequestPasswordReset(ctx context.Context, email string) error {
+	s.background(ctx, "failed to send password reset email", func(ctx context.Context) error {
+		return s.requestPasswordReset(ctx, email)
+	})
+	return nil
+}
+
+func (s *LocalAuthService) requestPasswordReset(ctx context.Context, email string) error {
+	user, err := s.userRepo.GetByEmail(ctx, normalizeEmail(email))
+	if errors.Is(err, domain.ErrRecordNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	var token string
+	err = s.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		_, err := s.accountRepo.GetLocalAccountForUpdate(ctx, user.ID)
+		if err != nil {
+			return err
+		}
+		token, err = s.createToken(ctx, user.ID, domain.AccountTokenResetPassword, resetPasswordTTL)
+		return err
+	})
+	if errors.Is(err, domain.ErrRecordNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	return s.mailer.Send(ctx, s.resetEmail(user.Email, token))
+}
+
+func (s *LocalAuthService) ResetPassword(ctx context.Context, req dto.PasswordResetRequest) error {
+	if err := s.validationSvc.ValidateStruct(req); err != nil {
+		return err
+	}
+	hash, err := hashPassword(ctx, req.Password)
+	if err != nil {
+		return err
+	}
+
+	return s.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		stored, err := s.useToken(ctx, req.Token, domain.AccountTokenResetPassword)
+		if err != nil {
+			return err
+		}
+		account, err := s.accountRepo.GetLocalAccountForUpdate(ctx, stored.UserID)
+		if err != nil {
+			return err
+		}
+
+		// Following the link proves the email is the user's.
+		now := utils.Now()
+		account.PasswordHash = hash
+		if account.EmailVerifiedAt == nil {
+			account.EmailVerifiedAt = &now
+		}
+		account.FailedLogins = 0
+		account.LockedUntil = nil
+		if _, err := s.accountRepo.UpdateLocalAccount(ctx, account); err != nil {
+			return err
+		}
+		err = s.accountRepo.DeleteAccountTokens(ctx, stored.UserID, domain.AccountTokenResetPassword)
+		if err != nil {
+			return err
+		}
+
+		// Whoever knew the old password is signed out.
+		return s.authSvc.RevokeAllSessions(ctx, stored.UserID)
+	})
+}
+
+// background runs fn after the request that started it has been answered,
+// logging its error with msg.
+func (s *LocalAuthService) background(ctx context.Context, msg string, fn func(ctx context.Context) error) {
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), backgroundTimeout)
+	s.pending.Add(1)
+	go func() {
+		defer s.pending.Done()
+		defer cancel()
+		if err := fn(ctx); err != nil {
+			utils.Error(msg, err)
+		}
+	}()
+}
+
+// createToken stores a new token of the user for purpose and returns it.
+func (s *LocalAuthService) createToken(ctx context.Context, userID int64, purpose string, ttl time.Duration) (string, error) {
+	token, err := newOpaqueToken()
+	if err != nil {
+		return "", err
+	}
+	_, err = s.accountRepo.CreateAccountToken(ctx, domain.AccountToken{
+		UserID:    userID,
+		Purpose:   purpose,
+		TokenHash: hashToken(token),
+		ExpiresAt: utils.Now().Add(ttl),
+	})
+	if err != nil {
+		return "", err
+	}
+	return token, nil
+}
+
+// useToken marks the unused, unexpired token for purpose as used, or fails
+// with ErrAuthentication.
+func (s *LocalAuthService) useToken(ctx context.Context, token, purpose string) (domain.AccountToken, error) {
+	errInvalid := fmt.Errorf("%w: %s", domain.ErrAuthentication, "invalid or expired link")
+
+	stored, err := s.accountRepo.GetAccountTokenByHashForUpdate(ctx, hashToken(token))
+	if errors.Is(err, domain.ErrRecordNotFound) {
+		return domain.AccountToken{}, errInvalid
+	}
+	if err != nil {
+		return domain.AccountToken{}, err
+	}
+
+	now := utils.Now()
+	if stored.Purpose != purpose || stored.UsedAt != nil || !now.Before(stored.ExpiresAt) {
+		return domain.AccountToken{}, errInvalid
+	}
+	if err := s.accountRepo.MarkAccountTokenUsed(ctx, stored.ID, now); err != nil {
+		return domain.AccountToken{}, err
+	}
+	return stored, nil
+}
+
+func (s *LocalAuthService) verificationEmail(to, token string) domain.Email {
+	return domain.Email{
+		To:      to,
+		Subject: "Verify your Book Tracker email",
+		Body: fmt.Sprintf("Open this link to verify your email and finish signing up:\n\n%s/verify-email?token=%s\n\n"+
+			"The link expires in 48 hours. If you did not sign up for Book Tracker, ignore this email.\n",
+			s.appURL, token),
+	}
+}
+
+func (s *LocalAuthService) alreadyRegisteredEmail(to string) domain.Email {
+	return domain.Email{
+		To:      to,
+		Subject: "Your Book Tracker account",
+		Body: fmt.Sprintf("Someone tried to sign up for Book Tracker with this email, which already has an account. "+
+			"If it was you and you forgot your password, reset it here:\n\n%s/forgot-password\n\n"+
+			"Otherwise ignore this email; your account is unchanged.\n", s.appURL),
+	}
+}
+
+func (s *LocalAuthService) resetEmail(to, token string) domain.Email {
+	return domain.Email{
+		To:      to,
+		Subject: "Reset your Book Tracker password",
+		Body: fmt.Sprintf("Open this link to choose a new password:\n\n%s/reset-password?token=%s\n\n"+
+			"The link expires in an hour and signs out all your devices. If you did not ask for it, ignore this "+
+			"email; your password stays the same.\n", s.appURL, token),
+	}
+}
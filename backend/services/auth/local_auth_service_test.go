@@ -0,0 +1,369 @@
+package auth
+
+import (
+	"context"
+	"regexp"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/rimvydascivilis/book-tracker/backend/domain"
+	"github.com/rimvydascivilis/book-tracker/backend/dto"
+	"github.com/rimvydascivilis/book-tracker/backend/internal/repository/memory"
+	"github.com/rimvydascivilis/book-tracker/backend/services/validation"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// recordingMailer keeps the emails it is asked to send.
+type recordingMailer struct {
+	mu   sync.Mutex
+	sent []domain.Email
+}
+
+func (m *recordingMailer) Send(ctx context.Context, email domain.Email) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.sent = append(m.sent, email)
+	return nil
+}
+
+var tokenInLink = regexp.MustCompile(`token=([A-Za-z0-9_-]+)`)
+
+// lastToken returns the token linked in the last email sent.
+func (m *recordingMailer) lastToken(t *testing.T) string {
+	t.Helper()
+	require.NotEmpty(t, m.sent)
+	match := tokenInLink.FindStringSubmatch(m.sent[len(m.sent)-1].Body)
+	require.NotNil(t, match, "email links a token")
+	return match[1]
+}
+
+type localFixture struct {
+	svc      *LocalAuthService
+	mailer   *recordingMailer
+	users    *memory.UserRepository
+	accounts *memory.LocalAccountRepository
+	sessions *memory.AuthSessionRepository
+}
+
+func setupLocalAuthService(t *testing.T) localFixture {
+	// Hashing at full cost makes every test take seconds.
+	params := defaultArgon2Params
+	defaultArgon2Params = argon2Params{memory: 1024, time: 1, threads: 1, saltLen: 16, keyLen: 32}
+	t.Cleanup(func() { defaultArgon2Params = params })
+
+	store := memory.NewStore()
+	f := localFixture{
+		mailer:   &recordingMailer{},
+		users:    memory.NewUserRepository(store),
+		accounts: memory.NewLocalAccountRepository(store),
+		sessions: memory.NewAuthSessionRepository(store),
+	}
+	txManager := memory.NewTxManager(store)
+	jwtSvc := NewJWTService("my_secret", testAccessTTL, f.users)
+	authSvc := NewAuthService(nil, nil, jwtSvc, f.sessions, txManager, testRefreshTTL)
+	f.svc = NewLocalAuthService(authSvc, f.users, f.accounts, f.mailer, txManager, validation.NewValidationService(),
+		"https://books.example.com/")
+	return f
+}
+
+// register signs up and verifies email with password.
+func (f localFixture) register(t *testing.T, email, password string) {
+	t.Helper()
+	ctx := context.Background()
+	require.NoError(t, f.svc.Register(ctx, dto.PasswordRequest{Email: email, Password: password}))
+	require.NoError(t, f.svc.VerifyEmail(ctx, f.mail().lastToken(t)))
+}
+
+// mail returns the mailer once the emails being sent have been sent.
+func (f localFixture) mail() *recordingMailer {
+	f.svc.pending.Wait()
+	return f.mailer
+}
+
+func (f localFixture) login(email, password string) (dto.TokenResponse, error) {
+	return f.svc.Login(context.Background(), dto.PasswordRequest{Email: email, Password: password}, "Firefox")
+}
+
+func TestLocalAuthService_RegisterAndVerify(t *testing.T) {
+	f := setupLocalAuthService(t)
+	ctx := context.Background()
+
+	err := f.svc.Register(ctx, dto.PasswordRequest{Email: " Reader@Example.com", Password: "correct horse"})
+	require.NoError(t, err)
+	require.Len(t, f.mail().sent, 1)
+	assert.Equal(t, "reader@example.com", f.mail().sent[0].To)
+	assert.Contains(t, f.mail().sent[0].Body, "https://books.example.com/verify-email?token=")
+
+	_, err = f.login("reader@example.com", "correct horse")
+	assert.ErrorIs(t, err, domain.ErrAuthentication, "the email is not verified yet")
+
+	token := f.mail().lastToken(t)
+	require.NoError(t, f.svc.VerifyEmail(ctx, token))
+	assert.ErrorIs(t, f.svc.VerifyEmail(ctx, token), domain.ErrAuthentication, "links work once")
+
+	tokens, err := f.login("READER@example.com", "correct horse")
+	require.NoError(t, err)
+	assert.NotEmpty(t, tokens.Token)
+	assert.NotEmpty(t, tokens.RefreshToken)
+
+	user, err := f.users.GetByEmail(ctx, "reader@example.com")
+	require.NoError(t, err)
+	sessions, err := f.sessions.GetActiveSessionsByUserID(ctx, user.ID, time.Now())
+	require.NoError(t, err)
+	assert.Len(t, sessions, 1)
+}
+
+func TestLocalAuthService_Register_ExistingUser(t *testing.T) {
+	f := setupLocalAuthService(t)
+	ctx := context.Background()
+	google, err := f.users.CreateUser(ctx, domain.User{Email: "reader@example.com"})
+	require.NoError(t, err)
+
+	f.register(t, "reader@example.com", "correct horse")
+
+	_, err = f.login("reader@example.com", "correct horse")
+	require.NoError(t, err)
+	user, err := f.users.GetByEmail(ctx, "reader@example.com")
+	require.NoError(t, err)
+	assert.Equal(t, google.ID, user.ID, "the password signs in to the existing user")
+}
+
+func TestLocalAuthService_Register_Verified(t *testing.T) {
+	f := setupLocalAuthService(t)
+	f.register(t, "reader@example.com", "correct horse")
+
+	err := f.svc.Register(context.Background(), dto.PasswordRequest{Email: "reader@example.com", Password: "battery staple"})
+
+	require.NoError(t, err, "the response does not tell the email is taken")
+	assert.Contains(t, f.mail().sent[len(f.mail().sent)-1].Body, "already has an account")
+	_, err = f.login("reader@example.com", "battery staple")
+	assert.ErrorIs(t, err, domain.ErrAuthentication)
+	_, err = f.login("reader@example.com", "correct horse")
+	assert.NoError(t, err)
+}
+
+// TestLocalAuthService_Register_Unverified registers an email someone else
+// registered first: the earlier link no longer verifies the account.
+func TestLocalAuthService_Register_Unverified(t *testing.T) {
+	f := setupLocalAuthService(t)
+	ctx := context.Background()
+
+	require.NoError(t, f.svc.Register(ctx, dto.PasswordRequest{Email: "reader@example.com", Password: "squatter pass"}))
+	squatterLink := f.mail().lastToken(t)
+	require.NoError(t, f.svc.Register(ctx, dto.PasswordRequest{Email: "reader@example.com", Password: "correct horse"}))
+	ownerLink := f.mail().lastToken(t)
+
+	assert.ErrorIs(t, f.svc.VerifyEmail(ctx, squatterLink), domain.ErrAuthentication)
+	require.NoError(t, f.svc.VerifyEmail(ctx, ownerLink))
+
+	_, err := f.login("reader@example.com", "squatter pass")
+	assert.ErrorIs(t, err, domain.ErrAuthentication)
+	_, err = f.login("reader@example.com", "correct horse")
+	assert.NoError(t, err)
+}
+
+func TestLocalAuthService_Register_Invalid(t *testing.T) {
+	f := setupLocalAuthService(t)
+	ctx := context.Background()
+
+	err := f.svc.Register(ctx, dto.PasswordRequest{Email: "reader@example.com", Password: "short"})
+	assert.ErrorIs(t, err, domain.ErrValidation)
+	err = f.svc.Register(ctx, dto.PasswordRequest{Email: "not-an-email", Password: "correct horse"})
+	assert.ErrorIs(t, err, domain.ErrValidation)
+	assert.Empty(t, f.mail().sent)
+}
+
+func TestLocalAuthService_Login_Unknown(t *testing.T) {
+	f := setupLocalAuthService(t)
+	_, err := f.users.CreateUser(context.Background(), domain.User{Email: "google@example.com"})
+	require.NoError(t, err)
+
+	_, err = f.login("nobody@example.com", "correct horse")
+	assert.ErrorIs(t, err, domain.ErrAuthentication)
+	_, err = f.login("google@example.com", "correct horse")
+	assert.ErrorIs(t, err, domain.ErrAuthentication, "users without a password cannot sign in with one")
+}
+
+func TestLocalAuthService_Login_Throttled(t *testing.T) {
+	f := setupLocalAuthService(t)
+	ctx := context.Background()
+	f.register(t, "reader@example.com", "correct horse")
+
+	for i := 0; i < maxFailedLogins; i++ {
+		_, err := f.login("reader@example.com", "wrong password")
+		assert.ErrorIs(t, err, domain.ErrAuthentication)
+	}
+	_, err := f.login("reader@example.com", "correct horse")
+	assert.ErrorIs(t, err, domain.ErrTooManyAttempts, "locked even with the right password")
+
+	user, err := f.users.GetByEmail(ctx, "reader@example.com")
+	require.NoError(t, err)
+	account, err := f.accounts.GetLocalAccountForUpdate(ctx, user.ID)
+	require.NoError(t, err)
+	require.NotNil(t, account.LockedUntil)
+	assert.WithinDuration(t, time.Now().Add(loginLockout), *account.LockedUntil, time.Minute)
+
+	past := time.Now().Add(-time.Second)
+	account.LockedUntil = &past
+	_, err = f.accounts.UpdateLocalAccount(ctx, account)
+	require.NoError(t, err)
+
+	_, err = f.login("reader@example.com", "correct horse")
+	require.NoError(t, err)
+	account, err = f.accounts.GetLocalAccountForUpdate(ctx, user.ID)
+	require.NoError(t, err)
+	assert.Nil(t, account.LockedUntil)
+	assert.Zero(t, account.FailedLogins)
+}
+
+// racingAccounts runs changed after Login reads the account to check the
+// password, as if another request committed while it was hashing.
+type racingAccounts struct {
+	*memory.LocalAccountRepository
+	changed func(account domain.LocalAccount)
+}
+
+func (r racingAccounts) GetLocalAccount(ctx context.Context, userID int64) (domain.LocalAccount, error) {
+	account, err := r.LocalAccountRepository.GetLocalAccount(ctx, userID)
+	if err == nil {
+		r.changed(account)
+	}
+	return account, err
+}
+
+func TestLocalAuthService_Login_LockedWhileHashing(t *testing.T) {
+	f := setupLocalAuthService(t)
+	f.register(t, "reader@example.com", "correct horse")
+	f.svc.accountRepo = racingAccounts{f.accounts, func(account domain.LocalAccount) {
+		// Outside a transaction, or this would wait for it to end.
+		lockedUntil := time.Now().Add(loginLockout)
+		account.LockedUntil = &lockedUntil
+		_, err := f.accounts.UpdateLocalAccount(context.Background(), account)
+		require.NoError(t, err)
+	}}
+
+	_, err := f.login("reader@example.com", "correct horse")
+
+	assert.ErrorIs(t, err, domain.ErrTooManyAttempts, "the lockout is checked again before signing in")
+}
+
+func TestLocalAuthService_Login_PasswordChangedWhileHashing(t *testing.T) {
+	f := setupLocalAuthService(t)
+	ctx := context.Background()
+	f.register(t, "reader@example.com", "correct horse")
+	f.svc.accountRepo = racingAccounts{f.accounts, func(account domain.LocalAccount) {
+		hash, err := hashPassword(ctx, "battery staple")
+		require.NoError(t, err)
+		account.PasswordHash = hash
+		_, err = f.accounts.UpdateLocalAccount(ctx, account)
+		require.NoError(t, err)
+	}}
+
+	_, err := f.login("reader@example.com", "correct horse")
+	assert.ErrorIs(t, err, domain.ErrAuthentication, "the old password no longer signs in")
+
+	user, err := f.users.GetByEmail(ctx, "reader@example.com")
+	require.NoError(t, err)
+	account, err := f.accounts.GetLocalAccount(ctx, user.ID)
+	require.NoError(t, err)
+	assert.Zero(t, account.FailedLogins, "the right password at the time is not a failed login")
+}
+
+// TestLocalAuthService_Login_ThrottledUnknown locks out emails without a
+// local account like accounts, so a lockout does not reveal which exist.
+func TestLocalAuthService_Login_ThrottledUnknown(t *testing.T) {
+	f := setupLocalAuthService(t)
+	_, err := f.users.CreateUser(context.Background(), domain.User{Email: "google@example.com"})
+	require.NoError(t, err)
+
+	for _, email := range []string{"nobody@example.com", "google@example.com"} {
+		for i := 0; i < maxFailedLogins; i++ {
+			_, err := f.login(email, "wrong password")
+			assert.ErrorIs(t, err, domain.ErrAuthentication, email)
+		}
+		_, err := f.login(email, "wrong password")
+		assert.ErrorIs(t, err, domain.ErrTooManyAttempts, email)
+	}
+
+	_, err = f.login("other@example.com", "wrong password")
+	assert.ErrorIs(t, err, domain.ErrAuthentication, "other emails are not locked")
+}
+
+func TestLocalAuthService_ResetPassword(t *testing.T) {
+	f := setupLocalAuthService(t)
+	ctx := context.Background()
+	f.register(t, "reader@example.com", "correct horse")
+	_, err := f.login("reader@example.com", "correct horse")
+	require.NoError(t, err)
+
+	require.NoError(t, f.svc.RequestPasswordReset(ctx, "Reader@example.com"))
+	token := f.mail().lastToken(t)
+	assert.Contains(t, f.mail().sent[len(f.mail().sent)-1].Body, "https://books.example.com/reset-password?token=")
+
+	err = f.svc.ResetPassword(ctx, dto.PasswordResetRequest{Token: token, Password: "battery staple"})
+	require.NoError(t, err)
+
+	user, err := f.users.GetByEmail(ctx, "reader@example.com")
+	require.NoError(t, err)
+	sessions, err := f.sessions.GetActiveSessionsByUserID(ctx, user.ID, time.Now())
+	require.NoError(t, err)
+	assert.Empty(t, sessions, "resetting signs every device out")
+
+	_, err = f.login("reader@example.com", "correct horse")
+	assert.ErrorIs(t, err, domain.ErrAuthentication)
+	_, err = f.login("reader@example.com", "battery staple")
+	assert.NoError(t, err)
+
+	err = f.svc.ResetPassword(ctx, dto.PasswordResetRequest{Token: token, Password: "another one"})
+	assert.ErrorIs(t, err, domain.ErrAuthentication, "links work once")
+}
+
+func TestLocalAuthService_ResetPassword_VerifiesEmail(t *testing.T) {
+	f := setupLocalAuthService(t)
+	ctx := context.Background()
+	require.NoError(t, f.svc.Register(ctx, dto.PasswordRequest{Email: "reader@example.com", Password: "correct horse"}))
+	verifyToken := f.mail().lastToken(t)
+
+	require.NoError(t, f.svc.RequestPasswordReset(ctx, "reader@example.com"))
+	resetToken := f.mail().lastToken(t)
+	assert.ErrorIs(t, f.svc.ResetPassword(ctx, dto.PasswordResetRequest{Token: verifyToken, Password: "battery staple"}),
+		domain.ErrAuthentication, "links only work for their purpose")
+	require.NoError(t, f.svc.ResetPassword(ctx, dto.PasswordResetRequest{Token: resetToken, Password: "battery staple"}))
+
+	_, err := f.login("reader@example.com", "battery staple")
+	assert.NoError(t, err)
+}
+
+func TestLocalAuthService_ResetPassword_Expired(t *testing.T) {
+	f := setupLocalAuthService(t)
+	ctx := context.Background()
+	f.register(t, "reader@example.com", "correct horse")
+	user, err := f.users.GetByEmail(ctx, "reader@example.com")
+	require.NoError(t, err)
+
+	token := "expired-token"
+	_, err = f.accounts.CreateAccountToken(ctx, domain.AccountToken{
+		UserID:    user.ID,
+		Purpose:   domain.AccountTokenResetPassword,
+		TokenHash: hashToken(token),
+		ExpiresAt: time.Now().Add(-time.Minute),
+	})
+	require.NoError(t, err)
+
+	err = f.svc.ResetPassword(ctx, dto.PasswordResetRequest{Token: token, Password: "battery staple"})
+
+	assert.ErrorIs(t, err, domain.ErrAuthentication)
+}
+
+func TestLocalAuthService_RequestPasswordReset_Unknown(t *testing.T) {
+	f := setupLocalAuthService(t)
+	ctx := context.Background()
+	_, err := f.users.CreateUser(ctx, domain.User{Email: "google@example.com"})
+	require.NoError(t, err)
+
+	assert.NoError(t, f.svc.RequestPasswordReset(ctx, "nobody@example.com"))
+	assert.NoError(t, f.svc.RequestPasswordReset(ctx, "google@example.com"))
+	assert.Empty(t, f.mail().sent)
+}
@@ -0,0 +1,268 @@
+package auth
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/rimvydascivilis/book-tracker/backend/domain"
+)
+
+const (
+	// jwksCacheTTL is how long the keys of a provider are used before they
+	// are fetched again.
+	jwksCacheTTL = time.Hour
+	// jwksMinRefresh limits how often tokens signed with an unknown key can
+	// make the keys be fetched again, as happens after a key rotation.
+	jwksMinRefresh = time.Minute
+	// clockSkew is the leeway given to the expiry and issue times of tokens.
+	clockSkew = time.Minute
+)
+
+// signingMethods are the algorithms ID tokens may be signed with. HMAC is
+// left out: its key is the client secret, which this server does not have.
+var signingMethods = []string{"RS256", "RS384", "RS512", "PS256", "PS384", "PS512", "ES256", "ES384", "ES512"}
+
+// OIDCService validates ID tokens of several OpenID Connect providers. The
+// provider of a token is the one whose issuer matches its iss claim; its
+// signature is verified locally with the keys the provider publishes.
+type OIDCService struct {
+	// providers are keyed by issuer.
+	providers map[string]*oidcProvider
+}
+
+func NewOIDCService(providers []domain.OIDCProvider, client *http.Client) *OIDCService {
+	svc := &OIDCService{
+		providers: make(map[string]*oidcProvider, len(providers)),
+	}
+	for _, p := range providers {
+		svc.providers[p.Issuer] = &oidcProvider{
+			OIDCProvider: p,
+			client:       client,
+		}
+	}
+	return svc
+}
+
+type idTokenClaims struct {
+	jwt.RegisteredClaims
+	Email string `json:"email"`
+	// EmailVerified is a bool, or a string with some providers.
+	EmailVerified interface{} `json:"email_verified"`
+}
+
+// ValidateToken returns the email of the user the ID token was issued to.
+func (s *OIDCService) ValidateToken(token string) (string, error) {
+	var unverified jwt.RegisteredClaims
+	if _, _, err := jwt.NewParser().ParseUnverified(token, &unverified); err != nil {
+		return "", fmt.Errorf("%w: %s", domain.ErrAuthentication, "malformed id token")
+	}
+	provider, ok := s.providers[unverified.Issuer]
+	if !ok {
+		return "", fmt.Errorf("%w: unknown issuer %q", domain.ErrAuthentication, unverified.Issuer)
+	}
+
+	var claims idTokenClaims
+	parser := jwt.NewParser(
+		jwt.WithValidMethods(signingMethods),
+		jwt.WithIssuer(provider.Issuer),
+		jwt.WithAudience(provider.ClientID),
+		jwt.WithExpirationRequired(),
+		jwt.WithLeeway(clockSkew),
+	)
+	_, err := parser.ParseWithClaims(token, &claims, provider.keyFunc)
+	if err != nil {
+		if errors.Is(err, domain.ErrUpstream) {
+			return "", err
+		}
+		return "", fmt.Errorf("%w: %s: %s", domain.ErrAuthentication, provider.Name, err)
+	}
+
+	if claims.Email == "" {
+		return "", fmt.Errorf("%w: %s", domain.ErrAuthentication, "id token does not contain email")
+	}
+	if verified, _ := claims.EmailVerified.(bool); !verified && claims.EmailVerified != "true" {
+		return "", fmt.Errorf("%w: %s", domain.ErrAuthentication, "email is not verified")
+	}
+
+	return claims.Email, nil
+}
+
+// oidcProvider discovers its keys on first use and caches them.
+type oidcProvider struct {
+	domain.OIDCProvider
+	client *http.Client
+
+	mu        sync.Mutex
+	jwksURI   string
+	keys      map[string]crypto.PublicKey
+	fetchedAt time.Time
+}
+
+func (p *oidcProvider) keyFunc(token *jwt.Token) (interface{}, error) {
+	kid, _ := token.Header["kid"].(string)
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	stale := time.Since(p.fetchedAt) > jwksCacheTTL
+	_, known := p.keys[kid]
+	if stale || (!known && time.Since(p.fetchedAt) > jwksMinRefresh) {
+		if err := p.fetchKeys(); err != nil {
+			return nil, err
+		}
+	}
+
+	if key, ok := p.keys[kid]; ok {
+		return key, nil
+	}
+	// Providers with a single key may leave kid out of their tokens.
+	if kid == "" && len(p.keys) == 1 {
+		for _, key := range p.keys {
+			return key, nil
+		}
+	}
+	return nil, fmt.Errorf("unknown signing key %q", kid)
+}
+
+// fetchKeys loads the keys of the provider, discovering where they are
+// published first. p.mu must be held.
+func (p *oidcProvider) fetchKeys() error {
+	if p.jwksURI == "" {
+		var discovery struct {
+			Issuer  string `json:"issuer"`
+			JWKSURI string `json:"jwks_uri"`
+		}
+		url := strings.TrimSuffix(p.Issuer, "/") + "/.well-known/openid-configuration"
+		if err := p.getJSON(url, &discovery); err != nil {
+			return err
+		}
+		if discovery.Issuer != p.Issuer {
+			return fmt.Errorf("%w: %s announces issuer %q", domain.ErrUpstream, p.Name, discovery.Issuer)
+		}
+		if discovery.JWKSURI == "" {
+			return fmt.Errorf("%w: %s announces no jwks_uri", domain.ErrUpstream, p.Name)
+		}
+		p.jwksURI = discovery.JWKSURI
+	}
+
+	var jwks struct {
+		Keys []jsonWebKey `json:"keys"`
+	}
+	if err := p.getJSON(p.jwksURI, &jwks); err != nil {
+		return err
+	}
+
+	keys := make(map[string]crypto.PublicKey, len(jwks.Keys))
+	for _, jwk := range jwks.Keys {
+		if jwk.Use != "" && jwk.Use != "sig" {
+			continue
+		}
+		key, err := jwk.publicKey()
+		if err != nil {
+			// Keys of a type that is not supported are skipped, the
+			// provider may publish others.
+			continue
+		}
+		keys[jwk.Kid] = key
+	}
+	p.keys = keys
+	p.fetchedAt = time.Now()
+	return nil
+}
+
+func (p *oidcProvider) getJSON(url string, v interface{}) error {
+	res, err := p.client.Get(url)
+	if err != nil {
+		return fmt.Errorf("%w: %s: %s", domain.ErrUpstream, p.Name, err)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return fmt.Errorf("%w: %s: GET %s returned %d", domain.ErrUpstream, p.Name, url, res.StatusCode)
+	}
+	if err := json.NewDecoder(res.Body).Decode(v); err != nil {
+		return fmt.Errorf("%w: %s: %s", domain.ErrUpstream, p.Name, err)
+	}
+	return nil
+}
+
+// jsonWebKey is a public key of a JWKS, RFC 7517.
+type jsonWebKey struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	// N and E are the modulus and exponent of RSA keys.
+	N string `json:"n"`
+	E string `json:"e"`
+	// Crv, X and Y are the curve and point of EC keys.
+	Crv string `json:"crv"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+}
+
+func (k jsonWebKey) publicKey() (crypto.PublicKey, error) {
+	switch k.Kty {
+	case "RSA":
+		n, err := decodeBigInt(k.N)
+		if err != nil {
+			return nil, err
+		}
+		e, err := decodeBigInt(k.E)
+		if err != nil {
+			return nil, err
+		}
+		if !e.IsInt64() {
+			return nil, errors.New("rsa exponent too large")
+		}
+		return &rsa.PublicKey{N: n, E: int(e.Int64())}, nil
+	case "EC":
+		var curve elliptic.Curve
+		switch k.Crv {
+		case "P-256":
+			curve = elliptic.P256()
+		case "P-384":
+			curve = elliptic.P384()
+		case "P-521":
+			curve = elliptic.P521()
+		default:
+			return nil, fmt.Errorf("unsupported curve %q", k.Crv)
+		}
+		x, err := decodeBigInt(k.X)
+		if err != nil {
+			return nil, err
+		}
+		y, err := decodeBigInt(k.Y)
+		if err != nil {
+			return nil, err
+		}
+		if !curve.IsOnCurve(x, y) {
+			return nil, errors.New("ec point is not on the curve")
+		}
+		return &ecdsa.PublicKey{Curve: curve, X: x, Y: y}, nil
+	default:
+		return nil, fmt.Errorf("unsupported key type %q", k.Kty)
+	}
+}
+
+func decodeBigInt(s string) (*big.Int, error) {
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, err
+	}
+	if len(b) == 0 {
+		return nil, errors.New("empty key parameter")
+	}
+	return new(big.Int).SetBytes(b), nil
+}
@@ -0,0 +1,269 @@
+package auth
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/rimvydascivilis/book-tracker/backend/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeIssuer is a minimal OpenID provider serving discovery and a JWKS.
+type fakeIssuer struct {
+	*httptest.Server
+
+	mu        sync.Mutex
+	keys      map[string]crypto.Signer
+	issuer    string
+	jwksFetch int
+}
+
+func newFakeIssuer(t *testing.T) *fakeIssuer {
+	f := &fakeIssuer{keys: map[string]crypto.Signer{}}
+	f.Server = httptest.NewServer(http.HandlerFunc(f.serve))
+	t.Cleanup(f.Close)
+	f.issuer = f.URL
+	f.addRSAKey(t, "rsa-1")
+	return f
+}
+
+func (f *fakeIssuer) serve(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	switch r.URL.Path {
+	case "/.well-known/openid-configuration":
+		_ = json.NewEncoder(w).Encode(map[string]string{"issuer": f.issuer, "jwks_uri": f.URL + "/keys"})
+	case "/keys":
+		f.jwksFetch++
+		keys := []map[string]string{}
+		for kid, key := range f.keys {
+			keys = append(keys, toJWK(kid, key.Public()))
+		}
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"keys": keys})
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+func (f *fakeIssuer) addRSAKey(t *testing.T, kid string) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	f.mu.Lock()
+	f.keys[kid] = key
+	f.mu.Unlock()
+}
+
+func (f *fakeIssuer) addECKey(t *testing.T, kid string) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	f.mu.Lock()
+	f.keys[kid] = key
+	f.mu.Unlock()
+}
+
+func (f *fakeIssuer) fetches() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.jwksFetch
+}
+
+// sign issues an ID token with the key kid, overriding the default claims
+// of a valid token with claims.
+func (f *fakeIssuer) sign(t *testing.T, kid string, claims jwt.MapClaims) string {
+	f.mu.Lock()
+	key := f.keys[kid]
+	f.mu.Unlock()
+
+	all := jwt.MapClaims{
+		"iss":            f.URL,
+		"aud":            "book-tracker",
+		"sub":            "42",
+		"email":          "reader@example.com",
+		"email_verified": true,
+		"iat":            time.Now().Unix(),
+		"exp":            time.Now().Add(time.Hour).Unix(),
+	}
+	for k, v := range claims {
+		all[k] = v
+	}
+
+	method := jwt.SigningMethod(jwt.SigningMethodRS256)
+	if _, ok := key.(*ecdsa.PrivateKey); ok {
+		method = jwt.SigningMethodES256
+	}
+	token := jwt.NewWithClaims(method, all)
+	token.Header["kid"] = kid
+	signed, err := token.SignedString(key)
+	require.NoError(t, err)
+	return signed
+}
+
+func toJWK(kid string, key crypto.PublicKey) map[string]string {
+	enc := base64.RawURLEncoding.EncodeToString
+	switch key := key.(type) {
+	case *rsa.PublicKey:
+		return map[string]string{
+			"kty": "RSA", "kid": kid, "use": "sig", "alg": "RS256",
+			"n": enc(key.N.Bytes()), "e": enc(big.NewInt(int64(key.E)).Bytes()),
+		}
+	case *ecdsa.PublicKey:
+		return map[string]string{
+			"kty": "EC", "kid": kid, "use": "sig", "crv": "P-256",
+			"x": enc(key.X.FillBytes(make([]byte, 32))), "y": enc(key.Y.FillBytes(make([]byte, 32))),
+		}
+	}
+	return nil
+}
+
+func newTestOIDCService(issuers ...*fakeIssuer) *OIDCService {
+	var providers []domain.OIDCProvider
+	for i, issuer := range issuers {
+		providers = append(providers, domain.OIDCProvider{
+			Name: []string{"keycloak", "gitlab"}[i], Issuer: issuer.URL, ClientID: "book-tracker",
+		})
+	}
+	return NewOIDCService(providers, &http.Client{Timeout: time.Second})
+}
+
+func TestOIDCService_ValidateToken(t *testing.T) {
+	issuer := newFakeIssuer(t)
+	svc := newTestOIDCService(issuer)
+
+	email, err := svc.ValidateToken(issuer.sign(t, "rsa-1", nil))
+
+	require.NoError(t, err)
+	assert.Equal(t, "reader@example.com", email)
+
+	// Some providers send the claim as a string.
+	email, err = svc.ValidateToken(issuer.sign(t, "rsa-1", jwt.MapClaims{"email_verified": "true"}))
+	require.NoError(t, err)
+	assert.Equal(t, "reader@example.com", email)
+}
+
+func TestOIDCService_ValidateToken_Rejected(t *testing.T) {
+	issuer := newFakeIssuer(t)
+	svc := newTestOIDCService(issuer)
+	other := newFakeIssuer(t)
+	other.keys = issuer.keys
+
+	tests := map[string]string{
+		"other audience":     issuer.sign(t, "rsa-1", jwt.MapClaims{"aud": "someone-else"}),
+		"expired":            issuer.sign(t, "rsa-1", jwt.MapClaims{"exp": time.Now().Add(-time.Hour).Unix()}),
+		"without expiry":     issuer.sign(t, "rsa-1", jwt.MapClaims{"exp": nil}),
+		"unknown issuer":     other.sign(t, "rsa-1", nil),
+		"without email":      issuer.sign(t, "rsa-1", jwt.MapClaims{"email": ""}),
+		"unverified email":   issuer.sign(t, "rsa-1", jwt.MapClaims{"email_verified": false}),
+		"unverified, string": issuer.sign(t, "rsa-1", jwt.MapClaims{"email_verified": "false"}),
+		"without verified":   issuer.sign(t, "rsa-1", jwt.MapClaims{"email_verified": nil}),
+		"malformed":          "not-a-jwt",
+	}
+	forged := issuer.sign(t, "rsa-1", jwt.MapClaims{"email": "admin@example.com"})
+	tests["tampered"] = forged[:len(forged)-4] + "AAAA"
+	unsigned, err := jwt.NewWithClaims(jwt.SigningMethodNone, jwt.MapClaims{
+		"iss": issuer.URL, "aud": "book-tracker", "email": "reader@example.com", "exp": time.Now().Add(time.Hour).Unix(),
+	}).SignedString(jwt.UnsafeAllowNoneSignatureType)
+	require.NoError(t, err)
+	tests["unsigned"] = unsigned
+
+	for name, token := range tests {
+		_, err := svc.ValidateToken(token)
+		assert.ErrorIs(t, err, domain.ErrAuthentication, name)
+	}
+}
+
+func TestOIDCService_ValidateToken_OtherKey(t *testing.T) {
+	issuer := newFakeIssuer(t)
+	svc := newTestOIDCService(issuer)
+	impostor := newFakeIssuer(t)
+	impostor.URL = issuer.URL
+
+	_, err := svc.ValidateToken(impostor.sign(t, "rsa-1", nil))
+
+	assert.ErrorIs(t, err, domain.ErrAuthentication)
+}
+
+func TestOIDCService_CachesKeys(t *testing.T) {
+	issuer := newFakeIssuer(t)
+	svc := newTestOIDCService(issuer)
+
+	for i := 0; i < 3; i++ {
+		_, err := svc.ValidateToken(issuer.sign(t, "rsa-1", nil))
+		require.NoError(t, err)
+	}
+
+	assert.Equal(t, 1, issuer.fetches())
+}
+
+// TestOIDCService_KeyRotation signs with a key published after the keys
+// were cached: they are fetched again, but at most once a minute.
+func TestOIDCService_KeyRotation(t *testing.T) {
+	issuer := newFakeIssuer(t)
+	svc := newTestOIDCService(issuer)
+	_, err := svc.ValidateToken(issuer.sign(t, "rsa-1", nil))
+	require.NoError(t, err)
+
+	issuer.addECKey(t, "ec-2")
+	_, err = svc.ValidateToken(issuer.sign(t, "ec-2", nil))
+	assert.ErrorIs(t, err, domain.ErrAuthentication, "keys were fetched less than a minute ago")
+	assert.Equal(t, 1, issuer.fetches())
+
+	svc.providers[issuer.URL].fetchedAt = time.Now().Add(-2 * jwksMinRefresh)
+	email, err := svc.ValidateToken(issuer.sign(t, "ec-2", nil))
+	require.NoError(t, err)
+	assert.Equal(t, "reader@example.com", email)
+	assert.Equal(t, 2, issuer.fetches())
+}
+
+func TestOIDCService_SeveralProviders(t *testing.T) {
+	keycloak := newFakeIssuer(t)
+	gitlab := newFakeIssuer(t)
+	svc := newTestOIDCService(keycloak, gitlab)
+
+	email, err := svc.ValidateToken(keycloak.sign(t, "rsa-1", jwt.MapClaims{"email": "kc@example.com"}))
+	require.NoError(t, err)
+	assert.Equal(t, "kc@example.com", email)
+
+	email, err = svc.ValidateToken(gitlab.sign(t, "rsa-1", jwt.MapClaims{"email": "gl@example.com"}))
+	require.NoError(t, err)
+	assert.Equal(t, "gl@example.com", email)
+
+	// A token of one provider cannot pass for the other's by naming it as
+	// issuer: it is checked with the keys of the named issuer.
+	_, err = svc.ValidateToken(gitlab.sign(t, "rsa-1", jwt.MapClaims{"iss": keycloak.URL}))
+	assert.ErrorIs(t, err, domain.ErrAuthentication)
+}
+
+func TestOIDCService_DiscoveryIssuerMismatch(t *testing.T) {
+	issuer := newFakeIssuer(t)
+	issuer.issuer = "https://elsewhere.example.com"
+	svc := newTestOIDCService(issuer)
+
+	_, err := svc.ValidateToken(issuer.sign(t, "rsa-1", nil))
+
+	assert.ErrorIs(t, err, domain.ErrUpstream)
+}
+
+func TestOIDCService_IssuerDown(t *testing.T) {
+	issuer := newFakeIssuer(t)
+	svc := newTestOIDCService(issuer)
+	token := issuer.sign(t, "rsa-1", nil)
+	issuer.Close()
+
+	_, err := svc.ValidateToken(token)
+
+	assert.ErrorIs(t, err, domain.ErrUpstream)
+}
@@ -0,0 +1,95 @@
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+
+	"golang.org/x/crypto/argon2"
+)
+
+// argon2Params are the argon2id costs new passwords are hashed with, the
+// second recommended option of RFC 9106 with less memory. Stored hashes carry
+// their own parameters, so these can be raised without breaking them.
+type argon2Params struct {
+	memory  uint32 // KiB
+	time    uint32
+	threads uint8
+	saltLen uint32
+	keyLen  uint32
+}
+
+var defaultArgon2Params = argon2Params{memory: 64 * 1024, time: 3, threads: 2, saltLen: 16, keyLen: 32}
+
+var errInvalidHash = errors.New("invalid password hash")
+
+// argon2Slots bounds the hashes computed at once, as each takes the memory
+// cost of its parameters: 64 MiB for new passwords.
+var argon2Slots = make(chan struct{}, 4)
+
+// argon2Key derives the argon2id key of password once a slot is free, or
+// fails with the error of ctx.
+func argon2Key(ctx context.Context, password string, salt []byte, p argon2Params) ([]byte, error) {
+	select {
+	case argon2Slots <- struct{}{}:
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+	defer func() { <-argon2Slots }()
+
+	return argon2.IDKey([]byte(password), salt, p.time, p.memory, p.threads, p.keyLen), nil
+}
+
+// hashPassword returns the argon2id hash of password in PHC string format,
+// $argon2id$v=19$m=65536,t=3,p=2$<salt>$<key>.
+func hashPassword(ctx context.Context, password string) (string, error) {
+	p := defaultArgon2Params
+	salt := make([]byte, p.saltLen)
+	if _, err := rand.Read(salt); err != nil {
+		return "", err
+	}
+	key, err := argon2Key(ctx, password, salt, p)
+	if err != nil {
+		return "", err
+	}
+
+	enc := base64.RawStdEncoding
+	return fmt.Sprintf("$argon2id$v=%d$m=%d,t=%d,p=%d$%s$%s", argon2.Version, p.memory, p.time, p.threads,
+		enc.EncodeToString(salt), enc.EncodeToString(key)), nil
+}
+
+// checkPassword reports whether password matches hash, in constant time.
+func checkPassword(ctx context.Context, password, hash string) (bool, error) {
+	parts := strings.Split(hash, "$")
+	if len(parts) != 6 || parts[0] != "" || parts[1] != "argon2id" {
+		return false, errInvalidHash
+	}
+
+	var version int
+	if _, err := fmt.Sscanf(parts[2], "v=%d", &version); err != nil || version != argon2.Version {
+		return false, errInvalidHash
+	}
+	var p argon2Params
+	if _, err := fmt.Sscanf(parts[3], "m=%d,t=%d,p=%d", &p.memory, &p.time, &p.threads); err != nil {
+		return false, errInvalidHash
+	}
+	salt, err := base64.RawStdEncoding.DecodeString(parts[4])
+	if err != nil {
+		return false, errInvalidHash
+	}
+	key, err := base64.RawStdEncoding.DecodeString(parts[5])
+	if err != nil || len(key) == 0 {
+		return false, errInvalidHash
+	}
+
+	p.keyLen = uint32(len(key))
+	other, err := argon2Key(ctx, password, salt, p)
+	if err != nil {
+		return false, err
+	}
+	return subtle.ConstantTimeCompare(key, other) == 1, nil
+}
@@ -0,0 +1,69 @@
+package auth
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHashPassword(t *testing.T) {
+	hash, err := hashPassword(context.Background(), "correct horse")
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(hash, "$argon2id$v=19$m=65536,t=3,p=2$"))
+
+	other, err := hashPassword(context.Background(), "correct horse")
+	require.NoError(t, err)
+	assert.NotEqual(t, hash, other, "every hash has its own salt")
+
+	ok, err := checkPassword(context.Background(), "correct horse", hash)
+	require.NoError(t, err)
+	assert.True(t, ok)
+	ok, err = checkPassword(context.Background(), "correct horsE", hash)
+	require.NoError(t, err)
+	assert.False(t, ok)
+}
+
+func TestCheckPassword_OtherParameters(t *testing.T) {
+	// Hashes made with other costs still check, with the costs they carry.
+	hash := "$argon2id$v=19$m=1024,t=1,p=1$c29tZXNhbHRzb21lc2FsdA$FiU+I6KbINfHoMBfkVDnS6qmzxgy7cg41IT8JQoCvvk"
+
+	ok, err := checkPassword(context.Background(), "password", hash)
+	require.NoError(t, err)
+	assert.True(t, ok)
+}
+
+func TestCheckPassword_InvalidHash(t *testing.T) {
+	for _, hash := range []string{
+		"",
+		"plain text",
+		"$2a$10$abcdefghijklmnopqrstuv",
+		"$argon2i$v=19$m=1024,t=1,p=1$c2FsdA$a2V5",
+		"$argon2id$v=16$m=1024,t=1,p=1$c2FsdA$a2V5",
+		"$argon2id$v=19$m=1024$c2FsdA$a2V5",
+		"$argon2id$v=19$m=1024,t=1,p=1$c2FsdA$",
+	} {
+		_, err := checkPassword(context.Background(), "password", hash)
+		assert.ErrorIs(t, err, errInvalidHash, hash)
+	}
+}
+
+func TestHashPassword_Busy(t *testing.T) {
+	// With every slot taken, hashing waits until the context is done.
+	for i := 0; i < cap(argon2Slots); i++ {
+		argon2Slots <- struct{}{}
+	}
+	t.Cleanup(func() {
+		for i := 0; i < cap(argon2Slots); i++ {
+			<-argon2Slots
+		}
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, err := hashPassword(ctx, "correct horse")
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
@@ -0,0 +1,57 @@
+package auth
+
+import (
+	"sync"
+	"time"
+)
+
+// maxTrackedEmails bounds the emails a failureCounter remembers.
+const maxTrackedEmails = 10000
+
+// failureCounter locks out emails without a local account the way local
+// accounts are locked, so that a lockout does not tell which emails are
+// registered. The counts are kept in memory only.
+type failureCounter struct {
+	mu      sync.Mutex
+	entries map[string]failures
+}
+
+type failures struct {
+	count       int
+	lockedUntil time.Time
+}
+
+func newFailureCounter() *failureCounter {
+	return &failureCounter{entries: make(map[string]failures)}
+}
+
+// locked reports whether email is locked out at now.
+func (c *failureCounter) locked(email string, now time.Time) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return now.Before(c.entries[email].lockedUntil)
+}
+
+// fail counts a failed login of email at now, locking it out for
+// loginLockout after maxFailedLogins in a row.
+func (c *failureCounter) fail(email string, now time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if _, ok := c.entries[email]; !ok && len(c.entries) >= maxTrackedEmails {
+		// Emails that are not locked out start counting again.
+		for key, entry := range c.entries {
+			if !now.Before(entry.lockedUntil) {
+				delete(c.entries, key)
+			}
+		}
+	}
+
+	entry := c.entries[email]
+	entry.count++
+	if entry.count >= maxFailedLogins {
+		entry.count = 0
+		entry.lockedUntil = now.Add(loginLockout)
+	}
+	c.entries[email] = entry
+}
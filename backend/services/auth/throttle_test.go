@@ -0,0 +1,38 @@
+package auth
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFailureCounter(t *testing.T) {
+	c := newFailureCounter()
+	now := time.Now()
+
+	for i := 0; i < maxFailedLogins-1; i++ {
+		c.fail("reader@example.com", now)
+		assert.False(t, c.locked("reader@example.com", now))
+	}
+	c.fail("reader@example.com", now)
+	assert.True(t, c.locked("reader@example.com", now))
+	assert.False(t, c.locked("other@example.com", now))
+	assert.False(t, c.locked("reader@example.com", now.Add(loginLockout)))
+}
+
+func TestFailureCounter_Bounded(t *testing.T) {
+	c := newFailureCounter()
+	now := time.Now()
+	for i := 0; i < maxFailedLogins; i++ {
+		c.fail("locked@example.com", now)
+	}
+	for i := 0; len(c.entries) < maxTrackedEmails; i++ {
+		c.fail(fmt.Sprintf("reader%d@example.com", i), now)
+	}
+
+	c.fail("new@example.com", now)
+	assert.Len(t, c.entries, 2, "only locked out emails are kept")
+	assert.True(t, c.locked("locked@example.com", now))
+}
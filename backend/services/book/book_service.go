@@ -2,29 +2,216 @@ package book
 
 import (
 	"context"
+	"fmt"
+	"strings"
 
 	"github.com/rimvydascivilis/book-tracker/backend/domain"
+	"github.com/rimvydascivilis/book-tracker/backend/utils"
 )
 
 type bookService struct {
-	bookRepo      domain.BookRepository
-	validationSvc domain.ValidationService
+	bookRepo         domain.BookRepository
+	authorRepo       domain.AuthorRepository
+	txManager        domain.TxManager
+	metadataProvider domain.MetadataProvider
+	validationSvc    domain.ValidationService
 }
 
-func NewBookService(repo domain.BookRepository, validator domain.ValidationService) *bookService {
+func NewBookService(repo domain.BookRepository, authorRepo domain.AuthorRepository, txManager domain.TxManager,
+	metadataProvider domain.MetadataProvider, validator domain.ValidationService) *bookService {
 	return &bookService{
-		bookRepo:      repo,
-		validationSvc: validator,
+		bookRepo:         repo,
+		authorRepo:       authorRepo,
+		txManager:        txManager,
+		metadataProvider: metadataProvider,
+		validationSvc:    validator,
 	}
 }
 
+// normalizeBook strips ISBN formatting, fills in whichever ISBN form can be
+// derived from the other and drops blank or repeated author names.
+func normalizeBook(book *domain.Book) {
+	book.ISBN10 = utils.NormalizeISBN(book.ISBN10)
+	book.ISBN13 = utils.NormalizeISBN(book.ISBN13)
+	if book.ISBN13 == "" {
+		if isbn13, ok := utils.ISBN10To13(book.ISBN10); ok {
+			book.ISBN13 = isbn13
+		}
+	}
+	if book.ISBN10 == "" {
+		if isbn10, ok := utils.ISBN13To10(book.ISBN13); ok {
+			book.ISBN10 = isbn10
+		}
+	}
+
+	if book.Authors == nil {
+		return
+	}
+	// Names that differ only in case are the same author; the first spelling
+	// is kept.
+	seen := map[string]bool{}
+	authors := make([]domain.Author, 0, len(book.Authors))
+	for _, author := range book.Authors {
+		name := strings.TrimSpace(author.Name)
+		key := strings.ToLower(name)
+		if name == "" || seen[key] {
+			continue
+		}
+		seen[key] = true
+		authors = append(authors, domain.Author{Name: name})
+	}
+	book.Authors = authors
+}
+
+func (s *bookService) setAuthors(ctx context.Context, bookID int64, authors []domain.Author) ([]domain.Author, error) {
+	names := make([]string, 0, len(authors))
+	for _, author := range authors {
+		names = append(names, author.Name)
+	}
+
+	created, err := s.authorRepo.GetOrCreateAuthors(ctx, names)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]int64, 0, len(created))
+	for _, author := range created {
+		ids = append(ids, author.ID)
+	}
+	if err := s.authorRepo.SetBookAuthors(ctx, bookID, ids); err != nil {
+		return nil, err
+	}
+
+	return created, nil
+}
+
+// withAuthors loads the authors of every book with a single query.
+func (s *bookService) withAuthors(ctx context.Context, books []domain.Book) ([]domain.Book, error) {
+	ids := make([]int64, 0, len(books))
+	for _, book := range books {
+		ids = append(ids, book.ID)
+	}
+
+	authors, err := s.authorRepo.GetAuthorsByBookIDs(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range books {
+		books[i].Authors = authors[books[i].ID]
+		if books[i].Authors == nil {
+			books[i].Authors = []domain.Author{}
+		}
+	}
+
+	return books, nil
+}
+
 func (s *bookService) CreateBook(ctx context.Context, userID int64, book domain.Book) (domain.Book, error) {
 	book.UserID = userID
+	normalizeBook(&book)
 	if err := s.validationSvc.ValidateStruct(book); err != nil {
 		return domain.Book{}, err
 	}
 
-	return s.bookRepo.CreateBook(ctx, book)
+	err := s.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		created, err := s.bookRepo.CreateBook(ctx, book)
+		if err != nil {
+			return err
+		}
+
+		created.Authors = []domain.Author{}
+		if len(book.Authors) > 0 {
+			created.Authors, err = s.setAuthors(ctx, created.ID, book.Authors)
+			if err != nil {
+				return err
+			}
+		}
+
+		book = created
+		return nil
+	})
+	if err != nil {
+		return domain.Book{}, err
+	}
+
+	return book, nil
+}
+
+// CreateBookFromISBN prefills book from the metadata provider. Fields already
+// set in book take precedence over the looked up ones.
+func (s *bookService) CreateBookFromISBN(ctx context.Context, userID int64, isbn string, book domain.Book) (domain.Book, error) {
+	found, err := s.LookupBook(ctx, isbn)
+	if err != nil {
+		return domain.Book{}, err
+	}
+
+	if book.Title == "" {
+		book.Title = found.Title
+	}
+	if len(book.Authors) == 0 {
+		book.Authors = found.Authors
+	}
+	if book.ISBN10 == "" && book.ISBN13 == "" {
+		book.ISBN10 = found.ISBN10
+		book.ISBN13 = found.ISBN13
+	}
+	if book.Publisher == "" {
+		book.Publisher = found.Publisher
+	}
+	if book.PublicationYear == 0 {
+		book.PublicationYear = found.PublicationYear
+	}
+	if book.Language == "" {
+		book.Language = found.Language
+	}
+	if book.Description == "" {
+		book.Description = found.Description
+	}
+	if book.PageCount == 0 {
+		book.PageCount = found.PageCount
+	}
+
+	return s.CreateBook(ctx, userID, book)
+}
+
+func (s *bookService) LookupBook(ctx context.Context, isbn string) (domain.Book, error) {
+	isbn = utils.NormalizeISBN(isbn)
+	if !utils.ValidISBN10(isbn) && !utils.ValidISBN13(isbn) {
+		return domain.Book{}, fmt.Errorf("%w: %s", domain.ErrValidation, "invalid ISBN")
+	}
+
+	book, err := s.metadataProvider.LookupISBN(ctx, isbn)
+	if err != nil {
+		return domain.Book{}, err
+	}
+
+	normalizeBook(&book)
+	return book, nil
+}
+
+func (s *bookService) SearchMetadata(ctx context.Context, title, author string, limit int64) ([]domain.Book, error) {
+	title = strings.TrimSpace(title)
+	author = strings.TrimSpace(author)
+	if title == "" && author == "" {
+		return nil, fmt.Errorf("%w: %s", domain.ErrValidation, "title or author is required")
+	}
+	if limit < 1 {
+		limit = 10
+	}
+	if limit > 20 {
+		limit = 20
+	}
+
+	books, err := s.metadataProvider.Search(ctx, title, author, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range books {
+		normalizeBook(&books[i])
+	}
+	return books, nil
 }
 
 func (s *bookService) GetBooks(ctx context.Context, userID, page, limit int64) ([]domain.Book, bool, error) {
@@ -54,10 +241,15 @@ func (s *bookService) GetBooks(ctx context.Context, userID, page, limit int64) (
 		return nil, false, err
 	}
 
+	books, err = s.withAuthors(ctx, books)
+	if err != nil {
+		return nil, false, err
+	}
+
 	return books, hasMore, nil
 }
 
-func (s *bookService) SearchBooks(ctx context.Context, userID int64, title string, limit int64) ([]domain.Book, error) {
+func (s *bookService) SearchBooks(ctx context.Context, userID int64, query string, limit int64) ([]domain.Book, error) {
 	if limit < 1 {
 		limit = 10
 	}
@@ -65,35 +257,88 @@ func (s *bookService) SearchBooks(ctx context.Context, userID int64, title strin
 		limit = 20
 	}
 
-	return s.bookRepo.SearchBooksByTitle(ctx, userID, title, limit)
+	books, err := s.bookRepo.SearchBooks(ctx, userID, strings.TrimSpace(query), limit)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.withAuthors(ctx, books)
 }
 
+// UpdateBook changes only the fields set in book. Setting either ISBN replaces
+// both, and a non-nil Authors replaces the whole author list.
 func (s *bookService) UpdateBook(ctx context.Context, userID int64, book domain.Book) (domain.Book, error) {
-	currBook, err := s.bookRepo.GetBookByUserID(ctx, userID, book.ID)
-	if err != nil {
-		return domain.Book{}, err
-	}
+	var updated domain.Book
+	err := s.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		currBook, err := s.bookRepo.GetBookByUserID(ctx, userID, book.ID)
+		if err != nil {
+			return err
+		}
 
-	if book.Title != "" {
-		currBook.Title = book.Title
-	}
-	if book.Rating != 0 {
-		currBook.Rating = book.Rating
-	}
-	currBook.UserID = userID
+		if book.Title != "" {
+			currBook.Title = book.Title
+		}
+		if book.Rating != 0 {
+			currBook.Rating = book.Rating
+		}
+		if book.ISBN10 != "" || book.ISBN13 != "" {
+			currBook.ISBN10 = book.ISBN10
+			currBook.ISBN13 = book.ISBN13
+		}
+		if book.Publisher != "" {
+			currBook.Publisher = book.Publisher
+		}
+		if book.PublicationYear != 0 {
+			currBook.PublicationYear = book.PublicationYear
+		}
+		if book.Language != "" {
+			currBook.Language = book.Language
+		}
+		if book.Description != "" {
+			currBook.Description = book.Description
+		}
+		if book.PageCount != 0 {
+			currBook.PageCount = book.PageCount
+		}
+		currBook.Authors = book.Authors
+		currBook.UserID = userID
+
+		normalizeBook(&currBook)
+		if err := s.validationSvc.ValidateStruct(currBook); err != nil {
+			return err
+		}
 
-	if err := s.validationSvc.ValidateStruct(currBook); err != nil {
+		updated, err = s.bookRepo.UpdateBook(ctx, currBook)
+		if err != nil {
+			return err
+		}
+
+		if currBook.Authors != nil {
+			updated.Authors, err = s.setAuthors(ctx, updated.ID, currBook.Authors)
+			return err
+		}
+
+		books, err := s.withAuthors(ctx, []domain.Book{updated})
+		if err != nil {
+			return err
+		}
+		updated = books[0]
+		return nil
+	})
+	if err != nil {
 		return domain.Book{}, err
 	}
 
-	return s.bookRepo.UpdateBook(ctx, currBook)
+	return updated, nil
 }
 
 func (s *bookService) DeleteBook(ctx context.Context, userID, bookID int64) error {
-	_, err := s.bookRepo.GetBookByUserID(ctx, userID, bookID)
-	if err != nil {
-		return err
-	}
+	return s.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		_, err := s.bookRepo.GetBookByUserIDForUpdate(ctx, userID, bookID)
+		if err != nil {
+			return err
+		}
 
-	return s.bookRepo.DeleteBook(ctx, userID, bookID)
+		return s.bookRepo.DeleteBook(ctx, userID, bookID)
+	})
 }
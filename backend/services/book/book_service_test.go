@@ -11,16 +11,20 @@ import (
 	"github.com/stretchr/testify/mock"
 )
 
-func setupBookService() (domain.BookService, *mocks.BookRepository, *mocks.ValidationService) {
+func setupBookService() (domain.BookService, *mocks.BookRepository, *mocks.AuthorRepository, *mocks.ValidationService) {
 	bookRepo := new(mocks.BookRepository)
+	authorRepo := new(mocks.AuthorRepository)
 	validationSvc := new(mocks.ValidationService)
-	userService := NewBookService(bookRepo, validationSvc)
+	txManager := new(mocks.TxManager)
+	txManager.On("WithinTransaction", mock.Anything, mock.Anything).Maybe().
+		Return(func(ctx context.Context, fn func(context.Context) error) error { return fn(ctx) })
+	userService := NewBookService(bookRepo, authorRepo, txManager, new(mocks.MetadataProvider), validationSvc)
 
-	return userService, bookRepo, validationSvc
+	return userService, bookRepo, authorRepo, validationSvc
 }
 
 func TestCreateBook(t *testing.T) {
-	service, mockRepo, validationSvc := setupBookService()
+	service, mockRepo, _, validationSvc := setupBookService()
 
 	reqBook := domain.Book{
 		Title:  "New Book",
@@ -48,8 +52,45 @@ func TestCreateBook(t *testing.T) {
 	validationSvc.AssertExpectations(t)
 }
 
+func TestCreateBook_NormalizesMetadataAndSetsAuthors(t *testing.T) {
+	service, mockRepo, authorRepo, validationSvc := setupBookService()
+
+	userID := int64(1)
+	reqBook := domain.Book{
+		Title:     "Dune",
+		ISBN10:    "0-441-01359-7",
+		PageCount: 412,
+		Authors:   []domain.Author{{Name: " Frank Herbert "}, {Name: ""}, {Name: "Frank Herbert"}},
+	}
+	book := domain.Book{
+		UserID:    userID,
+		Title:     "Dune",
+		ISBN10:    "0441013597",
+		ISBN13:    "9780441013593",
+		PageCount: 412,
+		Authors:   []domain.Author{{Name: "Frank Herbert"}},
+	}
+	createdBook := book
+	createdBook.ID = 5
+	author := domain.Author{ID: 3, Name: "Frank Herbert"}
+
+	validationSvc.On("ValidateStruct", book).Return(nil)
+	mockRepo.On("CreateBook", mock.Anything, book).Return(createdBook, nil)
+	authorRepo.On("GetOrCreateAuthors", mock.Anything, []string{"Frank Herbert"}).Return([]domain.Author{author}, nil)
+	authorRepo.On("SetBookAuthors", mock.Anything, int64(5), []int64{3}).Return(nil)
+
+	res, err := service.CreateBook(context.Background(), userID, reqBook)
+
+	assert.NoError(t, err)
+	assert.Equal(t, int64(5), res.ID)
+	assert.Equal(t, "9780441013593", res.ISBN13)
+	assert.Equal(t, []domain.Author{author}, res.Authors)
+	mockRepo.AssertExpectations(t)
+	authorRepo.AssertExpectations(t)
+}
+
 func TestCreateBook_EmptyTitle(t *testing.T) {
-	service, _, validationSvc := setupBookService()
+	service, _, _, validationSvc := setupBookService()
 
 	userID := int64(1)
 	book := domain.Book{
@@ -70,7 +111,7 @@ func TestCreateBook_EmptyTitle(t *testing.T) {
 }
 
 func TestCreateBook_InvalidRating(t *testing.T) {
-	service, _, validationSvc := setupBookService()
+	service, _, _, validationSvc := setupBookService()
 
 	userID := int64(1)
 	book := domain.Book{
@@ -94,7 +135,7 @@ func TestCreateBook_InvalidRating(t *testing.T) {
 }
 
 func TestGetBooks(t *testing.T) {
-	service, mockRepo, _ := setupBookService()
+	service, mockRepo, authorRepo, _ := setupBookService()
 
 	userID := int64(1)
 	page := int64(1)
@@ -103,20 +144,24 @@ func TestGetBooks(t *testing.T) {
 		{ID: 1, Title: "Book 1", Rating: 5},
 		{ID: 2, Title: "Book 2", Rating: 4},
 	}
+	author := domain.Author{ID: 7, Name: "Frank Herbert"}
 
 	mockRepo.On("GetBooksByUser", mock.Anything, userID, int64(0), limit).Return(books, nil)
 	mockRepo.On("CountBooksByUser", mock.Anything, userID).Return(int64(2), nil)
+	authorRepo.On("GetAuthorsByBookIDs", mock.Anything, []int64{1, 2}).
+		Return(map[int64][]domain.Author{1: {author}}, nil)
 
 	resultBooks, hasMore, err := service.GetBooks(context.Background(), userID, page, limit)
 
 	assert.NoError(t, err)
-	assert.Equal(t, books, resultBooks)
+	assert.Equal(t, []domain.Author{author}, resultBooks[0].Authors)
+	assert.Equal(t, []domain.Author{}, resultBooks[1].Authors)
 	assert.False(t, hasMore)
 	mockRepo.AssertExpectations(t)
 }
 
 func TestGetBooks_hasMore(t *testing.T) {
-	service, mockRepo, _ := setupBookService()
+	service, mockRepo, authorRepo, _ := setupBookService()
 
 	userID := int64(1)
 	page := int64(1)
@@ -128,17 +173,18 @@ func TestGetBooks_hasMore(t *testing.T) {
 
 	mockRepo.On("GetBooksByUser", mock.Anything, userID, int64(0), limit).Return(books, nil)
 	mockRepo.On("CountBooksByUser", mock.Anything, userID).Return(int64(3), nil)
+	authorRepo.On("GetAuthorsByBookIDs", mock.Anything, []int64{1, 2}).Return(map[int64][]domain.Author{}, nil)
 
 	resultBooks, hasMore, err := service.GetBooks(context.Background(), userID, page, limit)
 
 	assert.NoError(t, err)
-	assert.Equal(t, books, resultBooks)
+	assert.Len(t, resultBooks, 2)
 	assert.True(t, hasMore)
 	mockRepo.AssertExpectations(t)
 }
 
 func TestUpdateBook(t *testing.T) {
-	service, mockRepo, validationSvc := setupBookService()
+	service, mockRepo, authorRepo, validationSvc := setupBookService()
 
 	userID := int64(1)
 	book := domain.Book{
@@ -162,16 +208,63 @@ func TestUpdateBook(t *testing.T) {
 	mockRepo.On("GetBookByUserID", mock.Anything, userID, book.ID).Return(existingBook, nil)
 	validationSvc.On("ValidateStruct", updatedBook).Return(nil)
 	mockRepo.On("UpdateBook", mock.Anything, updatedBook).Return(book, nil)
+	authorRepo.On("GetAuthorsByBookIDs", mock.Anything, []int64{1}).Return(map[int64][]domain.Author{}, nil)
 
 	updatedBook, err := service.UpdateBook(context.Background(), userID, book)
 
 	assert.NoError(t, err)
 	assert.Equal(t, book.Title, updatedBook.Title)
 	mockRepo.AssertExpectations(t)
+	authorRepo.AssertExpectations(t)
+}
+
+func TestNormalizeBook_AuthorsIgnoreCase(t *testing.T) {
+	book := domain.Book{Authors: []domain.Author{
+		{Name: "Ursula K. Le Guin"}, {Name: " ursula k. le guin"}, {Name: "URSULA K. LE GUIN"}, {Name: "Frank Herbert"},
+	}}
+
+	normalizeBook(&book)
+
+	assert.Equal(t, []domain.Author{{Name: "Ursula K. Le Guin"}, {Name: "Frank Herbert"}}, book.Authors)
+}
+
+func TestUpdateBook_ReplacesAuthorsAndISBN(t *testing.T) {
+	service, mockRepo, authorRepo, validationSvc := setupBookService()
+
+	userID := int64(1)
+	existingBook := domain.Book{ID: 1, UserID: userID, Title: "Dune", ISBN10: "0441013597", ISBN13: "9780441013593"}
+	book := domain.Book{
+		ID:      1,
+		ISBN13:  "978-0-8044-2957-3",
+		Authors: []domain.Author{{Name: "Frank Herbert"}},
+	}
+	updatedBook := domain.Book{
+		ID:      1,
+		UserID:  userID,
+		Title:   "Dune",
+		ISBN10:  "080442957X",
+		ISBN13:  "9780804429573",
+		Authors: []domain.Author{{Name: "Frank Herbert"}},
+	}
+	author := domain.Author{ID: 3, Name: "Frank Herbert"}
+
+	mockRepo.On("GetBookByUserID", mock.Anything, userID, book.ID).Return(existingBook, nil)
+	validationSvc.On("ValidateStruct", updatedBook).Return(nil)
+	mockRepo.On("UpdateBook", mock.Anything, updatedBook).Return(updatedBook, nil)
+	authorRepo.On("GetOrCreateAuthors", mock.Anything, []string{"Frank Herbert"}).Return([]domain.Author{author}, nil)
+	authorRepo.On("SetBookAuthors", mock.Anything, int64(1), []int64{3}).Return(nil)
+
+	res, err := service.UpdateBook(context.Background(), userID, book)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []domain.Author{author}, res.Authors)
+	assert.Equal(t, "080442957X", res.ISBN10)
+	mockRepo.AssertExpectations(t)
+	authorRepo.AssertExpectations(t)
 }
 
 func TestUpdateBook_InvalidRating(t *testing.T) {
-	service, mockRepo, validationSvc := setupBookService()
+	service, mockRepo, _, validationSvc := setupBookService()
 
 	userID := int64(1)
 	book := domain.Book{
@@ -201,13 +294,30 @@ func TestUpdateBook_InvalidRating(t *testing.T) {
 	mockRepo.AssertExpectations(t)
 }
 
+func TestSearchBooks(t *testing.T) {
+	service, mockRepo, authorRepo, _ := setupBookService()
+
+	userID := int64(1)
+	books := []domain.Book{{ID: 1, Title: "Dune"}}
+	author := domain.Author{ID: 3, Name: "Frank Herbert"}
+
+	mockRepo.On("SearchBooks", mock.Anything, userID, "herbert", int64(20)).Return(books, nil)
+	authorRepo.On("GetAuthorsByBookIDs", mock.Anything, []int64{1}).Return(map[int64][]domain.Author{1: {author}}, nil)
+
+	res, err := service.SearchBooks(context.Background(), userID, " herbert ", 50)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []domain.Author{author}, res[0].Authors)
+	mockRepo.AssertExpectations(t)
+}
+
 func TestDeleteBook(t *testing.T) {
-	service, mockRepo, _ := setupBookService()
+	service, mockRepo, _, _ := setupBookService()
 
 	userID := int64(1)
 	bookID := int64(1)
 
-	mockRepo.On("GetBookByUserID", mock.Anything, userID, bookID).Return(domain.Book{}, nil)
+	mockRepo.On("GetBookByUserIDForUpdate", mock.Anything, userID, bookID).Return(domain.Book{}, nil)
 	mockRepo.On("DeleteBook", mock.Anything, userID, bookID).Return(nil)
 
 	err := service.DeleteBook(context.Background(), userID, bookID)
@@ -217,12 +327,12 @@ func TestDeleteBook(t *testing.T) {
 }
 
 func TestDeleteBook_NotFound(t *testing.T) {
-	service, mockRepo, _ := setupBookService()
+	service, mockRepo, _, _ := setupBookService()
 
 	userID := int64(1)
 	bookID := int64(1)
 
-	mockRepo.On("GetBookByUserID", mock.Anything, userID, bookID).Return(domain.Book{}, domain.ErrRecordNotFound)
+	mockRepo.On("GetBookByUserIDForUpdate", mock.Anything, userID, bookID).Return(domain.Book{}, domain.ErrRecordNotFound)
 
 	err := service.DeleteBook(context.Background(), userID, bookID)
 
@@ -230,3 +340,114 @@ func TestDeleteBook_NotFound(t *testing.T) {
 	assert.Equal(t, domain.ErrRecordNotFound, err)
 	mockRepo.AssertExpectations(t)
 }
+
+func setupMetadataBookService() (domain.BookService, *mocks.BookRepository, *mocks.MetadataProvider, *mocks.ValidationService) {
+	bookRepo := new(mocks.BookRepository)
+	authorRepo := new(mocks.AuthorRepository)
+	metadataProvider := new(mocks.MetadataProvider)
+	validationSvc := new(mocks.ValidationService)
+	txManager := new(mocks.TxManager)
+	txManager.On("WithinTransaction", mock.Anything, mock.Anything).Maybe().
+		Return(func(ctx context.Context, fn func(context.Context) error) error { return fn(ctx) })
+	authorRepo.On("GetOrCreateAuthors", mock.Anything, mock.Anything).Maybe().
+		Return(func(_ context.Context, names []string) ([]domain.Author, error) {
+			authors := make([]domain.Author, 0, len(names))
+			for i, name := range names {
+				authors = append(authors, domain.Author{ID: int64(i + 1), Name: name})
+			}
+			return authors, nil
+		})
+	authorRepo.On("SetBookAuthors", mock.Anything, mock.Anything, mock.Anything).Maybe().Return(nil)
+
+	return NewBookService(bookRepo, authorRepo, txManager, metadataProvider, validationSvc), bookRepo, metadataProvider, validationSvc
+}
+
+func TestLookupBook(t *testing.T) {
+	service, _, metadataProvider, _ := setupMetadataBookService()
+
+	metadataProvider.On("LookupISBN", mock.Anything, "9780132350884").Return(domain.Book{
+		Title:   "Clean Code",
+		Authors: []domain.Author{{Name: " Robert C. Martin "}},
+		ISBN13:  "9780132350884",
+	}, nil)
+
+	book, err := service.LookupBook(context.Background(), "978-0-13-235088-4")
+
+	assert.NoError(t, err)
+	assert.Equal(t, "Clean Code", book.Title)
+	assert.Equal(t, "0132350882", book.ISBN10)
+	assert.Equal(t, []domain.Author{{Name: "Robert C. Martin"}}, book.Authors)
+}
+
+func TestLookupBook_InvalidISBN(t *testing.T) {
+	service, _, metadataProvider, _ := setupMetadataBookService()
+
+	_, err := service.LookupBook(context.Background(), "12345")
+
+	assert.ErrorIs(t, err, domain.ErrValidation)
+	metadataProvider.AssertNotCalled(t, "LookupISBN", mock.Anything, mock.Anything)
+}
+
+func TestCreateBookFromISBN(t *testing.T) {
+	service, bookRepo, metadataProvider, validationSvc := setupMetadataBookService()
+
+	metadataProvider.On("LookupISBN", mock.Anything, "9780132350884").Return(domain.Book{
+		Title:     "Clean Code",
+		Authors:   []domain.Author{{Name: "Robert C. Martin"}},
+		ISBN13:    "9780132350884",
+		Publisher: "Prentice Hall",
+		PageCount: 431,
+	}, nil)
+
+	expected := domain.Book{
+		UserID:    1,
+		Title:     "My Clean Code",
+		Rating:    5,
+		Authors:   []domain.Author{{Name: "Robert C. Martin"}},
+		ISBN10:    "0132350882",
+		ISBN13:    "9780132350884",
+		Publisher: "Prentice Hall",
+		PageCount: 431,
+	}
+	validationSvc.On("ValidateStruct", expected).Return(nil)
+	bookRepo.On("CreateBook", mock.Anything, expected).Return(func(_ context.Context, b domain.Book) (domain.Book, error) {
+		b.ID = 7
+		return b, nil
+	})
+
+	book, err := service.CreateBookFromISBN(context.Background(), 1, "9780132350884", domain.Book{Title: "My Clean Code", Rating: 5})
+
+	assert.NoError(t, err)
+	assert.Equal(t, int64(7), book.ID)
+	assert.Equal(t, "My Clean Code", book.Title)
+	assert.Equal(t, int64(431), book.PageCount)
+	assert.Equal(t, []domain.Author{{ID: 1, Name: "Robert C. Martin"}}, book.Authors)
+}
+
+func TestCreateBookFromISBN_NotFound(t *testing.T) {
+	service, bookRepo, metadataProvider, _ := setupMetadataBookService()
+
+	metadataProvider.On("LookupISBN", mock.Anything, "9780132350884").
+		Return(domain.Book{}, fmt.Errorf("%w: %s", domain.ErrRecordNotFound, "no book with this ISBN"))
+
+	_, err := service.CreateBookFromISBN(context.Background(), 1, "9780132350884", domain.Book{})
+
+	assert.ErrorIs(t, err, domain.ErrRecordNotFound)
+	bookRepo.AssertNotCalled(t, "CreateBook", mock.Anything, mock.Anything)
+}
+
+func TestSearchMetadata(t *testing.T) {
+	service, _, metadataProvider, _ := setupMetadataBookService()
+
+	metadataProvider.On("Search", mock.Anything, "Clean Code", "", int64(20)).
+		Return([]domain.Book{{Title: "Clean Code", ISBN10: "0132350882"}}, nil)
+
+	books, err := service.SearchMetadata(context.Background(), " Clean Code ", "", 100)
+
+	assert.NoError(t, err)
+	assert.Len(t, books, 1)
+	assert.Equal(t, "9780132350884", books[0].ISBN13)
+
+	_, err = service.SearchMetadata(context.Background(), "", " ", 10)
+	assert.ErrorIs(t, err, domain.ErrValidation)
+}
@@ -2,32 +2,61 @@ package goal
 
 import (
 	"context"
-	"errors"
+	"fmt"
+	"sort"
 	"time"
 
 	"github.com/rimvydascivilis/book-tracker/backend/domain"
 	"github.com/rimvydascivilis/book-tracker/backend/dto"
+	"github.com/rimvydascivilis/book-tracker/backend/utils"
 )
 
 type goalService struct {
 	goalRepo      domain.GoalRepository
 	progressRepo  domain.ProgressRepository
-	readingRepo   domain.ReadingRepository
+	userRepo      domain.UserRepository
+	streakSvc     domain.StreakService
+	txManager     domain.TxManager
 	validationSvc domain.ValidationService
 }
 
-func NewGoalService(repo domain.GoalRepository, progressRepo domain.ProgressRepository,
-	readingRepo domain.ReadingRepository, validator domain.ValidationService) domain.GoalService {
+func NewGoalService(repo domain.GoalRepository, progressRepo domain.ProgressRepository, userRepo domain.UserRepository,
+	streakSvc domain.StreakService, txManager domain.TxManager, validator domain.ValidationService) domain.GoalService {
 	return &goalService{
 		goalRepo:      repo,
 		progressRepo:  progressRepo,
-		readingRepo:   readingRepo,
+		userRepo:      userRepo,
+		streakSvc:     streakSvc,
+		txManager:     txManager,
 		validationSvc: validator,
 	}
 }
 
-func (s *goalService) GetGoal(ctx context.Context, userID int64) (domain.Goal, error) {
-	goal, err := s.goalRepo.GetGoalByUserID(ctx, userID)
+func (s *goalService) GetGoals(ctx context.Context, userID int64) ([]domain.Goal, error) {
+	return s.goalRepo.GetGoalsByUserID(ctx, userID)
+}
+
+func (s *goalService) CreateGoal(ctx context.Context, userID int64, goal domain.Goal) (domain.Goal, error) {
+	goal.ID = 0
+	goal.UserID = userID
+
+	if err := s.validationSvc.ValidateStruct(goal); err != nil {
+		return domain.Goal{}, err
+	}
+
+	today, err := s.today(ctx, userID)
+	if err != nil {
+		return domain.Goal{}, err
+	}
+
+	err = s.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		if goal, err = s.goalRepo.CreateGoal(ctx, goal); err != nil {
+			return err
+		}
+		_, err = s.goalRepo.CreateGoalVersion(ctx, newGoalVersion(goal, today))
+		return err
+	})
 	if err != nil {
 		return domain.Goal{}, err
 	}
@@ -35,87 +64,258 @@ func (s *goalService) GetGoal(ctx context.Context, userID int64) (domain.Goal, e
 	return goal, nil
 }
 
-func (s *goalService) SetGoal(ctx context.Context, userID int64, goal domain.Goal) (domain.Goal, error) {
-	currentGoal, err := s.goalRepo.GetGoalByUserID(ctx, userID)
-	if err == nil {
-		if goal.Type != "" {
-			currentGoal.Type = goal.Type
-		}
-		if goal.Frequency != "" {
-			currentGoal.Frequency = goal.Frequency
+func (s *goalService) UpdateGoal(ctx context.Context, userID, goalID int64, goal domain.Goal) (domain.Goal, error) {
+	currentGoal, err := s.getUserGoal(ctx, userID, goalID)
+	if err != nil {
+		return domain.Goal{}, err
+	}
+	previousGoal := currentGoal
+
+	if goal.Type != "" {
+		currentGoal.Type = goal.Type
+	}
+	if goal.Frequency != "" {
+		currentGoal.Frequency = goal.Frequency
+	}
+	if goal.Value > 0 {
+		currentGoal.Value = goal.Value
+	}
+
+	if err := s.validationSvc.ValidateStruct(currentGoal); err != nil {
+		return domain.Goal{}, err
+	}
+
+	// Setting the same values again keeps the current version.
+	if currentGoal == previousGoal {
+		return currentGoal, nil
+	}
+
+	// The new version starts today; a change made on the day the version
+	// started replaces it.
+	today, err := s.today(ctx, userID)
+	if err != nil {
+		return domain.Goal{}, err
+	}
+	err = s.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		if currentGoal, err = s.goalRepo.UpdateGoal(ctx, currentGoal); err != nil {
+			return err
 		}
-		if goal.Value > 0 {
-			currentGoal.Value = goal.Value
+		if err := s.goalRepo.EndGoalVersion(ctx, goalID, today.AddDate(0, 0, -1)); err != nil {
+			return err
 		}
-		currentGoal.UserID = userID
+		_, err = s.goalRepo.CreateGoalVersion(ctx, newGoalVersion(currentGoal, today))
+		return err
+	})
+	if err != nil {
+		return domain.Goal{}, err
+	}
 
-		if err := s.validationSvc.ValidateStruct(currentGoal); err != nil {
-			return domain.Goal{}, err
-		}
+	return currentGoal, nil
+}
 
-		return s.goalRepo.UpdateGoal(ctx, currentGoal)
-	} else if errors.Is(err, domain.ErrRecordNotFound) {
-		goal.UserID = userID
+// DeleteGoal keeps the history of the goal until yesterday.
+func (s *goalService) DeleteGoal(ctx context.Context, userID, goalID int64) error {
+	if _, err := s.getUserGoal(ctx, userID, goalID); err != nil {
+		return err
+	}
+	today, err := s.today(ctx, userID)
+	if err != nil {
+		return err
+	}
 
-		if err := s.validationSvc.ValidateStruct(goal); err != nil {
-			return domain.Goal{}, err
+	return s.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := s.goalRepo.EndGoalVersion(ctx, goalID, today.AddDate(0, 0, -1)); err != nil {
+			return err
 		}
+		return s.goalRepo.DeleteGoal(ctx, goalID)
+	})
+}
 
-		return s.goalRepo.CreateGoal(ctx, goal)
+// today is the current day in the timezone of the user, which goal periods
+// are counted in.
+func (s *goalService) today(ctx context.Context, userID int64) (time.Time, error) {
+	user, err := s.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return time.Time{}, err
 	}
+	return utils.Today(user.Location()), nil
+}
 
-	return domain.Goal{}, err
+func newGoalVersion(goal domain.Goal, from time.Time) domain.GoalVersion {
+	return domain.GoalVersion{
+		GoalID:        goal.ID,
+		UserID:        goal.UserID,
+		Type:          goal.Type,
+		Frequency:     goal.Frequency,
+		Value:         goal.Value,
+		EffectiveFrom: from,
+	}
+}
+
+func (s *goalService) getUserGoal(ctx context.Context, userID, goalID int64) (domain.Goal, error) {
+	goal, err := s.goalRepo.GetGoalByID(ctx, goalID)
+	if err != nil {
+		return domain.Goal{}, err
+	}
+	if goal.UserID != userID {
+		return domain.Goal{}, fmt.Errorf("%w: %s", domain.ErrForbidden, "goal does not belong to user")
+	}
+
+	return goal, nil
 }
 
 func (s *goalService) GetGoalProgress(ctx context.Context, userID int64) (dto.GoalProgressResponse, error) {
-	goal, err := s.goalRepo.GetGoalByUserID(ctx, userID)
+	goals, err := s.goalRepo.GetGoalsByUserID(ctx, userID)
 	if err != nil {
 		return dto.GoalProgressResponse{}, err
 	}
 
-	period := ""
-	if goal.Frequency == domain.GoalFrequencyDaily {
-		period = time.Now().Format("2006-01-02")
-	} else if goal.Frequency == domain.GoalFrequencyMonthly {
-		period = time.Now().Format("2006-01")
+	today, err := s.today(ctx, userID)
+	if err != nil {
+		return dto.GoalProgressResponse{}, err
 	}
-	readingIDs, err := s.progressRepo.GetUserReadingIDsByPeriod(ctx, userID, period)
+	cache := map[[2]time.Time]dto.PeriodProgress{}
+	res := dto.GoalProgressResponse{Goals: make([]dto.GoalProgress, 0, len(goals))}
+	for _, goal := range goals {
+		from, to := goal.Period(today)
+		progress, err := s.progressBetween(ctx, cache, userID, goal.Type, from, to)
+		if err != nil {
+			return dto.GoalProgressResponse{}, err
+		}
 
+		done := min(progress, goal.Value)
+		res.Goals = append(res.Goals, dto.GoalProgress{
+			GoalID:      goal.ID,
+			Type:        goal.Type,
+			Frequency:   goal.Frequency,
+			Value:       goal.Value,
+			Progress:    progress,
+			Percentage:  float64(done) / float64(goal.Value) * 100,
+			Left:        goal.Value - done,
+			PeriodStart: from.Format("2006-01-02"),
+			PeriodEnd:   to.Format("2006-01-02"),
+		})
+	}
+
+	res.Streaks, err = s.streakSvc.GetStreaks(ctx, userID)
 	if err != nil {
 		return dto.GoalProgressResponse{}, err
 	}
 
-	var progress int64
-	for _, readingID := range readingIDs {
-		if goal.Type == domain.GoalTypePages {
-			dayProgress, err := s.progressRepo.GetProgressByReadingAndDate(ctx, readingID, period)
-			if err != nil {
-				return dto.GoalProgressResponse{}, err
-			}
+	return res, nil
+}
 
-			progress += dayProgress
-		} else if goal.Type == domain.GoalTypeBooks {
-			totalProgress, err := s.progressRepo.GetTotalProgressByReadingID(ctx, readingID)
-			if err != nil {
-				return dto.GoalProgressResponse{}, err
-			}
+func (s *goalService) GetGoalHistory(ctx context.Context, userID int64) (dto.GoalHistoryResponse, error) {
+	versions, err := s.goalRepo.GetGoalVersionsByUserID(ctx, userID)
+	if err != nil {
+		return dto.GoalHistoryResponse{}, err
+	}
 
-			reading, err := s.readingRepo.GetReadingByID(ctx, readingID)
-			if err != nil {
-				return dto.GoalProgressResponse{}, err
+	today, err := s.today(ctx, userID)
+	if err != nil {
+		return dto.GoalHistoryResponse{}, err
+	}
+	yesterday := today.AddDate(0, 0, -1)
+
+	// The periods of every version are added up from a single query over
+	// the days since the first of them started.
+	var byDay map[time.Time]dto.DayProgress
+	if len(versions) > 0 {
+		var first time.Time
+		for i, version := range versions {
+			if from, _ := version.Goal().Period(version.EffectiveFrom); i == 0 || from.Before(first) {
+				first = from
 			}
+		}
+		days, err := s.progressRepo.GetProgressByDay(ctx, userID, first, yesterday)
+		if err != nil {
+			return dto.GoalHistoryResponse{}, err
+		}
+		byDay = make(map[time.Time]dto.DayProgress, len(days))
+		for _, day := range days {
+			byDay[utils.Date(day.Day)] = day
+		}
+	}
 
-			if reading.GetStatus(totalProgress) == domain.ReadingStatusCompleted {
-				progress++
+	res := dto.GoalHistoryResponse{Periods: []dto.GoalPeriod{}}
+	for _, version := range versions {
+		// Periods that end after the version do not belong to it, and the
+		// period that contains today is not over yet.
+		last := yesterday
+		if version.EffectiveTo != nil && version.EffectiveTo.Before(last) {
+			last = utils.Date(*version.EffectiveTo)
+		}
+
+		goal := version.Goal()
+		for day := utils.Date(version.EffectiveFrom); !day.After(last); {
+			from, to := goal.Period(day)
+			if to.After(last) {
+				break
 			}
+
+			achieved := goalProgress(sumDays(byDay, from, to), goal.Type)
+			res.Periods = append(res.Periods, dto.GoalPeriod{
+				GoalID:      goal.ID,
+				Type:        goal.Type,
+				Frequency:   goal.Frequency,
+				Value:       goal.Value,
+				Achieved:    achieved,
+				Hit:         achieved >= goal.Value,
+				PeriodStart: from.Format("2006-01-02"),
+				PeriodEnd:   to.Format("2006-01-02"),
+			})
+			day = to.AddDate(0, 0, 1)
 		}
 	}
 
-	progress = min(progress, goal.Value)
-	goalProgress := dto.GoalProgressResponse{
-		Percentage: float64(progress) / float64(goal.Value) * 100,
-		Left:       goal.Value - progress,
+	sort.SliceStable(res.Periods, func(i, j int) bool {
+		return res.Periods[i].PeriodEnd > res.Periods[j].PeriodEnd
+	})
+	return res, nil
+}
+
+// progressBetween returns what goals of goalType count from one day to
+// another. Progress is loaded once per period and shared by the goals
+// measured over it.
+func (s *goalService) progressBetween(ctx context.Context, cache map[[2]time.Time]dto.PeriodProgress, userID int64,
+	goalType string, from, to time.Time) (int64, error) {
+	progress, ok := cache[[2]time.Time{from, to}]
+	if !ok {
+		var err error
+		if progress, err = s.progressRepo.GetPeriodProgress(ctx, userID, from, to); err != nil {
+			return 0, err
+		}
+		cache[[2]time.Time{from, to}] = progress
 	}
 
-	return goalProgress, nil
+	return goalProgress(progress, goalType), nil
+}
+
+// sumDays adds up the progress of the days from one day to another.
+func sumDays(byDay map[time.Time]dto.DayProgress, from, to time.Time) dto.PeriodProgress {
+	var pageHundredths int64
+	var progress dto.PeriodProgress
+	for day := from; !day.After(to); day = day.AddDate(0, 0, 1) {
+		p := byDay[day]
+		pageHundredths += p.PageHundredths
+		progress.Minutes += p.Minutes
+		progress.Books += p.Books
+	}
+	progress.Pages = pageHundredths / 100
+	return progress
+}
+
+// goalProgress returns what goals of goalType count of progress: normalized
+// pages, minutes or finished readings. Every finished reading counts, so a
+// re-read book counts again.
+func goalProgress(progress dto.PeriodProgress, goalType string) int64 {
+	switch goalType {
+	case domain.GoalTypeBooks:
+		return progress.Books
+	case domain.GoalTypeMinutes:
+		return progress.Minutes
+	default:
+		return progress.Pages
+	}
 }
@@ -4,61 +4,125 @@ import (
 	"context"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/rimvydascivilis/book-tracker/backend/domain"
+	"github.com/rimvydascivilis/book-tracker/backend/dto"
+	"github.com/rimvydascivilis/book-tracker/backend/internal/repository/memory"
 	"github.com/rimvydascivilis/book-tracker/backend/mocks"
+	"github.com/rimvydascivilis/book-tracker/backend/services/validation"
+	"github.com/rimvydascivilis/book-tracker/backend/utils"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 )
 
+func newTxManager() *mocks.TxManager {
+	txManager := new(mocks.TxManager)
+	txManager.On("WithinTransaction", mock.Anything, mock.Anything).Maybe().
+		Return(func(ctx context.Context, fn func(context.Context) error) error { return fn(ctx) })
+	return txManager
+}
+
+func newUserRepository(timezone string) *mocks.UserRepository {
+	userRepo := new(mocks.UserRepository)
+	userRepo.On("GetByID", mock.Anything, mock.Anything).Maybe().
+		Return(func(_ context.Context, id int64) (domain.User, error) {
+			return domain.User{ID: id, Timezone: timezone}, nil
+		})
+	return userRepo
+}
+
 func setupGoalService() (domain.GoalService, *mocks.GoalRepository, *mocks.ValidationService) {
 	goalRepo := new(mocks.GoalRepository)
 	validationSvc := new(mocks.ValidationService)
-	goalService := NewGoalService(goalRepo, nil, nil, validationSvc)
+	goalService := NewGoalService(goalRepo, nil, newUserRepository("UTC"), nil, newTxManager(), validationSvc)
 
 	return goalService, goalRepo, validationSvc
 }
 
-func TestGetGoal_Success(t *testing.T) {
+func TestGetGoals_Success(t *testing.T) {
 	service, mockRepo, _ := setupGoalService()
 
 	userID := int64(1)
+	expectedGoals := []domain.Goal{
+		{ID: 1, UserID: userID, Type: "pages", Frequency: "daily", Value: 20},
+		{ID: 2, UserID: userID, Type: "books", Frequency: "yearly", Value: 24},
+	}
+
+	mockRepo.On("GetGoalsByUserID", mock.Anything, userID).Return(expectedGoals, nil)
+
+	result, err := service.GetGoals(context.Background(), userID)
+
+	assert.NoError(t, err)
+	assert.Equal(t, expectedGoals, result)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestCreateGoal_Success(t *testing.T) {
+	service, mockRepo, validationSvc := setupGoalService()
+
+	userID := int64(1)
+	newGoal := domain.Goal{
+		ID:        7,
+		Type:      "books",
+		Frequency: "yearly",
+		Value:     24,
+	}
 	expectedGoal := domain.Goal{
 		UserID:    userID,
 		Type:      "books",
-		Frequency: "daily",
-		Value:     10,
+		Frequency: "yearly",
+		Value:     24,
 	}
 
-	mockRepo.On("GetGoalByUserID", mock.Anything, userID).Return(expectedGoal, nil)
+	validationSvc.On("ValidateStruct", expectedGoal).Return(nil)
+	mockRepo.On("CreateGoal", mock.Anything, expectedGoal).Return(domain.Goal{ID: 3, UserID: userID, Type: "books", Frequency: "yearly", Value: 24}, nil)
+	mockRepo.On("CreateGoalVersion", mock.Anything, domain.GoalVersion{
+		GoalID: 3, UserID: userID, Type: "books", Frequency: "yearly", Value: 24, EffectiveFrom: utils.Date(utils.Now()),
+	}).Return(domain.GoalVersion{ID: 1}, nil)
 
-	result, err := service.GetGoal(context.Background(), userID)
+	result, err := service.CreateGoal(context.Background(), userID, newGoal)
 
 	assert.NoError(t, err)
-	assert.Equal(t, expectedGoal, result)
+	assert.Equal(t, int64(3), result.ID)
 	mockRepo.AssertExpectations(t)
+	validationSvc.AssertExpectations(t)
 }
 
-func TestGetGoal_NotFound(t *testing.T) {
-	service, mockRepo, _ := setupGoalService()
+func TestCreateGoal_ValidationError(t *testing.T) {
+	service, mockRepo, validationSvc := setupGoalService()
 
 	userID := int64(1)
+	newGoal := domain.Goal{
+		Type:      "books",
+		Frequency: "hourly",
+		Value:     10,
+	}
+	expectedGoal := domain.Goal{
+		UserID:    userID,
+		Type:      "books",
+		Frequency: "hourly",
+		Value:     10,
+	}
 
-	mockRepo.On("GetGoalByUserID", mock.Anything, userID).Return(domain.Goal{}, domain.ErrRecordNotFound)
+	validationSvc.On("ValidateStruct", expectedGoal).Return(fmt.Errorf("validation error"))
 
-	result, err := service.GetGoal(context.Background(), userID)
+	result, err := service.CreateGoal(context.Background(), userID, newGoal)
 
 	assert.Error(t, err)
-	assert.Equal(t, domain.ErrRecordNotFound, err)
+	assert.Contains(t, err.Error(), "validation error")
 	assert.Equal(t, domain.Goal{}, result)
-	mockRepo.AssertExpectations(t)
+	mockRepo.AssertNotCalled(t, "CreateGoal", mock.Anything, mock.Anything)
+	validationSvc.AssertExpectations(t)
 }
 
-func TestSetGoal_UpdateExistingGoal(t *testing.T) {
+func TestUpdateGoal_Success(t *testing.T) {
 	service, mockRepo, validationSvc := setupGoalService()
 
 	userID := int64(1)
 	currentGoal := domain.Goal{
+		ID:        4,
 		UserID:    userID,
 		Type:      "pages",
 		Frequency: "weekly",
@@ -69,17 +133,21 @@ func TestSetGoal_UpdateExistingGoal(t *testing.T) {
 		Value:     20,
 	}
 	expectedGoal := domain.Goal{
+		ID:        4,
 		UserID:    userID,
 		Type:      "pages",
 		Frequency: "daily",
 		Value:     20,
 	}
 
-	mockRepo.On("GetGoalByUserID", mock.Anything, userID).Return(currentGoal, nil)
+	mockRepo.On("GetGoalByID", mock.Anything, int64(4)).Return(currentGoal, nil)
 	validationSvc.On("ValidateStruct", expectedGoal).Return(nil)
 	mockRepo.On("UpdateGoal", mock.Anything, expectedGoal).Return(expectedGoal, nil)
+	today := utils.Date(utils.Now())
+	mockRepo.On("EndGoalVersion", mock.Anything, int64(4), today.AddDate(0, 0, -1)).Return(nil)
+	mockRepo.On("CreateGoalVersion", mock.Anything, newGoalVersion(expectedGoal, today)).Return(domain.GoalVersion{ID: 2}, nil)
 
-	result, err := service.SetGoal(context.Background(), userID, updatedGoal)
+	result, err := service.UpdateGoal(context.Background(), userID, 4, updatedGoal)
 
 	assert.NoError(t, err)
 	assert.Equal(t, expectedGoal, result)
@@ -87,93 +155,336 @@ func TestSetGoal_UpdateExistingGoal(t *testing.T) {
 	validationSvc.AssertExpectations(t)
 }
 
-func TestSetGoal_CreateNewGoal(t *testing.T) {
+func TestUpdateGoal_UpdateError(t *testing.T) {
 	service, mockRepo, validationSvc := setupGoalService()
 
 	userID := int64(1)
-	newGoal := domain.Goal{
-		Type:      "books",
-		Frequency: "daily",
-		Value:     10,
+	currentGoal := domain.Goal{
+		ID:        4,
+		UserID:    userID,
+		Type:      "pages",
+		Frequency: "weekly",
+		Value:     5,
 	}
 	expectedGoal := domain.Goal{
+		ID:        4,
 		UserID:    userID,
-		Type:      "books",
+		Type:      "pages",
 		Frequency: "daily",
-		Value:     10,
+		Value:     5,
 	}
 
-	mockRepo.On("GetGoalByUserID", mock.Anything, userID).Return(domain.Goal{}, domain.ErrRecordNotFound)
+	mockRepo.On("GetGoalByID", mock.Anything, int64(4)).Return(currentGoal, nil)
 	validationSvc.On("ValidateStruct", expectedGoal).Return(nil)
-	mockRepo.On("CreateGoal", mock.Anything, expectedGoal).Return(expectedGoal, nil)
+	mockRepo.On("UpdateGoal", mock.Anything, expectedGoal).Return(domain.Goal{}, fmt.Errorf("%w: daily pages goal", domain.ErrAlreadyExists))
 
-	result, err := service.SetGoal(context.Background(), userID, newGoal)
+	result, err := service.UpdateGoal(context.Background(), userID, 4, domain.Goal{Frequency: "daily"})
 
-	assert.NoError(t, err)
-	assert.Equal(t, expectedGoal, result)
+	assert.ErrorIs(t, err, domain.ErrAlreadyExists)
+	assert.Equal(t, domain.Goal{}, result)
 	mockRepo.AssertExpectations(t)
+	mockRepo.AssertNotCalled(t, "EndGoalVersion", mock.Anything, mock.Anything, mock.Anything)
 	validationSvc.AssertExpectations(t)
 }
 
-func TestSetGoal_ValidationError(t *testing.T) {
+func TestUpdateGoal_Unchanged(t *testing.T) {
 	service, mockRepo, validationSvc := setupGoalService()
 
-	userID := int64(1)
-	newGoal := domain.Goal{
-		Type:      "books",
-		Frequency: "daily",
-		Value:     10,
-	}
-	expectedGoal := domain.Goal{
-		UserID:    userID,
-		Type:      "books",
-		Frequency: "daily",
-		Value:     10,
-	}
+	goal := domain.Goal{ID: 4, UserID: 1, Type: "pages", Frequency: "daily", Value: 5}
+	mockRepo.On("GetGoalByID", mock.Anything, int64(4)).Return(goal, nil)
+	validationSvc.On("ValidateStruct", goal).Return(nil)
 
-	mockRepo.On("GetGoalByUserID", mock.Anything, userID).Return(domain.Goal{}, domain.ErrRecordNotFound)
-	validationSvc.On("ValidateStruct", expectedGoal).Return(fmt.Errorf("validation error"))
+	result, err := service.UpdateGoal(context.Background(), 1, 4, domain.Goal{Value: 5})
 
-	result, err := service.SetGoal(context.Background(), userID, newGoal)
+	assert.NoError(t, err)
+	assert.Equal(t, goal, result)
+	mockRepo.AssertNotCalled(t, "UpdateGoal", mock.Anything, mock.Anything)
+	mockRepo.AssertNotCalled(t, "CreateGoalVersion", mock.Anything, mock.Anything)
+}
 
-	assert.Error(t, err)
-	assert.Contains(t, err.Error(), "validation error")
-	assert.Equal(t, domain.Goal{}, result)
+func TestUpdateGoal_OtherUsersGoal(t *testing.T) {
+	service, mockRepo, validationSvc := setupGoalService()
+
+	mockRepo.On("GetGoalByID", mock.Anything, int64(4)).Return(domain.Goal{ID: 4, UserID: 2, Type: "pages", Frequency: "daily", Value: 5}, nil)
+
+	_, err := service.UpdateGoal(context.Background(), 1, 4, domain.Goal{Value: 10})
+
+	assert.ErrorIs(t, err, domain.ErrForbidden)
+	validationSvc.AssertNotCalled(t, "ValidateStruct", mock.Anything)
+	mockRepo.AssertNotCalled(t, "UpdateGoal", mock.Anything, mock.Anything)
+}
+
+func TestDeleteGoal_Success(t *testing.T) {
+	service, mockRepo, _ := setupGoalService()
+
+	mockRepo.On("GetGoalByID", mock.Anything, int64(4)).Return(domain.Goal{ID: 4, UserID: 1, Type: "pages", Frequency: "daily", Value: 5}, nil)
+	mockRepo.On("EndGoalVersion", mock.Anything, int64(4), utils.Date(utils.Now()).AddDate(0, 0, -1)).Return(nil)
+	mockRepo.On("DeleteGoal", mock.Anything, int64(4)).Return(nil)
+
+	err := service.DeleteGoal(context.Background(), 1, 4)
+
+	assert.NoError(t, err)
 	mockRepo.AssertExpectations(t)
-	validationSvc.AssertExpectations(t)
 }
 
-func TestSetGoal_UpdateError(t *testing.T) {
-	service, mockRepo, validationSvc := setupGoalService()
+func TestDeleteGoal_NotFound(t *testing.T) {
+	service, mockRepo, _ := setupGoalService()
+
+	mockRepo.On("GetGoalByID", mock.Anything, int64(4)).Return(domain.Goal{}, fmt.Errorf("%w: goal 4 not found", domain.ErrRecordNotFound))
+
+	err := service.DeleteGoal(context.Background(), 1, 4)
+
+	assert.ErrorIs(t, err, domain.ErrRecordNotFound)
+	mockRepo.AssertNotCalled(t, "DeleteGoal", mock.Anything, mock.Anything)
+}
+
+func TestGetGoalProgress_BooksCountsFinishedReadings(t *testing.T) {
+	goalRepo := new(mocks.GoalRepository)
+	progressRepo := new(mocks.ProgressRepository)
+	streakSvc := new(mocks.StreakService)
+	service := NewGoalService(goalRepo, progressRepo, newUserRepository("UTC"), streakSvc, newTxManager(),
+		new(mocks.ValidationService))
 
 	userID := int64(1)
-	currentGoal := domain.Goal{
-		UserID:    userID,
-		Type:      "pages",
-		Frequency: "weekly",
-		Value:     5,
+	goal := domain.Goal{ID: 2, UserID: userID, Type: domain.GoalTypeBooks, Frequency: domain.GoalFrequencyMonthly, Value: 4}
+	from, to := goal.Period(utils.Now())
+	goalRepo.On("GetGoalsByUserID", mock.Anything, userID).Return([]domain.Goal{goal}, nil)
+	// Two readings of the same book finished this month count twice.
+	progressRepo.On("GetPeriodProgress", mock.Anything, userID, from, to).Return(dto.PeriodProgress{Pages: 120, Books: 2}, nil)
+	streakSvc.On("GetStreaks", mock.Anything, userID).Return(dto.StreakResponse{Current: 3, Longest: 8}, nil)
+
+	progress, err := service.GetGoalProgress(context.Background(), userID)
+
+	assert.NoError(t, err)
+	require.Len(t, progress.Goals, 1)
+	assert.Equal(t, int64(2), progress.Goals[0].GoalID)
+	assert.Equal(t, float64(50), progress.Goals[0].Percentage)
+	assert.Equal(t, int64(2), progress.Goals[0].Left)
+	assert.Equal(t, from.Format("2006-01-02"), progress.Goals[0].PeriodStart)
+	assert.Equal(t, 1, from.Day())
+	assert.Equal(t, dto.StreakResponse{Current: 3, Longest: 8}, progress.Streaks)
+	progressRepo.AssertExpectations(t)
+}
+
+func TestGetGoalProgress_OneQueryPerPeriod(t *testing.T) {
+	goalRepo := new(mocks.GoalRepository)
+	progressRepo := new(mocks.ProgressRepository)
+	streakSvc := new(mocks.StreakService)
+	service := NewGoalService(goalRepo, progressRepo, newUserRepository("UTC"), streakSvc, newTxManager(),
+		new(mocks.ValidationService))
+
+	userID := int64(1)
+	streakSvc.On("GetStreaks", mock.Anything, userID).Return(dto.StreakResponse{}, nil)
+	today := utils.Date(utils.Now())
+	progressRepo.On("GetPeriodProgress", mock.Anything, userID, today, today).
+		Return(dto.PeriodProgress{Pages: 50, Minutes: 45}, nil).Once()
+
+	goalRepo.On("GetGoalsByUserID", mock.Anything, userID).Return([]domain.Goal{
+		{ID: 1, UserID: userID, Type: domain.GoalTypePages, Frequency: domain.GoalFrequencyDaily, Value: 100},
+		{ID: 2, UserID: userID, Type: domain.GoalTypeMinutes, Frequency: domain.GoalFrequencyDaily, Value: 30},
+	}, nil)
+	progress, err := service.GetGoalProgress(context.Background(), userID)
+
+	assert.NoError(t, err)
+	require.Len(t, progress.Goals, 2)
+	assert.Equal(t, int64(50), progress.Goals[0].Progress)
+	assert.Equal(t, int64(50), progress.Goals[0].Left)
+	assert.Equal(t, int64(45), progress.Goals[1].Progress)
+	assert.Equal(t, int64(0), progress.Goals[1].Left, "going past the goal leaves nothing")
+	assert.Equal(t, float64(100), progress.Goals[1].Percentage)
+	progressRepo.AssertNumberOfCalls(t, "GetPeriodProgress", 1)
+}
+
+func TestGoalPeriod(t *testing.T) {
+	// A Wednesday in a leap year.
+	day := time.Date(2024, 2, 14, 18, 30, 0, 0, time.UTC)
+
+	for frequency, want := range map[string][2]string{
+		domain.GoalFrequencyDaily:   {"2024-02-14", "2024-02-14"},
+		domain.GoalFrequencyWeekly:  {"2024-02-12", "2024-02-18"},
+		domain.GoalFrequencyMonthly: {"2024-02-01", "2024-02-29"},
+		domain.GoalFrequencyYearly:  {"2024-01-01", "2024-12-31"},
+	} {
+		from, to := domain.Goal{Frequency: frequency}.Period(day)
+		assert.Equal(t, want, [2]string{from.Format("2006-01-02"), to.Format("2006-01-02")}, frequency)
 	}
-	updatedGoal := domain.Goal{
-		Type:      "books",
-		Frequency: "daily",
-		Value:     20,
+
+	// Sunday still belongs to the week that started on Monday.
+	from, _ := domain.Goal{Frequency: domain.GoalFrequencyWeekly}.Period(time.Date(2024, 2, 18, 0, 0, 0, 0, time.UTC))
+	assert.Equal(t, "2024-02-12", from.Format("2006-01-02"))
+}
+
+// TestGetGoalProgress_TodayOfUser counts the periods from today in the
+// timezone of the user, which for Kiritimati is often tomorrow in UTC.
+func TestGetGoalProgress_TodayOfUser(t *testing.T) {
+	goalRepo := new(mocks.GoalRepository)
+	progressRepo := new(mocks.ProgressRepository)
+	streakSvc := new(mocks.StreakService)
+	service := NewGoalService(goalRepo, progressRepo, newUserRepository("Pacific/Kiritimati"), streakSvc,
+		newTxManager(), new(mocks.ValidationService))
+
+	userID := int64(1)
+	loc, err := time.LoadLocation("Pacific/Kiritimati")
+	require.NoError(t, err)
+	today := utils.Today(loc)
+	goalRepo.On("GetGoalsByUserID", mock.Anything, userID).Return([]domain.Goal{
+		{ID: 1, UserID: userID, Type: domain.GoalTypePages, Frequency: domain.GoalFrequencyDaily, Value: 100},
+	}, nil)
+	progressRepo.On("GetPeriodProgress", mock.Anything, userID, today, today).Return(dto.PeriodProgress{Pages: 30}, nil)
+	streakSvc.On("GetStreaks", mock.Anything, userID).Return(dto.StreakResponse{}, nil)
+
+	progress, err := service.GetGoalProgress(context.Background(), userID)
+
+	assert.NoError(t, err)
+	require.Len(t, progress.Goals, 1)
+	assert.Equal(t, today.Format("2006-01-02"), progress.Goals[0].PeriodStart)
+	assert.Equal(t, int64(30), progress.Goals[0].Progress)
+}
+
+// TestGoalPeriod_DST takes the period from the day of the user, not from a
+// fixed offset: 21:30 UTC on the night Vilnius moved to summer time is
+// already 00:30 on the first of April there.
+func TestGoalPeriod_DST(t *testing.T) {
+	vilnius, err := time.LoadLocation("Europe/Vilnius")
+	require.NoError(t, err)
+	day := utils.DateIn(time.Date(2024, 3, 31, 21, 30, 0, 0, time.UTC), vilnius)
+
+	from, to := domain.Goal{Frequency: domain.GoalFrequencyMonthly}.Period(day)
+	assert.Equal(t, [2]string{"2024-04-01", "2024-04-30"}, [2]string{from.Format("2006-01-02"), to.Format("2006-01-02")})
+
+	// The week the clocks changed in is seven days long all the same.
+	from, to = domain.Goal{Frequency: domain.GoalFrequencyWeekly}.Period(day.AddDate(0, 0, -1))
+	assert.Equal(t, [2]string{"2024-03-25", "2024-03-31"}, [2]string{from.Format("2006-01-02"), to.Format("2006-01-02")})
+
+	// Autumn: 22:30 UTC on the last Sunday of October is 00:30 on Monday.
+	day = utils.DateIn(time.Date(2024, 10, 27, 22, 30, 0, 0, time.UTC), vilnius)
+	from, _ = domain.Goal{Frequency: domain.GoalFrequencyWeekly}.Period(day)
+	assert.Equal(t, "2024-10-28", from.Format("2006-01-02"))
+}
+
+func setupGoalHistory(t *testing.T) (domain.GoalService, *memory.Store, domain.User) {
+	store := memory.NewStore()
+	user, err := memory.NewUserRepository(store).CreateUser(context.Background(), domain.User{Email: "user@example.com"})
+	require.NoError(t, err)
+
+	service := NewGoalService(memory.NewGoalRepository(store), memory.NewProgressRepository(store),
+		memory.NewUserRepository(store), nil, memory.NewTxManager(store), validation.NewValidationService())
+	return service, store, user
+}
+
+func TestGetGoalHistory_UsesTargetInEffect(t *testing.T) {
+	service, store, user := setupGoalHistory(t)
+	ctx := context.Background()
+	today := utils.Date(utils.Now())
+	daysAgo := func(days int) time.Time { return today.AddDate(0, 0, -days) }
+
+	book, err := memory.NewBookRepository(store).CreateBook(ctx, domain.Book{UserID: user.ID, Title: "Dune"})
+	require.NoError(t, err)
+	reading, err := memory.NewReadingRepository(store).CreateReading(ctx, domain.Reading{
+		UserID: user.ID, BookID: book.ID, Format: domain.ReadingFormatPrint, TotalPages: 500, Status: domain.ReadingStatusReading,
+	})
+	require.NoError(t, err)
+	var position int64
+	for days, pages := range map[int]int64{4: 20, 3: 20, 2: 20, 1: 40, 0: 5} {
+		position += pages
+		_, err := memory.NewProgressRepository(store).CreateProgress(ctx, domain.Progress{
+			UserID: user.ID, ReadingID: reading.ID, Pages: pages, Position: position, ReadingDate: daysAgo(days),
+		})
+		require.NoError(t, err)
 	}
-	expectedGoal := domain.Goal{
-		UserID:    userID,
-		Type:      "books",
-		Frequency: "daily",
-		Value:     20,
+
+	// 10 pages a day until three days ago, 30 since.
+	goalRepo := memory.NewGoalRepository(store)
+	goal, err := goalRepo.CreateGoal(ctx, domain.Goal{UserID: user.ID, Type: domain.GoalTypePages, Frequency: domain.GoalFrequencyDaily, Value: 30})
+	require.NoError(t, err)
+	ended := daysAgo(3)
+	for _, version := range []domain.GoalVersion{
+		{GoalID: goal.ID, UserID: user.ID, Type: goal.Type, Frequency: goal.Frequency, Value: 10, EffectiveFrom: daysAgo(4), EffectiveTo: &ended},
+		{GoalID: goal.ID, UserID: user.ID, Type: goal.Type, Frequency: goal.Frequency, Value: 30, EffectiveFrom: daysAgo(2)},
+	} {
+		_, err := goalRepo.CreateGoalVersion(ctx, version)
+		require.NoError(t, err)
 	}
 
-	mockRepo.On("GetGoalByUserID", mock.Anything, userID).Return(currentGoal, nil)
-	validationSvc.On("ValidateStruct", expectedGoal).Return(nil)
-	mockRepo.On("UpdateGoal", mock.Anything, expectedGoal).Return(domain.Goal{}, fmt.Errorf("update error"))
+	history, err := service.GetGoalHistory(ctx, user.ID)
+
+	require.NoError(t, err)
+	require.Len(t, history.Periods, 4, "today is not over yet")
+	for i, want := range []struct {
+		days     int
+		value    int64
+		achieved int64
+		hit      bool
+	}{
+		{1, 30, 40, true},
+		{2, 30, 20, false},
+		{3, 10, 20, true},
+		{4, 10, 20, true},
+	} {
+		period := history.Periods[i]
+		assert.Equal(t, daysAgo(want.days).Format("2006-01-02"), period.PeriodEnd)
+		assert.Equal(t, goal.ID, period.GoalID)
+		assert.Equal(t, want.value, period.Value)
+		assert.Equal(t, want.achieved, period.Achieved)
+		assert.Equal(t, want.hit, period.Hit)
+	}
+}
 
-	result, err := service.SetGoal(context.Background(), userID, updatedGoal)
+func TestGetGoalHistory_OneQuery(t *testing.T) {
+	goalRepo := new(mocks.GoalRepository)
+	progressRepo := new(mocks.ProgressRepository)
+	service := NewGoalService(goalRepo, progressRepo, newUserRepository("UTC"), nil, newTxManager(),
+		new(mocks.ValidationService))
 
-	assert.Error(t, err)
-	assert.Contains(t, err.Error(), "update error")
-	assert.Equal(t, domain.Goal{}, result)
-	mockRepo.AssertExpectations(t)
-	validationSvc.AssertExpectations(t)
+	userID := int64(1)
+	today := utils.Date(utils.Now())
+	daysAgo := func(days int) time.Time { return today.AddDate(0, 0, -days) }
+	weekStart, _ := domain.Goal{Frequency: domain.GoalFrequencyWeekly}.Period(daysAgo(60))
+	goalRepo.On("GetGoalVersionsByUserID", mock.Anything, userID).Return([]domain.GoalVersion{
+		{ID: 1, GoalID: 1, UserID: userID, Type: domain.GoalTypePages, Frequency: domain.GoalFrequencyDaily, Value: 20, EffectiveFrom: daysAgo(40)},
+		{ID: 2, GoalID: 2, UserID: userID, Type: domain.GoalTypeMinutes, Frequency: domain.GoalFrequencyWeekly, Value: 60, EffectiveFrom: daysAgo(60)},
+	}, nil)
+	progressRepo.On("GetProgressByDay", mock.Anything, userID, weekStart, daysAgo(1)).Return([]dto.DayProgress{
+		{Day: daysAgo(2), PageHundredths: 1950, Minutes: 30},
+		{Day: daysAgo(3), PageHundredths: 2050, Minutes: 40},
+	}, nil).Once()
+
+	history, err := service.GetGoalHistory(context.Background(), userID)
+
+	require.NoError(t, err)
+	progressRepo.AssertNumberOfCalls(t, "GetProgressByDay", 1)
+	achieved := map[string]int64{}
+	for _, period := range history.Periods {
+		if period.Type == domain.GoalTypePages {
+			achieved[period.PeriodEnd] = period.Achieved
+		}
+	}
+	assert.Len(t, achieved, 40)
+	assert.Equal(t, int64(19), achieved[daysAgo(2).Format("2006-01-02")])
+	assert.Equal(t, int64(20), achieved[daysAgo(3).Format("2006-01-02")])
+	assert.Equal(t, int64(0), achieved[daysAgo(4).Format("2006-01-02")])
+}
+
+func TestGetGoalHistory_ChangesMadeToday(t *testing.T) {
+	service, store, user := setupGoalHistory(t)
+	ctx := context.Background()
+
+	goal, err := service.CreateGoal(ctx, user.ID, domain.Goal{Type: domain.GoalTypeBooks, Frequency: domain.GoalFrequencyYearly, Value: 12})
+	require.NoError(t, err)
+	_, err = service.UpdateGoal(ctx, user.ID, goal.ID, domain.Goal{Value: 24})
+	require.NoError(t, err)
+
+	versions, err := memory.NewGoalRepository(store).GetGoalVersionsByUserID(ctx, user.ID)
+	require.NoError(t, err)
+	require.Len(t, versions, 1, "a change on the day the version started replaces it")
+	assert.Equal(t, int64(24), versions[0].Value)
+	assert.Nil(t, versions[0].EffectiveTo)
+
+	require.NoError(t, service.DeleteGoal(ctx, user.ID, goal.ID))
+	versions, err = memory.NewGoalRepository(store).GetGoalVersionsByUserID(ctx, user.ID)
+	require.NoError(t, err)
+	assert.Empty(t, versions)
+	history, err := service.GetGoalHistory(ctx, user.ID)
+	require.NoError(t, err)
+	assert.Empty(t, history.Periods)
 }
@@ -0,0 +1,200 @@
+package importer
+
+import (
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/rimvydascivilis/book-tracker/backend/domain"
+	"github.com/rimvydascivilis/book-tracker/backend/utils"
+)
+
+const (
+	goodreadsShelfRead             = "read"
+	goodreadsShelfCurrentlyReading = "currently-reading"
+	goodreadsShelfToRead           = "to-read"
+)
+
+// goodreadsRecord is one data line of a Goodreads export keyed by column name.
+// number counts data lines from 1, not counting the header.
+type goodreadsRecord struct {
+	number int64
+	fields map[string]string
+}
+
+type goodreadsBook struct {
+	id        string
+	title     string
+	authors   []string
+	isbn10    string
+	isbn13    string
+	rating    float64
+	publisher string
+	pages     int64
+	year      int64
+	dateRead  time.Time
+	dateAdded time.Time
+	shelf     string
+	// shelves are the custom shelves the book is on.
+	shelves []string
+}
+
+// parseGoodreadsCSV splits a Goodreads library export into records. Only
+// problems with the file as a whole are returned; bad values are reported
+// per row by parseGoodreadsBook.
+func parseGoodreadsCSV(data []byte) ([]goodreadsRecord, error) {
+	reader := csv.NewReader(bytes.NewReader(bytes.TrimPrefix(data, []byte("\ufeff"))))
+	reader.FieldsPerRecord = -1
+	reader.LazyQuotes = true
+
+	header, err := reader.Read()
+	if errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("%w: %s", domain.ErrValidation, "file is empty")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", domain.ErrValidation, err)
+	}
+	for i := range header {
+		header[i] = strings.TrimSpace(header[i])
+	}
+	for _, required := range []string{"Book Id", "Title"} {
+		if !slices.Contains(header, required) {
+			return nil, fmt.Errorf("%w: not a Goodreads export, missing column %q", domain.ErrValidation, required)
+		}
+	}
+
+	var records []goodreadsRecord
+	for {
+		line, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s", domain.ErrValidation, err)
+		}
+
+		fields := make(map[string]string, len(header))
+		for i, value := range line {
+			if i < len(header) {
+				fields[header[i]] = strings.TrimSpace(value)
+			}
+		}
+		records = append(records, goodreadsRecord{number: int64(len(records) + 1), fields: fields})
+	}
+	if len(records) == 0 {
+		return nil, fmt.Errorf("%w: %s", domain.ErrValidation, "file has no books")
+	}
+
+	return records, nil
+}
+
+func parseGoodreadsBook(record goodreadsRecord) (goodreadsBook, error) {
+	f := record.fields
+	book := goodreadsBook{
+		id:        f["Book Id"],
+		title:     f["Title"],
+		publisher: f["Publisher"],
+		shelf:     f["Exclusive Shelf"],
+	}
+	if book.id == "" {
+		return goodreadsBook{}, fmt.Errorf("%w: %s", domain.ErrValidation, "missing Book Id")
+	}
+
+	book.authors = splitList(f["Author"] + "," + f["Additional Authors"])
+	book.isbn10 = goodreadsISBN(f["ISBN"], utils.ValidISBN10)
+	book.isbn13 = goodreadsISBN(f["ISBN13"], utils.ValidISBN13)
+
+	var err error
+	if book.rating, err = parseNumber(f, "My Rating"); err != nil {
+		return goodreadsBook{}, err
+	}
+	pages, err := parseNumber(f, "Number of Pages")
+	if err != nil {
+		return goodreadsBook{}, err
+	}
+	book.pages = int64(pages)
+
+	year, err := parseNumber(f, "Year Published")
+	if err != nil {
+		return goodreadsBook{}, err
+	}
+	if year == 0 {
+		if year, err = parseNumber(f, "Original Publication Year"); err != nil {
+			return goodreadsBook{}, err
+		}
+	}
+	book.year = int64(year)
+
+	if book.dateRead, err = parseDate(f, "Date Read"); err != nil {
+		return goodreadsBook{}, err
+	}
+	if book.dateAdded, err = parseDate(f, "Date Added"); err != nil {
+		return goodreadsBook{}, err
+	}
+
+	for _, shelf := range splitList(f["Bookshelves"] + "," + book.shelf) {
+		switch shelf {
+		case goodreadsShelfRead, goodreadsShelfCurrentlyReading, goodreadsShelfToRead:
+		default:
+			book.shelves = append(book.shelves, shelf)
+		}
+	}
+
+	return book, nil
+}
+
+// goodreadsISBN unwraps the ="0132350882" spreadsheet formula Goodreads uses
+// for ISBNs and drops values that are not valid ISBNs, such as ASINs.
+func goodreadsISBN(value string, valid func(string) bool) string {
+	value = strings.Trim(strings.TrimPrefix(value, "="), `"`)
+	value = utils.NormalizeISBN(value)
+	if !valid(value) {
+		return ""
+	}
+	return value
+}
+
+func parseNumber(fields map[string]string, column string) (float64, error) {
+	value := fields[column]
+	if value == "" {
+		return 0, nil
+	}
+
+	n, err := strconv.ParseFloat(value, 64)
+	if err != nil || n < 0 {
+		return 0, fmt.Errorf("%w: invalid %s %q", domain.ErrValidation, column, value)
+	}
+	return n, nil
+}
+
+func parseDate(fields map[string]string, column string) (time.Time, error) {
+	value := fields[column]
+	if value == "" {
+		return time.Time{}, nil
+	}
+
+	for _, layout := range []string{"2006/01/02", "2006-01-02"} {
+		if date, err := time.ParseInLocation(layout, value, time.UTC); err == nil {
+			return date, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("%w: invalid %s %q", domain.ErrValidation, column, value)
+}
+
+// splitList splits a comma separated cell, dropping blanks and repeats.
+func splitList(value string) []string {
+	var items []string
+	for _, item := range strings.Split(value, ",") {
+		item = strings.TrimSpace(item)
+		if item != "" && !slices.Contains(items, item) {
+			items = append(items, item)
+		}
+	}
+	return items
+}
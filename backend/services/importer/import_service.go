@@ -0,0 +1,378 @@
+package importer
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/rimvydascivilis/book-tracker/backend/domain"
+	"github.com/rimvydascivilis/book-tracker/backend/utils"
+)
+
+// maxRowErrorLength matches the size of the import_row.error column.
+const maxRowErrorLength = 1000
+
+type ImportService struct {
+	importRepo    domain.ImportRepository
+	bookSvc       domain.BookService
+	readingRepo   domain.ReadingRepository
+	progressRepo  domain.ProgressRepository
+	listRepo      domain.ListRepository
+	listItemRepo  domain.ListItemRepository
+	txManager     domain.TxManager
+	validationSvc domain.ValidationService
+
+	mu      sync.Mutex
+	running map[int64]bool
+	wg      sync.WaitGroup
+}
+
+func NewImportService(importRepo domain.ImportRepository, bookSvc domain.BookService,
+	readingRepo domain.ReadingRepository, progressRepo domain.ProgressRepository,
+	listRepo domain.ListRepository, listItemRepo domain.ListItemRepository,
+	txManager domain.TxManager, validationSvc domain.ValidationService) *ImportService {
+	return &ImportService{
+		importRepo:    importRepo,
+		bookSvc:       bookSvc,
+		readingRepo:   readingRepo,
+		progressRepo:  progressRepo,
+		listRepo:      listRepo,
+		listItemRepo:  listItemRepo,
+		txManager:     txManager,
+		validationSvc: validationSvc,
+		running:       map[int64]bool{},
+	}
+}
+
+func (s *ImportService) ImportGoodreads(ctx context.Context, userID int64, data []byte) (domain.ImportJob, error) {
+	records, err := parseGoodreadsCSV(data)
+	if err != nil {
+		return domain.ImportJob{}, err
+	}
+
+	sum := sha256.Sum256(data)
+	checksum := hex.EncodeToString(sum[:])
+
+	job, err := s.importRepo.GetImportJobByChecksum(ctx, userID, domain.ImportSourceGoodreads, checksum)
+	if err == nil {
+		if job.Status != domain.ImportStatusCompleted {
+			s.start(job)
+		}
+		return s.GetImportJob(ctx, userID, job.ID)
+	}
+	if !errors.Is(err, domain.ErrRecordNotFound) {
+		return domain.ImportJob{}, err
+	}
+
+	job, err = s.importRepo.CreateImportJob(ctx, domain.ImportJob{
+		UserID:    userID,
+		Source:    domain.ImportSourceGoodreads,
+		Checksum:  checksum,
+		Data:      data,
+		Status:    domain.ImportStatusPending,
+		TotalRows: int64(len(records)),
+	})
+	if err != nil {
+		return domain.ImportJob{}, err
+	}
+
+	s.start(job)
+	return job, nil
+}
+
+// GetImportJob returns the job with the outcome of every row processed so far.
+func (s *ImportService) GetImportJob(ctx context.Context, userID, jobID int64) (domain.ImportJob, error) {
+	job, err := s.importRepo.GetImportJobByID(ctx, jobID)
+	if err != nil {
+		return domain.ImportJob{}, err
+	}
+	if job.UserID != userID {
+		return domain.ImportJob{}, fmt.Errorf("%w: %s", domain.ErrForbidden, "import job does not belong to user")
+	}
+
+	job.Rows, err = s.importRepo.GetImportRowsByJobID(ctx, jobID)
+	if err != nil {
+		return domain.ImportJob{}, err
+	}
+	for _, row := range job.Rows {
+		switch row.Status {
+		case domain.ImportRowStatusImported:
+			job.ImportedRows++
+		case domain.ImportRowStatusSkipped:
+			job.SkippedRows++
+		case domain.ImportRowStatusFailed:
+			job.FailedRows++
+		}
+	}
+
+	return job, nil
+}
+
+func (s *ImportService) ResumeImports(ctx context.Context) error {
+	jobs, err := s.importRepo.GetUnfinishedImportJobs(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, job := range jobs {
+		s.start(job)
+	}
+	return nil
+}
+
+// start runs job in the background unless it is already running. Jobs are
+// detached from the request that started them.
+func (s *ImportService) start(job domain.ImportJob) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.running[job.ID] {
+		return
+	}
+	s.running[job.ID] = true
+
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+		defer func() {
+			s.mu.Lock()
+			delete(s.running, job.ID)
+			s.mu.Unlock()
+		}()
+
+		if err := s.run(context.Background(), job); err != nil {
+			utils.Error(fmt.Sprintf("import job %d failed", job.ID), err)
+			job.Status = domain.ImportStatusFailed
+			job.Error = "import stopped unexpectedly, upload the file again to resume"
+			if _, err := s.importRepo.UpdateImportJob(context.Background(), job); err != nil {
+				utils.Error("failed to save import job status", err)
+			}
+		}
+	}()
+}
+
+// run imports every row that has no recorded outcome yet.
+func (s *ImportService) run(ctx context.Context, job domain.ImportJob) error {
+	job.Status = domain.ImportStatusRunning
+	job.Error = ""
+	job, err := s.importRepo.UpdateImportJob(ctx, job)
+	if err != nil {
+		return err
+	}
+
+	records, err := parseGoodreadsCSV(job.Data)
+	if err != nil {
+		return err
+	}
+
+	done, err := s.importRepo.GetImportRowsByJobID(ctx, job.ID)
+	if err != nil {
+		return err
+	}
+	processed := make(map[int64]bool, len(done))
+	for _, row := range done {
+		processed[row.RowNumber] = true
+	}
+
+	for _, record := range records {
+		if processed[record.number] {
+			continue
+		}
+		if err := s.importRecord(ctx, job, record); err != nil {
+			return err
+		}
+	}
+
+	job.Status = domain.ImportStatusCompleted
+	_, err = s.importRepo.UpdateImportJob(ctx, job)
+	return err
+}
+
+// importRecord imports a single row and records its outcome in the same
+// transaction, so a row is never imported twice. A failed row is recorded
+// after the rollback; only failing to record it stops the job.
+func (s *ImportService) importRecord(ctx context.Context, job domain.ImportJob, record goodreadsRecord) error {
+	row := domain.ImportRow{
+		JobID:      job.ID,
+		RowNumber:  record.number,
+		ExternalID: record.fields["Book Id"],
+	}
+
+	err := s.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		book, err := parseGoodreadsBook(record)
+		if err != nil {
+			return err
+		}
+
+		row.Status = domain.ImportRowStatusImported
+		row.BookID, err = s.importRepo.GetImportedBookID(ctx, job.UserID, job.Source, book.id)
+		if err == nil {
+			row.Status = domain.ImportRowStatusSkipped
+		} else if errors.Is(err, domain.ErrRecordNotFound) {
+			row.BookID, err = s.importGoodreadsBook(ctx, job.UserID, book)
+		}
+		if err != nil {
+			return err
+		}
+
+		_, err = s.importRepo.CreateImportRow(ctx, row)
+		return err
+	})
+	if err == nil {
+		return nil
+	}
+
+	row.Status = domain.ImportRowStatusFailed
+	row.BookID = 0
+	row.Error = err.Error()
+	if !errors.Is(err, domain.ErrValidation) {
+		utils.Error(fmt.Sprintf("failed to import row %d of import job %d", row.RowNumber, job.ID), err)
+		row.Error = "failed to import row"
+	}
+	if len(row.Error) > maxRowErrorLength {
+		row.Error = row.Error[:maxRowErrorLength]
+	}
+
+	_, err = s.importRepo.CreateImportRow(ctx, row)
+	return err
+}
+
+// importGoodreadsBook creates the book, a reading for the read and
+// currently-reading shelves, a progress entry finishing it on the date it was
+// read, and adds it to a list for every custom shelf.
+func (s *ImportService) importGoodreadsBook(ctx context.Context, userID int64, gr goodreadsBook) (int64, error) {
+	hasReading := gr.shelf == goodreadsShelfRead || gr.shelf == goodreadsShelfCurrentlyReading
+	if hasReading && gr.pages == 0 {
+		return 0, fmt.Errorf("%w: %s", domain.ErrValidation, "Number of Pages is required for read and currently-reading books")
+	}
+
+	authors := make([]domain.Author, 0, len(gr.authors))
+	for _, name := range gr.authors {
+		authors = append(authors, domain.Author{Name: name})
+	}
+
+	book, err := s.bookSvc.CreateBook(ctx, userID, domain.Book{
+		Title:           gr.title,
+		Authors:         authors,
+		ISBN10:          gr.isbn10,
+		ISBN13:          gr.isbn13,
+		Publisher:       gr.publisher,
+		PublicationYear: gr.year,
+		PageCount:       gr.pages,
+		Rating:          gr.rating,
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	if hasReading {
+		if err := s.createReading(ctx, userID, book.ID, gr); err != nil {
+			return 0, err
+		}
+	}
+
+	for _, shelf := range gr.shelves {
+		if err := s.addToList(ctx, userID, shelf, book.ID); err != nil {
+			return 0, err
+		}
+	}
+
+	return book.ID, nil
+}
+
+func (s *ImportService) createReading(ctx context.Context, userID, bookID int64, gr goodreadsBook) error {
+	added := firstDate(gr.dateAdded, utils.Now())
+	// Books being read start on the day they were added, as Goodreads does
+	// not export when a book was started.
+	startedAt := utils.Date(added)
+	reading := domain.Reading{
+		UserID:     userID,
+		BookID:     bookID,
+		Format:     domain.ReadingFormatPrint,
+		TotalPages: gr.pages,
+		Status:     domain.ReadingStatusReading,
+		StartedAt:  &startedAt,
+		CreatedAt:  added,
+		UpdatedAt:  added,
+	}
+
+	// Goodreads leaves Date Read empty for many finished books, in which case
+	// the book counts as read on the day it was added.
+	var progress domain.Progress
+	if gr.shelf == goodreadsShelfRead {
+		progress = domain.Progress{
+			UserID:      userID,
+			Pages:       gr.pages,
+			Position:    gr.pages,
+			ReadingDate: firstDate(gr.dateRead, added),
+		}
+		reading.UpdatedAt = progress.ReadingDate
+		reading.Status = domain.ReadingStatusCompleted
+		// Like any reading, a finished one starts on the day of its first
+		// progress.
+		reading.StartedAt = &progress.ReadingDate
+		reading.FinishedAt = &progress.ReadingDate
+	}
+
+	if err := s.validationSvc.ValidateStruct(reading); err != nil {
+		return err
+	}
+	reading, err := s.readingRepo.CreateReading(ctx, reading)
+	if err != nil {
+		return err
+	}
+
+	if gr.shelf != goodreadsShelfRead {
+		return nil
+	}
+
+	progress.ReadingID = reading.ID
+	if err := s.validationSvc.ValidateStruct(progress); err != nil {
+		return err
+	}
+	_, err = s.progressRepo.CreateProgress(ctx, progress)
+	return err
+}
+
+// addToList adds the book to the user's list titled shelf, creating the list
+// when the user has none.
+func (s *ImportService) addToList(ctx context.Context, userID int64, shelf string, bookID int64) error {
+	lists, err := s.listRepo.GetListsByUserID(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	var list domain.List
+	for _, l := range lists {
+		if l.Title == shelf {
+			list = l
+			break
+		}
+	}
+
+	if list.ID == 0 {
+		list = domain.List{UserID: userID, Title: shelf}
+		if err := s.validationSvc.ValidateStruct(list); err != nil {
+			return err
+		}
+		list, err = s.listRepo.CreateList(ctx, list)
+		if err != nil {
+			return err
+		}
+	}
+
+	_, err = s.listItemRepo.CreateListItem(ctx, domain.ListItem{ListID: list.ID, BookID: bookID})
+	return err
+}
+
+func firstDate(dates ...time.Time) time.Time {
+	for _, date := range dates {
+		if !date.IsZero() {
+			return date
+		}
+	}
+	return time.Time{}
+}
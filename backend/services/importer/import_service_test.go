@@ -0,0 +1,231 @@
+package importer
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/rimvydascivilis/book-tracker/backend/domain"
+	"github.com/rimvydascivilis/book-tracker/backend/internal/repository/memory"
+	"github.com/rimvydascivilis/book-tracker/backend/services/book"
+	"github.com/rimvydascivilis/book-tracker/backend/services/validation"
+	"github.com/rimvydascivilis/book-tracker/backend/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const goodreadsHeader = "Book Id,Title,Author,Author l-f,Additional Authors,ISBN,ISBN13,My Rating,Average Rating," +
+	"Publisher,Binding,Number of Pages,Year Published,Original Publication Year,Date Read,Date Added," +
+	"Bookshelves,Bookshelves with positions,Exclusive Shelf,My Review,Spoiler,Private Notes,Read Count,Owned Copies\n"
+
+const goodreadsExport = goodreadsHeader +
+	`1,Dune,Frank Herbert,"Herbert, Frank",,"=""0441172717""","=""9780441172719""",5,4.25,Ace,Paperback,604,1990,1965,2021/03/14,2021/01/02,"sci-fi, favorites","sci-fi (#1), favorites (#2)",read,,,,1,0` + "\n" +
+	`2,Emma,Jane Austen,"Austen, Jane",,"=""""","=""""",0,4.01,Penguin,Paperback,474,2003,1815,,2024/05/01,,,currently-reading,,,,0,0` + "\n" +
+	`3,Good Omens,Terry Pratchett,"Pratchett, Terry",Neil Gaiman,"=""""","=""""",4,4.25,,Kindle Edition,,2006,1990,,2023/02/01,,,read,,,,1,0` + "\n" +
+	`4,Middlemarch,George Eliot,"Eliot, George",,"=""""","=""""",0,3.98,,Paperback,880,2003,1871,,2020/07/07,classics,classics (#1),to-read,,,,0,0` + "\n"
+
+type fixture struct {
+	svc     *ImportService
+	store   *memory.Store
+	user    domain.User
+	books   *memory.BookRepository
+	lists   *memory.ListRepository
+	imports *memory.ImportRepository
+}
+
+func setupImportService(t *testing.T) fixture {
+	store := memory.NewStore()
+	user, err := memory.NewUserRepository(store).CreateUser(context.Background(), domain.User{Email: "user@example.com"})
+	require.NoError(t, err)
+
+	validationSvc := validation.NewValidationService()
+	txManager := memory.NewTxManager(store)
+	bookSvc := book.NewBookService(memory.NewBookRepository(store), memory.NewAuthorRepository(store),
+		txManager, nil, validationSvc)
+
+	f := fixture{
+		store:   store,
+		user:    user,
+		books:   memory.NewBookRepository(store),
+		lists:   memory.NewListRepository(store),
+		imports: memory.NewImportRepository(store),
+	}
+	f.svc = NewImportService(f.imports, bookSvc, memory.NewReadingRepository(store), memory.NewProgressRepository(store),
+		f.lists, memory.NewListItemRepository(store), txManager, validationSvc)
+
+	return f
+}
+
+func (f fixture) importAndWait(t *testing.T, data string) domain.ImportJob {
+	t.Helper()
+
+	job, err := f.svc.ImportGoodreads(context.Background(), f.user.ID, []byte(data))
+	require.NoError(t, err)
+	f.svc.wg.Wait()
+
+	job, err = f.svc.GetImportJob(context.Background(), f.user.ID, job.ID)
+	require.NoError(t, err)
+	return job
+}
+
+func TestImportGoodreads(t *testing.T) {
+	f := setupImportService(t)
+	ctx := context.Background()
+
+	job := f.importAndWait(t, goodreadsExport)
+
+	assert.Equal(t, domain.ImportStatusCompleted, job.Status)
+	assert.Equal(t, int64(4), job.TotalRows)
+	assert.Equal(t, int64(3), job.ImportedRows)
+	assert.Equal(t, int64(1), job.FailedRows)
+	require.Len(t, job.Rows, 4)
+	assert.Equal(t, "3", job.Rows[2].ExternalID)
+	assert.Contains(t, job.Rows[2].Error, "Number of Pages is required")
+
+	books, err := f.books.GetBooksByUser(ctx, f.user.ID, 0, 10)
+	require.NoError(t, err)
+	require.Len(t, books, 3)
+	dune := books[0]
+	assert.Equal(t, "Dune", dune.Title)
+	assert.Equal(t, "9780441172719", dune.ISBN13)
+	assert.Equal(t, float64(5), dune.Rating)
+	assert.Equal(t, int64(604), dune.PageCount)
+	assert.Equal(t, int64(1990), dune.PublicationYear)
+
+	readings, err := memory.NewReadingRepository(f.store).GetReadingsByUserID(ctx, f.user.ID, "", 0, 10)
+	require.NoError(t, err)
+	assert.Len(t, readings, 2, "to-read books get no reading")
+
+	for _, reading := range readings {
+		progress, err := memory.NewProgressRepository(f.store).GetTotalProgressByReadingID(ctx, reading.ID)
+		require.NoError(t, err)
+		if reading.BookID == dune.ID {
+			assert.Equal(t, int64(604), progress)
+			dateRead := time.Date(2021, 3, 14, 0, 0, 0, 0, time.UTC)
+			period, err := memory.NewProgressRepository(f.store).GetPeriodProgress(ctx, f.user.ID, dateRead, dateRead)
+			require.NoError(t, err)
+			assert.Equal(t, int64(604), period.Pages, "read books are finished on Date Read")
+		} else {
+			assert.Equal(t, int64(0), progress)
+		}
+	}
+
+	lists, err := f.lists.GetListsByUserID(ctx, f.user.ID)
+	require.NoError(t, err)
+	titles := []string{}
+	for _, list := range lists {
+		titles = append(titles, list.Title)
+	}
+	assert.ElementsMatch(t, []string{"sci-fi", "favorites", "classics"}, titles)
+}
+
+// TestImportGoodreads_CurrentlyReading starts the books being read on the day
+// they were added, or on the day of the import without Date Added.
+func TestImportGoodreads_CurrentlyReading(t *testing.T) {
+	f := setupImportService(t)
+	ctx := context.Background()
+	data := goodreadsHeader +
+		`2,Emma,Jane Austen,"Austen, Jane",,"=""""","=""""",0,4.01,Penguin,Paperback,474,2003,1815,,2024/05/01,,,currently-reading,,,,0,0` + "\n" +
+		`5,Persuasion,Jane Austen,"Austen, Jane",,"=""""","=""""",0,4.14,Penguin,Paperback,249,2003,1817,,,,,currently-reading,,,,0,0` + "\n"
+
+	job := f.importAndWait(t, data)
+	require.Equal(t, int64(2), job.ImportedRows, job.Rows)
+
+	readings, err := memory.NewReadingRepository(f.store).GetReadingsByUserID(ctx, f.user.ID, "", 0, 10)
+	require.NoError(t, err)
+	require.Len(t, readings, 2)
+	started := map[string]time.Time{}
+	for _, reading := range readings {
+		book, err := f.books.GetBookByUserID(ctx, f.user.ID, reading.BookID)
+		require.NoError(t, err)
+		assert.Equal(t, domain.ReadingStatusReading, reading.Status, book.Title)
+		require.NotNil(t, reading.StartedAt, book.Title)
+		started[book.Title] = *reading.StartedAt
+	}
+	assert.Equal(t, time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC), started["Emma"])
+	assert.Equal(t, utils.Date(time.Now()), started["Persuasion"])
+}
+
+func TestImportGoodreads_IsIdempotent(t *testing.T) {
+	f := setupImportService(t)
+	ctx := context.Background()
+
+	first := f.importAndWait(t, goodreadsExport)
+	again := f.importAndWait(t, goodreadsExport)
+	assert.Equal(t, first.ID, again.ID, "the same file returns the same job")
+
+	// A newer export with the failed row fixed imports only that row.
+	fixed := goodreadsHeader +
+		`1,Dune,Frank Herbert,,,,,5,,,,604,,,2021/03/14,2021/01/02,,,read,,,,1,0` + "\n" +
+		`3,Good Omens,Terry Pratchett,,Neil Gaiman,,,4,,,,288,,,,2023/02/01,,,read,,,,1,0` + "\n"
+	job := f.importAndWait(t, fixed)
+
+	assert.NotEqual(t, first.ID, job.ID)
+	assert.Equal(t, int64(1), job.SkippedRows)
+	assert.Equal(t, int64(1), job.ImportedRows)
+
+	count, err := f.books.CountBooksByUser(ctx, f.user.ID)
+	require.NoError(t, err)
+	assert.Equal(t, int64(4), count)
+}
+
+func TestImportGoodreads_Resume(t *testing.T) {
+	f := setupImportService(t)
+	ctx := context.Background()
+
+	// A job interrupted after its first row.
+	job, err := f.imports.CreateImportJob(ctx, domain.ImportJob{UserID: f.user.ID, Source: domain.ImportSourceGoodreads,
+		Checksum: "abc", Data: []byte(goodreadsExport), Status: domain.ImportStatusRunning, TotalRows: 4})
+	require.NoError(t, err)
+	_, err = f.imports.CreateImportRow(ctx, domain.ImportRow{JobID: job.ID, RowNumber: 1, ExternalID: "1",
+		Status: domain.ImportRowStatusFailed, Error: "interrupted"})
+	require.NoError(t, err)
+
+	require.NoError(t, f.svc.ResumeImports(ctx))
+	f.svc.wg.Wait()
+
+	job, err = f.svc.GetImportJob(ctx, f.user.ID, job.ID)
+	require.NoError(t, err)
+	assert.Equal(t, domain.ImportStatusCompleted, job.Status)
+	assert.Equal(t, "interrupted", job.Rows[0].Error, "recorded rows are not processed again")
+	assert.Equal(t, int64(2), job.ImportedRows)
+
+	count, err := f.books.CountBooksByUser(ctx, f.user.ID)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), count)
+}
+
+func TestImportGoodreads_InvalidFile(t *testing.T) {
+	f := setupImportService(t)
+
+	for _, data := range []string{"", "Title,Author\nDune,Frank Herbert\n", goodreadsHeader} {
+		_, err := f.svc.ImportGoodreads(context.Background(), f.user.ID, []byte(data))
+		assert.ErrorIs(t, err, domain.ErrValidation)
+	}
+}
+
+func TestGetImportJob_OtherUser(t *testing.T) {
+	f := setupImportService(t)
+
+	job := f.importAndWait(t, goodreadsExport)
+
+	_, err := f.svc.GetImportJob(context.Background(), f.user.ID+1, job.ID)
+	assert.ErrorIs(t, err, domain.ErrForbidden)
+}
+
+func TestParseGoodreadsBook(t *testing.T) {
+	records, err := parseGoodreadsCSV([]byte("\ufeff" + goodreadsExport))
+	require.NoError(t, err)
+	require.Len(t, records, 4)
+
+	gr, err := parseGoodreadsBook(records[2])
+	require.NoError(t, err)
+	assert.Equal(t, []string{"Terry Pratchett", "Neil Gaiman"}, gr.authors)
+	assert.Equal(t, "", gr.isbn13)
+	assert.Equal(t, int64(2006), gr.year)
+	assert.Equal(t, time.Date(2023, 2, 1, 0, 0, 0, 0, time.UTC), gr.dateAdded)
+
+	records[0].fields["Date Read"] = "14/03/2021"
+	_, err = parseGoodreadsBook(records[0])
+	assert.ErrorIs(t, err, domain.ErrValidation)
+}
@@ -13,15 +13,17 @@ type listService struct {
 	listRepo      domain.ListRepository
 	listItemRepo  domain.ListItemRepository
 	bookRepo      domain.BookRepository
+	txManager     domain.TxManager
 	validationSvc domain.ValidationService
 }
 
 func NewListService(repo domain.ListRepository, listItemRepo domain.ListItemRepository,
-	bookRepo domain.BookRepository, validationSvc domain.ValidationService) domain.ListService {
+	bookRepo domain.BookRepository, txManager domain.TxManager, validationSvc domain.ValidationService) domain.ListService {
 	return &listService{
 		listRepo:      repo,
 		listItemRepo:  listItemRepo,
 		bookRepo:      bookRepo,
+		txManager:     txManager,
 		validationSvc: validationSvc,
 	}
 }
@@ -49,22 +51,21 @@ func (s *listService) GetList(ctx context.Context, userID int64, listID int64) (
 		return dto.ListResponse{}, err
 	}
 
-	listItems, err := s.listItemRepo.GetListItemsByListID(ctx, listID)
+	if list.UserID != userID {
+		return dto.ListResponse{}, fmt.Errorf("%w: %s", domain.ErrForbidden, "list does not belong to user")
+	}
+
+	listItems, err := s.listItemRepo.GetListItemBooksByListID(ctx, listID)
 	if err != nil {
 		return dto.ListResponse{}, err
 	}
 
 	var listItemsResp []dto.ListItemsResponse = make([]dto.ListItemsResponse, 0, len(listItems))
 	for _, item := range listItems {
-		book, err := s.bookRepo.GetBookByUserID(ctx, userID, item.BookID)
-		if err != nil {
-			return dto.ListResponse{}, err
-		}
-
 		listItemsResp = append(listItemsResp, dto.ListItemsResponse{
 			ID:       item.ID,
 			ListID:   item.ListID,
-			BookName: book.Title,
+			BookName: item.BookTitle,
 		})
 	}
 
@@ -99,35 +100,34 @@ func (s *listService) CreateList(ctx context.Context, userID int64, req dto.List
 }
 
 func (s *listService) AddBookToList(ctx context.Context, userID, listID, bookID int64) error {
-	list, err := s.listRepo.GetListByID(ctx, listID)
-	if err != nil {
-		return err
-	}
+	return s.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		list, err := s.listRepo.GetListByID(ctx, listID)
+		if err != nil {
+			return err
+		}
 
-	if list.UserID != userID {
-		return fmt.Errorf("%w: %s", domain.ErrForbidden, "list does not belong to user")
-	}
+		if list.UserID != userID {
+			return fmt.Errorf("%w: %s", domain.ErrForbidden, "list does not belong to user")
+		}
 
-	book, err := s.bookRepo.GetBookByUserID(ctx, userID, bookID)
-	if err != nil {
-		return err
-	}
+		// Locking the book keeps it from being deleted before the item is added.
+		book, err := s.bookRepo.GetBookByUserIDForUpdate(ctx, userID, bookID)
+		if err != nil {
+			return err
+		}
 
-	if book.UserID != userID {
-		return fmt.Errorf("%w: %s", domain.ErrForbidden, "book does not belong to user")
-	}
+		if book.UserID != userID {
+			return fmt.Errorf("%w: %s", domain.ErrForbidden, "book does not belong to user")
+		}
 
-	listItem := domain.ListItem{
-		ListID: listID,
-		BookID: bookID,
-	}
+		listItem := domain.ListItem{
+			ListID: listID,
+			BookID: bookID,
+		}
 
-	_, err = s.listItemRepo.CreateListItem(ctx, listItem)
-	if err != nil {
+		_, err = s.listItemRepo.CreateListItem(ctx, listItem)
 		return err
-	}
-
-	return nil
+	})
 }
 
 func (s *listService) RemoveBookFromList(ctx context.Context, userID, listID, itemID int64) error {
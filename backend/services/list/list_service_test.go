@@ -0,0 +1,148 @@
+package list
+
+import (
+	"context"
+	"testing"
+
+	"github.com/rimvydascivilis/book-tracker/backend/domain"
+	"github.com/rimvydascivilis/book-tracker/backend/dto"
+	"github.com/rimvydascivilis/book-tracker/backend/internal/repository/memory"
+	"github.com/rimvydascivilis/book-tracker/backend/services/validation"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fixture struct {
+	svc   domain.ListService
+	store *memory.Store
+	user  domain.User
+	other domain.User
+	books []domain.Book
+}
+
+func setupListService(t *testing.T) fixture {
+	ctx := context.Background()
+	store := memory.NewStore()
+	userRepo := memory.NewUserRepository(store)
+	bookRepo := memory.NewBookRepository(store)
+
+	user, err := userRepo.CreateUser(ctx, domain.User{Email: "user@example.com"})
+	require.NoError(t, err)
+	other, err := userRepo.CreateUser(ctx, domain.User{Email: "other@example.com"})
+	require.NoError(t, err)
+
+	var books []domain.Book
+	for _, title := range []string{"Dune", "Emma"} {
+		book, err := bookRepo.CreateBook(ctx, domain.Book{UserID: user.ID, Title: title})
+		require.NoError(t, err)
+		books = append(books, book)
+	}
+
+	svc := NewListService(memory.NewListRepository(store), memory.NewListItemRepository(store),
+		bookRepo, memory.NewTxManager(store), validation.NewValidationService())
+
+	return fixture{svc: svc, store: store, user: user, other: other, books: books}
+}
+
+func TestGetList_JoinsItemsToBooks(t *testing.T) {
+	f := setupListService(t)
+	ctx := context.Background()
+
+	list, err := f.svc.CreateList(ctx, f.user.ID, dto.ListRequest{Title: "Favourites"})
+	require.NoError(t, err)
+	require.NoError(t, f.svc.AddBookToList(ctx, f.user.ID, list.ID, f.books[1].ID))
+	require.NoError(t, f.svc.AddBookToList(ctx, f.user.ID, list.ID, f.books[0].ID))
+
+	res, err := f.svc.GetList(ctx, f.user.ID, list.ID)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "Favourites", res.Title)
+	assert.Len(t, res.ListItems, 2)
+	assert.Equal(t, "Emma", res.ListItems[0].BookName)
+	assert.Equal(t, "Dune", res.ListItems[1].BookName)
+}
+
+func TestGetList_NotFound(t *testing.T) {
+	f := setupListService(t)
+
+	_, err := f.svc.GetList(context.Background(), f.user.ID, 42)
+
+	assert.ErrorIs(t, err, domain.ErrRecordNotFound)
+}
+
+func TestGetList_OtherUsersList(t *testing.T) {
+	f := setupListService(t)
+	ctx := context.Background()
+
+	list, err := f.svc.CreateList(ctx, f.other.ID, dto.ListRequest{Title: "Theirs"})
+	require.NoError(t, err)
+
+	_, err = f.svc.GetList(ctx, f.user.ID, list.ID)
+
+	assert.ErrorIs(t, err, domain.ErrForbidden)
+}
+
+func TestCreateList_ValidationError(t *testing.T) {
+	f := setupListService(t)
+
+	_, err := f.svc.CreateList(context.Background(), f.user.ID, dto.ListRequest{Title: ""})
+
+	assert.ErrorIs(t, err, domain.ErrValidation)
+}
+
+func TestAddBookToList_OtherUsersList(t *testing.T) {
+	f := setupListService(t)
+	ctx := context.Background()
+
+	list, err := f.svc.CreateList(ctx, f.other.ID, dto.ListRequest{Title: "Theirs"})
+	require.NoError(t, err)
+
+	err = f.svc.AddBookToList(ctx, f.user.ID, list.ID, f.books[0].ID)
+
+	assert.ErrorIs(t, err, domain.ErrForbidden)
+}
+
+func TestAddBookToList_OtherUsersBook(t *testing.T) {
+	f := setupListService(t)
+	ctx := context.Background()
+
+	list, err := f.svc.CreateList(ctx, f.other.ID, dto.ListRequest{Title: "Theirs"})
+	require.NoError(t, err)
+
+	err = f.svc.AddBookToList(ctx, f.other.ID, list.ID, f.books[0].ID)
+
+	assert.ErrorIs(t, err, domain.ErrRecordNotFound)
+}
+
+func TestRemoveBookFromList(t *testing.T) {
+	f := setupListService(t)
+	ctx := context.Background()
+
+	list, err := f.svc.CreateList(ctx, f.user.ID, dto.ListRequest{Title: "Favourites"})
+	require.NoError(t, err)
+	require.NoError(t, f.svc.AddBookToList(ctx, f.user.ID, list.ID, f.books[0].ID))
+	res, err := f.svc.GetList(ctx, f.user.ID, list.ID)
+	require.NoError(t, err)
+
+	err = f.svc.RemoveBookFromList(ctx, f.user.ID, list.ID, res.ListItems[0].ID)
+	assert.NoError(t, err)
+
+	res, err = f.svc.GetList(ctx, f.user.ID, list.ID)
+	assert.NoError(t, err)
+	assert.Empty(t, res.ListItems)
+}
+
+func TestListLists_OnlyOwnLists(t *testing.T) {
+	f := setupListService(t)
+	ctx := context.Background()
+
+	_, err := f.svc.CreateList(ctx, f.user.ID, dto.ListRequest{Title: "Mine"})
+	require.NoError(t, err)
+	_, err = f.svc.CreateList(ctx, f.other.ID, dto.ListRequest{Title: "Theirs"})
+	require.NoError(t, err)
+
+	lists, err := f.svc.ListLists(ctx, f.user.ID)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []dto.ListListsResponse{{ID: lists[0].ID, Title: "Mine"}}, lists)
+}
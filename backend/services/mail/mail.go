@@ -0,0 +1,188 @@
+package mail
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"mime"
+	"mime/quotedprintable"
+	"net"
+	netmail "net/mail"
+	"net/smtp"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/rimvydascivilis/book-tracker/backend/domain"
+	"github.com/rimvydascivilis/book-tracker/backend/utils"
+)
+
+// smtpTimeout bounds sending one email, unless the context of the send
+// ends sooner.
+const smtpTimeout = 30 * time.Second
+
+// SMTPMailer sends email through an SMTP relay, upgrading the connection
+// with STARTTLS when the server offers it.
+type SMTPMailer struct {
+	host string
+	addr string
+	// from is the From header and sender the address alone, for the
+	// envelope.
+	from   string
+	sender string
+	// auth is nil when no username is configured.
+	auth smtp.Auth
+}
+
+func NewSMTPMailer(host string, port int, username, password, from string) *SMTPMailer {
+	m := &SMTPMailer{
+		host:   host,
+		addr:   net.JoinHostPort(host, strconv.Itoa(port)),
+		from:   from,
+		sender: from,
+	}
+	if addr, err := netmail.ParseAddress(from); err == nil {
+		m.sender = addr.Address
+	}
+	if username != "" {
+		m.auth = smtp.PlainAuth("", username, password, host)
+	}
+	return m
+}
+
+func (m *SMTPMailer) Send(ctx context.Context, email domain.Email) error {
+	msg, err := message(m.from, email, time.Now())
+	if err != nil {
+		return err
+	}
+	if err := m.send(ctx, email.To, msg); err != nil {
+		return fmt.Errorf("%w: smtp: %s", domain.ErrUpstream, err)
+	}
+	return nil
+}
+
+// send delivers msg the way smtp.SendMail does, but gives up after
+// smtpTimeout or once ctx is done.
+func (m *SMTPMailer) send(ctx context.Context, to string, msg []byte) error {
+	ctx, cancel := context.WithTimeout(ctx, smtpTimeout)
+	defer cancel()
+
+	var dialer net.Dialer
+	conn, err := dialer.DialContext(ctx, "tcp", m.addr)
+	if err != nil {
+		return err
+	}
+	deadline, _ := ctx.Deadline()
+	if err := conn.SetDeadline(deadline); err != nil {
+		_ = conn.Close()
+		return err
+	}
+	// Cancelling ctx interrupts whatever the connection is waiting for.
+	stop := context.AfterFunc(ctx, func() { _ = conn.SetDeadline(time.Now()) })
+	defer stop()
+
+	c, err := smtp.NewClient(conn, m.host)
+	if err != nil {
+		_ = conn.Close()
+		return err
+	}
+	defer c.Close()
+
+	if ok, _ := c.Extension("STARTTLS"); ok {
+		if err := c.StartTLS(&tls.Config{ServerName: m.host}); err != nil {
+			return err
+		}
+	}
+	if m.auth != nil {
+		if ok, _ := c.Extension("AUTH"); !ok {
+			return errors.New("server does not support AUTH")
+		}
+		if err := c.Auth(m.auth); err != nil {
+			return err
+		}
+	}
+	if err := c.Mail(m.sender); err != nil {
+		return err
+	}
+	if err := c.Rcpt(to); err != nil {
+		return err
+	}
+	w, err := c.Data()
+	if err != nil {
+		return err
+	}
+	if _, err := w.Write(msg); err != nil {
+		return err
+	}
+	if err := w.Close(); err != nil {
+		return err
+	}
+	return c.Quit()
+}
+
+// FileMailer writes every email as an .eml file to a directory instead of
+// sending it, for development and installs without a mail server.
+type FileMailer struct {
+	dir  string
+	from string
+	seq  atomic.Int64
+}
+
+func NewFileMailer(dir, from string) *FileMailer {
+	return &FileMailer{
+		dir:  dir,
+		from: from,
+	}
+}
+
+func (m *FileMailer) Send(ctx context.Context, email domain.Email) error {
+	now := time.Now()
+	msg, err := message(m.from, email, now)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(m.dir, 0o700); err != nil {
+		return err
+	}
+
+	name := fmt.Sprintf("%s-%d.eml", now.UTC().Format("20060102T150405.000000000"), m.seq.Add(1))
+	path := filepath.Join(m.dir, name)
+	if err := os.WriteFile(path, msg, 0o600); err != nil {
+		return err
+	}
+	utils.Info("email written to file", map[string]interface{}{"to": email.To, "path": path})
+	return nil
+}
+
+// message formats email as a plain text RFC 5322 message.
+func message(from string, email domain.Email, date time.Time) ([]byte, error) {
+	for _, header := range []string{from, email.To, email.Subject} {
+		if strings.ContainsAny(header, "\r\n") {
+			return nil, fmt.Errorf("%w: %s", domain.ErrValidation, "line break in email header")
+		}
+	}
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "From: %s\r\n", from)
+	fmt.Fprintf(&buf, "To: %s\r\n", email.To)
+	fmt.Fprintf(&buf, "Subject: %s\r\n", mime.QEncoding.Encode("utf-8", email.Subject))
+	fmt.Fprintf(&buf, "Date: %s\r\n", date.Format(time.RFC1123Z))
+	buf.WriteString("MIME-Version: 1.0\r\n")
+	buf.WriteString("Content-Type: text/plain; charset=utf-8\r\n")
+	buf.WriteString("Content-Transfer-Encoding: quoted-printable\r\n\r\n")
+
+	w := quotedprintable.NewWriter(&buf)
+	body := strings.ReplaceAll(strings.ReplaceAll(email.Body, "\r\n", "\n"), "\n", "\r\n")
+	if _, err := w.Write([]byte(body)); err != nil {
+		return nil, err
+	}
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
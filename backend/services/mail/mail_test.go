@@ -0,0 +1,159 @@
+package mail
+
+import (
+	"bufio"
+	"context"
+	"io"
+	"mime/quotedprintable"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/rimvydascivilis/book-tracker/backend/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testEmail = domain.Email{
+	To:      "reader@example.com",
+	Subject: "Verify your email",
+	Body:    "Open this link to verify your email:\nhttp://localhost:3000/verify-email?token=abc\n",
+}
+
+func TestFileMailer_Send(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "mail")
+	mailer := NewFileMailer(dir, "Book Tracker <noreply@example.com>")
+
+	require.NoError(t, mailer.Send(context.Background(), testEmail))
+	require.NoError(t, mailer.Send(context.Background(), testEmail))
+
+	files, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	require.Len(t, files, 2, "every email gets its own file")
+	raw, err := os.ReadFile(filepath.Join(dir, files[0].Name()))
+	require.NoError(t, err)
+	msg := string(raw)
+	assert.Contains(t, msg, "From: Book Tracker <noreply@example.com>\r\n")
+	assert.Contains(t, msg, "To: reader@example.com\r\n")
+	assert.Contains(t, msg, "Subject: Verify your email\r\n")
+	assert.Contains(t, msg, "verify-email?token=3Dabc\r\n", "the body is quoted-printable")
+}
+
+func TestMessage_RejectsHeaderInjection(t *testing.T) {
+	email := testEmail
+	email.To = "reader@example.com\r\nBcc: everyone@example.com"
+
+	err := NewFileMailer(t.TempDir(), "noreply@example.com").Send(context.Background(), email)
+
+	assert.ErrorIs(t, err, domain.ErrValidation)
+}
+
+// fakeSMTPServer accepts one message and sends it to received, after its
+// envelope sender.
+func fakeSMTPServer(t *testing.T) (int, <-chan string) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = l.Close() })
+
+	received := make(chan string, 2)
+	go func() {
+		conn, err := l.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+
+		r := bufio.NewReader(conn)
+		reply := func(line string) { _, _ = io.WriteString(conn, line+"\r\n") }
+		reply("220 localhost ESMTP")
+		for {
+			line, err := r.ReadString('\n')
+			if err != nil {
+				return
+			}
+			switch cmd := strings.ToUpper(strings.TrimSpace(line)); {
+			case strings.HasPrefix(cmd, "EHLO"), strings.HasPrefix(cmd, "HELO"):
+				reply("250 localhost")
+			case strings.HasPrefix(cmd, "MAIL FROM:"):
+				received <- strings.TrimSpace(line)
+				reply("250 ok")
+			case cmd == "DATA":
+				reply("354 go ahead")
+				var data strings.Builder
+				for {
+					line, err := r.ReadString('\n')
+					if err != nil {
+						return
+					}
+					if line == ".\r\n" {
+						break
+					}
+					data.WriteString(line)
+				}
+				received <- data.String()
+				reply("250 queued")
+			case cmd == "QUIT":
+				reply("221 bye")
+				return
+			default:
+				reply("250 ok")
+			}
+		}
+	}()
+	return l.Addr().(*net.TCPAddr).Port, received
+}
+
+func TestSMTPMailer_Send(t *testing.T) {
+	port, received := fakeSMTPServer(t)
+
+	mailer := NewSMTPMailer("127.0.0.1", port, "", "", "Book Tracker <noreply@example.com>")
+	require.NoError(t, mailer.Send(context.Background(), testEmail))
+
+	assert.Equal(t, "MAIL FROM:<noreply@example.com>", <-received, "the envelope has the address alone")
+	msg := <-received
+	assert.Contains(t, msg, "From: Book Tracker <noreply@example.com>\r\n")
+	assert.Contains(t, msg, "To: reader@example.com\r\n")
+	_, body, ok := strings.Cut(msg, "\r\n\r\n")
+	require.True(t, ok)
+	decoded, err := io.ReadAll(quotedprintable.NewReader(strings.NewReader(body)))
+	require.NoError(t, err)
+	assert.Contains(t, string(decoded), "verify-email?token=abc")
+}
+
+func TestSMTPMailer_ServerDown(t *testing.T) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	port := l.Addr().(*net.TCPAddr).Port
+	require.NoError(t, l.Close())
+
+	err = NewSMTPMailer("127.0.0.1", port, "", "", "noreply@example.com").Send(context.Background(), testEmail)
+
+	assert.ErrorIs(t, err, domain.ErrUpstream)
+}
+
+func TestSMTPMailer_ServerHangs(t *testing.T) {
+	// The server accepts connections but never greets.
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = l.Close() })
+	go func() {
+		for {
+			conn, err := l.Accept()
+			if err != nil {
+				return
+			}
+			t.Cleanup(func() { _ = conn.Close() })
+		}
+	}()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	err = NewSMTPMailer("127.0.0.1", l.Addr().(*net.TCPAddr).Port, "", "", "noreply@example.com").Send(ctx, testEmail)
+
+	assert.ErrorIs(t, err, domain.ErrUpstream)
+	assert.Less(t, time.Since(start), time.Second)
+}
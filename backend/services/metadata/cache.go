@@ -0,0 +1,114 @@
+package metadata
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/rimvydascivilis/book-tracker/backend/domain"
+	"github.com/rimvydascivilis/book-tracker/backend/utils"
+)
+
+const maxCacheEntries = 1000
+
+type cacheEntry struct {
+	books   []domain.Book
+	err     error
+	expires time.Time
+}
+
+// CachedProvider keeps successful and not-found responses of the wrapped
+// provider for ttl. Upstream failures are never cached.
+type CachedProvider struct {
+	provider domain.MetadataProvider
+	ttl      time.Duration
+	now      func() time.Time
+
+	mu      sync.Mutex
+	entries map[string]cacheEntry
+}
+
+func NewCachedProvider(provider domain.MetadataProvider, ttl time.Duration) *CachedProvider {
+	return &CachedProvider{
+		provider: provider,
+		ttl:      ttl,
+		now:      time.Now,
+		entries:  map[string]cacheEntry{},
+	}
+}
+
+func (c *CachedProvider) LookupISBN(ctx context.Context, isbn string) (domain.Book, error) {
+	books, err := c.cached("isbn:"+utils.NormalizeISBN(isbn), func() ([]domain.Book, error) {
+		book, err := c.provider.LookupISBN(ctx, isbn)
+		if err != nil {
+			return nil, err
+		}
+		return []domain.Book{book}, nil
+	})
+	if err != nil {
+		return domain.Book{}, err
+	}
+
+	return books[0], nil
+}
+
+func (c *CachedProvider) Search(ctx context.Context, title, author string, limit int64) ([]domain.Book, error) {
+	key := fmt.Sprintf("search:%d:%s\x00%s", limit, strings.ToLower(title), strings.ToLower(author))
+	return c.cached(key, func() ([]domain.Book, error) {
+		return c.provider.Search(ctx, title, author, limit)
+	})
+}
+
+func (c *CachedProvider) cached(key string, fetch func() ([]domain.Book, error)) ([]domain.Book, error) {
+	c.mu.Lock()
+	entry, ok := c.entries[key]
+	c.mu.Unlock()
+	if ok && c.now().Before(entry.expires) {
+		return copyBooks(entry.books), entry.err
+	}
+
+	books, err := fetch()
+	if err != nil && !errors.Is(err, domain.ErrRecordNotFound) {
+		return nil, err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if len(c.entries) >= maxCacheEntries {
+		c.evict()
+	}
+	c.entries[key] = cacheEntry{books: books, err: err, expires: c.now().Add(c.ttl)}
+
+	return copyBooks(books), err
+}
+
+// evict drops expired entries, or everything if none have expired yet.
+// Must be called with mu held.
+func (c *CachedProvider) evict() {
+	now := c.now()
+	for key, entry := range c.entries {
+		if !now.Before(entry.expires) {
+			delete(c.entries, key)
+		}
+	}
+	if len(c.entries) >= maxCacheEntries {
+		c.entries = map[string]cacheEntry{}
+	}
+}
+
+// copyBooks keeps callers from mutating cached author slices.
+func copyBooks(books []domain.Book) []domain.Book {
+	if books == nil {
+		return nil
+	}
+
+	copied := make([]domain.Book, len(books))
+	for i, book := range books {
+		book.Authors = append([]domain.Author{}, book.Authors...)
+		copied[i] = book
+	}
+	return copied
+}
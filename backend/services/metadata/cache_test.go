@@ -0,0 +1,58 @@
+package metadata
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/rimvydascivilis/book-tracker/backend/domain"
+	"github.com/rimvydascivilis/book-tracker/backend/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestCachedProvider_LookupISBN(t *testing.T) {
+	provider := new(mocks.MetadataProvider)
+	cache := NewCachedProvider(provider, time.Hour)
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	cache.now = func() time.Time { return now }
+
+	provider.On("LookupISBN", mock.Anything, "9780132350884").
+		Return(domain.Book{Title: "Clean Code", Authors: []domain.Author{{Name: "Robert C. Martin"}}}, nil).Twice()
+
+	book, err := cache.LookupISBN(context.Background(), "9780132350884")
+	assert.NoError(t, err)
+	book.Authors[0].Name = "changed by caller"
+
+	book, err = cache.LookupISBN(context.Background(), "9780132350884")
+	assert.NoError(t, err)
+	assert.Equal(t, "Robert C. Martin", book.Authors[0].Name)
+	provider.AssertNumberOfCalls(t, "LookupISBN", 1)
+
+	now = now.Add(time.Hour)
+	_, err = cache.LookupISBN(context.Background(), "9780132350884")
+	assert.NoError(t, err)
+	provider.AssertNumberOfCalls(t, "LookupISBN", 2)
+}
+
+func TestCachedProvider_CachesNotFoundButNotUpstreamErrors(t *testing.T) {
+	provider := new(mocks.MetadataProvider)
+	cache := NewCachedProvider(provider, time.Hour)
+
+	provider.On("LookupISBN", mock.Anything, "9780000000002").
+		Return(domain.Book{}, fmt.Errorf("%w: %s", domain.ErrRecordNotFound, "no book with this ISBN")).Once()
+	provider.On("Search", mock.Anything, "Clean Code", "", int64(10)).
+		Return(nil, fmt.Errorf("%w: %s", domain.ErrUpstream, "timeout"))
+
+	for i := 0; i < 2; i++ {
+		_, err := cache.LookupISBN(context.Background(), "9780000000002")
+		assert.ErrorIs(t, err, domain.ErrRecordNotFound)
+
+		_, err = cache.Search(context.Background(), "Clean Code", "", 10)
+		assert.ErrorIs(t, err, domain.ErrUpstream)
+	}
+
+	provider.AssertNumberOfCalls(t, "LookupISBN", 1)
+	provider.AssertNumberOfCalls(t, "Search", 2)
+}
@@ -0,0 +1,241 @@
+package metadata
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/rimvydascivilis/book-tracker/backend/domain"
+	"github.com/rimvydascivilis/book-tracker/backend/utils"
+	"golang.org/x/text/language"
+)
+
+const userAgent = "book-tracker (+https://github.com/rimvydascivilis/book-tracker)"
+
+var yearPattern = regexp.MustCompile(`\b\d{4}\b`)
+
+// OpenLibraryProvider talks to the Open Library JSON API, or anything serving
+// the same shape at baseURL.
+type OpenLibraryProvider struct {
+	baseURL string
+	client  *http.Client
+}
+
+func NewOpenLibraryProvider(baseURL string, client *http.Client) *OpenLibraryProvider {
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	return &OpenLibraryProvider{
+		baseURL: strings.TrimRight(baseURL, "/"),
+		client:  client,
+	}
+}
+
+type olName struct {
+	Name string `json:"name"`
+}
+
+type olEdition struct {
+	Title         string          `json:"title"`
+	Authors       []olName        `json:"authors"`
+	Publishers    []olName        `json:"publishers"`
+	PublishDate   string          `json:"publish_date"`
+	NumberOfPages int64           `json:"number_of_pages"`
+	Notes         json.RawMessage `json:"notes"`
+	Identifiers   struct {
+		ISBN10 []string `json:"isbn_10"`
+		ISBN13 []string `json:"isbn_13"`
+	} `json:"identifiers"`
+}
+
+type olSearchResponse struct {
+	Docs []struct {
+		Title               string   `json:"title"`
+		AuthorName          []string `json:"author_name"`
+		ISBN                []string `json:"isbn"`
+		Publisher           []string `json:"publisher"`
+		FirstPublishYear    int64    `json:"first_publish_year"`
+		Language            []string `json:"language"`
+		NumberOfPagesMedian int64    `json:"number_of_pages_median"`
+	} `json:"docs"`
+}
+
+func (p *OpenLibraryProvider) LookupISBN(ctx context.Context, isbn string) (domain.Book, error) {
+	isbn = utils.NormalizeISBN(isbn)
+	if !utils.ValidISBN10(isbn) && !utils.ValidISBN13(isbn) {
+		return domain.Book{}, fmt.Errorf("%w: %s", domain.ErrValidation, "invalid ISBN")
+	}
+
+	query := url.Values{}
+	query.Set("bibkeys", "ISBN:"+isbn)
+	query.Set("jscmd", "data")
+	query.Set("format", "json")
+
+	var resp map[string]olEdition
+	if err := p.get(ctx, "/api/books", query, &resp); err != nil {
+		return domain.Book{}, err
+	}
+
+	edition, ok := resp["ISBN:"+isbn]
+	if !ok {
+		return domain.Book{}, fmt.Errorf("%w: %s", domain.ErrRecordNotFound, "no book with this ISBN")
+	}
+
+	book := domain.Book{
+		Title:           strings.TrimSpace(edition.Title),
+		Authors:         []domain.Author{},
+		PageCount:       edition.NumberOfPages,
+		Description:     parseNotes(edition.Notes),
+		PublicationYear: parseYear(edition.PublishDate),
+	}
+	for _, author := range edition.Authors {
+		book.Authors = append(book.Authors, domain.Author{Name: author.Name})
+	}
+	if len(edition.Publishers) > 0 {
+		book.Publisher = edition.Publishers[0].Name
+	}
+	book.ISBN10 = firstISBN(edition.Identifiers.ISBN10, utils.ValidISBN10)
+	book.ISBN13 = firstISBN(edition.Identifiers.ISBN13, utils.ValidISBN13)
+	if utils.ValidISBN13(isbn) {
+		book.ISBN13 = isbn
+	} else {
+		book.ISBN10 = isbn
+	}
+
+	return book, nil
+}
+
+func (p *OpenLibraryProvider) Search(ctx context.Context, title, author string, limit int64) ([]domain.Book, error) {
+	query := url.Values{}
+	if title != "" {
+		query.Set("title", title)
+	}
+	if author != "" {
+		query.Set("author", author)
+	}
+	query.Set("limit", strconv.FormatInt(limit, 10))
+	query.Set("fields", "title,author_name,isbn,publisher,first_publish_year,language,number_of_pages_median")
+
+	var resp olSearchResponse
+	if err := p.get(ctx, "/search.json", query, &resp); err != nil {
+		return nil, err
+	}
+
+	books := make([]domain.Book, 0, len(resp.Docs))
+	for _, doc := range resp.Docs {
+		book := domain.Book{
+			Title:           strings.TrimSpace(doc.Title),
+			Authors:         []domain.Author{},
+			ISBN10:          firstISBN(doc.ISBN, utils.ValidISBN10),
+			ISBN13:          firstISBN(doc.ISBN, utils.ValidISBN13),
+			PublicationYear: doc.FirstPublishYear,
+			PageCount:       doc.NumberOfPagesMedian,
+		}
+		for _, name := range doc.AuthorName {
+			book.Authors = append(book.Authors, domain.Author{Name: name})
+		}
+		if len(doc.Publisher) > 0 {
+			book.Publisher = doc.Publisher[0]
+		}
+		if len(doc.Language) > 0 {
+			book.Language = parseLanguage(doc.Language[0])
+		}
+		books = append(books, book)
+	}
+
+	return books, nil
+}
+
+func (p *OpenLibraryProvider) get(ctx context.Context, path string, query url.Values, v interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, p.baseURL+path+"?"+query.Encode(), nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("User-Agent", userAgent)
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("%w: %s", domain.ErrUpstream, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("%w: %s returned %d", domain.ErrUpstream, path, resp.StatusCode)
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(v); err != nil {
+		return fmt.Errorf("%w: %s", domain.ErrUpstream, err)
+	}
+
+	return nil
+}
+
+func firstISBN(isbns []string, valid func(string) bool) string {
+	for _, isbn := range isbns {
+		isbn = utils.NormalizeISBN(isbn)
+		if valid(isbn) {
+			return isbn
+		}
+	}
+	return ""
+}
+
+// parseYear picks the year out of free-form dates such as "March 1999".
+func parseYear(date string) int64 {
+	year, err := strconv.ParseInt(yearPattern.FindString(date), 10, 64)
+	if err != nil {
+		return 0
+	}
+	return year
+}
+
+// parseNotes accepts both shapes Open Library uses for notes: a plain string
+// or a {"type": ..., "value": ...} text object.
+func parseNotes(raw json.RawMessage) string {
+	if len(raw) == 0 {
+		return ""
+	}
+
+	var text string
+	if err := json.Unmarshal(raw, &text); err == nil {
+		return strings.TrimSpace(text)
+	}
+
+	var typed struct {
+		Value string `json:"value"`
+	}
+	if err := json.Unmarshal(raw, &typed); err == nil {
+		return strings.TrimSpace(typed.Value)
+	}
+
+	return ""
+}
+
+// marcToISO6392T maps the MARC language codes that differ from their
+// ISO 639-2/T counterparts.
+var marcToISO6392T = map[string]string{
+	"alb": "sqi", "arm": "hye", "baq": "eus", "bur": "mya", "chi": "zho",
+	"cze": "ces", "dut": "nld", "fre": "fra", "geo": "kat", "ger": "deu",
+	"gre": "ell", "ice": "isl", "mac": "mkd", "mao": "mri", "may": "msa",
+	"per": "fas", "rum": "ron", "slo": "slk", "tib": "bod", "wel": "cym",
+}
+
+// parseLanguage converts the MARC codes Open Library returns ("eng") into the
+// BCP 47 tags stored on books ("en").
+func parseLanguage(code string) string {
+	if iso, ok := marcToISO6392T[code]; ok {
+		code = iso
+	}
+	base, err := language.ParseBase(code)
+	if err != nil {
+		return ""
+	}
+	return base.String()
+}
@@ -0,0 +1,138 @@
+package metadata
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/rimvydascivilis/book-tracker/backend/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+const editionResponse = `{
+	"ISBN:9780132350884": {
+		"title": "Clean Code",
+		"authors": [{"name": "Robert C. Martin", "url": "https://openlibrary.org/authors/OL216228A"}],
+		"identifiers": {"isbn_10": ["0132350882"], "isbn_13": ["9780132350884"]},
+		"publishers": [{"name": "Prentice Hall"}],
+		"publish_date": "August 2008",
+		"number_of_pages": 431,
+		"notes": {"type": "/type/text", "value": "Includes index."}
+	}
+}`
+
+const searchResponse = `{
+	"numFound": 1,
+	"docs": [{
+		"title": "Clean Code",
+		"author_name": ["Robert C. Martin"],
+		"isbn": ["not-an-isbn", "9780132350884", "0132350882"],
+		"publisher": ["Prentice Hall"],
+		"first_publish_year": 2008,
+		"language": ["eng"],
+		"number_of_pages_median": 464
+	}]
+}`
+
+func newStubServer(t *testing.T) *httptest.Server {
+	t.Helper()
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/books", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "data", r.URL.Query().Get("jscmd"))
+		assert.NotEmpty(t, r.Header.Get("User-Agent"))
+		if r.URL.Query().Get("bibkeys") == "ISBN:9780132350884" {
+			_, _ = w.Write([]byte(editionResponse))
+			return
+		}
+		_, _ = w.Write([]byte(`{}`))
+	})
+	mux.HandleFunc("/search.json", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Clean Code", r.URL.Query().Get("title"))
+		assert.Equal(t, "Martin", r.URL.Query().Get("author"))
+		assert.Equal(t, "5", r.URL.Query().Get("limit"))
+		_, _ = w.Write([]byte(searchResponse))
+	})
+	mux.HandleFunc("/broken/", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	})
+
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestOpenLibraryProvider_LookupISBN(t *testing.T) {
+	server := newStubServer(t)
+	provider := NewOpenLibraryProvider(server.URL+"/", server.Client())
+
+	book, err := provider.LookupISBN(context.Background(), "978-0-13-235088-4")
+
+	assert.NoError(t, err)
+	assert.Equal(t, domain.Book{
+		Title:           "Clean Code",
+		Authors:         []domain.Author{{Name: "Robert C. Martin"}},
+		ISBN10:          "0132350882",
+		ISBN13:          "9780132350884",
+		Publisher:       "Prentice Hall",
+		PublicationYear: 2008,
+		Description:     "Includes index.",
+		PageCount:       431,
+	}, book)
+}
+
+func TestOpenLibraryProvider_LookupISBN_NotFound(t *testing.T) {
+	server := newStubServer(t)
+	provider := NewOpenLibraryProvider(server.URL, server.Client())
+
+	_, err := provider.LookupISBN(context.Background(), "9780000000002")
+
+	assert.ErrorIs(t, err, domain.ErrRecordNotFound)
+}
+
+func TestOpenLibraryProvider_LookupISBN_Invalid(t *testing.T) {
+	provider := NewOpenLibraryProvider("http://127.0.0.1:0", nil)
+
+	_, err := provider.LookupISBN(context.Background(), "12345")
+
+	assert.ErrorIs(t, err, domain.ErrValidation)
+}
+
+func TestOpenLibraryProvider_UpstreamError(t *testing.T) {
+	server := newStubServer(t)
+	provider := NewOpenLibraryProvider(server.URL+"/broken", server.Client())
+
+	_, err := provider.LookupISBN(context.Background(), "9780132350884")
+	assert.ErrorIs(t, err, domain.ErrUpstream)
+
+	_, err = provider.Search(context.Background(), "Clean Code", "", 5)
+	assert.ErrorIs(t, err, domain.ErrUpstream)
+}
+
+func TestOpenLibraryProvider_Search(t *testing.T) {
+	server := newStubServer(t)
+	provider := NewOpenLibraryProvider(server.URL, server.Client())
+
+	books, err := provider.Search(context.Background(), "Clean Code", "Martin", 5)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []domain.Book{{
+		Title:           "Clean Code",
+		Authors:         []domain.Author{{Name: "Robert C. Martin"}},
+		ISBN10:          "0132350882",
+		ISBN13:          "9780132350884",
+		Publisher:       "Prentice Hall",
+		PublicationYear: 2008,
+		Language:        "en",
+		PageCount:       464,
+	}}, books)
+}
+
+func TestParseLanguage(t *testing.T) {
+	assert.Equal(t, "en", parseLanguage("eng"))
+	assert.Equal(t, "fr", parseLanguage("fre"))
+	assert.Equal(t, "de", parseLanguage("ger"))
+	assert.Equal(t, "lt", parseLanguage("lit"))
+	assert.Equal(t, "", parseLanguage("???"))
+}
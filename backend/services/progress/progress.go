@@ -7,51 +7,313 @@ import (
 
 	"github.com/rimvydascivilis/book-tracker/backend/domain"
 	"github.com/rimvydascivilis/book-tracker/backend/dto"
+	"github.com/rimvydascivilis/book-tracker/backend/utils"
 )
 
 type progressService struct {
 	progressRepo  domain.ProgressRepository
 	readingRepo   domain.ReadingRepository
+	userRepo      domain.UserRepository
+	txManager     domain.TxManager
 	validationSvc domain.ValidationService
 }
 
-func NewProgressService(repo domain.ProgressRepository, readingRepo domain.ReadingRepository, validator domain.ValidationService) *progressService {
+func NewProgressService(repo domain.ProgressRepository, readingRepo domain.ReadingRepository,
+	userRepo domain.UserRepository, txManager domain.TxManager, validator domain.ValidationService) *progressService {
 	return &progressService{
 		progressRepo:  repo,
 		readingRepo:   readingRepo,
+		userRepo:      userRepo,
+		txManager:     txManager,
 		validationSvc: validator,
 	}
 }
 
-func (s *progressService) CreateProgress(ctx context.Context, userID, readingID int64, progressReq dto.ProgressRequest) (domain.Progress, error) {
-	if progressReq.Date.After(time.Now()) {
-		return domain.Progress{}, fmt.Errorf("%w: %s", domain.ErrValidation, "reading date cannot be in the future")
+func (s *progressService) GetReadingProgress(ctx context.Context, userID, readingID int64) ([]domain.Progress, error) {
+	reading, err := s.readingRepo.GetReadingByID(ctx, readingID)
+	if err != nil {
+		return nil, err
+	}
+	if reading.UserID != userID {
+		return nil, fmt.Errorf("%w: %s", domain.ErrForbidden, "reading does not belong to user")
 	}
 
+	return s.progressRepo.GetProgressByReadingID(ctx, readingID)
+}
+
+func (s *progressService) CreateProgress(ctx context.Context, userID, readingID int64, progressReq dto.ProgressRequest) (domain.Progress, error) {
+	date, err := s.checkRequest(ctx, userID, progressReq)
+	if err != nil {
+		return domain.Progress{}, err
+	}
 	progress := domain.Progress{
 		ReadingID:   readingID,
 		UserID:      userID,
-		Pages:       progressReq.Pages,
-		ReadingDate: progressReq.Date,
+		ReadingDate: date,
 	}
 
-	if err := s.validationSvc.ValidateStruct(progress); err != nil {
+	// The reading row stays locked until the progress is inserted, so
+	// concurrent requests cannot both pass the total pages check.
+	err = s.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		reading, err := s.getUserReadingForUpdate(ctx, userID, readingID)
+		if err != nil {
+			return err
+		}
+		if !reading.IsOpen() {
+			return fmt.Errorf("%w: cannot add progress to a %s reading", domain.ErrValidation, reading.Status)
+		}
+
+		totalReadPages, err := s.progressRepo.GetTotalProgressByReadingID(ctx, readingID)
+		if err != nil {
+			return err
+		}
+
+		progress.Pages, err = pagesRead(progressReq, totalReadPages)
+		if err != nil {
+			return err
+		}
+		progress.Position = totalReadPages + progress.Pages
+		if err := s.validationSvc.ValidateStruct(progress); err != nil {
+			return err
+		}
+		if progress.Position > reading.TotalPages {
+			return fmt.Errorf("%w: total progress cannot be greater than %d %s",
+				domain.ErrValidation, reading.TotalPages, reading.Unit())
+		}
+
+		progress, err = s.progressRepo.CreateProgress(ctx, progress)
+		if err != nil {
+			return err
+		}
+		// A backdated entry moves the ones read after it.
+		total, err := s.updatePositions(ctx, reading)
+		if err != nil {
+			return err
+		}
+		progress, err = s.progressRepo.GetProgressByID(ctx, progress.ID)
+		if err != nil {
+			return err
+		}
+
+		return s.updateReading(ctx, reading, progress, total)
+	})
+	if err != nil {
 		return domain.Progress{}, err
 	}
 
-	reading, err := s.readingRepo.GetReadingByID(ctx, readingID)
+	return progress, nil
+}
+
+// UpdateProgress corrects the pages and date of a progress entry. A page is
+// taken relative to the position before the entry. Entries of ended readings
+// can be corrected too, but only an open reading is started or completed by
+// the change.
+func (s *progressService) UpdateProgress(ctx context.Context, userID, progressID int64, progressReq dto.ProgressRequest) (domain.Progress, error) {
+	date, err := s.checkRequest(ctx, userID, progressReq)
 	if err != nil {
 		return domain.Progress{}, err
 	}
 
-	totalReadPages, err := s.progressRepo.GetTotalProgressByReadingID(ctx, readingID)
+	var progress domain.Progress
+	err = s.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		reading, current, err := s.getUserProgressForUpdate(ctx, userID, progressID)
+		if err != nil {
+			return err
+		}
+
+		previous := current.Position - current.Pages
+		progress = current
+		progress.ReadingDate = date
+		progress.Pages, err = pagesRead(progressReq, previous)
+		if err != nil {
+			return err
+		}
+		progress.Position = previous + progress.Pages
+		if err := s.validationSvc.ValidateStruct(progress); err != nil {
+			return err
+		}
+
+		totalReadPages, err := s.progressRepo.GetTotalProgressByReadingID(ctx, reading.ID)
+		if err != nil {
+			return err
+		}
+		total := totalReadPages - current.Pages + progress.Pages
+		if total > reading.TotalPages || progress.Position > reading.TotalPages {
+			return fmt.Errorf("%w: total progress cannot be greater than %d %s",
+				domain.ErrValidation, reading.TotalPages, reading.Unit())
+		}
+		if total < 0 {
+			return fmt.Errorf("%w: %s", domain.ErrValidation, "total progress cannot be negative")
+		}
+
+		if _, err := s.progressRepo.UpdateProgress(ctx, progress); err != nil {
+			return err
+		}
+		if _, err := s.updatePositions(ctx, reading); err != nil {
+			return err
+		}
+		progress, err = s.progressRepo.GetProgressByID(ctx, progress.ID)
+		if err != nil {
+			return err
+		}
+
+		if !reading.IsOpen() {
+			return nil
+		}
+		return s.updateReading(ctx, reading, progress, total)
+	})
 	if err != nil {
 		return domain.Progress{}, err
 	}
 
-	if totalReadPages+progress.Pages > reading.TotalPages {
-		return domain.Progress{}, fmt.Errorf("%w: %s", domain.ErrValidation, "total progress cannot be greater than total pages")
+	return progress, nil
+}
+
+// DeleteProgress deletes a progress entry, moving back the entries read
+// after it. The reading keeps its status and dates.
+func (s *progressService) DeleteProgress(ctx context.Context, userID, progressID int64) error {
+	return s.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		reading, _, err := s.getUserProgressForUpdate(ctx, userID, progressID)
+		if err != nil {
+			return err
+		}
+
+		if err := s.progressRepo.DeleteProgress(ctx, progressID); err != nil {
+			return err
+		}
+		_, err = s.updatePositions(ctx, reading)
+		return err
+	})
+}
+
+// checkRequest validates a progress request before the reading is locked and
+// returns its reading date: the day of its instant in the timezone of the
+// user, which must not be after their today.
+func (s *progressService) checkRequest(ctx context.Context, userID int64, req dto.ProgressRequest) (time.Time, error) {
+	user, err := s.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return time.Time{}, err
+	}
+	date := utils.DateIn(req.Date, user.Location())
+	if date.After(utils.Today(user.Location())) {
+		return time.Time{}, fmt.Errorf("%w: %s", domain.ErrValidation, "reading date cannot be in the future")
+	}
+	if req.Page != nil && req.Pages != 0 {
+		return time.Time{}, fmt.Errorf("%w: %s", domain.ErrValidation, "give either the pages read or the current page, not both")
+	}
+	return date, nil
+}
+
+// pagesRead works out the pages of a progress request made at position. Only
+// a correction may go back.
+func pagesRead(req dto.ProgressRequest, position int64) (int64, error) {
+	if req.Page == nil {
+		if req.Pages < 0 && !req.Correction {
+			return 0, fmt.Errorf("%w: %s", domain.ErrValidation, "pages read cannot be negative unless it is a correction")
+		}
+		return req.Pages, nil
+	}
+
+	page := *req.Page
+	switch {
+	case page < 0:
+		return 0, fmt.Errorf("%w: %s", domain.ErrValidation, "page cannot be negative")
+	case page == position:
+		return 0, fmt.Errorf("%w: already on page %d", domain.ErrValidation, position)
+	case page < position && !req.Correction:
+		return 0, fmt.Errorf("%w: page %d is before the current page %d; mark it as a correction to go back",
+			domain.ErrValidation, page, position)
+	}
+	return page - position, nil
+}
+
+// getUserReadingForUpdate locks the reading, which also serializes changes to
+// its progress.
+func (s *progressService) getUserReadingForUpdate(ctx context.Context, userID, readingID int64) (domain.Reading, error) {
+	reading, err := s.readingRepo.GetReadingByIDForUpdate(ctx, readingID)
+	if err != nil {
+		return domain.Reading{}, err
+	}
+	if reading.UserID != userID {
+		return domain.Reading{}, fmt.Errorf("%w: %s", domain.ErrForbidden, "reading does not belong to user")
+	}
+	return reading, nil
+}
+
+// getUserProgressForUpdate locks the reading of a progress entry and returns
+// both. The entry is read again once the reading is locked, as it may have
+// changed while waiting for the lock.
+func (s *progressService) getUserProgressForUpdate(ctx context.Context, userID, progressID int64) (domain.Reading, domain.Progress, error) {
+	progress, err := s.progressRepo.GetProgressByID(ctx, progressID)
+	if err != nil {
+		return domain.Reading{}, domain.Progress{}, err
+	}
+	reading, err := s.getUserReadingForUpdate(ctx, userID, progress.ReadingID)
+	if err != nil {
+		return domain.Reading{}, domain.Progress{}, err
+	}
+	progress, err = s.progressRepo.GetProgressByID(ctx, progressID)
+	if err != nil {
+		return domain.Reading{}, domain.Progress{}, err
+	}
+	return reading, progress, nil
+}
+
+// updatePositions sets the position of every entry of the locked reading to
+// the pages read up to it, in the order they were read, and returns the
+// total. It fails if the position would go below zero or past the end of the
+// reading at any entry.
+func (s *progressService) updatePositions(ctx context.Context, reading domain.Reading) (int64, error) {
+	entries, err := s.progressRepo.GetProgressByReadingID(ctx, reading.ID)
+	if err != nil {
+		return 0, err
+	}
+
+	var position int64
+	for _, entry := range entries {
+		position += entry.Pages
+		if position < 0 {
+			return 0, fmt.Errorf("%w: %s", domain.ErrValidation, "progress cannot go back before the start")
+		}
+		if position > reading.TotalPages {
+			return 0, fmt.Errorf("%w: total progress cannot be greater than %d %s",
+				domain.ErrValidation, reading.TotalPages, reading.Unit())
+		}
+		if entry.Position == position {
+			continue
+		}
+		entry.Position = position
+		if _, err := s.progressRepo.UpdateProgress(ctx, entry); err != nil {
+			return 0, err
+		}
+	}
+	return position, nil
+}
+
+// updateReading resumes a not started or paused reading, starting it on its
+// earliest progress, and completes it on the day its last page was read.
+func (s *progressService) updateReading(ctx context.Context, reading domain.Reading, progress domain.Progress, total int64) error {
+	date := utils.Date(progress.ReadingDate)
+	changed := false
+
+	if reading.StartedAt == nil || date.Before(*reading.StartedAt) {
+		reading.StartedAt = &date
+		changed = true
+	}
+	if reading.Status != domain.ReadingStatusReading {
+		reading.Status = domain.ReadingStatusReading
+		changed = true
+	}
+	if total >= reading.TotalPages {
+		reading.Status = domain.ReadingStatusCompleted
+		reading.FinishedAt = &date
+		changed = true
+	}
+	if !changed {
+		return nil
 	}
 
-	return s.progressRepo.CreateProgress(ctx, progress)
+	reading.UpdatedAt = utils.Now()
+	_, err := s.readingRepo.UpdateReading(ctx, reading)
+	return err
 }
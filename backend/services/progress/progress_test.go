@@ -0,0 +1,542 @@
+package progress
+
+import (
+	"context"
+	"database/sql"
+	"io/fs"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/rimvydascivilis/book-tracker/backend/domain"
+	"github.com/rimvydascivilis/book-tracker/backend/dto"
+	"github.com/rimvydascivilis/book-tracker/backend/internal/migration"
+	"github.com/rimvydascivilis/book-tracker/backend/internal/repository/memory"
+	"github.com/rimvydascivilis/book-tracker/backend/internal/repository/sqlite"
+	"github.com/rimvydascivilis/book-tracker/backend/migrations"
+	"github.com/rimvydascivilis/book-tracker/backend/services/validation"
+	"github.com/rimvydascivilis/book-tracker/backend/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type backend struct {
+	user     domain.UserRepository
+	book     domain.BookRepository
+	reading  domain.ReadingRepository
+	progress domain.ProgressRepository
+	tx       domain.TxManager
+}
+
+func memoryBackend(t *testing.T) backend {
+	store := memory.NewStore()
+	return backend{
+		user:     memory.NewUserRepository(store),
+		book:     memory.NewBookRepository(store),
+		reading:  memory.NewReadingRepository(store),
+		progress: memory.NewProgressRepository(store),
+		tx:       memory.NewTxManager(store),
+	}
+}
+
+func sqliteBackend(t *testing.T) backend {
+	db, err := sqlite.Open(":memory:")
+	require.NoError(t, err)
+	t.Cleanup(func() { db.Close() })
+	migrate(t, db)
+
+	return backend{
+		user:     sqlite.NewUserRepository(db),
+		book:     sqlite.NewBookRepository(db),
+		reading:  sqlite.NewReadingRepository(db),
+		progress: sqlite.NewProgressRepository(db),
+		tx:       sqlite.NewTxManager(db),
+	}
+}
+
+func migrate(t *testing.T, db *sql.DB) {
+	migrationsFS, err := fs.Sub(migrations.SQLite, "sqlite")
+	require.NoError(t, err)
+	migrator, err := migration.NewMigrator(db, migrationsFS)
+	require.NoError(t, err)
+	_, err = migrator.Up(context.Background())
+	require.NoError(t, err)
+}
+
+// slowProgressRepository widens the window between reading the current total
+// and inserting new progress, so an unsynchronized check-then-act would let
+// concurrent requests through.
+type slowProgressRepository struct {
+	domain.ProgressRepository
+}
+
+func (r slowProgressRepository) GetTotalProgressByReadingID(ctx context.Context, readingID int64) (int64, error) {
+	total, err := r.ProgressRepository.GetTotalProgressByReadingID(ctx, readingID)
+	time.Sleep(time.Millisecond)
+	return total, err
+}
+
+func setupReading(t *testing.T, b backend, totalPages int64) domain.Reading {
+	ctx := context.Background()
+	user, err := b.user.CreateUser(ctx, domain.User{Email: "user@example.com", CreatedAt: time.Now()})
+	require.NoError(t, err)
+	book, err := b.book.CreateBook(ctx, domain.Book{UserID: user.ID, Title: "Dune", CreatedAt: time.Now()})
+	require.NoError(t, err)
+	reading, err := b.reading.CreateReading(ctx, domain.Reading{
+		UserID: user.ID, BookID: book.ID, TotalPages: totalPages, CreatedAt: time.Now(), UpdatedAt: time.Now(),
+	})
+	require.NoError(t, err)
+	return reading
+}
+
+func createOtherUser(t *testing.T, b backend) domain.User {
+	user, err := b.user.CreateUser(context.Background(), domain.User{Email: "other@example.com", CreatedAt: time.Now()})
+	require.NoError(t, err)
+	return user
+}
+
+func TestCreateProgress_ConcurrentRequestsCannotExceedTotalPages(t *testing.T) {
+	backends := map[string]func(t *testing.T) backend{
+		"memory": memoryBackend,
+		"sqlite": sqliteBackend,
+	}
+
+	for name, newBackend := range backends {
+		t.Run(name, func(t *testing.T) {
+			b := newBackend(t)
+			reading := setupReading(t, b, 100)
+			svc := NewProgressService(slowProgressRepository{b.progress}, b.reading, b.user, b.tx, validation.NewValidationService())
+
+			const requests = 20
+			var wg sync.WaitGroup
+			errs := make(chan error, requests)
+			for i := 0; i < requests; i++ {
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					_, err := svc.CreateProgress(context.Background(), reading.UserID, reading.ID,
+						dto.ProgressRequest{Pages: 10, Date: time.Now().UTC().Add(-time.Hour)})
+					errs <- err
+				}()
+			}
+			wg.Wait()
+			close(errs)
+
+			var succeeded int
+			for err := range errs {
+				if err == nil {
+					succeeded++
+					continue
+				}
+				assert.ErrorIs(t, err, domain.ErrValidation)
+			}
+
+			total, err := b.progress.GetTotalProgressByReadingID(context.Background(), reading.ID)
+			assert.NoError(t, err)
+			assert.Equal(t, 10, succeeded)
+			assert.Equal(t, int64(100), total)
+		})
+	}
+}
+
+func TestCreateProgress_ExceedsTotalPages(t *testing.T) {
+	b := memoryBackend(t)
+	reading := setupReading(t, b, 100)
+	svc := NewProgressService(b.progress, b.reading, b.user, b.tx, validation.NewValidationService())
+
+	_, err := svc.CreateProgress(context.Background(), reading.UserID, reading.ID,
+		dto.ProgressRequest{Pages: 101, Date: time.Now().UTC().Add(-time.Hour)})
+
+	assert.ErrorIs(t, err, domain.ErrValidation)
+}
+
+func TestCreateProgress_FutureDate(t *testing.T) {
+	b := memoryBackend(t)
+	reading := setupReading(t, b, 100)
+	svc := NewProgressService(b.progress, b.reading, b.user, b.tx, validation.NewValidationService())
+
+	_, err := svc.CreateProgress(context.Background(), reading.UserID, reading.ID,
+		dto.ProgressRequest{Pages: 10, Date: time.Now().UTC().Add(24 * time.Hour)})
+
+	assert.ErrorIs(t, err, domain.ErrValidation)
+}
+
+func TestCreateProgress_SetsReadingDates(t *testing.T) {
+	b := sqliteBackend(t)
+	ctx := context.Background()
+	reading := setupReading(t, b, 100)
+	svc := NewProgressService(b.progress, b.reading, b.user, b.tx, validation.NewValidationService())
+
+	for _, p := range []dto.ProgressRequest{
+		{Pages: 30, Date: time.Date(2024, 3, 10, 21, 0, 0, 0, time.UTC)},
+		{Pages: 20, Date: time.Date(2024, 3, 8, 9, 0, 0, 0, time.UTC)},
+	} {
+		_, err := svc.CreateProgress(ctx, reading.UserID, reading.ID, p)
+		require.NoError(t, err)
+	}
+
+	reading, err := b.reading.GetReadingByID(ctx, reading.ID)
+	require.NoError(t, err)
+	require.NotNil(t, reading.StartedAt)
+	assert.Equal(t, time.Date(2024, 3, 8, 0, 0, 0, 0, time.UTC), *reading.StartedAt)
+	assert.Nil(t, reading.FinishedAt)
+	assert.Equal(t, domain.ReadingStatusReading, reading.Status)
+
+	_, err = svc.CreateProgress(ctx, reading.UserID, reading.ID,
+		dto.ProgressRequest{Pages: 50, Date: time.Date(2024, 3, 12, 7, 0, 0, 0, time.UTC)})
+	require.NoError(t, err)
+
+	reading, err = b.reading.GetReadingByID(ctx, reading.ID)
+	require.NoError(t, err)
+	require.NotNil(t, reading.FinishedAt)
+	assert.Equal(t, time.Date(2024, 3, 12, 0, 0, 0, 0, time.UTC), *reading.FinishedAt)
+	assert.Equal(t, domain.ReadingStatusCompleted, reading.Status)
+}
+
+func TestCreateProgress_AbandonedReading(t *testing.T) {
+	b := memoryBackend(t)
+	ctx := context.Background()
+	reading := setupReading(t, b, 100)
+	reading.Status = domain.ReadingStatusAbandoned
+	_, err := b.reading.UpdateReading(ctx, reading)
+	require.NoError(t, err)
+	svc := NewProgressService(b.progress, b.reading, b.user, b.tx, validation.NewValidationService())
+
+	_, err = svc.CreateProgress(ctx, reading.UserID, reading.ID, dto.ProgressRequest{Pages: 10, Date: time.Now().UTC()})
+
+	assert.ErrorIs(t, err, domain.ErrValidation)
+}
+
+func TestCreateProgress_ReadingOfOtherUser(t *testing.T) {
+	b := memoryBackend(t)
+	reading := setupReading(t, b, 100)
+	other := createOtherUser(t, b)
+	svc := NewProgressService(b.progress, b.reading, b.user, b.tx, validation.NewValidationService())
+
+	_, err := svc.CreateProgress(context.Background(), other.ID, reading.ID, dto.ProgressRequest{Pages: 10, Date: time.Now().UTC()})
+
+	assert.ErrorIs(t, err, domain.ErrForbidden)
+}
+
+func TestUpdateProgress(t *testing.T) {
+	b := sqliteBackend(t)
+	ctx := context.Background()
+	reading := setupReading(t, b, 100)
+	svc := NewProgressService(b.progress, b.reading, b.user, b.tx, validation.NewValidationService())
+
+	first, err := svc.CreateProgress(ctx, reading.UserID, reading.ID, dto.ProgressRequest{Pages: 60, Date: time.Now().UTC()})
+	require.NoError(t, err)
+	_, err = svc.CreateProgress(ctx, reading.UserID, reading.ID, dto.ProgressRequest{Pages: 30, Date: time.Now().UTC()})
+	require.NoError(t, err)
+
+	_, err = svc.UpdateProgress(ctx, reading.UserID, first.ID, dto.ProgressRequest{Pages: 80, Date: time.Now().UTC()})
+	assert.ErrorIs(t, err, domain.ErrValidation)
+
+	_, err = svc.UpdateProgress(ctx, createOtherUser(t, b).ID, first.ID, dto.ProgressRequest{Pages: 10, Date: time.Now().UTC()})
+	assert.ErrorIs(t, err, domain.ErrForbidden)
+
+	updated, err := svc.UpdateProgress(ctx, reading.UserID, first.ID, dto.ProgressRequest{Pages: 70, Date: time.Now().UTC()})
+	require.NoError(t, err)
+	assert.Equal(t, int64(70), updated.Pages)
+
+	reading, err = b.reading.GetReadingByID(ctx, reading.ID)
+	require.NoError(t, err)
+	assert.Equal(t, domain.ReadingStatusCompleted, reading.Status)
+}
+
+func TestDeleteProgress(t *testing.T) {
+	b := memoryBackend(t)
+	ctx := context.Background()
+	reading := setupReading(t, b, 100)
+	svc := NewProgressService(b.progress, b.reading, b.user, b.tx, validation.NewValidationService())
+
+	progress, err := svc.CreateProgress(ctx, reading.UserID, reading.ID, dto.ProgressRequest{Pages: 40, Date: time.Now().UTC()})
+	require.NoError(t, err)
+
+	err = svc.DeleteProgress(ctx, reading.UserID+1, progress.ID)
+	assert.ErrorIs(t, err, domain.ErrForbidden)
+
+	require.NoError(t, svc.DeleteProgress(ctx, reading.UserID, progress.ID))
+	entries, err := svc.GetReadingProgress(ctx, reading.UserID, reading.ID)
+	assert.NoError(t, err)
+	assert.Empty(t, entries)
+
+	err = svc.DeleteProgress(ctx, reading.UserID, progress.ID)
+	assert.ErrorIs(t, err, domain.ErrRecordNotFound)
+}
+
+func TestCreateProgress_CurrentPage(t *testing.T) {
+	b := sqliteBackend(t)
+	ctx := context.Background()
+	reading := setupReading(t, b, 300)
+	svc := NewProgressService(b.progress, b.reading, b.user, b.tx, validation.NewValidationService())
+	page := func(p int64) *int64 { return &p }
+
+	first, err := svc.CreateProgress(ctx, reading.UserID, reading.ID, dto.ProgressRequest{Page: page(40), Date: time.Now().UTC()})
+	require.NoError(t, err)
+	assert.Equal(t, int64(40), first.Pages)
+	assert.Equal(t, int64(40), first.Position)
+
+	second, err := svc.CreateProgress(ctx, reading.UserID, reading.ID, dto.ProgressRequest{Pages: 15, Date: time.Now().UTC()})
+	require.NoError(t, err)
+	assert.Equal(t, int64(55), second.Position)
+
+	third, err := svc.CreateProgress(ctx, reading.UserID, reading.ID, dto.ProgressRequest{Page: page(213), Date: time.Now().UTC()})
+	require.NoError(t, err)
+	assert.Equal(t, int64(158), third.Pages)
+
+	total, err := b.progress.GetTotalProgressByReadingID(ctx, reading.ID)
+	require.NoError(t, err)
+	assert.Equal(t, int64(213), total)
+}
+
+func TestCreateProgress_Backwards(t *testing.T) {
+	b := memoryBackend(t)
+	ctx := context.Background()
+	reading := setupReading(t, b, 300)
+	svc := NewProgressService(b.progress, b.reading, b.user, b.tx, validation.NewValidationService())
+	page := func(p int64) *int64 { return &p }
+
+	_, err := svc.CreateProgress(ctx, reading.UserID, reading.ID, dto.ProgressRequest{Page: page(100), Date: time.Now().UTC()})
+	require.NoError(t, err)
+
+	_, err = svc.CreateProgress(ctx, reading.UserID, reading.ID, dto.ProgressRequest{Page: page(90), Date: time.Now().UTC()})
+	assert.ErrorIs(t, err, domain.ErrValidation)
+	_, err = svc.CreateProgress(ctx, reading.UserID, reading.ID, dto.ProgressRequest{Pages: -10, Date: time.Now().UTC()})
+	assert.ErrorIs(t, err, domain.ErrValidation)
+	_, err = svc.CreateProgress(ctx, reading.UserID, reading.ID, dto.ProgressRequest{Page: page(100), Date: time.Now().UTC()})
+	assert.ErrorIs(t, err, domain.ErrValidation)
+	_, err = svc.CreateProgress(ctx, reading.UserID, reading.ID, dto.ProgressRequest{Pages: 5, Page: page(120), Date: time.Now().UTC()})
+	assert.ErrorIs(t, err, domain.ErrValidation)
+
+	correction, err := svc.CreateProgress(ctx, reading.UserID, reading.ID,
+		dto.ProgressRequest{Page: page(90), Correction: true, Date: time.Now().UTC()})
+	require.NoError(t, err)
+	assert.Equal(t, int64(-10), correction.Pages)
+	assert.Equal(t, int64(90), correction.Position)
+
+	_, err = svc.CreateProgress(ctx, reading.UserID, reading.ID,
+		dto.ProgressRequest{Pages: -100, Correction: true, Date: time.Now().UTC()})
+	assert.ErrorIs(t, err, domain.ErrValidation)
+}
+
+func TestUpdateProgress_CurrentPage(t *testing.T) {
+	b := memoryBackend(t)
+	ctx := context.Background()
+	reading := setupReading(t, b, 300)
+	svc := NewProgressService(b.progress, b.reading, b.user, b.tx, validation.NewValidationService())
+
+	_, err := svc.CreateProgress(ctx, reading.UserID, reading.ID, dto.ProgressRequest{Pages: 40, Date: time.Now().UTC()})
+	require.NoError(t, err)
+	last, err := svc.CreateProgress(ctx, reading.UserID, reading.ID, dto.ProgressRequest{Pages: 20, Date: time.Now().UTC()})
+	require.NoError(t, err)
+
+	page := int64(75)
+	updated, err := svc.UpdateProgress(ctx, reading.UserID, last.ID, dto.ProgressRequest{Page: &page, Date: time.Now().UTC()})
+	require.NoError(t, err)
+	assert.Equal(t, int64(35), updated.Pages)
+	assert.Equal(t, int64(75), updated.Position)
+}
+
+// positions returns the positions of the entries of the reading in the
+// order they were read.
+func positions(t *testing.T, b backend, readingID int64) []int64 {
+	t.Helper()
+	entries, err := b.progress.GetProgressByReadingID(context.Background(), readingID)
+	require.NoError(t, err)
+	res := []int64{}
+	for _, entry := range entries {
+		res = append(res, entry.Position)
+	}
+	return res
+}
+
+func TestProgress_PositionsFollowChanges(t *testing.T) {
+	b := sqliteBackend(t)
+	ctx := context.Background()
+	reading := setupReading(t, b, 300)
+	svc := NewProgressService(b.progress, b.reading, b.user, b.tx, validation.NewValidationService())
+	day := func(n int) time.Time { return time.Date(2024, 3, n, 12, 0, 0, 0, time.UTC) }
+
+	first, err := svc.CreateProgress(ctx, reading.UserID, reading.ID, dto.ProgressRequest{Pages: 40, Date: day(1)})
+	require.NoError(t, err)
+	second, err := svc.CreateProgress(ctx, reading.UserID, reading.ID, dto.ProgressRequest{Pages: 30, Date: day(3)})
+	require.NoError(t, err)
+	third, err := svc.CreateProgress(ctx, reading.UserID, reading.ID, dto.ProgressRequest{Pages: 20, Date: day(5)})
+	require.NoError(t, err)
+	assert.Equal(t, []int64{40, 70, 90}, positions(t, b, reading.ID))
+
+	// Editing an entry moves the ones after it, so a later edit by page
+	// starts from the right place.
+	_, err = svc.UpdateProgress(ctx, reading.UserID, first.ID, dto.ProgressRequest{Pages: 50, Date: day(1)})
+	require.NoError(t, err)
+	assert.Equal(t, []int64{50, 80, 100}, positions(t, b, reading.ID))
+	page := int64(95)
+	updated, err := svc.UpdateProgress(ctx, reading.UserID, second.ID, dto.ProgressRequest{Page: &page, Date: day(3)})
+	require.NoError(t, err)
+	assert.Equal(t, int64(45), updated.Pages)
+	assert.Equal(t, int64(95), updated.Position)
+	assert.Equal(t, []int64{50, 95, 115}, positions(t, b, reading.ID))
+
+	// So does moving an entry to another day.
+	moved, err := svc.UpdateProgress(ctx, reading.UserID, third.ID, dto.ProgressRequest{Pages: 20, Date: day(2)})
+	require.NoError(t, err)
+	assert.Equal(t, int64(70), moved.Position)
+	assert.Equal(t, []int64{50, 70, 115}, positions(t, b, reading.ID))
+
+	// A backdated entry goes in where it was read.
+	backdated, err := svc.CreateProgress(ctx, reading.UserID, reading.ID, dto.ProgressRequest{Pages: 10, Date: day(1).AddDate(0, 0, -1)})
+	require.NoError(t, err)
+	assert.Equal(t, int64(10), backdated.Position)
+	assert.Equal(t, []int64{10, 60, 80, 125}, positions(t, b, reading.ID))
+
+	require.NoError(t, svc.DeleteProgress(ctx, reading.UserID, first.ID))
+	assert.Equal(t, []int64{10, 30, 75}, positions(t, b, reading.ID))
+}
+
+func TestDeleteProgress_KeepsPositionsValid(t *testing.T) {
+	b := memoryBackend(t)
+	ctx := context.Background()
+	reading := setupReading(t, b, 100)
+	svc := NewProgressService(b.progress, b.reading, b.user, b.tx, validation.NewValidationService())
+
+	first, err := svc.CreateProgress(ctx, reading.UserID, reading.ID, dto.ProgressRequest{Pages: 60, Date: time.Now().UTC()})
+	require.NoError(t, err)
+	page := int64(20)
+	_, err = svc.CreateProgress(ctx, reading.UserID, reading.ID,
+		dto.ProgressRequest{Page: &page, Correction: true, Date: time.Now().UTC()})
+	require.NoError(t, err)
+
+	// Without the first entry, the correction would go back to page -40.
+	err = svc.DeleteProgress(ctx, reading.UserID, first.ID)
+	assert.ErrorIs(t, err, domain.ErrValidation)
+	assert.Equal(t, []int64{60, 20}, positions(t, b, reading.ID))
+}
+
+// lockHookReadingRepository runs onLock before locking a reading, as if
+// another request changed its progress while this one waited for the lock.
+type lockHookReadingRepository struct {
+	domain.ReadingRepository
+	onLock func(ctx context.Context)
+}
+
+func (r lockHookReadingRepository) GetReadingByIDForUpdate(ctx context.Context, id int64) (domain.Reading, error) {
+	if r.onLock != nil {
+		r.onLock(ctx)
+	}
+	return r.ReadingRepository.GetReadingByIDForUpdate(ctx, id)
+}
+
+func TestUpdateProgress_ChangedWhileWaitingForLock(t *testing.T) {
+	b := memoryBackend(t)
+	ctx := context.Background()
+	reading := setupReading(t, b, 100)
+	svc := NewProgressService(b.progress, b.reading, b.user, b.tx, validation.NewValidationService())
+
+	first, err := svc.CreateProgress(ctx, reading.UserID, reading.ID, dto.ProgressRequest{Pages: 50, Date: time.Now().UTC()})
+	require.NoError(t, err)
+	_, err = svc.CreateProgress(ctx, reading.UserID, reading.ID, dto.ProgressRequest{Pages: 30, Date: time.Now().UTC()})
+	require.NoError(t, err)
+
+	// The first entry goes from 50 to 70 pages just before the lock is
+	// taken. Setting it to 60 fits, which the 50 read before would not
+	// tell.
+	readings := lockHookReadingRepository{ReadingRepository: b.reading}
+	readings.onLock = func(ctx context.Context) {
+		readings.onLock = nil
+		_, err := svc.UpdateProgress(ctx, reading.UserID, first.ID, dto.ProgressRequest{Pages: 70, Date: time.Now().UTC()})
+		require.NoError(t, err)
+	}
+	racing := NewProgressService(b.progress, &readings, b.user, b.tx, validation.NewValidationService())
+
+	updated, err := racing.UpdateProgress(ctx, reading.UserID, first.ID, dto.ProgressRequest{Pages: 60, Date: time.Now().UTC()})
+	require.NoError(t, err)
+	assert.Equal(t, int64(60), updated.Pages)
+	total, err := b.progress.GetTotalProgressByReadingID(ctx, reading.ID)
+	require.NoError(t, err)
+	assert.Equal(t, int64(90), total)
+}
+
+func setTimezone(t *testing.T, b backend, userID int64, timezone string) *time.Location {
+	ctx := context.Background()
+	user, err := b.user.GetByID(ctx, userID)
+	require.NoError(t, err)
+	user.Timezone = timezone
+	_, err = b.user.UpdateUser(ctx, user)
+	require.NoError(t, err)
+	return user.Location()
+}
+
+// TestCreateProgress_TodayOfUser checks the future date rule against the day
+// of the user. Kiritimati is fourteen hours ahead of UTC and Pago Pago eleven
+// hours behind, so their days never coincide.
+func TestCreateProgress_TodayOfUser(t *testing.T) {
+	b := sqliteBackend(t)
+	ctx := context.Background()
+	ahead := setupReading(t, b, 100)
+	aheadToday := utils.Today(setTimezone(t, b, ahead.UserID, "Pacific/Kiritimati"))
+	svc := NewProgressService(b.progress, b.reading, b.user, b.tx, validation.NewValidationService())
+
+	progress, err := svc.CreateProgress(ctx, ahead.UserID, ahead.ID, dto.ProgressRequest{Pages: 10, Date: aheadToday})
+	require.NoError(t, err)
+	assert.Equal(t, aheadToday, progress.ReadingDate)
+
+	behind, err := b.reading.CreateReading(ctx, domain.Reading{
+		UserID: createOtherUser(t, b).ID, BookID: ahead.BookID, TotalPages: 100, CreatedAt: time.Now(), UpdatedAt: time.Now(),
+	})
+	require.NoError(t, err)
+	pagoPago := setTimezone(t, b, behind.UserID, "Pacific/Pago_Pago")
+
+	// The same instant is a day earlier in Pago Pago.
+	progress, err = svc.CreateProgress(ctx, behind.UserID, behind.ID, dto.ProgressRequest{Pages: 10, Date: time.Now()})
+	require.NoError(t, err)
+	assert.Equal(t, utils.Today(pagoPago), progress.ReadingDate)
+	assert.True(t, progress.ReadingDate.Before(aheadToday))
+
+	_, err = svc.CreateProgress(ctx, behind.UserID, behind.ID,
+		dto.ProgressRequest{Pages: 10, Date: time.Now().Add(24 * time.Hour)})
+	assert.ErrorIs(t, err, domain.ErrValidation)
+}
+
+// TestCreateProgress_UTCInstant stores a UTC instant, as sent by the
+// frontend, on the day it falls on for the user.
+func TestCreateProgress_UTCInstant(t *testing.T) {
+	b := sqliteBackend(t)
+	ctx := context.Background()
+	reading := setupReading(t, b, 100)
+	setTimezone(t, b, reading.UserID, "Europe/Vilnius")
+	svc := NewProgressService(b.progress, b.reading, b.user, b.tx, validation.NewValidationService())
+
+	// 22:30 UTC is 01:30 of the next day in summer in Vilnius, UTC+3.
+	date, err := time.Parse(time.RFC3339, "2024-06-10T22:30:00Z")
+	require.NoError(t, err)
+	progress, err := svc.CreateProgress(ctx, reading.UserID, reading.ID, dto.ProgressRequest{Pages: 10, Date: date})
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2024, 6, 11, 0, 0, 0, 0, time.UTC), progress.ReadingDate)
+
+	updated, err := svc.UpdateProgress(ctx, reading.UserID, progress.ID,
+		dto.ProgressRequest{Pages: 10, Date: date.Add(-time.Hour)})
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2024, 6, 11, 0, 0, 0, 0, time.UTC), updated.ReadingDate)
+}
+
+// TestCreateProgress_DayOfRequest keeps the day of the user a request falls
+// on, including across a DST change.
+func TestCreateProgress_DayOfRequest(t *testing.T) {
+	b := sqliteBackend(t)
+	ctx := context.Background()
+	reading := setupReading(t, b, 100)
+	vilnius := setTimezone(t, b, reading.UserID, "Europe/Vilnius")
+	svc := NewProgressService(b.progress, b.reading, b.user, b.tx, validation.NewValidationService())
+
+	// 00:30 on the night clocks went forward is still 22:30 of the day
+	// before in UTC.
+	progress, err := svc.CreateProgress(ctx, reading.UserID, reading.ID,
+		dto.ProgressRequest{Pages: 10, Date: time.Date(2024, 3, 31, 0, 30, 0, 0, vilnius)})
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2024, 3, 31, 0, 0, 0, 0, time.UTC), progress.ReadingDate)
+
+	stored, err := b.progress.GetProgressByID(ctx, progress.ID)
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2024, 3, 31, 0, 0, 0, 0, time.UTC), stored.ReadingDate)
+}
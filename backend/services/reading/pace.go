@@ -0,0 +1,121 @@
+package reading
+
+import (
+	"math"
+	"time"
+
+	"github.com/rimvydascivilis/book-tracker/backend/domain"
+	"github.com/rimvydascivilis/book-tracker/backend/dto"
+	"github.com/rimvydascivilis/book-tracker/backend/utils"
+)
+
+const day = 24 * time.Hour
+
+// calculatePace works out the pace of the reading as of today from its
+// progress entries, which are in the order they were read. Windows that
+// reach back before the reading started are shortened to its start, so a
+// reading begun yesterday is not averaged over a month.
+func calculatePace(reading domain.Reading, progress []domain.Progress, today time.Time, windows []int64) dto.Pace {
+	var position int64
+	for _, p := range progress {
+		position += p.Pages
+	}
+
+	pace := dto.Pace{
+		Unit:       reading.Unit(),
+		Remaining:  max(reading.TotalPages-position, 0),
+		Windows:    make([]dto.PaceWindow, 0, len(windows)),
+		TargetDate: reading.TargetDate,
+	}
+
+	start := startDate(reading, progress, today)
+	for _, days := range windows {
+		from := today.AddDate(0, 0, -int(days-1))
+		span := days
+		if from.Before(start) {
+			from = start
+			span = daysBetween(start, today) + 1
+		}
+
+		var read int64
+		for _, p := range progress {
+			date := utils.Date(p.ReadingDate)
+			if !date.Before(from) && !date.After(today) {
+				read += p.Pages
+			}
+		}
+
+		perDay := float64(read) / float64(span)
+		pace.Windows = append(pace.Windows, dto.PaceWindow{Days: days, PerDay: round(perDay)})
+		if pace.EstimatedFinish == nil && perDay > 0 && pace.Remaining > 0 {
+			finish := today.AddDate(0, 0, int(math.Ceil(float64(pace.Remaining)/perDay)))
+			pace.EstimatedFinish = &finish
+		}
+	}
+
+	if reading.TargetDate != nil && pace.Remaining > 0 {
+		// A target in the past leaves today to catch up.
+		days := max(daysBetween(today, utils.Date(*reading.TargetDate))+1, 1)
+		needed := round(float64(pace.Remaining) / float64(days))
+		pace.NeededPerDay = &needed
+	}
+
+	return pace
+}
+
+// dailyPace returns the progress of every day from the start of the reading
+// until it finished or, while it is open, today.
+func dailyPace(reading domain.Reading, progress []domain.Progress, today time.Time) []dto.PaceDay {
+	if reading.StartedAt == nil && len(progress) == 0 {
+		return []dto.PaceDay{}
+	}
+
+	end := today
+	if !reading.IsOpen() && reading.FinishedAt != nil {
+		end = utils.Date(*reading.FinishedAt)
+	}
+	pages := map[time.Time]int64{}
+	for _, p := range progress {
+		date := utils.Date(p.ReadingDate)
+		pages[date] += p.Pages
+		if date.After(end) {
+			end = date
+		}
+	}
+
+	start := startDate(reading, progress, today)
+	series := make([]dto.PaceDay, 0, daysBetween(start, end)+1)
+	var position int64
+	for date := start; !date.After(end); date = date.AddDate(0, 0, 1) {
+		position += pages[date]
+		series = append(series, dto.PaceDay{
+			Date:     date.Format("2006-01-02"),
+			Pages:    pages[date],
+			Position: position,
+		})
+	}
+	return series
+}
+
+// startDate is the day the reading started, or of its first progress when
+// that is earlier.
+func startDate(reading domain.Reading, progress []domain.Progress, today time.Time) time.Time {
+	start := today
+	if reading.StartedAt != nil {
+		start = utils.Date(*reading.StartedAt)
+	}
+	for _, p := range progress {
+		if date := utils.Date(p.ReadingDate); date.Before(start) {
+			start = date
+		}
+	}
+	return start
+}
+
+func daysBetween(from, to time.Time) int64 {
+	return int64(to.Sub(from).Round(day) / day)
+}
+
+func round(v float64) float64 {
+	return math.Round(v*100) / 100
+}
@@ -0,0 +1,91 @@
+package reading
+
+import (
+	"testing"
+	"time"
+
+	"github.com/rimvydascivilis/book-tracker/backend/domain"
+	"github.com/rimvydascivilis/book-tracker/backend/dto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func date(month time.Month, d int) time.Time {
+	return time.Date(2024, month, d, 0, 0, 0, 0, time.UTC)
+}
+
+func TestCalculatePace(t *testing.T) {
+	started := date(3, 1)
+	target := date(4, 9)
+	reading := domain.Reading{
+		Format: domain.ReadingFormatPrint, TotalPages: 400, Status: domain.ReadingStatusReading,
+		StartedAt: &started, TargetDate: &target,
+	}
+	progress := []domain.Progress{
+		{Pages: 100, ReadingDate: date(3, 1)},
+		{Pages: 60, ReadingDate: date(3, 25)},
+		{Pages: 80, ReadingDate: date(3, 30)},
+		// A correction counts against the pace.
+		{Pages: -10, ReadingDate: date(3, 30)},
+	}
+
+	pace := calculatePace(reading, progress, date(3, 31), []int64{7, 30, 90})
+
+	assert.Equal(t, domain.UnitPages, pace.Unit)
+	assert.Equal(t, int64(170), pace.Remaining)
+	assert.Equal(t, []dto.PaceWindow{
+		{Days: 7, PerDay: 18.57},
+		{Days: 30, PerDay: 4.33},
+		// The reading is 31 days old, so the window is cut to its start.
+		{Days: 90, PerDay: 7.42},
+	}, pace.Windows)
+	require.NotNil(t, pace.EstimatedFinish)
+	assert.Equal(t, date(4, 10), *pace.EstimatedFinish)
+	require.NotNil(t, pace.NeededPerDay)
+	assert.Equal(t, 17.0, *pace.NeededPerDay)
+}
+
+func TestCalculatePace_NoRecentProgress(t *testing.T) {
+	started := date(1, 10)
+	reading := domain.Reading{Format: domain.ReadingFormatPrint, TotalPages: 300, StartedAt: &started}
+	progress := []domain.Progress{{Pages: 50, ReadingDate: date(1, 10)}}
+
+	pace := calculatePace(reading, progress, date(3, 31), []int64{7, 365})
+
+	assert.Equal(t, 0.0, pace.Windows[0].PerDay)
+	require.NotNil(t, pace.EstimatedFinish, "falls back to the next window")
+	assert.Nil(t, pace.NeededPerDay)
+}
+
+func TestCalculatePace_TargetPassed(t *testing.T) {
+	target := date(3, 1)
+	reading := domain.Reading{Format: domain.ReadingFormatPrint, TotalPages: 300, TargetDate: &target}
+
+	pace := calculatePace(reading, nil, date(3, 31), []int64{7})
+
+	assert.Nil(t, pace.EstimatedFinish)
+	require.NotNil(t, pace.NeededPerDay)
+	assert.Equal(t, 300.0, *pace.NeededPerDay)
+}
+
+func TestDailyPace(t *testing.T) {
+	started := date(3, 1)
+	finished := date(3, 3)
+	reading := domain.Reading{
+		Format: domain.ReadingFormatPrint, TotalPages: 100, Status: domain.ReadingStatusCompleted,
+		StartedAt: &started, FinishedAt: &finished,
+	}
+	progress := []domain.Progress{
+		{Pages: 40, ReadingDate: date(3, 1)},
+		{Pages: 60, ReadingDate: date(3, 3)},
+	}
+
+	series := dailyPace(reading, progress, date(3, 31))
+
+	assert.Equal(t, []dto.PaceDay{
+		{Date: "2024-03-01", Pages: 40, Position: 40},
+		{Date: "2024-03-02", Pages: 0, Position: 40},
+		{Date: "2024-03-03", Pages: 60, Position: 100},
+	}, series)
+	assert.Empty(t, dailyPace(domain.Reading{Status: domain.ReadingStatusNotStarted}, nil, date(3, 31)))
+}
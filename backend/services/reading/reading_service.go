@@ -3,6 +3,7 @@ package reading
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/rimvydascivilis/book-tracker/backend/domain"
 	"github.com/rimvydascivilis/book-tracker/backend/dto"
@@ -13,20 +14,34 @@ type ReadingService struct {
 	readingRepo   domain.ReadingRepository
 	progressRepo  domain.ProgressRepository
 	bookRepo      domain.BookRepository
+	userRepo      domain.UserRepository
+	txManager     domain.TxManager
 	validationSvc domain.ValidationService
+	// paceWindows are the days pace is averaged over unless a request
+	// asks for others.
+	paceWindows []int64
 }
 
 func NewReadingService(repo domain.ReadingRepository, progressRepo domain.ProgressRepository,
-	bookRepo domain.BookRepository, validationSvc domain.ValidationService) *ReadingService {
+	bookRepo domain.BookRepository, userRepo domain.UserRepository, txManager domain.TxManager,
+	validationSvc domain.ValidationService, paceWindows []int64) *ReadingService {
 	return &ReadingService{
 		readingRepo:   repo,
 		progressRepo:  progressRepo,
 		bookRepo:      bookRepo,
+		userRepo:      userRepo,
+		txManager:     txManager,
 		validationSvc: validationSvc,
+		paceWindows:   paceWindows,
 	}
 }
 
-func (s *ReadingService) GetReadings(ctx context.Context, userID, page, limit int64) ([]dto.ReadingResponse, bool, error) {
+// GetReadings pages through the readings of the user, optionally only those
+// with status.
+func (s *ReadingService) GetReadings(ctx context.Context, userID int64, status string, page, limit int64) ([]dto.ReadingResponse, bool, error) {
+	if status != "" && !validStatus(status) {
+		return nil, false, fmt.Errorf("%w: unknown reading status %q", domain.ErrValidation, status)
+	}
 	if page < 1 {
 		page = 1
 	}
@@ -37,7 +52,7 @@ func (s *ReadingService) GetReadings(ctx context.Context, userID, page, limit in
 		limit = 100
 	}
 
-	totalCount, err := s.readingRepo.CountReadingsByUserID(ctx, userID)
+	totalCount, err := s.readingRepo.CountReadingsByUserID(ctx, userID, status)
 	if err != nil {
 		return nil, false, err
 	}
@@ -48,58 +63,375 @@ func (s *ReadingService) GetReadings(ctx context.Context, userID, page, limit in
 	}
 
 	offset := (page - 1) * limit
-	readings, err := s.readingRepo.GetReadingsByUserID(ctx, userID, offset, limit)
+	summaries, err := s.readingRepo.GetReadingSummariesByUserID(ctx, userID, status, offset, limit)
+	if err != nil {
+		return nil, false, err
+	}
+
+	readings := make([]domain.Reading, 0, len(summaries))
+	for _, summary := range summaries {
+		readings = append(readings, summary.Reading)
+	}
+	entries, err := s.openProgress(ctx, readings)
+	if err != nil {
+		return nil, false, err
+	}
+	today, err := s.today(ctx, userID)
 	if err != nil {
 		return nil, false, err
 	}
 
-	combinedResponse := make([]dto.ReadingResponse, 0, len(readings))
+	combinedResponse := make([]dto.ReadingResponse, 0, len(summaries))
+	for _, summary := range summaries {
+		combinedResponse = append(combinedResponse,
+			s.toResponse(summary.Reading, summary.BookTitle, summary.Progress, entries[summary.ID], today))
+	}
+
+	return combinedResponse, hasMore, nil
+}
+
+func (s *ReadingService) GetBookReadings(ctx context.Context, userID, bookID int64) ([]dto.ReadingResponse, error) {
+	book, err := s.bookRepo.GetBookByUserID(ctx, userID, bookID)
+	if err != nil {
+		return nil, err
+	}
+
+	readings, err := s.readingRepo.GetReadingsByUserIDAndBookID(ctx, userID, bookID)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]int64, 0, len(readings))
 	for _, reading := range readings {
-		book, err := s.bookRepo.GetBookByUserID(ctx, userID, reading.BookID)
-		if err != nil {
-			return nil, false, err
+		ids = append(ids, reading.ID)
+	}
+	entries, err := s.progressRepo.GetProgressByReadingIDs(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	today, err := s.today(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	history := make([]dto.ReadingResponse, 0, len(readings))
+	for _, reading := range readings {
+		var progress int64
+		for _, p := range entries[reading.ID] {
+			progress += p.Pages
 		}
+		history = append(history, s.toResponse(reading, book.Title, progress, entries[reading.ID], today))
+	}
 
-		progress, err := s.progressRepo.GetTotalProgressByReadingID(ctx, reading.ID)
-		if err != nil {
-			return nil, false, err
+	return history, nil
+}
+
+// openProgress loads the progress entries of the readings still being read,
+// which their pace is worked out from, in one query.
+func (s *ReadingService) openProgress(ctx context.Context, readings []domain.Reading) (map[int64][]domain.Progress, error) {
+	var ids []int64
+	for _, reading := range readings {
+		if reading.Status == domain.ReadingStatusReading {
+			ids = append(ids, reading.ID)
 		}
+	}
+	if len(ids) == 0 {
+		return map[int64][]domain.Progress{}, nil
+	}
+	return s.progressRepo.GetProgressByReadingIDs(ctx, ids)
+}
 
-		combinedResponse = append(combinedResponse, dto.ReadingResponse{
-			BookTitle: book.Title,
-			Status:    reading.GetStatus(progress),
-			Progress:  progress,
-			Reading: dto.Reading{
-				ID:         reading.ID,
-				TotalPages: reading.TotalPages,
-				Link:       reading.Link,
-			},
-		})
+// toResponse describes the reading with the pages read so far and, while it
+// is being read, its pace as of today worked out from entries.
+func (s *ReadingService) toResponse(reading domain.Reading, bookTitle string, progress int64, entries []domain.Progress,
+	today time.Time) dto.ReadingResponse {
+	var pace *dto.Pace
+	if reading.Status == domain.ReadingStatusReading {
+		p := calculatePace(reading, entries, today, s.paceWindows)
+		pace = &p
 	}
 
-	return combinedResponse, hasMore, nil
+	return dto.ReadingResponse{
+		BookTitle: bookTitle,
+		Status:    reading.Status,
+		Progress:  progress,
+		Reading: dto.Reading{
+			ID:         reading.ID,
+			BookID:     reading.BookID,
+			Format:     reading.Format,
+			Unit:       reading.Unit(),
+			TotalPages: reading.TotalPages,
+			Link:       reading.Link,
+			StartedAt:  reading.StartedAt,
+			FinishedAt: reading.FinishedAt,
+			TargetDate: reading.TargetDate,
+		},
+		Pace: pace,
+	}
+}
+
+// GetReadingPace returns the pace of the reading averaged over windows, or
+// the configured windows when none are given, with its daily progress.
+func (s *ReadingService) GetReadingPace(ctx context.Context, userID, readingID int64, windows []int64) (dto.PaceResponse, error) {
+	if len(windows) == 0 {
+		windows = s.paceWindows
+	}
+	for _, days := range windows {
+		if days < 1 {
+			return dto.PaceResponse{}, fmt.Errorf("%w: %s", domain.ErrValidation, "pace windows must be at least one day")
+		}
+	}
+
+	reading, err := s.readingRepo.GetReadingByID(ctx, readingID)
+	if err != nil {
+		return dto.PaceResponse{}, err
+	}
+	if reading.UserID != userID {
+		return dto.PaceResponse{}, fmt.Errorf("%w: %s", domain.ErrForbidden, "reading does not belong to user")
+	}
+
+	progress, err := s.progressRepo.GetProgressByReadingID(ctx, readingID)
+	if err != nil {
+		return dto.PaceResponse{}, err
+	}
+
+	today, err := s.today(ctx, userID)
+	if err != nil {
+		return dto.PaceResponse{}, err
+	}
+	return dto.PaceResponse{
+		Pace:  calculatePace(reading, progress, today, windows),
+		Daily: dailyPace(reading, progress, today),
+	}, nil
 }
 
+// CreateReading starts a new reading of a book. A book can be read any number
+// of times, but only once at a time: the previous reading must be completed
+// or abandoned. Print readings default to the page count of the book, ebooks
+// are 100 percent long and audiobooks need their length in minutes.
 func (s *ReadingService) CreateReading(ctx context.Context, userID int64, reading domain.Reading) (domain.Reading, error) {
+	if reading.Format == "" {
+		reading.Format = domain.ReadingFormatPrint
+	}
+	if reading.Format == domain.ReadingFormatEbook {
+		reading.TotalPages = 100
+	}
 	reading.UserID = userID
 	reading.CreatedAt = utils.Now()
 	reading.UpdatedAt = utils.Now()
-	if err := s.validationSvc.ValidateStruct(reading); err != nil {
+	// Readings are finished by their progress or a status change, never on
+	// creation.
+	reading.Status = domain.ReadingStatusNotStarted
+	reading.FinishedAt = nil
+
+	if reading.StartedAt != nil {
+		// Like a reading date, the start is the day of its instant in the
+		// timezone of the user.
+		user, err := s.userRepo.GetByID(ctx, userID)
+		if err != nil {
+			return domain.Reading{}, err
+		}
+		startedAt := utils.DateIn(*reading.StartedAt, user.Location())
+		if startedAt.After(utils.Today(user.Location())) {
+			return domain.Reading{}, fmt.Errorf("%w: %s", domain.ErrValidation, "start date cannot be in the future")
+		}
+		reading.StartedAt = &startedAt
+		reading.Status = domain.ReadingStatusReading
+	}
+	if reading.TargetDate != nil {
+		targetDate := utils.Date(*reading.TargetDate)
+		reading.TargetDate = &targetDate
+	}
+
+	err := s.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		if reading.BookID != 0 {
+			// Locking the book keeps concurrent requests from both starting
+			// a reading of it.
+			book, err := s.bookRepo.GetBookByUserIDForUpdate(ctx, userID, reading.BookID)
+			if err != nil {
+				return err
+			}
+			if reading.TotalPages == 0 && reading.Format == domain.ReadingFormatPrint {
+				reading.TotalPages = book.PageCount
+			}
+		}
+
+		if err := s.validationSvc.ValidateStruct(reading); err != nil {
+			return err
+		}
+
+		if err := s.requireNoOpenReading(ctx, userID, reading.BookID, 0); err != nil {
+			return err
+		}
+
+		var err error
+		reading, err = s.readingRepo.CreateReading(ctx, reading)
+		return err
+	})
+	if err != nil {
+		return domain.Reading{}, err
+	}
+
+	return reading, nil
+}
+
+// UpdateReading changes the total pages, link and target date of the
+// reading. The total pages cannot drop below the pages already read; the
+// status is left as it is.
+func (s *ReadingService) UpdateReading(ctx context.Context, userID, readingID int64, req dto.ReadingRequest) (domain.Reading, error) {
+	var reading domain.Reading
+	err := s.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		reading, err = s.getUserReadingForUpdate(ctx, userID, readingID)
+		if err != nil {
+			return err
+		}
+
+		if reading.Format != domain.ReadingFormatEbook {
+			reading.TotalPages = req.TotalPages
+		}
+		reading.Link = req.Link
+		reading.TargetDate = nil
+		if req.TargetDate != nil {
+			targetDate := utils.Date(*req.TargetDate)
+			reading.TargetDate = &targetDate
+		}
+		reading.UpdatedAt = utils.Now()
+		if err := s.validationSvc.ValidateStruct(reading); err != nil {
+			return err
+		}
+
+		totalReadPages, err := s.progressRepo.GetTotalProgressByReadingID(ctx, readingID)
+		if err != nil {
+			return err
+		}
+		if totalReadPages > reading.TotalPages {
+			return fmt.Errorf("%w: total cannot be less than the %d %s already read",
+				domain.ErrValidation, totalReadPages, reading.Unit())
+		}
+
+		reading, err = s.readingRepo.UpdateReading(ctx, reading)
+		return err
+	})
+	if err != nil {
+		return domain.Reading{}, err
+	}
+
+	return reading, nil
+}
+
+// DeleteReading deletes the reading and all of its progress.
+func (s *ReadingService) DeleteReading(ctx context.Context, userID, readingID int64) error {
+	return s.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		if _, err := s.getUserReadingForUpdate(ctx, userID, readingID); err != nil {
+			return err
+		}
+
+		return s.readingRepo.DeleteReading(ctx, readingID)
+	})
+}
+
+// UpdateReadingStatus moves the reading to status. Starting a reading sets its
+// start date and completing or abandoning it sets its finish date, both to
+// today unless they are already known.
+func (s *ReadingService) UpdateReadingStatus(ctx context.Context, userID, readingID int64, status string) (domain.Reading, error) {
+	if !validStatus(status) {
+		return domain.Reading{}, fmt.Errorf("%w: unknown reading status %q", domain.ErrValidation, status)
+	}
+
+	today, err := s.today(ctx, userID)
+	if err != nil {
 		return domain.Reading{}, err
 	}
 
-	_, err := s.bookRepo.GetBookByUserID(ctx, userID, reading.BookID)
+	var reading domain.Reading
+	err = s.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		reading, err = s.getUserReadingForUpdate(ctx, userID, readingID)
+		if err != nil {
+			return err
+		}
+
+		if reading.Status == status {
+			return nil
+		}
+		if !reading.CanTransitionTo(status) {
+			return fmt.Errorf("%w: a %s reading cannot become %s", domain.ErrValidation, reading.Status, status)
+		}
+
+		switch status {
+		case domain.ReadingStatusReading:
+			if !reading.IsOpen() {
+				if err := s.requireNoOpenReading(ctx, userID, reading.BookID, reading.ID); err != nil {
+					return err
+				}
+			}
+			if reading.StartedAt == nil {
+				reading.StartedAt = &today
+			}
+			reading.FinishedAt = nil
+		case domain.ReadingStatusCompleted:
+			if reading.StartedAt == nil {
+				reading.StartedAt = &today
+			}
+			reading.FinishedAt = &today
+		case domain.ReadingStatusAbandoned:
+			reading.FinishedAt = &today
+		}
+
+		reading.Status = status
+		reading.UpdatedAt = utils.Now()
+		reading, err = s.readingRepo.UpdateReading(ctx, reading)
+		return err
+	})
 	if err != nil {
 		return domain.Reading{}, err
 	}
 
-	readingCount, err := s.readingRepo.CountReadingsByUserIDAndBookID(ctx, userID, reading.BookID)
+	return reading, nil
+}
+
+// today is the current day in the timezone of the user.
+func (s *ReadingService) today(ctx context.Context, userID int64) (time.Time, error) {
+	user, err := s.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return utils.Today(user.Location()), nil
+}
+
+func (s *ReadingService) getUserReadingForUpdate(ctx context.Context, userID, readingID int64) (domain.Reading, error) {
+	reading, err := s.readingRepo.GetReadingByIDForUpdate(ctx, readingID)
 	if err != nil {
 		return domain.Reading{}, err
 	}
-	if readingCount > 0 {
-		return domain.Reading{}, fmt.Errorf("%w: %d", domain.ErrAlreadyExists, reading.BookID)
+	if reading.UserID != userID {
+		return domain.Reading{}, fmt.Errorf("%w: %s", domain.ErrForbidden, "reading does not belong to user")
 	}
+	return reading, nil
+}
 
-	return s.readingRepo.CreateReading(ctx, reading)
+// requireNoOpenReading fails when the book has an open reading other than
+// exceptID.
+func (s *ReadingService) requireNoOpenReading(ctx context.Context, userID, bookID, exceptID int64) error {
+	readings, err := s.readingRepo.GetReadingsByUserIDAndBookID(ctx, userID, bookID)
+	if err != nil {
+		return err
+	}
+
+	for _, r := range readings {
+		if r.ID != exceptID && r.IsOpen() {
+			return fmt.Errorf("%w: book %d already has an unfinished reading %d", domain.ErrAlreadyExists, bookID, r.ID)
+		}
+	}
+	return nil
+}
+
+func validStatus(status string) bool {
+	switch status {
+	case domain.ReadingStatusNotStarted, domain.ReadingStatusReading, domain.ReadingStatusPaused,
+		domain.ReadingStatusAbandoned, domain.ReadingStatusCompleted:
+		return true
+	}
+	return false
 }
@@ -0,0 +1,368 @@
+package reading
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/rimvydascivilis/book-tracker/backend/domain"
+	"github.com/rimvydascivilis/book-tracker/backend/dto"
+	"github.com/rimvydascivilis/book-tracker/backend/internal/repository/memory"
+	"github.com/rimvydascivilis/book-tracker/backend/services/validation"
+	"github.com/rimvydascivilis/book-tracker/backend/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func setupReadingService(t *testing.T) (*ReadingService, *memory.Store, domain.User, domain.Book) {
+	ctx := context.Background()
+	store := memory.NewStore()
+
+	user, err := memory.NewUserRepository(store).CreateUser(ctx, domain.User{Email: "user@example.com"})
+	require.NoError(t, err)
+	book, err := memory.NewBookRepository(store).CreateBook(ctx, domain.Book{UserID: user.ID, Title: "Dune"})
+	require.NoError(t, err)
+
+	svc := NewReadingService(memory.NewReadingRepository(store), memory.NewProgressRepository(store),
+		memory.NewBookRepository(store), memory.NewUserRepository(store), memory.NewTxManager(store),
+		validation.NewValidationService(), []int64{7, 30})
+
+	return svc, store, user, book
+}
+
+func TestGetReadings_CombinesBookAndProgress(t *testing.T) {
+	svc, store, user, book := setupReadingService(t)
+	ctx := context.Background()
+
+	reading, err := svc.CreateReading(ctx, user.ID, domain.Reading{BookID: book.ID, TotalPages: 100})
+	require.NoError(t, err)
+	_, err = memory.NewProgressRepository(store).CreateProgress(ctx, domain.Progress{
+		UserID: user.ID, ReadingID: reading.ID, Pages: 40, ReadingDate: time.Now(),
+	})
+	require.NoError(t, err)
+	_, err = svc.UpdateReadingStatus(ctx, user.ID, reading.ID, domain.ReadingStatusReading)
+	require.NoError(t, err)
+
+	readings, hasMore, err := svc.GetReadings(ctx, user.ID, "", 1, 10)
+
+	assert.NoError(t, err)
+	assert.False(t, hasMore)
+	assert.Len(t, readings, 1)
+	assert.Equal(t, "Dune", readings[0].BookTitle)
+	assert.Equal(t, int64(40), readings[0].Progress)
+	assert.Equal(t, domain.ReadingStatusReading, readings[0].Status)
+	require.NotNil(t, readings[0].Pace)
+	assert.Equal(t, int64(60), readings[0].Pace.Remaining)
+	assert.Len(t, readings[0].Pace.Windows, 2)
+}
+
+func TestCreateReading_BookOfOtherUser(t *testing.T) {
+	svc, _, _, book := setupReadingService(t)
+
+	_, err := svc.CreateReading(context.Background(), book.UserID+1, domain.Reading{BookID: book.ID, TotalPages: 100})
+
+	assert.ErrorIs(t, err, domain.ErrRecordNotFound)
+}
+
+func TestCreateReading_UnfinishedReadingExists(t *testing.T) {
+	svc, _, user, book := setupReadingService(t)
+	ctx := context.Background()
+
+	_, err := svc.CreateReading(ctx, user.ID, domain.Reading{BookID: book.ID, TotalPages: 100})
+	require.NoError(t, err)
+
+	_, err = svc.CreateReading(ctx, user.ID, domain.Reading{BookID: book.ID, TotalPages: 100})
+
+	assert.ErrorIs(t, err, domain.ErrAlreadyExists)
+}
+
+func TestCreateReading_ReRead(t *testing.T) {
+	svc, store, user, book := setupReadingService(t)
+	ctx := context.Background()
+
+	first, err := svc.CreateReading(ctx, user.ID, domain.Reading{BookID: book.ID, TotalPages: 100})
+	require.NoError(t, err)
+	finished := time.Date(2023, 6, 1, 0, 0, 0, 0, time.UTC)
+	first.FinishedAt = &finished
+	first.Status = domain.ReadingStatusCompleted
+	_, err = memory.NewReadingRepository(store).UpdateReading(ctx, first)
+	require.NoError(t, err)
+
+	started := time.Date(2024, 1, 5, 18, 30, 0, 0, time.UTC)
+	second, err := svc.CreateReading(ctx, user.ID, domain.Reading{BookID: book.ID, TotalPages: 120, StartedAt: &started})
+	require.NoError(t, err)
+	assert.NotEqual(t, first.ID, second.ID)
+	assert.Equal(t, time.Date(2024, 1, 5, 0, 0, 0, 0, time.UTC), *second.StartedAt)
+
+	history, err := svc.GetBookReadings(ctx, user.ID, book.ID)
+
+	assert.NoError(t, err)
+	require.Len(t, history, 2)
+	assert.Equal(t, second.ID, history[0].Reading.ID)
+	assert.Nil(t, history[0].Reading.FinishedAt)
+	assert.Equal(t, first.ID, history[1].Reading.ID)
+	assert.Equal(t, finished, *history[1].Reading.FinishedAt)
+}
+
+func TestCreateReading_StartInFuture(t *testing.T) {
+	svc, _, user, book := setupReadingService(t)
+
+	started := time.Now().Add(48 * time.Hour)
+	_, err := svc.CreateReading(context.Background(), user.ID, domain.Reading{BookID: book.ID, TotalPages: 100, StartedAt: &started})
+
+	assert.ErrorIs(t, err, domain.ErrValidation)
+}
+
+// TestCreateReading_StartTodayOfUser lets a user fourteen hours ahead of UTC
+// start a reading today even while it is still yesterday in UTC.
+func TestCreateReading_StartTodayOfUser(t *testing.T) {
+	svc, store, user, book := setupReadingService(t)
+	ctx := context.Background()
+	user.Timezone = "Pacific/Kiritimati"
+	user, err := memory.NewUserRepository(store).UpdateUser(ctx, user)
+	require.NoError(t, err)
+
+	started := utils.Today(user.Location())
+	reading, err := svc.CreateReading(ctx, user.ID, domain.Reading{BookID: book.ID, TotalPages: 100, StartedAt: &started})
+
+	require.NoError(t, err)
+	assert.Equal(t, started, *reading.StartedAt)
+}
+
+// TestCreateReading_StartDayOfUser stores the start as the day of its instant
+// for the user: early morning in UTC is still the previous day in Pago Pago,
+// eleven hours behind.
+func TestCreateReading_StartDayOfUser(t *testing.T) {
+	svc, store, user, book := setupReadingService(t)
+	ctx := context.Background()
+	user.Timezone = "Pacific/Pago_Pago"
+	user, err := memory.NewUserRepository(store).UpdateUser(ctx, user)
+	require.NoError(t, err)
+
+	started := time.Date(2024, 1, 5, 5, 0, 0, 0, time.UTC)
+	reading, err := svc.CreateReading(ctx, user.ID, domain.Reading{BookID: book.ID, TotalPages: 100, StartedAt: &started})
+
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2024, 1, 4, 0, 0, 0, 0, time.UTC), *reading.StartedAt)
+}
+
+func TestGetBookReadings_BookOfOtherUser(t *testing.T) {
+	svc, _, _, book := setupReadingService(t)
+
+	_, err := svc.GetBookReadings(context.Background(), book.UserID+1, book.ID)
+
+	assert.ErrorIs(t, err, domain.ErrRecordNotFound)
+}
+
+func TestCreateReading_DefaultsTotalPagesFromBook(t *testing.T) {
+	svc, store, user, _ := setupReadingService(t)
+	ctx := context.Background()
+
+	book, err := memory.NewBookRepository(store).CreateBook(ctx, domain.Book{UserID: user.ID, Title: "Emma", PageCount: 474})
+	require.NoError(t, err)
+
+	reading, err := svc.CreateReading(ctx, user.ID, domain.Reading{BookID: book.ID})
+
+	assert.NoError(t, err)
+	assert.Equal(t, int64(474), reading.TotalPages)
+}
+
+func TestCreateReading_NoPageCount(t *testing.T) {
+	svc, _, user, book := setupReadingService(t)
+
+	_, err := svc.CreateReading(context.Background(), user.ID, domain.Reading{BookID: book.ID})
+
+	assert.ErrorIs(t, err, domain.ErrValidation)
+}
+
+func TestGetReadings_FiltersByStatus(t *testing.T) {
+	svc, _, user, book := setupReadingService(t)
+	ctx := context.Background()
+
+	reading, err := svc.CreateReading(ctx, user.ID, domain.Reading{BookID: book.ID, TotalPages: 100})
+	require.NoError(t, err)
+	_, err = svc.UpdateReadingStatus(ctx, user.ID, reading.ID, domain.ReadingStatusAbandoned)
+	require.NoError(t, err)
+
+	readings, _, err := svc.GetReadings(ctx, user.ID, domain.ReadingStatusAbandoned, 1, 10)
+	assert.NoError(t, err)
+	assert.Len(t, readings, 1)
+
+	readings, _, err = svc.GetReadings(ctx, user.ID, domain.ReadingStatusPaused, 1, 10)
+	assert.NoError(t, err)
+	assert.Empty(t, readings)
+
+	_, _, err = svc.GetReadings(ctx, user.ID, "unknown", 1, 10)
+	assert.ErrorIs(t, err, domain.ErrValidation)
+}
+
+func TestUpdateReadingStatus(t *testing.T) {
+	svc, _, user, book := setupReadingService(t)
+	ctx := context.Background()
+
+	reading, err := svc.CreateReading(ctx, user.ID, domain.Reading{BookID: book.ID, TotalPages: 100})
+	require.NoError(t, err)
+
+	started, err := svc.UpdateReadingStatus(ctx, user.ID, reading.ID, domain.ReadingStatusReading)
+	require.NoError(t, err)
+	assert.Equal(t, domain.ReadingStatusReading, started.Status)
+	assert.NotNil(t, started.StartedAt)
+
+	paused, err := svc.UpdateReadingStatus(ctx, user.ID, reading.ID, domain.ReadingStatusPaused)
+	require.NoError(t, err)
+	assert.Equal(t, domain.ReadingStatusPaused, paused.Status)
+	assert.Nil(t, paused.FinishedAt)
+
+	abandoned, err := svc.UpdateReadingStatus(ctx, user.ID, reading.ID, domain.ReadingStatusAbandoned)
+	require.NoError(t, err)
+	assert.Equal(t, domain.ReadingStatusAbandoned, abandoned.Status)
+	assert.NotNil(t, abandoned.FinishedAt)
+
+	resumed, err := svc.UpdateReadingStatus(ctx, user.ID, reading.ID, domain.ReadingStatusReading)
+	require.NoError(t, err)
+	assert.Nil(t, resumed.FinishedAt)
+}
+
+func TestUpdateReadingStatus_InvalidTransition(t *testing.T) {
+	svc, _, user, book := setupReadingService(t)
+	ctx := context.Background()
+
+	reading, err := svc.CreateReading(ctx, user.ID, domain.Reading{BookID: book.ID, TotalPages: 100})
+	require.NoError(t, err)
+
+	_, err = svc.UpdateReadingStatus(ctx, user.ID, reading.ID, domain.ReadingStatusPaused)
+
+	assert.ErrorIs(t, err, domain.ErrValidation)
+}
+
+func TestUpdateReadingStatus_ReadingOfOtherUser(t *testing.T) {
+	svc, store, user, book := setupReadingService(t)
+	ctx := context.Background()
+
+	reading, err := svc.CreateReading(ctx, user.ID, domain.Reading{BookID: book.ID, TotalPages: 100})
+	require.NoError(t, err)
+	other, err := memory.NewUserRepository(store).CreateUser(ctx, domain.User{Email: "other@example.com"})
+	require.NoError(t, err)
+
+	_, err = svc.UpdateReadingStatus(ctx, other.ID, reading.ID, domain.ReadingStatusReading)
+
+	assert.ErrorIs(t, err, domain.ErrForbidden)
+}
+
+func TestUpdateReadingStatus_ResumeWhileAnotherIsOpen(t *testing.T) {
+	svc, _, user, book := setupReadingService(t)
+	ctx := context.Background()
+
+	first, err := svc.CreateReading(ctx, user.ID, domain.Reading{BookID: book.ID, TotalPages: 100})
+	require.NoError(t, err)
+	_, err = svc.UpdateReadingStatus(ctx, user.ID, first.ID, domain.ReadingStatusAbandoned)
+	require.NoError(t, err)
+	_, err = svc.CreateReading(ctx, user.ID, domain.Reading{BookID: book.ID, TotalPages: 100})
+	require.NoError(t, err)
+
+	_, err = svc.UpdateReadingStatus(ctx, user.ID, first.ID, domain.ReadingStatusReading)
+
+	assert.ErrorIs(t, err, domain.ErrAlreadyExists)
+}
+
+func TestUpdateReading(t *testing.T) {
+	svc, store, user, book := setupReadingService(t)
+	ctx := context.Background()
+
+	reading, err := svc.CreateReading(ctx, user.ID, domain.Reading{BookID: book.ID, TotalPages: 100})
+	require.NoError(t, err)
+	_, err = memory.NewProgressRepository(store).CreateProgress(ctx, domain.Progress{
+		UserID: user.ID, ReadingID: reading.ID, Pages: 40, ReadingDate: time.Now(),
+	})
+	require.NoError(t, err)
+
+	updated, err := svc.UpdateReading(ctx, user.ID, reading.ID, dto.ReadingRequest{TotalPages: 120, Link: "https://example.com/dune"})
+	require.NoError(t, err)
+	assert.Equal(t, int64(120), updated.TotalPages)
+	assert.Equal(t, "https://example.com/dune", updated.Link)
+
+	_, err = svc.UpdateReading(ctx, user.ID, reading.ID, dto.ReadingRequest{TotalPages: 30})
+	assert.ErrorIs(t, err, domain.ErrValidation)
+
+	_, err = svc.UpdateReading(ctx, user.ID, reading.ID, dto.ReadingRequest{TotalPages: 100, Link: "not a link"})
+	assert.ErrorIs(t, err, domain.ErrValidation)
+
+	_, err = svc.UpdateReading(ctx, user.ID+1, reading.ID, dto.ReadingRequest{TotalPages: 100})
+	assert.ErrorIs(t, err, domain.ErrForbidden)
+}
+
+func TestDeleteReading(t *testing.T) {
+	svc, store, user, book := setupReadingService(t)
+	ctx := context.Background()
+
+	reading, err := svc.CreateReading(ctx, user.ID, domain.Reading{BookID: book.ID, TotalPages: 100})
+	require.NoError(t, err)
+	_, err = memory.NewProgressRepository(store).CreateProgress(ctx, domain.Progress{
+		UserID: user.ID, ReadingID: reading.ID, Pages: 40, ReadingDate: time.Now(),
+	})
+	require.NoError(t, err)
+
+	err = svc.DeleteReading(ctx, user.ID+1, reading.ID)
+	assert.ErrorIs(t, err, domain.ErrForbidden)
+
+	require.NoError(t, svc.DeleteReading(ctx, user.ID, reading.ID))
+	readings, err := svc.GetBookReadings(ctx, user.ID, book.ID)
+	assert.NoError(t, err)
+	assert.Empty(t, readings)
+	total, err := memory.NewProgressRepository(store).GetTotalProgressByReadingID(ctx, reading.ID)
+	assert.NoError(t, err)
+	assert.Zero(t, total)
+}
+
+func TestCreateReading_Formats(t *testing.T) {
+	svc, store, user, _ := setupReadingService(t)
+	ctx := context.Background()
+	book, err := memory.NewBookRepository(store).CreateBook(ctx, domain.Book{UserID: user.ID, Title: "Emma", PageCount: 474})
+	require.NoError(t, err)
+
+	ebook, err := svc.CreateReading(ctx, user.ID, domain.Reading{BookID: book.ID, Format: domain.ReadingFormatEbook, TotalPages: 474})
+	require.NoError(t, err)
+	assert.Equal(t, int64(100), ebook.TotalPages)
+	assert.Equal(t, domain.UnitPercent, ebook.Unit())
+	_, err = svc.UpdateReadingStatus(ctx, user.ID, ebook.ID, domain.ReadingStatusAbandoned)
+	require.NoError(t, err)
+
+	// An audiobook is not as long as the page count of the book.
+	_, err = svc.CreateReading(ctx, user.ID, domain.Reading{BookID: book.ID, Format: domain.ReadingFormatAudiobook})
+	assert.ErrorIs(t, err, domain.ErrValidation)
+
+	audiobook, err := svc.CreateReading(ctx, user.ID, domain.Reading{BookID: book.ID, Format: domain.ReadingFormatAudiobook, TotalPages: 720})
+	require.NoError(t, err)
+	readings, err := svc.GetBookReadings(ctx, user.ID, book.ID)
+	require.NoError(t, err)
+	assert.Equal(t, audiobook.ID, readings[0].Reading.ID)
+	assert.Equal(t, domain.UnitMinutes, readings[0].Reading.Unit)
+
+	_, err = svc.CreateReading(ctx, user.ID, domain.Reading{BookID: book.ID, Format: "scroll", TotalPages: 10})
+	assert.ErrorIs(t, err, domain.ErrValidation)
+}
+
+func TestGetReadingPace(t *testing.T) {
+	svc, store, user, book := setupReadingService(t)
+	ctx := context.Background()
+	started := utils.Date(utils.Now()).AddDate(0, 0, -2)
+
+	reading, err := svc.CreateReading(ctx, user.ID, domain.Reading{BookID: book.ID, TotalPages: 100, StartedAt: &started})
+	require.NoError(t, err)
+	_, err = memory.NewProgressRepository(store).CreateProgress(ctx, domain.Progress{
+		UserID: user.ID, ReadingID: reading.ID, Pages: 30, Position: 30, ReadingDate: started,
+	})
+	require.NoError(t, err)
+
+	pace, err := svc.GetReadingPace(ctx, user.ID, reading.ID, []int64{14})
+
+	require.NoError(t, err)
+	assert.Equal(t, []dto.PaceWindow{{Days: 14, PerDay: 10}}, pace.Windows)
+	require.Len(t, pace.Daily, 3)
+	assert.Equal(t, dto.PaceDay{Date: started.Format("2006-01-02"), Pages: 30, Position: 30}, pace.Daily[0])
+
+	_, err = svc.GetReadingPace(ctx, user.ID+1, reading.ID, nil)
+	assert.ErrorIs(t, err, domain.ErrForbidden)
+	_, err = svc.GetReadingPace(ctx, user.ID, reading.ID, []int64{0})
+	assert.ErrorIs(t, err, domain.ErrValidation)
+}
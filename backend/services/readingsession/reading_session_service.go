@@ -0,0 +1,213 @@
+package readingsession
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/rimvydascivilis/book-tracker/backend/domain"
+	"github.com/rimvydascivilis/book-tracker/backend/dto"
+	"github.com/rimvydascivilis/book-tracker/backend/utils"
+)
+
+type ReadingSessionService struct {
+	sessionRepo  domain.ReadingSessionRepository
+	readingRepo  domain.ReadingRepository
+	progressRepo domain.ProgressRepository
+	userRepo     domain.UserRepository
+	progressSvc  domain.ProgressService
+	txManager    domain.TxManager
+	timeout      time.Duration
+}
+
+func NewReadingSessionService(repo domain.ReadingSessionRepository, readingRepo domain.ReadingRepository,
+	progressRepo domain.ProgressRepository, userRepo domain.UserRepository, progressSvc domain.ProgressService,
+	txManager domain.TxManager, timeout time.Duration) *ReadingSessionService {
+	return &ReadingSessionService{
+		sessionRepo:  repo,
+		readingRepo:  readingRepo,
+		progressRepo: progressRepo,
+		userRepo:     userRepo,
+		progressSvc:  progressSvc,
+		txManager:    txManager,
+		timeout:      timeout,
+	}
+}
+
+func (s *ReadingSessionService) GetReadingSessions(ctx context.Context, userID, readingID int64) ([]domain.ReadingSession, error) {
+	reading, err := s.readingRepo.GetReadingByID(ctx, readingID)
+	if err != nil {
+		return nil, err
+	}
+	if reading.UserID != userID {
+		return nil, fmt.Errorf("%w: %s", domain.ErrForbidden, "reading does not belong to user")
+	}
+
+	return s.sessionRepo.GetReadingSessionsByReadingID(ctx, readingID)
+}
+
+// StartReadingSession starts timing the reading from its current position. A
+// session of the user that ran past the timeout is closed first instead of
+// blocking the new one.
+func (s *ReadingSessionService) StartReadingSession(ctx context.Context, userID, readingID int64) (domain.ReadingSession, error) {
+	now := utils.Now()
+	var session domain.ReadingSession
+	err := s.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		// The open session is locked before the reading, as when stopping,
+		// so that a start and a stop cannot deadlock.
+		open, err := s.sessionRepo.GetOpenReadingSessionForUpdate(ctx, userID)
+		hasOpen := err == nil
+		if err != nil && !errors.Is(err, domain.ErrRecordNotFound) {
+			return err
+		}
+
+		reading, err := s.readingRepo.GetReadingByIDForUpdate(ctx, readingID)
+		if err != nil {
+			return err
+		}
+		if reading.UserID != userID {
+			return fmt.Errorf("%w: %s", domain.ErrForbidden, "reading does not belong to user")
+		}
+		if !reading.IsOpen() {
+			return fmt.Errorf("%w: cannot start a session on a %s reading", domain.ErrValidation, reading.Status)
+		}
+
+		if hasOpen {
+			if !s.expired(open, now) {
+				return fmt.Errorf("%w: session %d of reading %d is still running",
+					domain.ErrAlreadyExists, open.ID, open.ReadingID)
+			}
+			if err := s.autoClose(ctx, open); err != nil {
+				return err
+			}
+		}
+
+		position, err := s.progressRepo.GetTotalProgressByReadingID(ctx, readingID)
+		if err != nil {
+			return err
+		}
+
+		session, err = s.sessionRepo.CreateReadingSession(ctx, domain.ReadingSession{
+			UserID:        userID,
+			ReadingID:     readingID,
+			StartPosition: position,
+			StartedAt:     now,
+		})
+		return err
+	})
+	if err != nil {
+		return domain.ReadingSession{}, err
+	}
+
+	return session, nil
+}
+
+// StopReadingSession ends the running session of the reading on page. Moving
+// past the start position logs the difference as progress for today in the
+// timezone of the user; staying on it only records the time. A session that
+// ran past the timeout is closed without progress and the request fails.
+func (s *ReadingSessionService) StopReadingSession(ctx context.Context, userID, readingID, page int64) (domain.ReadingSession, error) {
+	user, err := s.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return domain.ReadingSession{}, err
+	}
+
+	now := utils.Now()
+	var session domain.ReadingSession
+	expired := false
+	err = s.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		// The session is locked first and the reading by CreateProgress.
+		var err error
+		session, err = s.sessionRepo.GetOpenReadingSessionForUpdate(ctx, userID)
+		if errors.Is(err, domain.ErrRecordNotFound) || (err == nil && session.ReadingID != readingID) {
+			return fmt.Errorf("%w: no session of reading %d is running", domain.ErrRecordNotFound, readingID)
+		}
+		if err != nil {
+			return err
+		}
+
+		if s.expired(session, now) {
+			// Committed on purpose: the close stands even though the stop
+			// is refused.
+			expired = true
+			return s.autoClose(ctx, session)
+		}
+
+		position, err := s.progressRepo.GetTotalProgressByReadingID(ctx, readingID)
+		if err != nil {
+			return err
+		}
+		if page != position {
+			progress, err := s.progressSvc.CreateProgress(ctx, userID, readingID, dto.ProgressRequest{Page: &page, Date: now.In(user.Location())})
+			if err != nil {
+				return err
+			}
+			session.ProgressID = &progress.ID
+		}
+
+		session.EndPosition = &page
+		session.EndedAt = &now
+		session.Duration = int64(now.Sub(session.StartedAt) / time.Second)
+		session, err = s.sessionRepo.UpdateReadingSession(ctx, session)
+		return err
+	})
+	if err != nil {
+		return domain.ReadingSession{}, err
+	}
+	if expired {
+		return domain.ReadingSession{}, fmt.Errorf("%w: the session ran longer than %s and was closed without progress",
+			domain.ErrValidation, s.timeout)
+	}
+
+	return session, nil
+}
+
+// CloseExpiredReadingSessions closes the sessions that ran past the timeout.
+// Each is closed in its own transaction, so a session stopped in the meantime
+// is left alone.
+func (s *ReadingSessionService) CloseExpiredReadingSessions(ctx context.Context) (int, error) {
+	sessions, err := s.sessionRepo.GetOpenReadingSessionsStartedBefore(ctx, utils.Now().Add(-s.timeout))
+	if err != nil {
+		return 0, err
+	}
+
+	closed := 0
+	for _, session := range sessions {
+		err := s.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+			open, err := s.sessionRepo.GetOpenReadingSessionForUpdate(ctx, session.UserID)
+			if errors.Is(err, domain.ErrRecordNotFound) || (err == nil && open.ID != session.ID) {
+				return nil
+			}
+			if err != nil {
+				return err
+			}
+
+			if err := s.autoClose(ctx, open); err != nil {
+				return err
+			}
+			closed++
+			return nil
+		})
+		if err != nil {
+			return closed, err
+		}
+	}
+
+	return closed, nil
+}
+
+func (s *ReadingSessionService) expired(session domain.ReadingSession, now time.Time) bool {
+	return now.After(session.StartedAt.Add(s.timeout))
+}
+
+// autoClose ends session at the timeout. It is unknown when the reading
+// actually stopped, so no time or progress is recorded.
+func (s *ReadingSessionService) autoClose(ctx context.Context, session domain.ReadingSession) error {
+	endedAt := session.StartedAt.Add(s.timeout)
+	session.EndedAt = &endedAt
+	session.Duration = 0
+	session.AutoClosed = true
+	_, err := s.sessionRepo.UpdateReadingSession(ctx, session)
+	return err
+}
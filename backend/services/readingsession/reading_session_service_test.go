@@ -0,0 +1,297 @@
+package readingsession
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/rimvydascivilis/book-tracker/backend/domain"
+	"github.com/rimvydascivilis/book-tracker/backend/internal/repository/memory"
+	"github.com/rimvydascivilis/book-tracker/backend/services/progress"
+	"github.com/rimvydascivilis/book-tracker/backend/services/validation"
+	"github.com/rimvydascivilis/book-tracker/backend/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testTimeout = 4 * time.Hour
+
+type fixture struct {
+	svc      *ReadingSessionService
+	sessions *memory.ReadingSessionRepository
+	progress *memory.ProgressRepository
+	readings *memory.ReadingRepository
+	user     domain.User
+	store    *memory.Store
+}
+
+func setupSessionService(t *testing.T) fixture {
+	store := memory.NewStore()
+	user, err := memory.NewUserRepository(store).CreateUser(context.Background(), domain.User{Email: "user@example.com"})
+	require.NoError(t, err)
+
+	f := fixture{
+		sessions: memory.NewReadingSessionRepository(store),
+		progress: memory.NewProgressRepository(store),
+		readings: memory.NewReadingRepository(store),
+		user:     user,
+		store:    store,
+	}
+	tx := memory.NewTxManager(store)
+	users := memory.NewUserRepository(store)
+	progressSvc := progress.NewProgressService(f.progress, f.readings, users, tx, validation.NewValidationService())
+	f.svc = NewReadingSessionService(f.sessions, f.readings, f.progress, users, progressSvc, tx, testTimeout)
+	return f
+}
+
+func (f fixture) createReading(t *testing.T, userID int64) domain.Reading {
+	t.Helper()
+	ctx := context.Background()
+
+	book, err := memory.NewBookRepository(f.store).CreateBook(ctx, domain.Book{UserID: userID, Title: "Dune"})
+	require.NoError(t, err)
+	reading, err := f.readings.CreateReading(ctx, domain.Reading{
+		UserID: userID, BookID: book.ID, Format: domain.ReadingFormatPrint, TotalPages: 300,
+		Status: domain.ReadingStatusNotStarted, CreatedAt: time.Now(), UpdatedAt: time.Now(),
+	})
+	require.NoError(t, err)
+	return reading
+}
+
+func TestStartAndStopReadingSession(t *testing.T) {
+	f := setupSessionService(t)
+	ctx := context.Background()
+	reading := f.createReading(t, f.user.ID)
+	_, err := f.progress.CreateProgress(ctx, domain.Progress{
+		UserID: f.user.ID, ReadingID: reading.ID, Pages: 20, Position: 20, ReadingDate: time.Now().Add(-48 * time.Hour),
+	})
+	require.NoError(t, err)
+
+	started, err := f.svc.StartReadingSession(ctx, f.user.ID, reading.ID)
+	require.NoError(t, err)
+	assert.Equal(t, int64(20), started.StartPosition)
+	assert.True(t, started.IsOpen())
+
+	stopped, err := f.svc.StopReadingSession(ctx, f.user.ID, reading.ID, 55)
+	require.NoError(t, err)
+
+	assert.False(t, stopped.IsOpen())
+	require.NotNil(t, stopped.EndPosition)
+	assert.Equal(t, int64(55), *stopped.EndPosition)
+	assert.GreaterOrEqual(t, stopped.Duration, int64(0))
+	require.NotNil(t, stopped.ProgressID)
+	logged, err := f.progress.GetProgressByID(ctx, *stopped.ProgressID)
+	require.NoError(t, err)
+	assert.Equal(t, int64(35), logged.Pages)
+	assert.Equal(t, int64(55), logged.Position)
+
+	updated, err := f.readings.GetReadingByID(ctx, reading.ID)
+	require.NoError(t, err)
+	assert.Equal(t, domain.ReadingStatusReading, updated.Status)
+
+	sessions, err := f.svc.GetReadingSessions(ctx, f.user.ID, reading.ID)
+	require.NoError(t, err)
+	require.Len(t, sessions, 1)
+	assert.Equal(t, stopped.ID, sessions[0].ID)
+}
+
+func TestStopReadingSession_SamePage(t *testing.T) {
+	f := setupSessionService(t)
+	ctx := context.Background()
+	reading := f.createReading(t, f.user.ID)
+
+	_, err := f.svc.StartReadingSession(ctx, f.user.ID, reading.ID)
+	require.NoError(t, err)
+	stopped, err := f.svc.StopReadingSession(ctx, f.user.ID, reading.ID, 0)
+
+	require.NoError(t, err)
+	assert.False(t, stopped.IsOpen())
+	assert.Nil(t, stopped.ProgressID)
+	total, err := f.progress.GetTotalProgressByReadingID(ctx, reading.ID)
+	require.NoError(t, err)
+	assert.Zero(t, total)
+}
+
+func TestStopReadingSession_InvalidPageKeepsSessionOpen(t *testing.T) {
+	f := setupSessionService(t)
+	ctx := context.Background()
+	reading := f.createReading(t, f.user.ID)
+
+	_, err := f.svc.StartReadingSession(ctx, f.user.ID, reading.ID)
+	require.NoError(t, err)
+	_, err = f.svc.StopReadingSession(ctx, f.user.ID, reading.ID, 301)
+	assert.ErrorIs(t, err, domain.ErrValidation)
+
+	open, err := f.sessions.GetOpenReadingSessionForUpdate(ctx, f.user.ID)
+	require.NoError(t, err)
+	assert.Equal(t, reading.ID, open.ReadingID)
+}
+
+func TestStartReadingSession_Overlapping(t *testing.T) {
+	f := setupSessionService(t)
+	ctx := context.Background()
+	first := f.createReading(t, f.user.ID)
+	second := f.createReading(t, f.user.ID)
+
+	_, err := f.svc.StartReadingSession(ctx, f.user.ID, first.ID)
+	require.NoError(t, err)
+
+	_, err = f.svc.StartReadingSession(ctx, f.user.ID, second.ID)
+	assert.ErrorIs(t, err, domain.ErrAlreadyExists)
+	_, err = f.svc.StopReadingSession(ctx, f.user.ID, second.ID, 10)
+	assert.ErrorIs(t, err, domain.ErrRecordNotFound)
+}
+
+func TestStartReadingSession_OtherUsersReading(t *testing.T) {
+	f := setupSessionService(t)
+	ctx := context.Background()
+	other, err := memory.NewUserRepository(f.store).CreateUser(ctx, domain.User{Email: "other@example.com"})
+	require.NoError(t, err)
+	reading := f.createReading(t, other.ID)
+
+	_, err = f.svc.StartReadingSession(ctx, f.user.ID, reading.ID)
+
+	assert.ErrorIs(t, err, domain.ErrForbidden)
+}
+
+func TestStartReadingSession_ClosesExpiredSession(t *testing.T) {
+	f := setupSessionService(t)
+	ctx := context.Background()
+	reading := f.createReading(t, f.user.ID)
+	stale, err := f.sessions.CreateReadingSession(ctx, domain.ReadingSession{
+		UserID: f.user.ID, ReadingID: reading.ID, StartedAt: time.Now().Add(-testTimeout - time.Minute),
+	})
+	require.NoError(t, err)
+
+	_, err = f.svc.StartReadingSession(ctx, f.user.ID, reading.ID)
+	require.NoError(t, err)
+
+	sessions, err := f.sessions.GetReadingSessionsByReadingID(ctx, reading.ID)
+	require.NoError(t, err)
+	require.Len(t, sessions, 2)
+	assert.Equal(t, stale.ID, sessions[1].ID)
+	assert.True(t, sessions[1].AutoClosed)
+	require.NotNil(t, sessions[1].EndedAt)
+	assert.Equal(t, stale.StartedAt.Add(testTimeout), *sessions[1].EndedAt)
+	assert.Zero(t, sessions[1].Duration)
+}
+
+func TestStopReadingSession_Expired(t *testing.T) {
+	f := setupSessionService(t)
+	ctx := context.Background()
+	reading := f.createReading(t, f.user.ID)
+	_, err := f.sessions.CreateReadingSession(ctx, domain.ReadingSession{
+		UserID: f.user.ID, ReadingID: reading.ID, StartedAt: time.Now().Add(-testTimeout - time.Minute),
+	})
+	require.NoError(t, err)
+
+	_, err = f.svc.StopReadingSession(ctx, f.user.ID, reading.ID, 40)
+
+	assert.ErrorIs(t, err, domain.ErrValidation)
+	_, err = f.sessions.GetOpenReadingSessionForUpdate(ctx, f.user.ID)
+	assert.ErrorIs(t, err, domain.ErrRecordNotFound)
+	total, err := f.progress.GetTotalProgressByReadingID(ctx, reading.ID)
+	require.NoError(t, err)
+	assert.Zero(t, total)
+}
+
+func TestCloseExpiredReadingSessions(t *testing.T) {
+	f := setupSessionService(t)
+	ctx := context.Background()
+	other, err := memory.NewUserRepository(f.store).CreateUser(ctx, domain.User{Email: "other@example.com"})
+	require.NoError(t, err)
+	stale := f.createReading(t, f.user.ID)
+	running := f.createReading(t, other.ID)
+
+	_, err = f.sessions.CreateReadingSession(ctx, domain.ReadingSession{
+		UserID: f.user.ID, ReadingID: stale.ID, StartedAt: time.Now().Add(-testTimeout - time.Minute),
+	})
+	require.NoError(t, err)
+	_, err = f.sessions.CreateReadingSession(ctx, domain.ReadingSession{
+		UserID: other.ID, ReadingID: running.ID, StartedAt: time.Now().Add(-time.Hour),
+	})
+	require.NoError(t, err)
+
+	closed, err := f.svc.CloseExpiredReadingSessions(ctx)
+
+	require.NoError(t, err)
+	assert.Equal(t, 1, closed)
+	_, err = f.sessions.GetOpenReadingSessionForUpdate(ctx, f.user.ID)
+	assert.ErrorIs(t, err, domain.ErrRecordNotFound)
+	_, err = f.sessions.GetOpenReadingSessionForUpdate(ctx, other.ID)
+	assert.NoError(t, err)
+}
+
+// TestStopReadingSession_LogsDayOfUser dates the progress of a session by
+// the day of the user: it is always a day later in Kiritimati than in Pago
+// Pago.
+func TestStopReadingSession_LogsDayOfUser(t *testing.T) {
+	f := setupSessionService(t)
+	ctx := context.Background()
+	users := memory.NewUserRepository(f.store)
+
+	for _, timezone := range []string{"Pacific/Kiritimati", "Pacific/Pago_Pago"} {
+		user, err := users.CreateUser(ctx, domain.User{Email: timezone + "@example.com", Timezone: timezone})
+		require.NoError(t, err)
+		reading := f.createReading(t, user.ID)
+
+		_, err = f.svc.StartReadingSession(ctx, user.ID, reading.ID)
+		require.NoError(t, err)
+		stopped, err := f.svc.StopReadingSession(ctx, user.ID, reading.ID, 10)
+		require.NoError(t, err)
+
+		logged, err := f.progress.GetProgressByID(ctx, *stopped.ProgressID)
+		require.NoError(t, err)
+		assert.Equal(t, utils.Today(user.Location()), logged.ReadingDate, timezone)
+	}
+}
+
+// lockRecorder records the rows locked through the repositories it wraps.
+type lockRecorder struct {
+	locks []string
+}
+
+type recordingSessionRepository struct {
+	domain.ReadingSessionRepository
+	recorder *lockRecorder
+}
+
+func (r recordingSessionRepository) GetOpenReadingSessionForUpdate(ctx context.Context, userID int64) (domain.ReadingSession, error) {
+	r.recorder.locks = append(r.recorder.locks, "session")
+	return r.ReadingSessionRepository.GetOpenReadingSessionForUpdate(ctx, userID)
+}
+
+type recordingReadingRepository struct {
+	domain.ReadingRepository
+	recorder *lockRecorder
+}
+
+func (r recordingReadingRepository) GetReadingByIDForUpdate(ctx context.Context, id int64) (domain.Reading, error) {
+	r.recorder.locks = append(r.recorder.locks, "reading")
+	return r.ReadingRepository.GetReadingByIDForUpdate(ctx, id)
+}
+
+// TestReadingSession_LockOrder checks that starting and stopping lock the
+// open session before the reading, so that they cannot deadlock.
+func TestReadingSession_LockOrder(t *testing.T) {
+	f := setupSessionService(t)
+	ctx := context.Background()
+	reading := f.createReading(t, f.user.ID)
+
+	recorder := &lockRecorder{}
+	sessions := recordingSessionRepository{f.sessions, recorder}
+	readings := recordingReadingRepository{f.readings, recorder}
+	tx := memory.NewTxManager(f.store)
+	users := memory.NewUserRepository(f.store)
+	progressSvc := progress.NewProgressService(f.progress, readings, users, tx, validation.NewValidationService())
+	svc := NewReadingSessionService(sessions, readings, f.progress, users, progressSvc, tx, testTimeout)
+
+	_, err := svc.StartReadingSession(ctx, f.user.ID, reading.ID)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"session", "reading"}, recorder.locks)
+
+	recorder.locks = nil
+	_, err = svc.StopReadingSession(ctx, f.user.ID, reading.ID, 30)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"session", "reading"}, recorder.locks)
+}
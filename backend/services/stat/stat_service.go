@@ -2,7 +2,10 @@ package stat
 
 import (
 	"context"
-	"errors"
+	"math"
+	"sort"
+	"strconv"
+	"time"
 
 	"github.com/rimvydascivilis/book-tracker/backend/domain"
 	"github.com/rimvydascivilis/book-tracker/backend/dto"
@@ -10,57 +13,136 @@ import (
 
 type StatService struct {
 	progressRepo domain.ProgressRepository
+	readingRepo  domain.ReadingRepository
 	goalRepo     domain.GoalRepository
 }
 
-func NewStatService(progressRepo domain.ProgressRepository, goalRepo domain.GoalRepository) *StatService {
+func NewStatService(progressRepo domain.ProgressRepository, readingRepo domain.ReadingRepository,
+	goalRepo domain.GoalRepository) *StatService {
 	return &StatService{
 		progressRepo: progressRepo,
+		readingRepo:  readingRepo,
 		goalRepo:     goalRepo,
 	}
 }
 
 func (s *StatService) GetProgress(ctx context.Context, userID, year, month int64, isMonthly bool) (dto.StatResponse, error) {
-	var goalLine int64
-	goal, err := s.goalRepo.GetGoalByUserID(ctx, userID)
-	if errors.Is(err, domain.ErrRecordNotFound) {
-		goalLine = 0
-	} else if err != nil {
+	goals, err := s.goalRepo.GetGoalsByUserID(ctx, userID)
+	if err != nil {
 		return dto.StatResponse{}, err
-	} else {
-		goalLine = s.calculateGoalLine(goal, isMonthly)
 	}
 
-	var res []dto.Progress
+	var res, finished []dto.Progress
 	if isMonthly {
 		res, err = s.progressRepo.GetMonthlyProgress(ctx, userID, year)
+		if err == nil {
+			finished, err = s.readingRepo.GetMonthlyFinishedReadings(ctx, userID, year)
+		}
 	} else {
 		res, err = s.progressRepo.GetDailyProgress(ctx, userID, year, month)
+		if err == nil {
+			finished, err = s.readingRepo.GetDailyFinishedReadings(ctx, userID, year, month)
+		}
 	}
 	if err != nil {
 		return dto.StatResponse{}, err
 	}
 
+	goalLine, goalType := calculateGoalLine(goals, year, month, isMonthly)
+	// Months differ in length, so every month of the monthly chart has its
+	// own goal line and Goal is the longest.
+	var monthGoals []int64
+	if isMonthly && goalType != "" {
+		monthGoals = make([]int64, 12)
+		goalLine = 0
+		for i := range monthGoals {
+			monthGoals[i], _ = calculateGoalLine(goals, year, int64(i+1), isMonthly)
+			goalLine = max(goalLine, monthGoals[i])
+		}
+	}
+
 	return dto.StatResponse{
-		Progress: res,
-		Goal:     goalLine,
+		Progress:   mergeFinished(res, finished),
+		Goal:       goalLine,
+		MonthGoals: monthGoals,
+		GoalType:   goalType,
 	}, nil
 }
 
-func (s *StatService) calculateGoalLine(goal domain.Goal, isMonthly bool) int64 {
-	if goal.Type == domain.GoalTypeBooks {
-		return 0
+// mergeFinished adds the finished reading counts to the pages read in the
+// same period, keeping the periods sorted.
+func mergeFinished(progress, finished []dto.Progress) []dto.Progress {
+	byDate := make(map[string]int, len(progress))
+	for i, p := range progress {
+		byDate[p.Date] = i
 	}
 
-	if isMonthly {
-		if goal.Frequency == domain.GoalFrequencyDaily {
-			return goal.Value * 30
+	for _, f := range finished {
+		if i, ok := byDate[f.Date]; ok {
+			progress[i].Books = f.Books
+			continue
 		}
-		return goal.Value
-	} else {
-		if goal.Frequency == domain.GoalFrequencyMonthly {
-			return goal.Value / 30
+		byDate[f.Date] = len(progress)
+		progress = append(progress, f)
+	}
+
+	sort.SliceStable(progress, func(i, j int) bool {
+		a, _ := strconv.Atoi(progress[i].Date)
+		b, _ := strconv.Atoi(progress[j].Date)
+		return a < b
+	})
+	return progress
+}
+
+// goalLineFrequencies lists the goal frequencies the goal line of the daily
+// and the monthly chart is taken from, best match first.
+var goalLineFrequencies = map[bool][]string{
+	false: {domain.GoalFrequencyDaily, domain.GoalFrequencyWeekly, domain.GoalFrequencyMonthly, domain.GoalFrequencyYearly},
+	true:  {domain.GoalFrequencyMonthly, domain.GoalFrequencyWeekly, domain.GoalFrequencyYearly, domain.GoalFrequencyDaily},
+}
+
+// calculateGoalLine picks the pages or minutes goal that best matches the
+// chart and returns its line for a bar in month with the goal type. Books
+// goals have no line.
+func calculateGoalLine(goals []domain.Goal, year, month int64, isMonthly bool) (int64, string) {
+	for _, frequency := range goalLineFrequencies[isMonthly] {
+		for _, goalType := range []string{domain.GoalTypePages, domain.GoalTypeMinutes} {
+			for _, goal := range goals {
+				if goal.Frequency == frequency && goal.Type == goalType {
+					return goalLine(goal, year, month, isMonthly), goal.Type
+				}
+			}
 		}
+	}
+	return 0, ""
+}
+
+// goalLine spreads the goal over one bar of the chart: a day of month or, on
+// the monthly chart, month itself.
+func goalLine(goal domain.Goal, year, month int64, isMonthly bool) int64 {
+	if goal.Frequency == domain.GoalFrequencyMonthly && isMonthly ||
+		goal.Frequency == domain.GoalFrequencyDaily && !isMonthly {
 		return goal.Value
 	}
+
+	daysInYear := float64(time.Date(int(year), time.December, 31, 0, 0, 0, 0, time.UTC).YearDay())
+	daysInMonth := float64(time.Date(int(year), time.Month(month)+1, 0, 0, 0, 0, 0, time.UTC).Day())
+	barDays := 1.0
+	if isMonthly {
+		barDays = daysInMonth
+	}
+
+	var periodDays float64
+	switch goal.Frequency {
+	case domain.GoalFrequencyDaily:
+		periodDays = 1
+	case domain.GoalFrequencyWeekly:
+		periodDays = 7
+	case domain.GoalFrequencyMonthly:
+		periodDays = daysInMonth
+	case domain.GoalFrequencyYearly:
+		periodDays = daysInYear
+	}
+
+	return int64(math.Round(float64(goal.Value) * barDays / periodDays))
 }
@@ -0,0 +1,101 @@
+package stat
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/rimvydascivilis/book-tracker/backend/domain"
+	"github.com/rimvydascivilis/book-tracker/backend/dto"
+	"github.com/rimvydascivilis/book-tracker/backend/internal/repository/memory"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMergeFinished(t *testing.T) {
+	progress := []dto.Progress{{Date: "3", Pages: 40}, {Date: "10", Pages: 12}}
+	finished := []dto.Progress{{Date: "10", Books: 2}, {Date: "4", Books: 1}}
+
+	merged := mergeFinished(progress, finished)
+
+	assert.Equal(t, []dto.Progress{
+		{Date: "3", Pages: 40},
+		{Date: "4", Books: 1},
+		{Date: "10", Pages: 12, Books: 2},
+	}, merged)
+}
+
+func TestCalculateGoalLine(t *testing.T) {
+	dailyPages := domain.Goal{Type: domain.GoalTypePages, Frequency: domain.GoalFrequencyDaily, Value: 20}
+	monthlyPages := domain.Goal{Type: domain.GoalTypePages, Frequency: domain.GoalFrequencyMonthly, Value: 580}
+	weeklyMinutes := domain.Goal{Type: domain.GoalTypeMinutes, Frequency: domain.GoalFrequencyWeekly, Value: 210}
+	yearlyBooks := domain.Goal{Type: domain.GoalTypeBooks, Frequency: domain.GoalFrequencyYearly, Value: 24}
+
+	tests := []struct {
+		name      string
+		goals     []domain.Goal
+		isMonthly bool
+		month     int64
+		line      int64
+		goalType  string
+	}{
+		{"daily goal on the daily chart", []domain.Goal{monthlyPages, dailyPages}, false, 2, 20, domain.GoalTypePages},
+		{"monthly goal on the monthly chart", []domain.Goal{dailyPages, monthlyPages}, true, 2, 580, domain.GoalTypePages},
+		// February 2024 has 29 days.
+		{"monthly goal spread over the days", []domain.Goal{monthlyPages}, false, 2, 20, domain.GoalTypePages},
+		{"monthly goal in a long month", []domain.Goal{monthlyPages}, false, 3, 19, domain.GoalTypePages},
+		{"daily goal on the monthly chart", []domain.Goal{dailyPages}, true, 2, 580, domain.GoalTypePages},
+		{"daily goal in a long month of the monthly chart", []domain.Goal{dailyPages}, true, 3, 620, domain.GoalTypePages},
+		{"weekly goal is closer than daily", []domain.Goal{dailyPages, weeklyMinutes}, true, 1, 930, domain.GoalTypeMinutes},
+		{"books goals have no line", []domain.Goal{yearlyBooks}, false, 2, 0, ""},
+		{"no goals", nil, true, 1, 0, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			line, goalType := calculateGoalLine(tt.goals, 2024, tt.month, tt.isMonthly)
+
+			assert.Equal(t, tt.line, line)
+			assert.Equal(t, tt.goalType, goalType)
+		})
+	}
+}
+
+// TestGetProgress_MonthlyGoalLines gives each month of the monthly chart the
+// line of a daily goal over its own days.
+func TestGetProgress_MonthlyGoalLines(t *testing.T) {
+	ctx := context.Background()
+	store := memory.NewStore()
+	user, err := memory.NewUserRepository(store).CreateUser(ctx, domain.User{Email: "user@example.com"})
+	require.NoError(t, err)
+	book, err := memory.NewBookRepository(store).CreateBook(ctx, domain.Book{UserID: user.ID, Title: "Dune"})
+	require.NoError(t, err)
+	reading, err := memory.NewReadingRepository(store).CreateReading(ctx, domain.Reading{
+		UserID: user.ID, BookID: book.ID, TotalPages: 500, CreatedAt: time.Now(), UpdatedAt: time.Now(),
+	})
+	require.NoError(t, err)
+	for _, day := range []time.Time{
+		time.Date(2024, 2, 10, 0, 0, 0, 0, time.UTC),
+		time.Date(2024, 3, 10, 0, 0, 0, 0, time.UTC),
+	} {
+		_, err = memory.NewProgressRepository(store).CreateProgress(ctx, domain.Progress{
+			UserID: user.ID, ReadingID: reading.ID, Pages: 10, ReadingDate: day,
+		})
+		require.NoError(t, err)
+	}
+	_, err = memory.NewGoalRepository(store).CreateGoal(ctx, domain.Goal{
+		UserID: user.ID, Type: domain.GoalTypePages, Frequency: domain.GoalFrequencyDaily, Value: 20,
+	})
+	require.NoError(t, err)
+	svc := NewStatService(memory.NewProgressRepository(store), memory.NewReadingRepository(store),
+		memory.NewGoalRepository(store))
+
+	stats, err := svc.GetProgress(ctx, user.ID, 2024, 0, true)
+
+	require.NoError(t, err)
+	require.Len(t, stats.MonthGoals, 12)
+	assert.Equal(t, int64(620), stats.MonthGoals[0])
+	assert.Equal(t, int64(580), stats.MonthGoals[1], "February 2024 has 29 days")
+	assert.Equal(t, int64(600), stats.MonthGoals[3])
+	assert.Equal(t, int64(620), stats.Goal)
+}
@@ -0,0 +1,83 @@
+package streak
+
+import (
+	"context"
+	"time"
+
+	"github.com/rimvydascivilis/book-tracker/backend/domain"
+	"github.com/rimvydascivilis/book-tracker/backend/dto"
+	"github.com/rimvydascivilis/book-tracker/backend/utils"
+)
+
+type StreakService struct {
+	progressRepo domain.ProgressRepository
+	userRepo     domain.UserRepository
+}
+
+func NewStreakService(progressRepo domain.ProgressRepository, userRepo domain.UserRepository) *StreakService {
+	return &StreakService{
+		progressRepo: progressRepo,
+		userRepo:     userRepo,
+	}
+}
+
+func (s *StreakService) GetStreaks(ctx context.Context, userID int64) (dto.StreakResponse, error) {
+	user, err := s.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return dto.StreakResponse{}, err
+	}
+
+	// Streaks are recomputed from every reading day, so progress logged for
+	// an earlier date closes the gap it fills.
+	days, err := s.progressRepo.GetReadingDays(ctx, userID)
+	if err != nil {
+		return dto.StreakResponse{}, err
+	}
+
+	today := utils.Today(user.Location())
+	return calculateStreaks(days, today, user), nil
+}
+
+// calculateStreaks counts runs of reading days, oldest first, as of today in
+// the user's timezone. Rest days between two reading days keep a run going
+// without adding to it, and reading on a rest day still counts. The current
+// streak holds until a day that is not a rest day passes without reading, so
+// it is not broken before today is over.
+func calculateStreaks(days []time.Time, today time.Time, user domain.User) dto.StreakResponse {
+	var res dto.StreakResponse
+	var run int64
+	var last time.Time
+	for _, day := range days {
+		if day.After(today) {
+			break
+		}
+		if !last.IsZero() && bridged(last, day, user) {
+			run++
+		} else {
+			run = 1
+		}
+		res.Longest = max(res.Longest, run)
+		last = day
+	}
+
+	if last.IsZero() {
+		return res
+	}
+	res.LastReadOn = last.Format("2006-01-02")
+	res.ReadToday = last.Equal(today)
+	if bridged(last, today, user) || res.ReadToday {
+		res.Current = run
+	}
+	return res
+}
+
+// bridged reports whether every day strictly between from and to is a rest
+// day, which is trivially true for consecutive days.
+func bridged(from, to time.Time, user domain.User) bool {
+	for day := from.AddDate(0, 0, 1); day.Before(to); day = day.AddDate(0, 0, 1) {
+		if !user.IsRestDay(day.Weekday()) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,107 @@
+package streak
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/rimvydascivilis/book-tracker/backend/domain"
+	"github.com/rimvydascivilis/book-tracker/backend/dto"
+	"github.com/rimvydascivilis/book-tracker/backend/internal/repository/memory"
+	"github.com/rimvydascivilis/book-tracker/backend/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// March 2024 starts on a Friday.
+func date(d int) time.Time {
+	return time.Date(2024, 3, d, 0, 0, 0, 0, time.UTC)
+}
+
+func TestCalculateStreaks(t *testing.T) {
+	days := []time.Time{date(1), date(2), date(3), date(5), date(6)}
+
+	streaks := calculateStreaks(days, date(7), domain.User{})
+
+	assert.Equal(t, dto.StreakResponse{Current: 2, Longest: 3, LastReadOn: "2024-03-06"}, streaks)
+}
+
+func TestCalculateStreaks_ReadToday(t *testing.T) {
+	days := []time.Time{date(5), date(6), date(7)}
+
+	streaks := calculateStreaks(days, date(7), domain.User{})
+
+	assert.Equal(t, dto.StreakResponse{Current: 3, Longest: 3, ReadToday: true, LastReadOn: "2024-03-07"}, streaks)
+}
+
+func TestCalculateStreaks_Broken(t *testing.T) {
+	days := []time.Time{date(4), date(5)}
+
+	streaks := calculateStreaks(days, date(7), domain.User{})
+
+	assert.Equal(t, int64(0), streaks.Current)
+	assert.Equal(t, int64(2), streaks.Longest)
+}
+
+func TestCalculateStreaks_RestDays(t *testing.T) {
+	user := domain.User{RestDays: []string{"saturday", "sunday"}}
+
+	// The weekend neither breaks the streak nor adds to it.
+	streaks := calculateStreaks([]time.Time{date(7), date(8), date(11)}, date(12), user)
+	assert.Equal(t, int64(3), streaks.Current)
+
+	// Reading on a rest day still counts.
+	streaks = calculateStreaks([]time.Time{date(7), date(8), date(9), date(11)}, date(12), user)
+	assert.Equal(t, int64(4), streaks.Current)
+
+	// A weekday without reading breaks it even after a rest day.
+	streaks = calculateStreaks([]time.Time{date(7), date(8), date(11)}, date(13), user)
+	assert.Equal(t, int64(0), streaks.Current)
+	assert.Equal(t, int64(3), streaks.Longest)
+
+	// Monday has not passed yet, so the streak from Friday is kept.
+	streaks = calculateStreaks([]time.Time{date(7), date(8)}, date(11), user)
+	assert.Equal(t, int64(2), streaks.Current)
+}
+
+func TestCalculateStreaks_NoReading(t *testing.T) {
+	streaks := calculateStreaks([]time.Time{}, date(7), domain.User{})
+
+	assert.Equal(t, dto.StreakResponse{}, streaks)
+}
+
+func TestGetStreaks_Backdated(t *testing.T) {
+	ctx := context.Background()
+	store := memory.NewStore()
+	user, err := memory.NewUserRepository(store).CreateUser(ctx, domain.User{Email: "user@example.com"})
+	require.NoError(t, err)
+	book, err := memory.NewBookRepository(store).CreateBook(ctx, domain.Book{UserID: user.ID, Title: "Dune"})
+	require.NoError(t, err)
+	reading, err := memory.NewReadingRepository(store).CreateReading(ctx, domain.Reading{
+		UserID: user.ID, BookID: book.ID, TotalPages: 300, CreatedAt: time.Now(), UpdatedAt: time.Now(),
+	})
+	require.NoError(t, err)
+	progressRepo := memory.NewProgressRepository(store)
+	svc := NewStreakService(progressRepo, memory.NewUserRepository(store))
+	today := utils.Date(utils.Now())
+	logOn := func(daysAgo int) {
+		_, err := progressRepo.CreateProgress(ctx, domain.Progress{
+			UserID: user.ID, ReadingID: reading.ID, Pages: 10, ReadingDate: today.AddDate(0, 0, -daysAgo),
+		})
+		require.NoError(t, err)
+	}
+
+	logOn(1)
+	logOn(3)
+	streaks, err := svc.GetStreaks(ctx, user.ID)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), streaks.Current)
+
+	// Progress logged late for the missed day joins both runs.
+	logOn(2)
+	streaks, err = svc.GetStreaks(ctx, user.ID)
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), streaks.Current)
+	assert.Equal(t, int64(3), streaks.Longest)
+	assert.False(t, streaks.ReadToday)
+}
@@ -3,8 +3,12 @@ package user
 import (
 	"context"
 	"errors"
+	"fmt"
+	"slices"
+	"strings"
 
 	"github.com/rimvydascivilis/book-tracker/backend/domain"
+	"github.com/rimvydascivilis/book-tracker/backend/dto"
 )
 
 type UserService struct {
@@ -35,3 +39,34 @@ func (a *UserService) GetOrCreateUser(ctx context.Context, email string) (domain
 
 	return user, nil
 }
+
+func (a *UserService) GetUser(ctx context.Context, userID int64) (domain.User, error) {
+	return a.userRepo.GetByID(ctx, userID)
+}
+
+func (a *UserService) UpdateUserSettings(ctx context.Context, userID int64, req dto.UserSettingsRequest) (domain.User, error) {
+	user, err := a.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return domain.User{}, err
+	}
+
+	user.Timezone = req.Timezone
+	if user.Timezone == "" {
+		user.Timezone = "UTC"
+	}
+	user.RestDays = nil
+	for _, day := range req.RestDays {
+		day = strings.ToLower(day)
+		if !slices.Contains(user.RestDays, day) {
+			user.RestDays = append(user.RestDays, day)
+		}
+	}
+	if len(user.RestDays) == 7 {
+		return domain.User{}, fmt.Errorf("%w: %s", domain.ErrValidation, "at least one day of the week must not be a rest day")
+	}
+	if err := a.validationSvc.ValidateStruct(user); err != nil {
+		return domain.User{}, err
+	}
+
+	return a.userRepo.UpdateUser(ctx, user)
+}
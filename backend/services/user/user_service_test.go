@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	"github.com/rimvydascivilis/book-tracker/backend/domain"
+	"github.com/rimvydascivilis/book-tracker/backend/dto"
 	"github.com/rimvydascivilis/book-tracker/backend/mocks"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -71,3 +72,37 @@ func TestUserService_GetOrCreateUser_Error(t *testing.T) {
 
 	userRepo.AssertExpectations(t)
 }
+
+func TestUserService_UpdateUserSettings(t *testing.T) {
+	userService, userRepo, validationSvc := setupUserService()
+
+	ctx := context.Background()
+	stored := domain.User{ID: 1, Email: "user@example.com", Timezone: "Europe/Vilnius", RestDays: []string{"sunday"}}
+	expected := domain.User{ID: 1, Email: "user@example.com", Timezone: "UTC", RestDays: []string{"saturday", "sunday"}}
+
+	userRepo.On("GetByID", ctx, int64(1)).Return(stored, nil)
+	validationSvc.On("ValidateStruct", expected).Return(nil)
+	userRepo.On("UpdateUser", ctx, expected).Return(expected, nil)
+
+	user, err := userService.UpdateUserSettings(ctx, 1, dto.UserSettingsRequest{RestDays: []string{"Saturday", "sunday", "SUNDAY"}})
+
+	assert.NoError(t, err)
+	assert.Equal(t, expected, user)
+	userRepo.AssertExpectations(t)
+	validationSvc.AssertExpectations(t)
+}
+
+func TestUserService_UpdateUserSettings_EveryDayRest(t *testing.T) {
+	userService, userRepo, validationSvc := setupUserService()
+
+	ctx := context.Background()
+	userRepo.On("GetByID", ctx, int64(1)).Return(domain.User{ID: 1, Email: "user@example.com"}, nil)
+
+	_, err := userService.UpdateUserSettings(ctx, 1, dto.UserSettingsRequest{
+		RestDays: []string{"monday", "tuesday", "wednesday", "thursday", "friday", "saturday", "sunday"},
+	})
+
+	assert.ErrorIs(t, err, domain.ErrValidation)
+	validationSvc.AssertNotCalled(t, "ValidateStruct", mock.Anything)
+	userRepo.AssertNotCalled(t, "UpdateUser", mock.Anything, mock.Anything)
+}
@@ -3,6 +3,7 @@ package validation
 import (
 	"errors"
 	"fmt"
+	"strings"
 
 	"github.com/go-playground/validator/v10"
 	"github.com/rimvydascivilis/book-tracker/backend/domain"
@@ -45,6 +46,8 @@ func formatFieldError(fe validator.FieldError) string {
 		return fmt.Sprintf("The %s field must be at most %s characters long.", fe.Field(), fe.Param())
 	case "min":
 		return fmt.Sprintf("The %s field must be at least %s characters long.", fe.Field(), fe.Param())
+	case "isbn10", "isbn13":
+		return fmt.Sprintf("The %s field must be a valid %s.", fe.Field(), strings.ToUpper(fe.Tag()))
 	default:
 		return fmt.Sprintf("The %s field is invalid.", fe.Field())
 	}
@@ -0,0 +1,93 @@
+package utils
+
+import "strings"
+
+// NormalizeISBN strips the hyphens and spaces ISBNs are usually printed with
+// and upper-cases the ISBN-10 "X" check digit.
+func NormalizeISBN(isbn string) string {
+	isbn = strings.NewReplacer("-", "", " ", "").Replace(isbn)
+	return strings.ToUpper(strings.TrimSpace(isbn))
+}
+
+func ValidISBN10(isbn string) bool {
+	if len(isbn) != 10 {
+		return false
+	}
+
+	sum := 0
+	for i := 0; i < 10; i++ {
+		c := isbn[i]
+		var digit int
+		switch {
+		case c >= '0' && c <= '9':
+			digit = int(c - '0')
+		case c == 'X' && i == 9:
+			digit = 10
+		default:
+			return false
+		}
+		sum += (10 - i) * digit
+	}
+
+	return sum%11 == 0
+}
+
+func ValidISBN13(isbn string) bool {
+	if len(isbn) != 13 || !isDigits(isbn) {
+		return false
+	}
+
+	return isbn13CheckDigit(isbn[:12]) == isbn[12]
+}
+
+// ISBN10To13 converts a valid ISBN-10 to its "978" ISBN-13 form.
+func ISBN10To13(isbn10 string) (string, bool) {
+	if !ValidISBN10(isbn10) {
+		return "", false
+	}
+
+	prefix := "978" + isbn10[:9]
+	return prefix + string(isbn13CheckDigit(prefix)), true
+}
+
+// ISBN13To10 converts a valid ISBN-13 to ISBN-10. Only "978" ISBNs have an
+// ISBN-10 form.
+func ISBN13To10(isbn13 string) (string, bool) {
+	if !ValidISBN13(isbn13) || !strings.HasPrefix(isbn13, "978") {
+		return "", false
+	}
+
+	body := isbn13[3:12]
+	sum := 0
+	for i := 0; i < 9; i++ {
+		sum += (10 - i) * int(body[i]-'0')
+	}
+
+	check := (11 - sum%11) % 11
+	if check == 10 {
+		return body + "X", true
+	}
+	return body + string(rune('0'+check)), true
+}
+
+func isbn13CheckDigit(first12 string) byte {
+	sum := 0
+	for i := 0; i < 12; i++ {
+		digit := int(first12[i] - '0')
+		if i%2 == 1 {
+			digit *= 3
+		}
+		sum += digit
+	}
+
+	return byte('0' + (10-sum%10)%10)
+}
+
+func isDigits(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,50 @@
+package utils_test
+
+import (
+	"testing"
+
+	"github.com/rimvydascivilis/book-tracker/backend/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalizeISBN(t *testing.T) {
+	assert.Equal(t, "978014303943X", utils.NormalizeISBN(" 978-0-14-303943-x "))
+	assert.Equal(t, "0441013597", utils.NormalizeISBN("0 441 01359 7"))
+}
+
+func TestValidISBN10(t *testing.T) {
+	assert.True(t, utils.ValidISBN10("0441013597"))
+	assert.True(t, utils.ValidISBN10("080442957X"))
+	assert.False(t, utils.ValidISBN10("0441013598"))
+	assert.False(t, utils.ValidISBN10("X441013597"))
+	assert.False(t, utils.ValidISBN10("044101359"))
+}
+
+func TestValidISBN13(t *testing.T) {
+	assert.True(t, utils.ValidISBN13("9780441013593"))
+	assert.False(t, utils.ValidISBN13("9780441013594"))
+	assert.False(t, utils.ValidISBN13("978044101359X"))
+	assert.False(t, utils.ValidISBN13("978044101359"))
+}
+
+func TestISBN10To13(t *testing.T) {
+	isbn13, ok := utils.ISBN10To13("0441013597")
+	assert.True(t, ok)
+	assert.Equal(t, "9780441013593", isbn13)
+
+	_, ok = utils.ISBN10To13("0441013598")
+	assert.False(t, ok)
+}
+
+func TestISBN13To10(t *testing.T) {
+	isbn10, ok := utils.ISBN13To10("9780441013593")
+	assert.True(t, ok)
+	assert.Equal(t, "0441013597", isbn10)
+
+	isbn10, ok = utils.ISBN13To10("9780804429573")
+	assert.True(t, ok)
+	assert.Equal(t, "080442957X", isbn10)
+
+	_, ok = utils.ISBN13To10("9791032305690")
+	assert.False(t, ok)
+}
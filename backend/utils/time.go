@@ -5,3 +5,19 @@ import "time"
 func Now() time.Time {
 	return time.Now().UTC()
 }
+
+// Date drops the time of day, keeping the calendar day of t in its own
+// location. Dates are stored without a time zone.
+func Date(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+// DateIn is the calendar day of the instant t in loc.
+func DateIn(t time.Time, loc *time.Location) time.Time {
+	return Date(t.In(loc))
+}
+
+// Today is the current day in loc.
+func Today(loc *time.Location) time.Time {
+	return DateIn(Now(), loc)
+}
@@ -0,0 +1,47 @@
+package utils_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/rimvydascivilis/book-tracker/backend/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDateIn_DST(t *testing.T) {
+	vilnius, err := time.LoadLocation("Europe/Vilnius")
+	require.NoError(t, err)
+	newYork, err := time.LoadLocation("America/New_York")
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		instant time.Time
+		loc     *time.Location
+		day     string
+	}{
+		// Vilnius moved from UTC+2 to UTC+3 at 01:00 UTC on 2024-03-31.
+		{"before spring forward", time.Date(2024, 3, 30, 21, 59, 0, 0, time.UTC), vilnius, "2024-03-30"},
+		{"midnight before spring forward", time.Date(2024, 3, 30, 22, 0, 0, 0, time.UTC), vilnius, "2024-03-31"},
+		{"midnight after spring forward", time.Date(2024, 3, 31, 21, 0, 0, 0, time.UTC), vilnius, "2024-04-01"},
+		{"last hour of the short day", time.Date(2024, 3, 31, 20, 59, 0, 0, time.UTC), vilnius, "2024-03-31"},
+		// New York moved from UTC-4 to UTC-5 at 06:00 UTC on 2024-11-03.
+		{"repeated hour", time.Date(2024, 11, 3, 6, 30, 0, 0, time.UTC), newYork, "2024-11-03"},
+		{"last hour of the long day", time.Date(2024, 11, 4, 4, 30, 0, 0, time.UTC), newYork, "2024-11-03"},
+		{"midnight after fall back", time.Date(2024, 11, 4, 5, 0, 0, 0, time.UTC), newYork, "2024-11-04"},
+	}
+
+	for _, tt := range tests {
+		day := utils.DateIn(tt.instant, tt.loc)
+		assert.Equal(t, tt.day, day.Format("2006-01-02"), tt.name)
+		assert.Equal(t, time.UTC, day.Location(), tt.name)
+		assert.Zero(t, day.Hour(), tt.name)
+	}
+}
+
+func TestDate_KeepsOwnDay(t *testing.T) {
+	late := time.Date(2024, 3, 10, 23, 30, 0, 0, time.FixedZone("UTC-8", -8*60*60))
+
+	assert.Equal(t, time.Date(2024, 3, 10, 0, 0, 0, 0, time.UTC), utils.Date(late))
+}
@@ -1,8 +1,16 @@
-import axios from 'axios';
-import {getToken} from '../service/TokenService';
+import axios, {AxiosError, InternalAxiosRequestConfig} from 'axios';
+import {
+  getRefreshToken,
+  getToken,
+  removeToken,
+  setToken,
+} from '../service/TokenService';
+
+const baseURL =
+  process.env.REACT_APP_API_BASE_URL || 'http://localhost:8081/api';
 
 const api = axios.create({
-  baseURL: process.env.REACT_APP_API_BASE_URL || 'http://localhost:8081/api',
+  baseURL,
   timeout: 10000, // 10 seconds
 });
 
@@ -14,11 +22,54 @@ api.interceptors.request.use(
     }
     return config;
   },
-  error => {
-    if (error.response.status === 401) {
-      // TODO: Handle unauthorized error
+  error => Promise.reject(error),
+);
+
+// Access tokens are short-lived: on a 401 the refresh token is exchanged
+// once for new tokens and the request retried. Concurrent requests share
+// the same refresh, as each refresh token can only be used once.
+let refreshing: Promise<string> | null = null;
+
+const refreshTokens = async (): Promise<string> => {
+  const refreshToken = getRefreshToken();
+  if (!refreshToken) {
+    throw new Error('no refresh token');
+  }
+  const response = await axios.post(`${baseURL}/auth/refresh`, {
+    refresh_token: refreshToken,
+  });
+  setToken(response.data.token, response.data.refresh_token);
+  return response.data.token;
+};
+
+api.interceptors.response.use(
+  response => response,
+  async (error: AxiosError) => {
+    const config = error.config as
+      | (InternalAxiosRequestConfig & {retried?: boolean})
+      | undefined;
+    if (
+      error.response?.status !== 401 ||
+      !config ||
+      config.retried ||
+      config.url === '/auth/login'
+    ) {
+      return Promise.reject(error);
+    }
+
+    try {
+      refreshing = refreshing || refreshTokens();
+      const token = await refreshing;
+      config.retried = true;
+      config.headers['Authorization'] = `Bearer ${token}`;
+      return api(config);
+    } catch {
+      removeToken();
+      window.location.reload();
+      return Promise.reject(error);
+    } finally {
+      refreshing = null;
     }
-    return Promise.reject(error);
   },
 );
 
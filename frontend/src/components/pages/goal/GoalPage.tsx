@@ -6,6 +6,7 @@ import {
   Button,
   Typography,
   Select,
+  List,
   message,
 } from 'antd';
 import {IGoal} from '../../../types/goalTypes';
@@ -13,50 +14,68 @@ import {IAxiosError} from '../../../types/errorTypes';
 import api from '../../../api/api';
 import Loading from '../../common/Loading';
 
-const {Title, Text} = Typography;
+const {Title} = Typography;
 const {Option} = Select;
 
 const GoalPage: React.FC = () => {
-  const [goal, setGoal] = useState<IGoal | null>(null);
+  const [goals, setGoals] = useState<IGoal[] | null>(null);
 
   useEffect(() => {
-    fetchGoal();
+    fetchGoals();
   }, []);
 
-  const fetchGoal = async () => {
+  const fetchGoals = async () => {
     try {
-      const response = await api.get('/goal');
-      const data = response.data as IGoal;
-      setGoal(data);
+      const response = await api.get('/goals');
+      setGoals((response.data.goals as IGoal[]) || []);
     } catch (error) {
       const axiosError = error as IAxiosError;
-      if (axiosError.response?.status === 404) {
-        // Goal not found, set default goal
-        setGoal({type: 'books', frequency: 'daily', value: 0});
-        return;
-      }
-
       message.error(
-        'Failed to fetch current goal: ' +
+        'Failed to fetch goals: ' +
           (axiosError.response?.data.message || 'Network error'),
       );
     }
   };
 
-  const updateGoal = async (values: IGoal) => {
+  // There is at most one goal per type and frequency, so setting one that
+  // exists changes its value.
+  const saveGoal = async (values: IGoal) => {
+    const existing = goals?.find(
+      goal => goal.type === values.type && goal.frequency === values.frequency,
+    );
     try {
-      const response = await api.put('/goal', values);
+      const response = existing
+        ? await api.put(`/goals/${existing.id}`, {value: values.value})
+        : await api.post('/goals', values);
 
       if (!response.data) {
         throw new Error('Invalid response');
       }
 
-      setGoal(values);
-      message.success('Goal updated successfully');
+      const saved = response.data as IGoal;
+      setGoals(prevGoals => [
+        ...(prevGoals || []).filter(goal => goal.id !== saved.id),
+        saved,
+      ]);
+      message.success('Goal saved successfully');
+    } catch (error) {
+      const axiosError = error as IAxiosError;
+      message.error(
+        'Failed to save goal: ' +
+          (axiosError.response?.data.message || 'Network error'),
+      );
+    }
+  };
+
+  const deleteGoal = async (goalID: number) => {
+    try {
+      await api.delete(`/goals/${goalID}`);
+      setGoals(prevGoals => (prevGoals || []).filter(g => g.id !== goalID));
+      message.success('Goal removed');
     } catch (error) {
       const axiosError = error as IAxiosError;
       message.error(
-        'Failed to update goal: ' +
+        'Failed to remove goal: ' +
           (axiosError.response?.data.message || 'Network error'),
       );
     }
@@ -67,14 +86,14 @@ const GoalPage: React.FC = () => {
     frequency: IGoal['frequency'];
     value: number;
   }) => {
-    updateGoal({
+    saveGoal({
       type: values.type,
       frequency: values.frequency,
       value: values.value,
     });
   };
 
-  if (goal === null) {
+  if (goals === null) {
     return <Loading />;
   }
 
@@ -90,12 +109,12 @@ const GoalPage: React.FC = () => {
       }}>
       <div style={{maxWidth: '400px', width: '100%'}}>
         <Title level={3} style={{textAlign: 'center'}}>
-          Change Your Reading Goal
+          Set Your Reading Goals
         </Title>
         <Form
           layout="vertical"
           onFinish={onFinish}
-          initialValues={goal}
+          initialValues={{type: 'books', frequency: 'daily'}}
           style={{marginTop: '24px'}}>
           <Form.Item
             label="Goal Type"
@@ -106,6 +125,7 @@ const GoalPage: React.FC = () => {
             <Select>
               <Option value="books">Books</Option>
               <Option value="pages">Pages</Option>
+              <Option value="minutes">Minutes</Option>
             </Select>
           </Form.Item>
 
@@ -117,7 +137,9 @@ const GoalPage: React.FC = () => {
             ]}>
             <Select>
               <Option value="daily">Daily</Option>
+              <Option value="weekly">Weekly</Option>
               <Option value="monthly">Monthly</Option>
+              <Option value="yearly">Yearly</Option>
             </Select>
           </Form.Item>
 
@@ -141,14 +163,25 @@ const GoalPage: React.FC = () => {
           </Form.Item>
         </Form>
 
-        {goal.value > 0 && (
-          <Text
-            style={{display: 'block', marginTop: '24px', textAlign: 'center'}}>
-            Current Goal:{' '}
-            <strong>
-              {goal.value} {goal.type} ({goal.frequency})
-            </strong>
-          </Text>
+        {goals.length > 0 && (
+          <List
+            header={<strong>Current Goals</strong>}
+            dataSource={goals}
+            renderItem={goal => (
+              <List.Item
+                actions={[
+                  <Button
+                    key="remove"
+                    type="link"
+                    danger
+                    onClick={() => goal.id && deleteGoal(goal.id)}>
+                    Remove
+                  </Button>,
+                ]}>
+                {goal.value} {goal.type} ({goal.frequency})
+              </List.Item>
+            )}
+          />
         )}
       </div>
     </Layout>
@@ -25,7 +25,7 @@ const LoginPage: React.FC = () => {
       const response = await api.post('/auth/login', {
         token: credentialResponse.credential,
       });
-      login(response.data.token);
+      login(response.data.token, response.data.refresh_token);
       message.success('Login successful!');
     } catch (error) {
       const axiosError = error as IAxiosError;
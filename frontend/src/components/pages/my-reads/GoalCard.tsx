@@ -1,58 +1,52 @@
-import React, {useEffect, useState} from 'react';
-import {Card, Progress, message} from 'antd';
-import api from '../../../api/api';
-import {IAxiosError} from '../../../types/errorTypes';
-import {IGoal, IGoalProgress} from '../../../types/goalTypes';
+import React from 'react';
+import {Card, Progress} from 'antd';
+import {IGoal, IGoalProgressResponse} from '../../../types/goalTypes';
 
 interface GoalCardProps {
-  progress: IGoalProgress;
+  progress: IGoalProgressResponse;
 }
 
-const GoalCard: React.FC<GoalCardProps> = ({progress}) => {
-  const [goal, setGoal] = useState<IGoal | null>(null);
-
-  const fetchGoal = async () => {
-    try {
-      const {data} = await api.get('/goal');
-      setGoal(data);
-    } catch (error) {
-      const axiosError = error as IAxiosError;
-      if (axiosError.response?.status === 404) {
-        message.info('Set a goal to track your reading progress');
-      } else {
-        message.error(
-          'Failed to fetch goal: ' +
-            (axiosError.response?.data.message || 'Network error'),
-        );
-      }
-    }
-  };
-
-  useEffect(() => {
-    fetchGoal();
-  }, []);
+const periodNames: Record<IGoal['frequency'], [string, string]> = {
+  daily: ['day', 'today'],
+  weekly: ['week', 'this week'],
+  monthly: ['month', 'this month'],
+  yearly: ['year', 'this year'],
+};
 
-  if (!goal) {
+const GoalCard: React.FC<GoalCardProps> = ({progress}) => {
+  if (progress.goals.length === 0) {
     return null;
   }
 
   return (
     <Card style={{marginBottom: '16px', textAlign: 'center'}}>
-      <h2>
-        {goal.value} {goal.type} per{' '}
-        {goal.frequency === 'daily' ? 'day' : 'month'}
-      </h2>
-      <Progress
-        percent={Math.floor(progress.percentage)}
-        status={progress.left === 0 ? 'success' : 'active'}
-      />
-
-      {progress.left === 0 ? (
-        <p>Congratulations! You have reached your goal</p>
-      ) : (
+      {progress.goals.map(goal => {
+        const [period, current] = periodNames[goal.frequency];
+        return (
+          <div key={goal.goal_id}>
+            <h2>
+              {goal.value} {goal.type} per {period}
+            </h2>
+            <Progress
+              percent={Math.floor(goal.percentage)}
+              status={goal.left === 0 ? 'success' : 'active'}
+            />
+
+            {goal.left === 0 ? (
+              <p>Congratulations! You have reached your goal</p>
+            ) : (
+              <p>
+                You have {goal.left} {goal.type} left to read to reach your
+                goal {current}
+              </p>
+            )}
+          </div>
+        );
+      })}
+      {progress.streaks.current > 0 && (
         <p>
-          You have {progress.left} {goal.type} left to read to reach your goal{' '}
-          {goal.frequency === 'daily' ? 'today' : 'this month'}
+          Current streak: <strong>{progress.streaks.current}</strong>{' '}
+          {progress.streaks.current === 1 ? 'day' : 'days'}
         </p>
       )}
     </Card>
@@ -12,13 +12,13 @@ import {PlusOutlined} from '@ant-design/icons';
 import api from '../../../api/api';
 import GoalCard from './GoalCard';
 import {IAxiosError} from '../../../types/errorTypes';
-import {IGoalProgress} from '../../../types/goalTypes';
+import {IGoalProgressResponse} from '../../../types/goalTypes';
 
 const MyReadsPage: React.FC = () => {
   const [readings, setReadings] = useState<ICombinedReading[]>([]);
-  const [goalProgress, setGoalProgress] = useState<IGoalProgress>({
-    percentage: 0,
-    left: 0,
+  const [goalProgress, setGoalProgress] = useState<IGoalProgressResponse>({
+    goals: [],
+    streaks: {current: 0, longest: 0, read_today: false},
   });
   const [isReadingFormVisible, setIsReadingFormVisible] =
     useState<boolean>(false);
@@ -64,23 +64,25 @@ const MyReadsPage: React.FC = () => {
 
   const fetchGoalProgress = async () => {
     try {
-      const {data} = await api.get('/goal/progress');
-      setGoalProgress(data);
+      const {data} = await api.get('/goals/progress');
+      const progress = data as IGoalProgressResponse;
+      setGoalProgress({...progress, goals: progress.goals || []});
+      return progress;
     } catch (error) {
       const axiosError = error as IAxiosError;
-      if (axiosError.response?.status === 404) {
-        message.info('Set a goal to track your reading progress');
-      } else {
-        message.error(
-          'Failed to fetch goal progress: ' +
-            (axiosError.response?.data.message || 'Network error'),
-        );
-      }
+      message.error(
+        'Failed to fetch goal progress: ' +
+          (axiosError.response?.data.message || 'Network error'),
+      );
     }
   };
 
   useEffect(() => {
-    fetchGoalProgress();
+    fetchGoalProgress().then(progress => {
+      if (progress && !progress.goals?.length) {
+        message.info('Set a goal to track your reading progress');
+      }
+    });
   }, []);
 
   const handleAddReading = async (newReading: IReadingFormValues) => {
@@ -1,8 +1,9 @@
 import React, {useState, useEffect} from 'react';
 import {DatePicker, Select, message, Row, Col, Typography, Card} from 'antd';
 import {
-  BarChart,
+  ComposedChart,
   Bar,
+  Line,
   XAxis,
   YAxis,
   CartesianGrid,
@@ -41,9 +42,12 @@ const StatsPage: React.FC = () => {
 
         if (frequency === 'monthly') {
           const months = Array.from({length: 12}, (_, i) => String(i + 1));
-          fetchedStats.progress = months.map(month => {
+          fetchedStats.progress = months.map((month, i) => {
             const existing = fetchedStats.progress.find(p => p.date === month);
-            return existing || {date: month, pages: 0};
+            return {
+              ...(existing || {date: month, pages: 0}),
+              goal: fetchedStats.month_goals?.[i],
+            };
           });
         } else if (frequency === 'daily' && month) {
           const daysInMonth = new Date(year, month, 0).getDate();
@@ -72,6 +76,7 @@ const StatsPage: React.FC = () => {
     stats?.progress.map(item => ({
       date: item.date,
       pages: item.pages,
+      goal: item.goal,
     })) || [];
 
   const maxPages = Math.max(...data.map(item => item.pages), 0);
@@ -120,7 +125,7 @@ const StatsPage: React.FC = () => {
         </Row>
 
         {stats ? (
-          <BarChart
+          <ComposedChart
             style={{margin: 'auto'}}
             width={800}
             height={400}
@@ -132,15 +137,27 @@ const StatsPage: React.FC = () => {
             <Tooltip />
             <Legend />
             <Bar dataKey="pages" fill="#8884d8" name="Pages Read" />
-            {stats.goal && (
-              <ReferenceLine
-                y={stats.goal}
-                label="Goal"
+            {stats.month_goals ? (
+              // Months differ in length, so each has its own goal line.
+              <Line
+                type="step"
+                dataKey="goal"
+                name="Goal"
                 stroke="red"
                 strokeDasharray="3 3"
+                dot={false}
               />
+            ) : (
+              stats.goal && (
+                <ReferenceLine
+                  y={stats.goal}
+                  label="Goal"
+                  stroke="red"
+                  strokeDasharray="3 3"
+                />
+              )
             )}
-          </BarChart>
+          </ComposedChart>
         ) : (
           <Title level={4} style={{textAlign: 'center'}}>
             No Data Available
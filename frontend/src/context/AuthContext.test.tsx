@@ -0,0 +1,60 @@
+import React from 'react';
+import {act, renderHook} from '@testing-library/react';
+import {InternalAxiosRequestConfig} from 'axios';
+import api from '../api/api';
+import {AuthProvider, useAuth} from './AuthContext';
+
+describe('AuthContext', () => {
+  let requests: InternalAxiosRequestConfig[];
+
+  beforeEach(() => {
+    requests = [];
+    api.defaults.adapter = async config => {
+      requests.push(config);
+      return {
+        data: '',
+        status: 204,
+        statusText: 'No Content',
+        headers: {},
+        config,
+      };
+    };
+    localStorage.setItem('token', 'access_token');
+    localStorage.setItem('refresh_token', 'refresh_token');
+  });
+
+  afterEach(() => {
+    localStorage.clear();
+  });
+
+  const wrapper = ({children}: {children: React.ReactNode}) => (
+    <AuthProvider>{children}</AuthProvider>
+  );
+
+  it('revokes the session with the current token on logout', async () => {
+    const {result} = renderHook(() => useAuth(), {wrapper});
+    expect(result.current.isAuthenticated).toBe(true);
+
+    await act(() => result.current.logout());
+
+    expect(requests).toHaveLength(1);
+    expect(requests[0].method).toBe('post');
+    expect(requests[0].url).toBe('/auth/logout');
+    expect(requests[0].headers.Authorization).toBe('Bearer access_token');
+    expect(localStorage.getItem('token')).toBeNull();
+    expect(localStorage.getItem('refresh_token')).toBeNull();
+    expect(result.current.isAuthenticated).toBe(false);
+  });
+
+  it('signs out locally when the server cannot be reached', async () => {
+    api.defaults.adapter = async () => {
+      throw new Error('Network Error');
+    };
+    const {result} = renderHook(() => useAuth(), {wrapper});
+
+    await act(() => result.current.logout());
+
+    expect(localStorage.getItem('token')).toBeNull();
+    expect(result.current.isAuthenticated).toBe(false);
+  });
+});
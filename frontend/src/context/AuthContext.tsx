@@ -1,10 +1,16 @@
 import React, {createContext, useContext, useState, useEffect} from 'react';
-import {isTokenSet, setToken, removeToken} from '../service/TokenService';
+import api from '../api/api';
+import {
+  getToken,
+  isTokenSet,
+  setToken,
+  removeToken,
+} from '../service/TokenService';
 
 interface AuthContextType {
   isAuthenticated: boolean;
-  login: (token: string) => void;
-  logout: () => void;
+  login: (token: string, refreshToken: string) => void;
+  logout: () => Promise<void>;
 }
 
 const AuthContext = createContext<AuthContextType | undefined>(undefined);
@@ -14,14 +20,27 @@ export const AuthProvider: React.FC<{children: React.ReactNode}> = ({
 }) => {
   const [isAuthenticated, setIsAuthenticated] = useState<boolean>(isTokenSet());
 
-  const login = (token: string) => {
-    setToken(token);
+  const login = (token: string, refreshToken: string) => {
+    setToken(token, refreshToken);
     setIsAuthenticated(true);
   };
 
-  const logout = () => {
-    removeToken();
-    setIsAuthenticated(false);
+  const logout = async () => {
+    // Signs this device out on the server too; the local tokens go either
+    // way, but only once the request has been sent with them.
+    const token = getToken();
+    try {
+      if (token) {
+        await api.post('/auth/logout', null, {
+          headers: {Authorization: `Bearer ${token}`},
+        });
+      }
+    } catch {
+      // The session expires on its own.
+    } finally {
+      removeToken();
+      setIsAuthenticated(false);
+    }
   };
 
   useEffect(() => {
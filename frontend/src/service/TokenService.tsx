@@ -1,17 +1,26 @@
 const tokenKey = 'token';
+const refreshTokenKey = 'refresh_token';
 
-export function setToken(token: string): void {
+export function setToken(token: string, refreshToken?: string): void {
   localStorage.setItem(tokenKey, token);
+  if (refreshToken) {
+    localStorage.setItem(refreshTokenKey, refreshToken);
+  }
 }
 
 export const removeToken = (): void => {
   localStorage.removeItem(tokenKey);
+  localStorage.removeItem(refreshTokenKey);
 };
 
 export const getToken = (): string | null => {
   return localStorage.getItem(tokenKey);
 };
 
+export const getRefreshToken = (): string | null => {
+  return localStorage.getItem(refreshTokenKey);
+};
+
 export const isTokenSet = (): boolean => {
   return localStorage.getItem(tokenKey) !== null;
 };
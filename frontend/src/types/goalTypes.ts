@@ -1,10 +1,30 @@
 export interface IGoal {
-  type: 'books' | 'pages';
-  frequency: 'daily' | 'monthly';
+  id?: number;
+  type: 'books' | 'pages' | 'minutes';
+  frequency: 'daily' | 'weekly' | 'monthly' | 'yearly';
   value: number;
 }
 
 export interface IGoalProgress {
+  goal_id: number;
+  type: IGoal['type'];
+  frequency: IGoal['frequency'];
+  value: number;
+  progress: number;
   percentage: number;
   left: number;
+  period_start: string;
+  period_end: string;
+}
+
+export interface IStreaks {
+  current: number;
+  longest: number;
+  read_today: boolean;
+  last_read_on?: string;
+}
+
+export interface IGoalProgressResponse {
+  goals: IGoalProgress[];
+  streaks: IStreaks;
 }
@@ -1,9 +1,12 @@
 export interface IProgressData {
   date: string;
   pages: number;
+  goal?: number;
 }
 
 export interface IStatResponse {
   progress: IProgressData[];
   goal: number;
+  // The goal line of each month of the monthly chart, January first.
+  month_goals?: number[];
 }